@@ -0,0 +1,62 @@
+// Package adminapi contains the helpers shared by the admin http APIs of the node components
+package adminapi
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/log"
+)
+
+const serverTimeout = 10 * time.Second
+
+// Start listens on the provided host and port and serves the admin API handler
+// in the background, the returned server must be closed by the caller
+func Start(name, host string, port int, handler http.Handler) (*http.Server, error) {
+	address := fmt.Sprintf("%s:%d", host, port)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for the %s admin API: %w", name, err)
+	}
+
+	srv := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: serverTimeout,
+		ReadTimeout:       serverTimeout,
+	}
+
+	go func() {
+		log.Infof("%s admin API listening on port %d", name, port)
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf("failed to serve the %s admin API, error: %v", name, err)
+		}
+	}()
+
+	return srv, nil
+}
+
+// BearerAuth rejects the requests without the provided bearer token in the Authorization header
+func BearerAuth(authToken string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !found || subtle.ConstantTimeCompare([]byte(token), []byte(authToken)) != 1 {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// WriteResponse writes the result as the json body of the response
+func WriteResponse(w http.ResponseWriter, result interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		log.Errorf("failed to write admin API response, error: %v", err)
+	}
+}
@@ -0,0 +1,45 @@
+package adminapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBearerAuth(t *testing.T) {
+	handler := BearerAuth("token", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		WriteResponse(w, map[string]bool{"ok": true})
+	}))
+
+	testCases := []struct {
+		name          string
+		authorization string
+		expectedCode  int
+	}{
+		{name: "no token", authorization: "", expectedCode: http.StatusUnauthorized},
+		{name: "not a bearer token", authorization: "token", expectedCode: http.StatusUnauthorized},
+		{name: "wrong token", authorization: "Bearer wrong", expectedCode: http.StatusUnauthorized},
+		{name: "valid token", authorization: "Bearer token", expectedCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			res := httptest.NewRecorder()
+			handler.ServeHTTP(res, req)
+			assert.Equal(t, tc.expectedCode, res.Code)
+		})
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+	assert.Equal(t, "application/json", res.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"ok":true}`, res.Body.String())
+}
@@ -0,0 +1,405 @@
+package aggregator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/adminapi"
+	"github.com/0xPolygonHermez/zkevm-node/aggregator/prover"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+)
+
+const (
+	adminProversEndpoint      = "/provers"
+	adminProofsEndpoint       = "/proofs"
+	adminCancelProofsEndpoint = "/proofs/cancel"
+	adminUnlockProofsEndpoint = "/proofs/unlock"
+	adminFinalProofEndpoint   = "/proofs/final"
+
+	proverStatusIdle    = "idle"
+	proverStatusWorking = "working"
+
+	// proofStatusPending is the status of a generated proof waiting to be
+	// aggregated or used to build the final proof
+	proofStatusPending = "pending"
+	// proofStatusGenerating is the status of a proof being generated by a prover
+	proofStatusGenerating = "generating"
+	// proofStatusLocked is the status of a generated proof locked by a prover
+	// to be aggregated or used to build the final proof
+	proofStatusLocked = "locked"
+)
+
+var (
+	// ErrAdminAuthTokenRequired is returned when the admin API is enabled without an auth token
+	ErrAdminAuthTokenRequired = errors.New("the auth token is required to enable the aggregator admin API")
+	// ErrInvalidProofRange is returned when the provided batch range is not valid
+	ErrInvalidProofRange = errors.New("invalid proof range, batchNumber must be lower or equal than batchNumberFinal")
+	// ErrProofRangeInUse is returned when trying to unlock proofs that are being
+	// used by a prover, the job of the prover must be cancelled instead
+	ErrProofRangeInUse = errors.New("proof range in use by a prover, cancel its job instead")
+)
+
+// proverJob is the task a connected prover is running
+type proverJob struct {
+	task  proverTask
+	since time.Time
+	// batchNumber and batchNumberFinal are the range of the batch proofs
+	// locked by the task, once locked is true
+	batchNumber      uint64
+	batchNumberFinal uint64
+	locked           bool
+	// proofID is the ID of the last proof requested to the prover by the task
+	proofID   string
+	cancel    context.CancelFunc
+	cancelled bool
+}
+
+// overlaps returns true if the proofs locked by the job overlap the provided range
+func (j *proverJob) overlaps(batchNumber, batchNumberFinal uint64) bool {
+	return j.locked && j.batchNumber <= batchNumberFinal && batchNumber <= j.batchNumberFinal
+}
+
+// connectedProver holds the information of a prover connected to the aggregator
+type connectedProver struct {
+	name        string
+	id          string
+	addr        string
+	forkIDs     []uint64
+	connectedAt time.Time
+	job         *proverJob
+}
+
+// proverRegistry keeps track of the connected provers and the jobs they are
+// running, so they can be inspected and cancelled from the admin API
+type proverRegistry struct {
+	mutex   sync.RWMutex
+	provers map[string]*connectedProver
+}
+
+func newProverRegistry() *proverRegistry {
+	return &proverRegistry{provers: make(map[string]*connectedProver)}
+}
+
+// add registers a connected prover
+func (r *proverRegistry) add(prover proverInterface, forkIDs []uint64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.provers[prover.ID()] = &connectedProver{
+		name:        prover.Name(),
+		id:          prover.ID(),
+		addr:        prover.Addr(),
+		forkIDs:     forkIDs,
+		connectedAt: time.Now(),
+	}
+}
+
+// remove unregisters a disconnected prover
+func (r *proverRegistry) remove(proverID string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.provers, proverID)
+}
+
+// startJob registers the task as the job of the prover and returns the context
+// the task must run with, it is cancelled when the job is cancelled
+func (r *proverRegistry) startJob(ctx context.Context, proverID string, task proverTask) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if p, found := r.provers[proverID]; found {
+		p.job = &proverJob{task: task, since: time.Now(), cancel: cancel}
+	}
+	return ctx, cancel
+}
+
+// endJob unregisters the job of the prover and returns it
+func (r *proverRegistry) endJob(proverID string) *proverJob {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	p, found := r.provers[proverID]
+	if !found {
+		return nil
+	}
+	job := p.job
+	p.job = nil
+	return job
+}
+
+// setJobBatchRange sets the range of the batch proofs locked by the job of the prover
+func (r *proverRegistry) setJobBatchRange(proverID string, batchNumber, batchNumberFinal uint64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if p, found := r.provers[proverID]; found && p.job != nil {
+		p.job.batchNumber = batchNumber
+		p.job.batchNumberFinal = batchNumberFinal
+		p.job.locked = true
+	}
+}
+
+// setJobProofID sets the ID of the proof requested to the prover by its job
+func (r *proverRegistry) setJobProofID(proverID string, proofID string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if p, found := r.provers[proverID]; found && p.job != nil {
+		p.job.proofID = proofID
+	}
+}
+
+// jobInRange returns the ID of a prover whose job has locked proofs overlapping
+// the provided range, if any
+func (r *proverRegistry) jobInRange(batchNumber, batchNumberFinal uint64) (string, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	for id, p := range r.provers {
+		if p.job != nil && p.job.overlaps(batchNumber, batchNumberFinal) {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// cancelJobs cancels the jobs of the provers that have locked proofs overlapping
+// the provided range and returns the IDs of the provers
+func (r *proverRegistry) cancelJobs(batchNumber, batchNumberFinal uint64) []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	cancelled := make([]string, 0)
+	for id, p := range r.provers {
+		if p.job != nil && p.job.overlaps(batchNumber, batchNumberFinal) {
+			p.job.cancelled = true
+			p.job.cancel()
+			cancelled = append(cancelled, id)
+		}
+	}
+	sort.Strings(cancelled)
+	return cancelled
+}
+
+// proverInfo is the information of a connected prover returned by the admin API
+type proverInfo struct {
+	Name             string     `json:"name"`
+	ID               string     `json:"id"`
+	Addr             string     `json:"addr"`
+	ForkIDs          []uint64   `json:"forkIds"`
+	ConnectedAt      time.Time  `json:"connectedAt"`
+	Status           string     `json:"status"`
+	Task             string     `json:"task,omitempty"`
+	TaskSince        *time.Time `json:"taskSince,omitempty"`
+	BatchNumber      *uint64    `json:"batchNumber,omitempty"`
+	BatchNumberFinal *uint64    `json:"batchNumberFinal,omitempty"`
+	ProofID          string     `json:"proofId,omitempty"`
+}
+
+// list returns the information of the connected provers, sorted by ID
+func (r *proverRegistry) list() []proverInfo {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	provers := make([]proverInfo, 0, len(r.provers))
+	for _, p := range r.provers {
+		info := proverInfo{
+			Name:        p.name,
+			ID:          p.id,
+			Addr:        p.addr,
+			ForkIDs:     p.forkIDs,
+			ConnectedAt: p.connectedAt,
+			Status:      proverStatusIdle,
+		}
+		if job := p.job; job != nil {
+			since := job.since
+			info.Status = proverStatusWorking
+			info.Task = string(job.task)
+			info.TaskSince = &since
+			info.ProofID = job.proofID
+			if job.locked {
+				batchNumber, batchNumberFinal := job.batchNumber, job.batchNumberFinal
+				info.BatchNumber = &batchNumber
+				info.BatchNumberFinal = &batchNumberFinal
+			}
+		}
+		provers = append(provers, info)
+	}
+	sort.Slice(provers, func(i, j int) bool { return provers[i].ID < provers[j].ID })
+	return provers
+}
+
+// trackedProver records in the registry the IDs of the proofs the prover is
+// asked to generate, so they can be cancelled in the prover as well
+type trackedProver struct {
+	proverInterface
+	registry *proverRegistry
+}
+
+// WaitRecursiveProof waits for a recursive proof to be generated by the prover
+func (p *trackedProver) WaitRecursiveProof(ctx context.Context, proofID string) (string, error) {
+	p.registry.setJobProofID(p.ID(), proofID)
+	return p.proverInterface.WaitRecursiveProof(ctx, proofID)
+}
+
+// WaitFinalProof waits for the final proof to be generated by the prover
+func (p *trackedProver) WaitFinalProof(ctx context.Context, proofID string) (*prover.FinalProof, error) {
+	p.registry.setJobProofID(p.ID(), proofID)
+	return p.proverInterface.WaitFinalProof(ctx, proofID)
+}
+
+// proofInfo is the information of a batch proof returned by the admin API
+type proofInfo struct {
+	BatchNumber      uint64     `json:"batchNumber"`
+	BatchNumberFinal uint64     `json:"batchNumberFinal"`
+	Status           string     `json:"status"`
+	ProofID          *string    `json:"proofId"`
+	Prover           *string    `json:"prover"`
+	ProverID         *string    `json:"proverId"`
+	GeneratingSince  *time.Time `json:"generatingSince"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	UpdatedAt        time.Time  `json:"updatedAt"`
+}
+
+func newProofInfo(proof *state.Proof) proofInfo {
+	status := proofStatusPending
+	if proof.GeneratingSince != nil {
+		status = proofStatusLocked
+		if proof.Proof == "" {
+			status = proofStatusGenerating
+		}
+	}
+	return proofInfo{
+		BatchNumber:      proof.BatchNumber,
+		BatchNumberFinal: proof.BatchNumberFinal,
+		Status:           status,
+		ProofID:          proof.ProofID,
+		Prover:           proof.Prover,
+		ProverID:         proof.ProverID,
+		GeneratingSince:  proof.GeneratingSince,
+		CreatedAt:        proof.CreatedAt,
+		UpdatedAt:        proof.UpdatedAt,
+	}
+}
+
+// proofRange is the range of batch proofs the admin API requests act on
+type proofRange struct {
+	BatchNumber      uint64 `json:"batchNumber"`
+	BatchNumberFinal uint64 `json:"batchNumberFinal"`
+}
+
+// startAdminServer starts the http server of the admin API
+func (a *Aggregator) startAdminServer() {
+	adminSrv, err := adminapi.Start("aggregator", a.cfg.Admin.Host, a.cfg.Admin.Port, a.adminHandler())
+	if err != nil {
+		log.Fatal(err)
+	}
+	a.adminSrv = adminSrv
+}
+
+func (a *Aggregator) adminHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(adminProversEndpoint, a.handleListProvers)
+	mux.HandleFunc(adminProofsEndpoint, a.handleListProofs)
+	mux.HandleFunc(adminCancelProofsEndpoint, a.handleCancelProofs)
+	mux.HandleFunc(adminUnlockProofsEndpoint, a.handleUnlockProofs)
+	mux.HandleFunc(adminFinalProofEndpoint, a.handleFinalProof)
+	return adminapi.BearerAuth(a.cfg.Admin.AuthToken, mux)
+}
+
+// handleListProvers returns the connected provers and the task each one is running
+func (a *Aggregator) handleListProvers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	adminapi.WriteResponse(w, a.provers.list())
+}
+
+// handleListProofs returns the batch proofs pending, generating and locked
+func (a *Aggregator) handleListProofs(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	proofs, err := a.State.GetBatchProofs(r.Context(), nil)
+	if err != nil {
+		log.Errorf("Failed to get batch proofs for the admin API: %v", err)
+		http.Error(w, "failed to get batch proofs", http.StatusInternalServerError)
+		return
+	}
+	result := make([]proofInfo, 0, len(proofs))
+	for _, proof := range proofs {
+		result = append(result, newProofInfo(proof))
+	}
+	adminapi.WriteResponse(w, result)
+}
+
+// handleCancelProofs cancels the jobs of the provers using proofs of the range,
+// the proofs are released or deleted by the tasks once cancelled
+func (a *Aggregator) handleCancelProofs(w http.ResponseWriter, r *http.Request) {
+	pr, ok := readProofRange(w, r)
+	if !ok {
+		return
+	}
+	cancelled := a.provers.cancelJobs(pr.BatchNumber, pr.BatchNumberFinal)
+	log.Infof("Admin API cancelled the jobs of the provers %v for proofs %d-%d", cancelled, pr.BatchNumber, pr.BatchNumberFinal)
+	adminapi.WriteResponse(w, map[string][]string{"cancelled": cancelled})
+}
+
+// handleUnlockProofs releases the generated proofs of the range that remain
+// locked without any prover using them
+func (a *Aggregator) handleUnlockProofs(w http.ResponseWriter, r *http.Request) {
+	pr, ok := readProofRange(w, r)
+	if !ok {
+		return
+	}
+
+	// the provers lock the proofs holding the state mutex, holding it while
+	// unlocking ensures no job locks proofs of the range meanwhile
+	a.StateDBMutex.Lock()
+	defer a.StateDBMutex.Unlock()
+
+	if proverID, inUse := a.provers.jobInRange(pr.BatchNumber, pr.BatchNumberFinal); inUse {
+		http.Error(w, fmt.Sprintf("%s: %s", ErrProofRangeInUse.Error(), proverID), http.StatusConflict)
+		return
+	}
+	n, err := a.State.UnlockBatchProofs(r.Context(), pr.BatchNumber, pr.BatchNumberFinal, nil)
+	if err != nil {
+		log.Errorf("Failed to unlock batch proofs for the admin API: %v", err)
+		http.Error(w, "failed to unlock batch proofs", http.StatusInternalServerError)
+		return
+	}
+	log.Infof("Admin API unlocked %d proofs for batches %d-%d", n, pr.BatchNumber, pr.BatchNumberFinal)
+	adminapi.WriteResponse(w, map[string]int64{"unlocked": n})
+}
+
+// handleFinalProof allows to build the final proof as soon as a proof is ready,
+// without waiting for the verify proof interval
+func (a *Aggregator) handleFinalProof(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	a.forceVerifyProofTime()
+	log.Info("Admin API triggered the final proof")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func readProofRange(w http.ResponseWriter, r *http.Request) (proofRange, bool) {
+	var pr proofRange
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return pr, false
+	}
+	if err := json.NewDecoder(r.Body).Decode(&pr); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+		return pr, false
+	}
+	if pr.BatchNumber > pr.BatchNumberFinal {
+		http.Error(w, ErrInvalidProofRange.Error(), http.StatusBadRequest)
+		return pr, false
+	}
+	return pr, true
+}
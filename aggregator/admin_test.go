@@ -0,0 +1,197 @@
+package aggregator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/aggregator/mocks"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const adminTestToken = "token"
+
+func newAdminTestAggregator(t *testing.T) (*Aggregator, *mocks.StateMock) {
+	stateMock := mocks.NewStateMock(t)
+	a, err := New(Config{Admin: AdminCfg{Enabled: true, AuthToken: adminTestToken}}, stateMock, nil, nil, nil)
+	require.NoError(t, err)
+	return &a, stateMock
+}
+
+func addAdminTestProver(t *testing.T, a *Aggregator, id string) {
+	proverMock := mocks.NewProverMock(t)
+	proverMock.On("Name").Return("name-" + id)
+	proverMock.On("ID").Return(id)
+	proverMock.On("Addr").Return("addr-" + id)
+	a.provers.add(proverMock, []uint64{forkId9})
+}
+
+func adminRequest(t *testing.T, a *Aggregator, method, endpoint string, body interface{}) *httptest.ResponseRecorder {
+	var reqBody bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&reqBody).Encode(body))
+	}
+	req := httptest.NewRequest(method, endpoint, &reqBody)
+	req.Header.Set("Authorization", "Bearer "+adminTestToken)
+	res := httptest.NewRecorder()
+	a.adminHandler().ServeHTTP(res, req)
+	return res
+}
+
+func TestAdminAuth(t *testing.T) {
+	_, err := New(Config{Admin: AdminCfg{Enabled: true}}, nil, nil, nil, nil)
+	assert.ErrorIs(t, err, ErrAdminAuthTokenRequired)
+
+	a, _ := newAdminTestAggregator(t)
+	addAdminTestProver(t, a, "id1")
+
+	for _, authorization := range []string{"", "Bearer wrong", adminTestToken} {
+		req := httptest.NewRequest(http.MethodGet, adminProversEndpoint, nil)
+		req.Header.Set("Authorization", authorization)
+		res := httptest.NewRecorder()
+		a.adminHandler().ServeHTTP(res, req)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	}
+
+	res := adminRequest(t, a, http.MethodGet, adminProversEndpoint, nil)
+	assert.Equal(t, http.StatusOK, res.Code)
+}
+
+func TestAdminListProvers(t *testing.T) {
+	a, _ := newAdminTestAggregator(t)
+	addAdminTestProver(t, a, "id1")
+	addAdminTestProver(t, a, "id2")
+
+	_, cancel := a.provers.startJob(context.Background(), "id2", taskAggregateBatch)
+	defer cancel()
+	a.provers.setJobBatchRange("id2", 1, 4)
+	a.provers.setJobProofID("id2", "proofID")
+
+	res := adminRequest(t, a, http.MethodGet, adminProversEndpoint, nil)
+	require.Equal(t, http.StatusOK, res.Code)
+
+	var provers []proverInfo
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&provers))
+	require.Len(t, provers, 2)
+	assert.Equal(t, "id1", provers[0].ID)
+	assert.Equal(t, "name-id1", provers[0].Name)
+	assert.Equal(t, "addr-id1", provers[0].Addr)
+	assert.Equal(t, []uint64{forkId9}, provers[0].ForkIDs)
+	assert.Equal(t, proverStatusIdle, provers[0].Status)
+	assert.Empty(t, provers[0].Task)
+
+	assert.Equal(t, "id2", provers[1].ID)
+	assert.Equal(t, proverStatusWorking, provers[1].Status)
+	assert.Equal(t, string(taskAggregateBatch), provers[1].Task)
+	assert.NotNil(t, provers[1].TaskSince)
+	require.NotNil(t, provers[1].BatchNumber)
+	require.NotNil(t, provers[1].BatchNumberFinal)
+	assert.Equal(t, uint64(1), *provers[1].BatchNumber)
+	assert.Equal(t, uint64(4), *provers[1].BatchNumberFinal)
+	assert.Equal(t, "proofID", provers[1].ProofID)
+
+	res = adminRequest(t, a, http.MethodPost, adminProversEndpoint, nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, res.Code)
+}
+
+func TestAdminListProofs(t *testing.T) {
+	a, stateMock := newAdminTestAggregator(t)
+	now := time.Now().UTC().Round(time.Microsecond)
+	proverID := "proverID"
+	proofs := []*state.Proof{
+		{BatchNumber: 1, BatchNumberFinal: 2, Proof: "proof"},
+		{BatchNumber: 3, BatchNumberFinal: 3, Proof: "proof", GeneratingSince: &now},
+		{BatchNumber: 4, BatchNumberFinal: 4, ProverID: &proverID, GeneratingSince: &now},
+	}
+	stateMock.On("GetBatchProofs", mock.Anything, nil).Return(proofs, nil).Once()
+
+	res := adminRequest(t, a, http.MethodGet, adminProofsEndpoint, nil)
+	require.Equal(t, http.StatusOK, res.Code)
+
+	var result []proofInfo
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&result))
+	require.Len(t, result, 3)
+	assert.Equal(t, proofStatusPending, result[0].Status)
+	assert.Equal(t, uint64(2), result[0].BatchNumberFinal)
+	assert.Equal(t, proofStatusLocked, result[1].Status)
+	assert.Equal(t, proofStatusGenerating, result[2].Status)
+	require.NotNil(t, result[2].ProverID)
+	assert.Equal(t, proverID, *result[2].ProverID)
+	require.NotNil(t, result[2].GeneratingSince)
+	assert.True(t, now.Equal(*result[2].GeneratingSince))
+
+	stateMock.On("GetBatchProofs", mock.Anything, nil).Return(nil, errors.New("banana")).Once()
+	res = adminRequest(t, a, http.MethodGet, adminProofsEndpoint, nil)
+	assert.Equal(t, http.StatusInternalServerError, res.Code)
+}
+
+func TestAdminCancelProofs(t *testing.T) {
+	a, _ := newAdminTestAggregator(t)
+	addAdminTestProver(t, a, "id1")
+	addAdminTestProver(t, a, "id2")
+
+	ctx1, cancel1 := a.provers.startJob(context.Background(), "id1", taskAggregateBatch)
+	defer cancel1()
+	a.provers.setJobBatchRange("id1", 1, 4)
+	ctx2, cancel2 := a.provers.startJob(context.Background(), "id2", taskGenerateBatch)
+	defer cancel2()
+	a.provers.setJobBatchRange("id2", 5, 5)
+
+	res := adminRequest(t, a, http.MethodPost, adminCancelProofsEndpoint, proofRange{BatchNumber: 3, BatchNumberFinal: 3})
+	require.Equal(t, http.StatusOK, res.Code)
+
+	var result map[string][]string
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&result))
+	assert.Equal(t, []string{"id1"}, result["cancelled"])
+	assert.ErrorIs(t, ctx1.Err(), context.Canceled)
+	assert.NoError(t, ctx2.Err())
+
+	job := a.provers.endJob("id1")
+	require.NotNil(t, job)
+	assert.True(t, job.cancelled)
+
+	res = adminRequest(t, a, http.MethodPost, adminCancelProofsEndpoint, proofRange{BatchNumber: 6, BatchNumberFinal: 5})
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	res = adminRequest(t, a, http.MethodGet, adminCancelProofsEndpoint, nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, res.Code)
+}
+
+func TestAdminUnlockProofs(t *testing.T) {
+	a, stateMock := newAdminTestAggregator(t)
+	addAdminTestProver(t, a, "id1")
+
+	_, cancel := a.provers.startJob(context.Background(), "id1", taskFinalProof)
+	defer cancel()
+	a.provers.setJobBatchRange("id1", 1, 4)
+
+	// proofs in use by a prover can't be unlocked
+	res := adminRequest(t, a, http.MethodPost, adminUnlockProofsEndpoint, proofRange{BatchNumber: 4, BatchNumberFinal: 6})
+	assert.Equal(t, http.StatusConflict, res.Code)
+
+	stateMock.On("UnlockBatchProofs", mock.Anything, uint64(5), uint64(6), nil).Return(int64(2), nil).Once()
+	res = adminRequest(t, a, http.MethodPost, adminUnlockProofsEndpoint, proofRange{BatchNumber: 5, BatchNumberFinal: 6})
+	require.Equal(t, http.StatusOK, res.Code)
+
+	var result map[string]int64
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&result))
+	assert.Equal(t, int64(2), result["unlocked"])
+}
+
+func TestAdminFinalProof(t *testing.T) {
+	a, _ := newAdminTestAggregator(t)
+	a.cfg.VerifyProofInterval.Duration = time.Hour
+	a.resetVerifyProofTime()
+	require.False(t, a.canVerifyProof())
+
+	res := adminRequest(t, a, http.MethodPost, adminFinalProofEndpoint, nil)
+	require.Equal(t, http.StatusAccepted, res.Code)
+	assert.Eventually(t, a.canVerifyProof, time.Second, 10*time.Millisecond)
+}
@@ -2,9 +2,9 @@ package aggregator
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"strconv"
 	"strings"
 	"sync"
@@ -16,10 +16,12 @@ import (
 	"github.com/0xPolygonHermez/zkevm-node/config/types"
 	"github.com/0xPolygonHermez/zkevm-node/encoding"
 	"github.com/0xPolygonHermez/zkevm-node/ethtxmanager"
+	"github.com/0xPolygonHermez/zkevm-node/event"
 	"github.com/0xPolygonHermez/zkevm-node/l1infotree"
 	"github.com/0xPolygonHermez/zkevm-node/log"
 	"github.com/0xPolygonHermez/zkevm-node/state"
 	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/jackc/pgx/v4"
 	"google.golang.org/grpc"
 	grpchealth "google.golang.org/grpc/health/grpc_health_v1"
@@ -33,13 +35,17 @@ const (
 	ethTxManagerOwner = "aggregator"
 	monitoredIDFormat = "proof-from-%v-to-%v"
 
-	forkId9 = uint64(9)
+	forkId9  = uint64(9)
+	forkId10 = uint64(10)
 )
 
 type finalProofMsg struct {
 	proverName     string
 	proverID       string
 	recursiveProof *state.Proof
+	// blobOuterProof is set when the final proof has been built from a blob
+	// outer proof. recursiveProof then holds the batch range it covers.
+	blobOuterProof *state.BlobOuterProof
 	finalProof     *prover.FinalProof
 }
 
@@ -53,6 +59,7 @@ type Aggregator struct {
 	EthTxManager            ethTxManager
 	Ethman                  etherman
 	ProfitabilityChecker    aggregatorTxProfitabilityChecker
+	Scheduler               proverScheduler
 	TimeSendFinalProof      time.Time
 	TimeCleanupLockedProofs types.Duration
 	StateDBMutex            *sync.Mutex
@@ -60,10 +67,12 @@ type Aggregator struct {
 
 	finalProof     chan finalProofMsg
 	verifyingProof bool
+	provers        *proverRegistry
 
-	srv  *grpc.Server
-	ctx  context.Context
-	exit context.CancelFunc
+	srv      *grpc.Server
+	adminSrv *http.Server
+	ctx      context.Context
+	exit     context.CancelFunc
 }
 
 // New creates a new aggregator.
@@ -72,13 +81,20 @@ func New(
 	stateInterface stateInterface,
 	ethTxManager ethTxManager,
 	etherman etherman,
+	eventLog *event.EventLog,
 ) (Aggregator, error) {
+	if cfg.Admin.Enabled && cfg.Admin.AuthToken == "" {
+		return Aggregator{}, ErrAdminAuthTokenRequired
+	}
+
 	var profitabilityChecker aggregatorTxProfitabilityChecker
 	switch cfg.TxProfitabilityCheckerType {
 	case ProfitabilityBase:
 		profitabilityChecker = NewTxProfitabilityCheckerBase(stateInterface, cfg.IntervalAfterWhichBatchConsolidateAnyway.Duration, cfg.TxProfitabilityMinReward.Int)
 	case ProfitabilityAcceptAll:
 		profitabilityChecker = NewTxProfitabilityCheckerAcceptAll(stateInterface, cfg.IntervalAfterWhichBatchConsolidateAnyway.Duration)
+	case ProfitabilityL1Cost:
+		profitabilityChecker = NewTxProfitabilityCheckerL1Cost(stateInterface, etherman, eventLog, cfg.IntervalAfterWhichBatchConsolidateAnyway.Duration, cfg.TxProfitabilityVerifyBatchesGas)
 	}
 
 	a := Aggregator{
@@ -88,11 +104,13 @@ func New(
 		EthTxManager:            ethTxManager,
 		Ethman:                  etherman,
 		ProfitabilityChecker:    profitabilityChecker,
+		Scheduler:               newProverScheduler(cfg.ProverSchedulerType, cfg.FinalProofProvers),
 		StateDBMutex:            &sync.Mutex{},
 		TimeSendFinalProofMutex: &sync.RWMutex{},
 		TimeCleanupLockedProofs: cfg.CleanupLockedProofsInterval,
 
 		finalProof: make(chan finalProofMsg),
+		provers:    newProverRegistry(),
 	}
 
 	return a, nil
@@ -121,6 +139,12 @@ func (a *Aggregator) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to initialize proofs cache %w", err)
 	}
 
+	// Delete ungenerated blob inner and blob outer proofs
+	err = a.State.DeleteUngeneratedBlobProofs(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to initialize blob proofs cache %w", err)
+	}
+
 	address := fmt.Sprintf("%s:%d", a.cfg.Host, a.cfg.Port)
 	lis, err := net.Listen("tcp", address)
 	if err != nil {
@@ -141,6 +165,10 @@ func (a *Aggregator) Start(ctx context.Context) error {
 		}
 	}()
 
+	if a.cfg.Admin.Enabled {
+		a.startAdminServer()
+	}
+
 	a.resetVerifyProofTime()
 
 	go a.cleanupLockedProofs()
@@ -154,6 +182,11 @@ func (a *Aggregator) Start(ctx context.Context) error {
 func (a *Aggregator) Stop() {
 	a.exit()
 	a.srv.Stop()
+	if a.adminSrv != nil {
+		if err := a.adminSrv.Close(); err != nil {
+			log.Errorf("Failed to stop the admin API: %v", err)
+		}
+	}
 }
 
 // Channel implements the bi-directional communication channel between the
@@ -180,12 +213,22 @@ func (a *Aggregator) Channel(stream prover.AggregatorService_ChannelServer) erro
 	)
 	log.Info("Establishing stream connection with prover")
 
-	// Check if prover supports the required Fork ID
-	if !prover.SupportsForkID(forkId9) {
-		err := errors.New("prover does not support required fork ID")
+	// Check the fork IDs supported by the prover, the scheduler only assigns
+	// to the prover the tasks it supports
+	forkIDs := []uint64{}
+	for _, forkID := range []uint64{forkId9, forkId10} {
+		if prover.SupportsForkID(forkID) {
+			forkIDs = append(forkIDs, forkID)
+		}
+	}
+	if err := a.Scheduler.AddProver(prover.ID(), prover.Name(), forkIDs); err != nil {
 		log.Warn(FirstToUpper(err.Error()))
 		return err
 	}
+	defer a.Scheduler.RemoveProver(prover.ID())
+
+	a.provers.add(prover, forkIDs)
+	defer a.provers.remove(prover.ID())
 
 	for {
 		select {
@@ -209,43 +252,7 @@ func (a *Aggregator) Channel(stream prover.AggregatorService_ChannelServer) erro
 				continue
 			}
 
-			_, err = a.tryBuildFinalProof(ctx, prover, nil)
-			if err != nil {
-				log.Errorf("Error checking proofs to verify: %v", err)
-			}
-
-			proofGenerated, err := a.tryAggregateBlobOuterProofs(ctx, prover)
-			if err != nil {
-				log.Errorf("Error trying to aggregate blobOuter proofs: %v", err)
-			}
-
-			if !proofGenerated {
-				proofGenerated, err = a.tryGenerateBlobOuterProof(ctx, prover)
-				if err != nil {
-					log.Errorf("Error trying to generate blobOuter proofs: %v", err)
-				}
-			}
-
-			if !proofGenerated {
-				proofGenerated, err = a.tryGenerateBlobInnerProof(ctx, prover)
-				if err != nil {
-					log.Errorf("Error trying to aggregate blobInner proofs: %v", err)
-				}
-			}
-
-			if !proofGenerated {
-				proofGenerated, err = a.tryAggregateBatchProofs(ctx, prover)
-				if err != nil {
-					log.Errorf("Error trying to aggregate batch proofs: %v", err)
-				}
-			}
-
-			if !proofGenerated {
-				proofGenerated, err = a.tryGenerateBatchProof(ctx, prover)
-				if err != nil {
-					log.Errorf("Error trying to generate batch proof: %v", err)
-				}
-			}
+			proofGenerated := a.runTasks(ctx, prover, a.Scheduler.NextTasks(prover.ID()))
 			if !proofGenerated {
 				// if no proof was generated (aggregated or batch) wait some time before retry
 				time.Sleep(a.cfg.RetryTime.Duration)
@@ -254,6 +261,80 @@ func (a *Aggregator) Channel(stream prover.AggregatorService_ChannelServer) erro
 	}
 }
 
+// runTasks tries the tasks assigned to the prover in order. Once a final proof
+// is built the rest of final tasks are skipped, once a recursive proof is
+// generated the rest of tasks are skipped. It returns true if a recursive proof
+// has been generated
+func (a *Aggregator) runTasks(ctx context.Context, prover proverInterface, tasks []proverTask) bool {
+	log := log.WithFields(
+		"prover", prover.Name(),
+		"proverId", prover.ID(),
+		"proverAddr", prover.Addr(),
+	)
+
+	finalProofBuilt, proofGenerated := false, false
+	for _, task := range tasks {
+		if proofGenerated || (finalProofBuilt && task.isFinal()) {
+			a.Scheduler.TaskDone(prover.ID(), task, false, 0)
+			continue
+		}
+
+		start := time.Now()
+		taskCtx, cancel := a.provers.startJob(ctx, prover.ID(), task)
+		generated, err := a.runTask(taskCtx, &trackedProver{proverInterface: prover, registry: a.provers}, task)
+		cancel()
+		if job := a.provers.endJob(prover.ID()); job != nil && job.cancelled {
+			log.Warnf("Task %s cancelled from the admin API", task)
+			a.cancelProofRequest(prover, job)
+		} else if err != nil {
+			log.Errorf("Error running task %s: %v", task, err)
+		}
+		a.Scheduler.TaskDone(prover.ID(), task, generated, time.Since(start))
+
+		if task.isFinal() {
+			finalProofBuilt = finalProofBuilt || generated
+		} else {
+			proofGenerated = generated
+		}
+	}
+	return proofGenerated
+}
+
+// runTask tries the task with the prover, it returns true if a proof has been
+// generated
+func (a *Aggregator) runTask(ctx context.Context, prover proverInterface, task proverTask) (bool, error) {
+	metrics.ScheduledTask(string(task))
+	switch task {
+	case taskFinalProof:
+		return a.tryBuildFinalProof(ctx, prover, nil)
+	case taskFinalBlobOuterProof:
+		return a.tryBuildFinalBlobOuterProof(ctx, prover, nil)
+	case taskAggregateBlobOuter:
+		return a.tryAggregateBlobOuterProofs(ctx, prover)
+	case taskGenerateBlobOuter:
+		return a.tryGenerateBlobOuterProof(ctx, prover)
+	case taskGenerateBlobInner:
+		return a.tryGenerateBlobInnerProof(ctx, prover)
+	case taskAggregateBatch:
+		return a.tryAggregateBatchProofs(ctx, prover)
+	case taskGenerateBatch:
+		return a.tryGenerateBatchProof(ctx, prover)
+	default:
+		return false, fmt.Errorf("unknown task %s", task)
+	}
+}
+
+// cancelProofRequest asks the prover to stop the generation of the last proof
+// requested by the cancelled job, if any
+func (a *Aggregator) cancelProofRequest(prover proverInterface, job *proverJob) {
+	if job.proofID == "" {
+		return
+	}
+	if err := prover.CancelProofRequest(job.proofID); err != nil {
+		log.Errorf("Failed to cancel proof %s in the prover %s: %v", job.proofID, prover.ID(), err)
+	}
+}
+
 // canVerifyProof returns true if we have reached the timeout to verify a proof
 // and no other prover is verifying a proof (verifyingProof = false).
 func (a *Aggregator) canVerifyProof() bool {
@@ -283,6 +364,14 @@ func (a *Aggregator) resetVerifyProofTime() {
 	a.TimeSendFinalProof = time.Now().Add(a.cfg.VerifyProofInterval.Duration)
 }
 
+// forceVerifyProofTime makes the timeout to verify a proof expire, so a final
+// proof can be built as soon as there is a proof ready.
+func (a *Aggregator) forceVerifyProofTime() {
+	a.TimeSendFinalProofMutex.Lock()
+	defer a.TimeSendFinalProofMutex.Unlock()
+	a.TimeSendFinalProof = time.Now()
+}
+
 // isSynced checks if the state is synchronized with L1. If a batch number is
 // provided, it makes sure that the state is synced with that batch.
 func (a *Aggregator) isSynced(ctx context.Context, batchNum *uint64) bool {
@@ -511,6 +600,14 @@ func (a *Aggregator) handleMonitoredTxResult(result ethtxmanager.MonitoredTxResu
 	log := log.WithFields("txId", result.ID, "batches", fmt.Sprintf("%d-%d", proofBatchNumber, proofBatchNumberFinal))
 	log.Info("Final proof verified")
 
+	if tracker, ok := a.ProfitabilityChecker.(verifyBatchesGasTracker); ok {
+		for _, txResult := range result.Txs {
+			if txResult.Receipt != nil && txResult.Receipt.Status == ethTypes.ReceiptStatusSuccessful {
+				tracker.SetVerifyBatchesGasUsed(txResult.Receipt.GasUsed)
+			}
+		}
+	}
+
 	// wait for the synchronizer to catch up the verified batches
 	log.Debug("A final proof has been sent, waiting for the network to be synced")
 	for !a.isSynced(a.ctx, &proofBatchNumberFinal) {
@@ -524,6 +621,11 @@ func (a *Aggregator) handleMonitoredTxResult(result ethtxmanager.MonitoredTxResu
 	if err != nil {
 		log.Errorf("Failed to store proof aggregation result: %v", err)
 	}
+
+	err = a.State.CleanupBlobProofs(a.ctx, proofBatchNumberFinal, nil)
+	if err != nil {
+		log.Errorf("Failed to cleanup blob proofs: %v", err)
+	}
 }
 
 func buildMonitoredTxID(batchNumber, batchNumberFinal uint64) string {
@@ -545,6 +647,16 @@ func (a *Aggregator) cleanupLockedProofs() {
 			} else if n > 1 {
 				log.Warnf("Found %d stale proofs and removed from cache", n)
 			}
+
+			n, err = a.State.CleanupLockedBlobProofs(a.ctx, a.cfg.GeneratingProofCleanupThreshold, nil)
+			if err != nil {
+				log.Errorf("Failed to cleanup locked blob proofs: %v", err)
+			}
+			if n == 1 {
+				log.Warn("Found a stale blob proof and removed form cache")
+			} else if n > 1 {
+				log.Warnf("Found %d stale blob proofs and removed from cache", n)
+			}
 		}
 	}
 }
@@ -175,7 +175,8 @@ func TestSendFinalProof(t *testing.T) {
 				}
 				m.stateMock.On("GetLastVerifiedBatch", mock.Anything, nil).Return(&verifiedBatch, nil).Once()
 				m.etherman.On("GetLatestVerifiedBatchNum").Return(batchNumFinal, nil).Once()
-				m.stateMock.On("CleanupBatchProofs", mock.Anything, batchNumFinal, nil).Run(func(args mock.Arguments) {
+				m.stateMock.On("CleanupBatchProofs", mock.Anything, batchNumFinal, nil).Return(nil).Once()
+				m.stateMock.On("CleanupBlobProofs", mock.Anything, batchNumFinal, nil).Run(func(args mock.Arguments) {
 					// test is done, stop the sendFinalProof method
 					a.exit()
 				}).Return(nil).Once()
@@ -191,7 +192,7 @@ func TestSendFinalProof(t *testing.T) {
 			stateMock := mocks.NewStateMock(t)
 			ethTxManager := mocks.NewEthTxManager(t)
 			etherman := mocks.NewEtherman(t)
-			a, err := New(cfg, stateMock, ethTxManager, etherman)
+			a, err := New(cfg, stateMock, ethTxManager, etherman, nil)
 			require.NoError(err)
 			a.ctx, a.exit = context.WithCancel(context.Background())
 			m := mox{
@@ -686,7 +687,7 @@ func TestTryAggregateProofs(t *testing.T) {
 			ethTxManager := mocks.NewEthTxManager(t)
 			etherman := mocks.NewEtherman(t)
 			proverMock := mocks.NewProverMock(t)
-			a, err := New(cfg, stateMock, ethTxManager, etherman)
+			a, err := New(cfg, stateMock, ethTxManager, etherman, nil)
 			require.NoError(err)
 			aggregatorCtx := context.WithValue(context.Background(), "owner", "aggregator") //nolint:staticcheck
 			a.ctx, a.exit = context.WithCancel(aggregatorCtx)
@@ -1029,7 +1030,7 @@ func TestTryGenerateBatchProof(t *testing.T) {
 			ethTxManager := mocks.NewEthTxManager(t)
 			etherman := mocks.NewEtherman(t)
 			proverMock := mocks.NewProverMock(t)
-			a, err := New(cfg, stateMock, ethTxManager, etherman)
+			a, err := New(cfg, stateMock, ethTxManager, etherman, nil)
 			require.NoError(err)
 			aggregatorCtx := context.WithValue(context.Background(), "owner", "aggregator") //nolint:staticcheck
 			a.ctx, a.exit = context.WithCancel(aggregatorCtx)
@@ -1053,6 +1054,348 @@ func TestTryGenerateBatchProof(t *testing.T) {
 	}
 }
 
+func TestTryGenerateBlobInnerProof(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	cfg := Config{
+		VerifyProofInterval:        configTypes.NewDuration(10000000),
+		TxProfitabilityCheckerType: ProfitabilityAcceptAll,
+	}
+	lastVerifiedBatch := state.VerifiedBatch{
+		BatchNumber: uint64(22),
+	}
+	previousBlobInner := state.BlobInner{
+		BlobInnerNum:     uint64(4),
+		BlobStateRoot:    common.BytesToHash([]byte("blobStateRoot")),
+		BlobAccInputHash: common.BytesToHash([]byte("blobAccInputHash")),
+	}
+	blobInnerToProve := state.BlobInner{
+		BlobInnerNum:         uint64(5),
+		Type:                 state.BlobTypeBlobTransaction,
+		MaxSequenceTimestamp: time.Unix(1700000000, 0),
+		ZkGasLimit:           uint64(1000000),
+		L1InfoLeafIndex:      uint32(7),
+		Coinbase:             common.BytesToAddress([]byte("coinbase")),
+		PointZ:               []byte("pointZ"),
+		PointY:               []byte("pointY"),
+		Data:                 []byte("data"),
+	}
+	firstBatchNum := uint64(23)
+	lastBatchNum := uint64(25)
+	previousBatch := state.Batch{
+		BatchNumber: firstBatchNum - 1,
+		StateRoot:   common.BytesToHash([]byte("stateRoot")),
+	}
+	l1InfoTreeLeaf := state.L1InfoTreeExitRootStorageEntry{
+		L1InfoTreeRoot:  common.BytesToHash([]byte("l1InfoTreeRoot")),
+		L1InfoTreeIndex: blobInnerToProve.L1InfoLeafIndex,
+	}
+	proofID := "proofId"
+	proverName := "proverName"
+	proverID := "proverID"
+	recursiveProof := "recursiveProof"
+	errBanana := errors.New("banana")
+	proverCtx := context.WithValue(context.Background(), "owner", "prover") //nolint:staticcheck
+	matchProverCtxFn := func(ctx context.Context) bool { return ctx.Value("owner") == "prover" }
+	matchAggregatorCtxFn := func(ctx context.Context) bool { return ctx.Value("owner") == "aggregator" }
+	setupInputProver := func(m mox) {
+		m.stateMock.On("GetBlobInner", mock.MatchedBy(matchProverCtxFn), previousBlobInner.BlobInnerNum, nil).Return(&previousBlobInner, nil).Once()
+		m.stateMock.On("GetBlobInnerBatchRange", mock.MatchedBy(matchProverCtxFn), blobInnerToProve.BlobInnerNum, nil).Return(firstBatchNum, lastBatchNum, nil).Once()
+		m.stateMock.On("GetBatchByNumber", mock.MatchedBy(matchProverCtxFn), previousBatch.BatchNumber, nil).Return(&previousBatch, nil).Once()
+		m.stateMock.On("GetL1InfoRootLeafByIndex", mock.MatchedBy(matchProverCtxFn), blobInnerToProve.L1InfoLeafIndex, nil).Return(l1InfoTreeLeaf, nil).Once()
+	}
+	matchInputProver := func(input *prover.InputBlobInnerProver) bool {
+		return assert.Equal(previousBlobInner.BlobStateRoot.Bytes(), input.PublicInputs.OldBlobStateRoot) &&
+			assert.Equal(previousBlobInner.BlobAccInputHash.Bytes(), input.PublicInputs.OldBlobAccInputHash) &&
+			assert.Equal(previousBlobInner.BlobInnerNum, input.PublicInputs.OldNumBlob) &&
+			assert.Equal(previousBatch.StateRoot.Bytes(), input.PublicInputs.OldStateRoot) &&
+			assert.Equal(forkId10, input.PublicInputs.ForkId) &&
+			assert.Equal(l1InfoTreeLeaf.L1InfoTreeRoot.Bytes(), input.PublicInputs.LastL1InfoTreeRoot) &&
+			assert.Equal(blobInnerToProve.Coinbase.String(), input.PublicInputs.SequencerAddr) &&
+			assert.Equal(uint64(blobInnerToProve.MaxSequenceTimestamp.Unix()), input.PublicInputs.TimestampLimit) &&
+			assert.Equal(uint32(state.BlobTypeBlobTransaction), input.PublicInputs.Type) &&
+			assert.Equal(blobInnerToProve.Data, input.PublicInputs.BlobData)
+	}
+	testCases := []struct {
+		name    string
+		setup   func(mox, *Aggregator)
+		asserts func(bool, *Aggregator, error)
+	}{
+		{
+			name: "getAndLockBlobInnerToProve returns ErrNotFound",
+			setup: func(m mox, a *Aggregator) {
+				m.proverMock.On("Name").Return(proverName).Twice()
+				m.proverMock.On("ID").Return(proverID).Twice()
+				m.proverMock.On("Addr").Return("addr")
+				m.stateMock.On("GetLastVerifiedBatch", mock.MatchedBy(matchProverCtxFn), nil).Return(&lastVerifiedBatch, nil).Once()
+				m.etherman.On("GetLatestBlockHeader", mock.Anything).Return(&types.Header{Number: new(big.Int).SetUint64(1)}, nil).Once()
+				m.stateMock.On("GetBlobInnerToProve", mock.MatchedBy(matchProverCtxFn), lastVerifiedBatch.BatchNumber, mock.Anything, nil).Return(nil, state.ErrNotFound).Once()
+			},
+			asserts: func(result bool, a *Aggregator, err error) {
+				assert.False(result)
+				assert.NoError(err)
+			},
+		},
+		{
+			name: "BlobInnerProof prover error",
+			setup: func(m mox, a *Aggregator) {
+				m.proverMock.On("Name").Return(proverName).Twice()
+				m.proverMock.On("ID").Return(proverID).Twice()
+				m.proverMock.On("Addr").Return("addr")
+				m.stateMock.On("GetLastVerifiedBatch", mock.MatchedBy(matchProverCtxFn), nil).Return(&lastVerifiedBatch, nil).Once()
+				m.etherman.On("GetLatestBlockHeader", mock.Anything).Return(&types.Header{Number: new(big.Int).SetUint64(1)}, nil).Once()
+				m.stateMock.On("GetBlobInnerToProve", mock.MatchedBy(matchProverCtxFn), lastVerifiedBatch.BatchNumber, mock.Anything, nil).Return(&blobInnerToProve, nil).Once()
+				m.stateMock.On("AddBlobInnerProof", mock.MatchedBy(matchProverCtxFn), mock.Anything, nil).Run(
+					func(args mock.Arguments) {
+						proof := args[1].(*state.BlobInnerProof)
+						assert.Equal(blobInnerToProve.BlobInnerNum, proof.BlobInnerNumber)
+						assert.Equal(&proverName, proof.Prover)
+						assert.Equal(&proverID, proof.ProverID)
+						assert.InDelta(time.Now().Unix(), proof.GeneratingSince.Unix(), float64(time.Second))
+					},
+				).Return(nil).Once()
+				setupInputProver(m)
+				m.proverMock.On("BlobInnerProof", mock.MatchedBy(matchInputProver)).Return(nil, errBanana).Once()
+				m.stateMock.On("DeleteBlobInnerProof", mock.MatchedBy(matchAggregatorCtxFn), blobInnerToProve.BlobInnerNum, nil).Return(nil).Once()
+			},
+			asserts: func(result bool, a *Aggregator, err error) {
+				assert.False(result)
+				assert.ErrorIs(err, errBanana)
+			},
+		},
+		{
+			name: "nominal case",
+			setup: func(m mox, a *Aggregator) {
+				m.proverMock.On("Name").Return(proverName).Twice()
+				m.proverMock.On("ID").Return(proverID).Twice()
+				m.proverMock.On("Addr").Return("addr")
+				m.stateMock.On("GetLastVerifiedBatch", mock.MatchedBy(matchProverCtxFn), nil).Return(&lastVerifiedBatch, nil).Once()
+				m.etherman.On("GetLatestBlockHeader", mock.Anything).Return(&types.Header{Number: new(big.Int).SetUint64(1)}, nil).Once()
+				m.stateMock.On("GetBlobInnerToProve", mock.MatchedBy(matchProverCtxFn), lastVerifiedBatch.BatchNumber, mock.Anything, nil).Return(&blobInnerToProve, nil).Once()
+				m.stateMock.On("AddBlobInnerProof", mock.MatchedBy(matchProverCtxFn), mock.Anything, nil).Return(nil).Once()
+				setupInputProver(m)
+				m.proverMock.On("BlobInnerProof", mock.MatchedBy(matchInputProver)).Return(&proofID, nil).Once()
+				m.proverMock.On("WaitRecursiveProof", mock.MatchedBy(matchProverCtxFn), proofID).Return(recursiveProof, nil).Once()
+				m.stateMock.On("UpdateBlobInnerProof", mock.MatchedBy(matchAggregatorCtxFn), mock.Anything, nil).Run(
+					func(args mock.Arguments) {
+						proof := args[1].(*state.BlobInnerProof)
+						assert.Equal(blobInnerToProve.BlobInnerNum, proof.BlobInnerNumber)
+						assert.Equal(&proofID, proof.ProofID)
+						assert.Equal(recursiveProof, proof.Proof)
+						assert.NotEmpty(proof.InputProver)
+						assert.Nil(proof.GeneratingSince)
+					},
+				).Return(nil).Once()
+			},
+			asserts: func(result bool, a *Aggregator, err error) {
+				assert.True(result)
+				assert.NoError(err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stateMock := mocks.NewStateMock(t)
+			ethTxManager := mocks.NewEthTxManager(t)
+			etherman := mocks.NewEtherman(t)
+			proverMock := mocks.NewProverMock(t)
+			a, err := New(cfg, stateMock, ethTxManager, etherman, nil)
+			require.NoError(err)
+			aggregatorCtx := context.WithValue(context.Background(), "owner", "aggregator") //nolint:staticcheck
+			a.ctx, a.exit = context.WithCancel(aggregatorCtx)
+			m := mox{
+				stateMock:    stateMock,
+				ethTxManager: ethTxManager,
+				etherman:     etherman,
+				proverMock:   proverMock,
+			}
+			if tc.setup != nil {
+				tc.setup(m, &a)
+			}
+
+			result, err := a.tryGenerateBlobInnerProof(proverCtx, proverMock)
+
+			if tc.asserts != nil {
+				tc.asserts(result, &a, err)
+			}
+		})
+	}
+}
+
+func TestTryGenerateBlobOuterProof(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	errBanana := errors.New("banana")
+	cfg := Config{
+		VerifyProofInterval: configTypes.NewDuration(10000000),
+	}
+	proofID := "proofId"
+	proverName := "proverName"
+	proverID := "proverID"
+	recursiveProof := "recursiveProof"
+	proverCtx := context.WithValue(context.Background(), "owner", "prover") //nolint:staticcheck
+	matchProverCtxFn := func(ctx context.Context) bool { return ctx.Value("owner") == "prover" }
+	matchAggregatorCtxFn := func(ctx context.Context) bool { return ctx.Value("owner") == "aggregator" }
+	blobInnerNum := uint64(5)
+	batchProof := state.Proof{
+		Proof:            "batchProof",
+		BatchNumber:      uint64(23),
+		BatchNumberFinal: uint64(25),
+	}
+	blobInnerProof := state.BlobInnerProof{
+		Proof:           "blobInnerProof",
+		BlobInnerNumber: blobInnerNum,
+	}
+	testCases := []struct {
+		name    string
+		setup   func(mox, *Aggregator)
+		asserts func(bool, *Aggregator, error)
+	}{
+		{
+			name: "getAndLockProofsToBlobOuter returns ErrNotFound",
+			setup: func(m mox, a *Aggregator) {
+				m.proverMock.On("Name").Return(proverName).Twice()
+				m.proverMock.On("ID").Return(proverID).Twice()
+				m.proverMock.On("Addr").Return("addr")
+				m.stateMock.On("GetProofsToBlobOuter", mock.MatchedBy(matchProverCtxFn), nil).Return(nil, nil, state.ErrNotFound).Once()
+			},
+			asserts: func(result bool, a *Aggregator, err error) {
+				assert.False(result)
+				assert.NoError(err)
+			},
+		},
+		{
+			name: "BlobOuterProof prover error",
+			setup: func(m mox, a *Aggregator) {
+				m.proverMock.On("Name").Return(proverName).Twice()
+				m.proverMock.On("ID").Return(proverID).Twice()
+				m.proverMock.On("Addr").Return("addr")
+				dbTx := &mocks.DbTxMock{}
+				lockProofsTxBegin := m.stateMock.On("BeginStateTransaction", mock.MatchedBy(matchProverCtxFn)).Return(dbTx, nil).Once()
+				lockProofsTxCommit := dbTx.On("Commit", mock.MatchedBy(matchProverCtxFn)).Return(nil).Once()
+				m.stateMock.On("GetProofsToBlobOuter", mock.MatchedBy(matchProverCtxFn), nil).Return(&batchProof, &blobInnerProof, nil).Once()
+				lockBatchProof := m.stateMock.
+					On("UpdateBatchProof", mock.MatchedBy(matchProverCtxFn), &batchProof, dbTx).
+					Run(func(args mock.Arguments) {
+						assert.NotNil(args[1].(*state.Proof).GeneratingSince)
+					}).
+					Return(nil).
+					Once()
+				lockBlobInnerProof := m.stateMock.
+					On("UpdateBlobInnerProof", mock.MatchedBy(matchProverCtxFn), &blobInnerProof, dbTx).
+					Run(func(args mock.Arguments) {
+						assert.NotNil(args[1].(*state.BlobInnerProof).GeneratingSince)
+					}).
+					Return(nil).
+					Once()
+				m.proverMock.On("BlobOuterProof", batchProof.Proof, blobInnerProof.Proof).Return(nil, errBanana).Once()
+				m.stateMock.On("BeginStateTransaction", mock.MatchedBy(matchAggregatorCtxFn)).Return(dbTx, nil).Once().NotBefore(lockProofsTxBegin)
+				m.stateMock.
+					On("UpdateBatchProof", mock.MatchedBy(matchAggregatorCtxFn), &batchProof, dbTx).
+					Run(func(args mock.Arguments) {
+						assert.Nil(args[1].(*state.Proof).GeneratingSince)
+					}).
+					Return(nil).
+					Once().
+					NotBefore(lockBatchProof)
+				m.stateMock.
+					On("UpdateBlobInnerProof", mock.MatchedBy(matchAggregatorCtxFn), &blobInnerProof, dbTx).
+					Run(func(args mock.Arguments) {
+						assert.Nil(args[1].(*state.BlobInnerProof).GeneratingSince)
+					}).
+					Return(nil).
+					Once().
+					NotBefore(lockBlobInnerProof)
+				dbTx.On("Commit", mock.MatchedBy(matchAggregatorCtxFn)).Return(nil).Once().NotBefore(lockProofsTxCommit)
+			},
+			asserts: func(result bool, a *Aggregator, err error) {
+				assert.False(result)
+				assert.ErrorIs(err, errBanana)
+			},
+		},
+		{
+			name: "nominal case",
+			setup: func(m mox, a *Aggregator) {
+				m.proverMock.On("Name").Return(proverName).Times(3)
+				m.proverMock.On("ID").Return(proverID).Times(3)
+				m.proverMock.On("Addr").Return("addr")
+				dbTx := &mocks.DbTxMock{}
+				m.stateMock.On("BeginStateTransaction", mock.MatchedBy(matchProverCtxFn)).Return(dbTx, nil).Twice()
+				dbTx.On("Commit", mock.MatchedBy(matchProverCtxFn)).Return(nil).Twice()
+				m.stateMock.On("GetProofsToBlobOuter", mock.MatchedBy(matchProverCtxFn), nil).Return(&batchProof, &blobInnerProof, nil).Once()
+				m.stateMock.On("UpdateBatchProof", mock.MatchedBy(matchProverCtxFn), &batchProof, dbTx).Return(nil).Once()
+				m.stateMock.On("UpdateBlobInnerProof", mock.MatchedBy(matchProverCtxFn), &blobInnerProof, dbTx).Return(nil).Once()
+				m.proverMock.On("BlobOuterProof", batchProof.Proof, blobInnerProof.Proof).Return(&proofID, nil).Once()
+				m.proverMock.On("WaitRecursiveProof", mock.MatchedBy(matchProverCtxFn), proofID).Return(recursiveProof, nil).Once()
+				m.stateMock.On("DeleteBatchProofs", mock.MatchedBy(matchProverCtxFn), batchProof.BatchNumber, batchProof.BatchNumberFinal, dbTx).Return(nil).Once()
+				m.stateMock.On("DeleteBlobInnerProof", mock.MatchedBy(matchProverCtxFn), blobInnerNum, dbTx).Return(nil).Once()
+				expectedInputProver := map[string]interface{}{
+					"batch_proof":      batchProof.Proof,
+					"blob_inner_proof": blobInnerProof.Proof,
+				}
+				b, err := json.Marshal(expectedInputProver)
+				require.NoError(err)
+				m.stateMock.On("AddBlobOuterProof", mock.MatchedBy(matchProverCtxFn), mock.Anything, dbTx).Run(
+					func(args mock.Arguments) {
+						proof := args[1].(*state.BlobOuterProof)
+						assert.Equal(blobInnerNum, proof.BlobOuterNumber)
+						assert.Equal(blobInnerNum, proof.BlobOuterNumberFinal)
+						assert.Equal(batchProof.BatchNumber, proof.BatchNumber)
+						assert.Equal(batchProof.BatchNumberFinal, proof.BatchNumberFinal)
+						assert.Equal(&proverName, proof.Prover)
+						assert.Equal(&proverID, proof.ProverID)
+						assert.Equal(string(b), proof.InputProver)
+						assert.Equal(recursiveProof, proof.Proof)
+						assert.InDelta(time.Now().Unix(), proof.GeneratingSince.Unix(), float64(time.Second))
+					},
+				).Return(nil).Once()
+				m.stateMock.On("UpdateBlobOuterProof", mock.MatchedBy(matchAggregatorCtxFn), mock.Anything, nil).Run(
+					func(args mock.Arguments) {
+						proof := args[1].(*state.BlobOuterProof)
+						assert.Equal(blobInnerNum, proof.BlobOuterNumber)
+						assert.Equal(recursiveProof, proof.Proof)
+						assert.Nil(proof.GeneratingSince)
+					},
+				).Return(nil).Once()
+			},
+			asserts: func(result bool, a *Aggregator, err error) {
+				assert.True(result)
+				assert.NoError(err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stateMock := mocks.NewStateMock(t)
+			ethTxManager := mocks.NewEthTxManager(t)
+			etherman := mocks.NewEtherman(t)
+			proverMock := mocks.NewProverMock(t)
+			a, err := New(cfg, stateMock, ethTxManager, etherman, nil)
+			require.NoError(err)
+			aggregatorCtx := context.WithValue(context.Background(), "owner", "aggregator") //nolint:staticcheck
+			a.ctx, a.exit = context.WithCancel(aggregatorCtx)
+			m := mox{
+				stateMock:    stateMock,
+				ethTxManager: ethTxManager,
+				etherman:     etherman,
+				proverMock:   proverMock,
+			}
+			if tc.setup != nil {
+				tc.setup(m, &a)
+			}
+			a.resetVerifyProofTime()
+
+			result, err := a.tryGenerateBlobOuterProof(proverCtx, proverMock)
+
+			if tc.asserts != nil {
+				tc.asserts(result, &a, err)
+			}
+		})
+	}
+}
+
 func TestTryBuildFinalProof(t *testing.T) {
 	require := require.New(t)
 	assert := assert.New(t)
@@ -1306,7 +1649,7 @@ func TestTryBuildFinalProof(t *testing.T) {
 			ethTxManager := mocks.NewEthTxManager(t)
 			etherman := mocks.NewEtherman(t)
 			proverMock := mocks.NewProverMock(t)
-			a, err := New(cfg, stateMock, ethTxManager, etherman)
+			a, err := New(cfg, stateMock, ethTxManager, etherman, nil)
 			require.NoError(err)
 			aggregatorCtx := context.WithValue(context.Background(), "owner", "aggregator") //nolint:staticcheck
 			a.ctx, a.exit = context.WithCancel(aggregatorCtx)
@@ -1436,7 +1779,7 @@ func TestIsSynced(t *testing.T) {
 			ethTxManager := mocks.NewEthTxManager(t)
 			etherman := mocks.NewEtherman(t)
 			proverMock := mocks.NewProverMock(t)
-			a, err := New(cfg, stateMock, ethTxManager, etherman)
+			a, err := New(cfg, stateMock, ethTxManager, etherman, nil)
 			require.NoError(err)
 			aggregatorCtx := context.WithValue(context.Background(), "owner", "aggregator") //nolint:staticcheck
 			a.ctx, a.exit = context.WithCancel(aggregatorCtx)
@@ -171,7 +171,7 @@ func (a *Aggregator) getAndLockBatchToProve(ctx context.Context, prover proverIn
 
 	if !isProfitable {
 		log.Infof("Batch is not profitable, pol collateral %d", big.NewInt(0))
-		return nil, nil, err
+		return nil, nil, state.ErrNotFound
 	}
 
 	now := time.Now().Round(time.Microsecond)
@@ -189,6 +189,7 @@ func (a *Aggregator) getAndLockBatchToProve(ctx context.Context, prover proverIn
 		log.Errorf("Failed to add batch proof, err: %v", err)
 		return nil, nil, err
 	}
+	a.provers.setJobBatchRange(proverID, proof.BatchNumber, proof.BatchNumberFinal)
 
 	return batchToVerify, proof, nil
 }
@@ -348,9 +349,11 @@ func (a *Aggregator) tryAggregateBatchProofs(ctx context.Context, prover proverI
 }
 
 func (a *Aggregator) getAndLockBatchProofsToAggregate(ctx context.Context, prover proverInterface) (*state.Proof, *state.Proof, error) {
+	proverID := prover.ID()
+
 	log := log.WithFields(
 		"prover", prover.Name(),
-		"proverId", prover.ID(),
+		"proverId", proverID,
 		"proverAddr", prover.Addr(),
 	)
 
@@ -390,6 +393,7 @@ func (a *Aggregator) getAndLockBatchProofsToAggregate(ctx context.Context, prove
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to set proof aggregation state %w", err)
 	}
+	a.provers.setJobBatchRange(proverID, proof1.BatchNumber, proof2.BatchNumberFinal)
 
 	return proof1, proof2, nil
 }
@@ -1,7 +1,255 @@
 package aggregator
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/aggregator/prover"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+)
 
 func (a *Aggregator) tryGenerateBlobInnerProof(ctx context.Context, prover proverInterface) (bool, error) {
-	return false, nil
+	log := log.WithFields(
+		"prover", prover.Name(),
+		"proverId", prover.ID(),
+		"proverAddr", prover.Addr(),
+	)
+	log.Debug("tryGenerateBlobInnerProof start")
+
+	blobInnerToProve, proof, err0 := a.getAndLockBlobInnerToProve(ctx, prover)
+	if errors.Is(err0, state.ErrNotFound) {
+		// nothing to proof, swallow the error
+		log.Debug("Nothing to generate blob inner proof")
+		return false, nil
+	}
+	if err0 != nil {
+		return false, err0
+	}
+
+	log = log.WithFields("blobInner", blobInnerToProve.BlobInnerNum)
+
+	var (
+		genProofID *string
+		err        error
+	)
+
+	defer func() {
+		if err != nil {
+			err2 := a.State.DeleteBlobInnerProof(a.ctx, proof.BlobInnerNumber, nil)
+			if err2 != nil {
+				log.Errorf("Failed to delete blob inner proof in progress, err: %v", err2)
+			}
+		}
+		log.Debug("tryGenerateBlobInnerProof end")
+	}()
+
+	log.Info("Generating proof from blob inner")
+
+	inputProver, err := a.buildBlobInnerInputProver(ctx, blobInnerToProve)
+	if err != nil {
+		err = fmt.Errorf("failed to build blob inner input prover, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	b, err := json.Marshal(inputProver)
+	if err != nil {
+		err = fmt.Errorf("failed to serialize blob inner input prover, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	proof.InputProver = string(b)
+
+	log.Infof("Sending a blob inner to the prover. OldBlobStateRoot [%#x], OldNumBlob [%d]",
+		inputProver.PublicInputs.OldBlobStateRoot, inputProver.PublicInputs.OldNumBlob)
+
+	genProofID, err = prover.BlobInnerProof(inputProver)
+	if err != nil {
+		err = fmt.Errorf("failed to get blob inner proof id, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	proof.ProofID = genProofID
+
+	log.Infof("Proof ID %v", *proof.ProofID)
+	log = log.WithFields("proofId", *proof.ProofID)
+
+	resGetProof, err := prover.WaitRecursiveProof(ctx, *proof.ProofID)
+	if err != nil {
+		err = fmt.Errorf("failed to get blob inner proof from prover, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	log.Info("Blob inner proof generated")
+
+	proof.Proof = resGetProof
+	proof.GeneratingSince = nil
+
+	// NOTE: prover is done, use a.ctx from now on
+
+	err = a.State.UpdateBlobInnerProof(a.ctx, proof, nil)
+	if err != nil {
+		err = fmt.Errorf("failed to store blob inner proof result, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (a *Aggregator) getAndLockBlobInnerToProve(ctx context.Context, prover proverInterface) (*state.BlobInner, *state.BlobInnerProof, error) {
+	proverID := prover.ID()
+	proverName := prover.Name()
+
+	log := log.WithFields(
+		"prover", proverName,
+		"proverId", proverID,
+		"proverAddr", prover.Addr(),
+	)
+
+	a.StateDBMutex.Lock()
+	defer a.StateDBMutex.Unlock()
+
+	lastVerifiedBatch, err := a.State.GetLastVerifiedBatch(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Get header of the last L1 block
+	lastL1BlockHeader, err := a.Ethman.GetLatestBlockHeader(ctx)
+	if err != nil {
+		log.Errorf("Failed to get last L1 block header, err: %v", err)
+		return nil, nil, err
+	}
+	lastL1BlockNumber := lastL1BlockHeader.Number.Uint64()
+
+	// Calculate max L1 block number for getting next blob inner to prove
+	maxL1BlockNumber := uint64(0)
+	if a.cfg.BatchProofL1BlockConfirmations <= lastL1BlockNumber {
+		maxL1BlockNumber = lastL1BlockNumber - a.cfg.BatchProofL1BlockConfirmations
+	}
+	log.Debugf("Max L1 block number for getting next blob inner to prove: %d", maxL1BlockNumber)
+
+	// Get blob inner pending to generate proof
+	blobInnerToProve, err := a.State.GetBlobInnerToProve(ctx, lastVerifiedBatch.BatchNumber, maxL1BlockNumber, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Infof("Found blob inner %d pending to generate proof", blobInnerToProve.BlobInnerNum)
+	log = log.WithFields("blobInner", blobInnerToProve.BlobInnerNum)
+
+	log.Info("Checking profitability to prove blob inner")
+
+	// pass pol collateral as zero here, bcs in smart contract fee for aggregator is not defined yet
+	isProfitable, err := a.ProfitabilityChecker.IsProfitable(ctx, big.NewInt(0))
+	if err != nil {
+		log.Errorf("Failed to check aggregator profitability, err: %v", err)
+		return nil, nil, err
+	}
+
+	if !isProfitable {
+		log.Infof("Blob inner is not profitable, pol collateral %d", big.NewInt(0))
+		return nil, nil, state.ErrNotFound
+	}
+
+	now := time.Now().Round(time.Microsecond)
+	proof := &state.BlobInnerProof{
+		BlobInnerNumber: blobInnerToProve.BlobInnerNum,
+		Prover:          &proverName,
+		ProverID:        &proverID,
+		GeneratingSince: &now,
+	}
+
+	// Avoid other prover to process the same blob inner
+	err = a.State.AddBlobInnerProof(ctx, proof, nil)
+	if err != nil {
+		log.Errorf("Failed to add blob inner proof, err: %v", err)
+		return nil, nil, err
+	}
+
+	return blobInnerToProve, proof, nil
+}
+
+func (a *Aggregator) buildBlobInnerInputProver(ctx context.Context, blobInner *state.BlobInner) (*prover.InputBlobInnerProver, error) {
+	// The first blob inner starts from an empty blob state
+	var (
+		oldBlobStateRoot, oldBlobAccInputHash common.Hash
+		oldNumBlob                            uint64
+	)
+	if blobInner.BlobInnerNum > 0 {
+		oldNumBlob = blobInner.BlobInnerNum - 1
+		previousBlobInner, err := a.State.GetBlobInner(ctx, oldNumBlob, nil)
+		if err != nil && !errors.Is(err, state.ErrNotFound) {
+			return nil, fmt.Errorf("failed to get previous blob inner, err: %v", err)
+		}
+		if previousBlobInner != nil {
+			oldBlobStateRoot = previousBlobInner.BlobStateRoot
+			oldBlobAccInputHash = previousBlobInner.BlobAccInputHash
+		}
+	}
+
+	firstBatchNumber, _, err := a.State.GetBlobInnerBatchRange(ctx, blobInner.BlobInnerNum, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get batches of blob inner %d, err: %w", blobInner.BlobInnerNum, err)
+	}
+
+	previousBatch, err := a.State.GetBatchByNumber(ctx, firstBatchNumber-1, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get previous batch, err: %w", err)
+	}
+
+	l1InfoTreeLeaf, err := a.State.GetL1InfoRootLeafByIndex(ctx, blobInner.L1InfoLeafIndex, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L1 info tree leaf %d, err: %w", blobInner.L1InfoLeafIndex, err)
+	}
+
+	inputProver := &prover.InputBlobInnerProver{
+		PublicInputs: &prover.PublicBlobInnerInputs{
+			OldBlobStateRoot:    oldBlobStateRoot.Bytes(),
+			OldBlobAccInputHash: oldBlobAccInputHash.Bytes(),
+			OldNumBlob:          oldNumBlob,
+			OldStateRoot:        previousBatch.StateRoot.Bytes(),
+			ForkId:              forkId10,
+			LastL1InfoTreeIndex: blobInner.L1InfoLeafIndex,
+			LastL1InfoTreeRoot:  l1InfoTreeLeaf.L1InfoTreeRoot.Bytes(),
+			SequencerAddr:       blobInner.Coinbase.String(),
+			TimestampLimit:      uint64(blobInner.MaxSequenceTimestamp.Unix()),
+			ZkGasLimit:          blobInner.ZkGasLimit,
+			Type:                uint32(blobInner.Type),
+			PointZ:              blobInner.PointZ,
+			PointY:              blobInner.PointY,
+			BlobData:            blobInner.Data,
+		},
+	}
+
+	printBlobInnerInputProver(inputProver)
+
+	return inputProver, nil
+}
+
+func printBlobInnerInputProver(inputProver *prover.InputBlobInnerProver) {
+	log.Debugf("OldBlobStateRoot: %v", common.BytesToHash(inputProver.PublicInputs.OldBlobStateRoot))
+	log.Debugf("OldBlobAccInputHash: %v", common.BytesToHash(inputProver.PublicInputs.OldBlobAccInputHash))
+	log.Debugf("OldNumBlob: %v", inputProver.PublicInputs.OldNumBlob)
+	log.Debugf("OldStateRoot: %v", common.BytesToHash(inputProver.PublicInputs.OldStateRoot))
+	log.Debugf("ForkId: %v", inputProver.PublicInputs.ForkId)
+	log.Debugf("LastL1InfoTreeIndex: %v", inputProver.PublicInputs.LastL1InfoTreeIndex)
+	log.Debugf("LastL1InfoTreeRoot: %v", common.BytesToHash(inputProver.PublicInputs.LastL1InfoTreeRoot))
+	log.Debugf("SequencerAddr: %v", inputProver.PublicInputs.SequencerAddr)
+	log.Debugf("TimestampLimit: %v", inputProver.PublicInputs.TimestampLimit)
+	log.Debugf("ZkGasLimit: %v", inputProver.PublicInputs.ZkGasLimit)
+	log.Debugf("Type: %v", inputProver.PublicInputs.Type)
+	log.Debugf("PointZ: %v", common.Bytes2Hex(inputProver.PublicInputs.PointZ))
+	log.Debugf("PointY: %v", common.Bytes2Hex(inputProver.PublicInputs.PointY))
+	log.Debugf("BlobData: %v", common.Bytes2Hex(inputProver.PublicInputs.BlobData))
 }
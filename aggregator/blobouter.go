@@ -1,11 +1,487 @@
 package aggregator
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+)
 
 func (a *Aggregator) tryGenerateBlobOuterProof(ctx context.Context, prover proverInterface) (bool, error) {
-	return false, nil
+	proverName := prover.Name()
+	proverID := prover.ID()
+
+	log := log.WithFields(
+		"prover", proverName,
+		"proverId", proverID,
+		"proverAddr", prover.Addr(),
+	)
+	log.Debug("tryGenerateBlobOuterProof start")
+
+	batchProof, blobInnerProof, err0 := a.getAndLockProofsToBlobOuter(ctx, prover)
+	if errors.Is(err0, state.ErrNotFound) {
+		// nothing to proof, swallow the error
+		log.Debug("Nothing to generate blob outer proof")
+		return false, nil
+	}
+	if err0 != nil {
+		return false, err0
+	}
+
+	var (
+		genProofID *string
+		err        error
+	)
+
+	defer func() {
+		if err != nil {
+			err2 := a.unlockProofsToBlobOuter(a.ctx, batchProof, blobInnerProof)
+			if err2 != nil {
+				log.Errorf("Failed to release blob outer proofs, err: %v", err2)
+			}
+		}
+		log.Debug("tryGenerateBlobOuterProof end")
+	}()
+
+	log = log.WithFields(
+		"blobInner", blobInnerProof.BlobInnerNumber,
+		"batches", fmt.Sprintf("%d-%d", batchProof.BatchNumber, batchProof.BatchNumberFinal),
+	)
+	log.Info("Generating blob outer proof")
+
+	inputProver := map[string]interface{}{
+		"batch_proof":      batchProof.Proof,
+		"blob_inner_proof": blobInnerProof.Proof,
+	}
+	b, err := json.Marshal(inputProver)
+	if err != nil {
+		err = fmt.Errorf("failed to serialize input prover, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	proof := &state.BlobOuterProof{
+		BlobOuterNumber:      blobInnerProof.BlobInnerNumber,
+		BlobOuterNumberFinal: blobInnerProof.BlobInnerNumber,
+		BatchNumber:          batchProof.BatchNumber,
+		BatchNumberFinal:     batchProof.BatchNumberFinal,
+		Prover:               &proverName,
+		ProverID:             &proverID,
+		InputProver:          string(b),
+	}
+
+	genProofID, err = prover.BlobOuterProof(batchProof.Proof, blobInnerProof.Proof)
+	if err != nil {
+		err = fmt.Errorf("failed to get blob outer proof id, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	proof.ProofID = genProofID
+
+	log.Infof("Proof ID for blob outer proof: %v", *proof.ProofID)
+	log = log.WithFields("proofId", *proof.ProofID)
+
+	recursiveProof, err := prover.WaitRecursiveProof(ctx, *proof.ProofID)
+	if err != nil {
+		err = fmt.Errorf("failed to get blob outer proof from prover, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	log.Info("Blob outer proof generated")
+
+	proof.Proof = recursiveProof
+
+	// update the state by removing the batch and blob inner proofs and
+	// storing the newly generated blob outer proof
+	dbTx, err := a.State.BeginStateTransaction(ctx)
+	if err != nil {
+		err = fmt.Errorf("failed to begin transaction to update blob outer proof state, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	err = a.State.DeleteBatchProofs(ctx, batchProof.BatchNumber, batchProof.BatchNumberFinal, dbTx)
+	if err == nil {
+		err = a.State.DeleteBlobInnerProof(ctx, blobInnerProof.BlobInnerNumber, dbTx)
+	}
+	if err != nil {
+		if err := dbTx.Rollback(ctx); err != nil {
+			err := fmt.Errorf("failed to rollback blob outer proof state, %w", err)
+			log.Error(FirstToUpper(err.Error()))
+			return false, err
+		}
+		err = fmt.Errorf("failed to delete proofs used to generate the blob outer proof, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	now := time.Now().Round(time.Microsecond)
+	proof.GeneratingSince = &now
+
+	err = a.State.AddBlobOuterProof(ctx, proof, dbTx)
+	if err != nil {
+		if err := dbTx.Rollback(ctx); err != nil {
+			err := fmt.Errorf("failed to rollback blob outer proof state, %w", err)
+			log.Error(FirstToUpper(err.Error()))
+			return false, err
+		}
+		err = fmt.Errorf("failed to store the blob outer proof, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	err = dbTx.Commit(ctx)
+	if err != nil {
+		err = fmt.Errorf("failed to store the blob outer proof, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	// The defer func is useless from now on, use a different variable
+	// name for errors (or shadow err in inner scopes) to not trigger it.
+
+	finalProofBuilt, finalProofErr := a.tryBuildFinalBlobOuterProof(ctx, prover, proof)
+	if finalProofErr != nil {
+		// just log the error and continue to handle the generated proof
+		log.Errorf("Error trying to build final proof: %v", finalProofErr)
+	}
+
+	// Prover is done, use a.ctx from now on
+
+	if !finalProofBuilt {
+		proof.GeneratingSince = nil
+
+		// final proof has not been generated, update the blob outer proof
+		err := a.State.UpdateBlobOuterProof(a.ctx, proof, nil)
+		if err != nil {
+			err = fmt.Errorf("failed to store blob outer proof result, %w", err)
+			log.Error(FirstToUpper(err.Error()))
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (a *Aggregator) getAndLockProofsToBlobOuter(ctx context.Context, prover proverInterface) (*state.Proof, *state.BlobInnerProof, error) {
+	proverID := prover.ID()
+
+	log := log.WithFields(
+		"prover", prover.Name(),
+		"proverId", proverID,
+		"proverAddr", prover.Addr(),
+	)
+
+	a.StateDBMutex.Lock()
+	defer a.StateDBMutex.Unlock()
+
+	batchProof, blobInnerProof, err := a.State.GetProofsToBlobOuter(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Set proofs in generating state in a single transaction
+	dbTx, err := a.State.BeginStateTransaction(ctx)
+	if err != nil {
+		log.Errorf("Failed to begin transaction to set blob outer proof state, err: %v", err)
+		return nil, nil, err
+	}
+
+	now := time.Now().Round(time.Microsecond)
+	batchProof.GeneratingSince = &now
+	err = a.State.UpdateBatchProof(ctx, batchProof, dbTx)
+	if err == nil {
+		blobInnerProof.GeneratingSince = &now
+		err = a.State.UpdateBlobInnerProof(ctx, blobInnerProof, dbTx)
+	}
+
+	if err != nil {
+		if err := dbTx.Rollback(ctx); err != nil {
+			err := fmt.Errorf("failed to rollback blob outer proof state %w", err)
+			log.Error(FirstToUpper(err.Error()))
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("failed to set blob outer proof state %w", err)
+	}
+
+	err = dbTx.Commit(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to set blob outer proof state %w", err)
+	}
+	a.provers.setJobBatchRange(proverID, batchProof.BatchNumber, batchProof.BatchNumberFinal)
+
+	return batchProof, blobInnerProof, nil
+}
+
+func (a *Aggregator) unlockProofsToBlobOuter(ctx context.Context, batchProof *state.Proof, blobInnerProof *state.BlobInnerProof) error {
+	// Release proofs from generating state in a single transaction
+	dbTx, err := a.State.BeginStateTransaction(ctx)
+	if err != nil {
+		log.Warnf("Failed to begin transaction to release blob outer proof state, err: %v", err)
+		return err
+	}
+
+	batchProof.GeneratingSince = nil
+	err = a.State.UpdateBatchProof(ctx, batchProof, dbTx)
+	if err == nil {
+		blobInnerProof.GeneratingSince = nil
+		err = a.State.UpdateBlobInnerProof(ctx, blobInnerProof, dbTx)
+	}
+
+	if err != nil {
+		if err := dbTx.Rollback(ctx); err != nil {
+			err := fmt.Errorf("failed to rollback blob outer proof state: %w", err)
+			log.Error(FirstToUpper(err.Error()))
+			return err
+		}
+		return fmt.Errorf("failed to release blob outer proof state: %w", err)
+	}
+
+	err = dbTx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release blob outer proof state %w", err)
+	}
+
+	return nil
 }
 
 func (a *Aggregator) tryAggregateBlobOuterProofs(ctx context.Context, prover proverInterface) (bool, error) {
-	return false, nil
+	proverName := prover.Name()
+	proverID := prover.ID()
+
+	log := log.WithFields(
+		"prover", proverName,
+		"proverId", proverID,
+		"proverAddr", prover.Addr(),
+	)
+	log.Debug("tryAggregateBlobOuterProofs start")
+
+	proof1, proof2, err0 := a.getAndLockBlobOuterProofsToAggregate(ctx, prover)
+	if errors.Is(err0, state.ErrNotFound) {
+		// nothing to aggregate, swallow the error
+		log.Debug("Nothing to aggregate")
+		return false, nil
+	}
+	if err0 != nil {
+		return false, err0
+	}
+
+	var (
+		aggrProofID *string
+		err         error
+	)
+
+	defer func() {
+		if err != nil {
+			err2 := a.unlockBlobOuterProofsToAggregate(a.ctx, proof1, proof2)
+			if err2 != nil {
+				log.Errorf("Failed to release aggregated blob outer proofs, err: %v", err2)
+			}
+		}
+		log.Debug("tryAggregateBlobOuterProofs end")
+	}()
+
+	log.Infof("Aggregating blob outer proofs: %d-%d and %d-%d", proof1.BlobOuterNumber, proof1.BlobOuterNumberFinal, proof2.BlobOuterNumber, proof2.BlobOuterNumberFinal)
+
+	blobs := fmt.Sprintf("%d-%d", proof1.BlobOuterNumber, proof2.BlobOuterNumberFinal)
+	log = log.WithFields("blobs", blobs)
+
+	inputProver := map[string]interface{}{
+		"blob_outer_proof_1": proof1.Proof,
+		"blob_outer_proof_2": proof2.Proof,
+	}
+	b, err := json.Marshal(inputProver)
+	if err != nil {
+		err = fmt.Errorf("failed to serialize input prover, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	proof := &state.BlobOuterProof{
+		BlobOuterNumber:      proof1.BlobOuterNumber,
+		BlobOuterNumberFinal: proof2.BlobOuterNumberFinal,
+		BatchNumber:          proof1.BatchNumber,
+		BatchNumberFinal:     proof2.BatchNumberFinal,
+		Prover:               &proverName,
+		ProverID:             &proverID,
+		InputProver:          string(b),
+	}
+
+	aggrProofID, err = prover.AggregatedBlobOuterProof(proof1.Proof, proof2.Proof)
+	if err != nil {
+		err = fmt.Errorf("failed to get aggregated blob outer proof id, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	proof.ProofID = aggrProofID
+
+	log.Infof("Proof ID for aggregated blob outer proof: %v", *proof.ProofID)
+	log = log.WithFields("proofId", *proof.ProofID)
+
+	recursiveProof, err := prover.WaitRecursiveProof(ctx, *proof.ProofID)
+	if err != nil {
+		err = fmt.Errorf("failed to get aggregated blob outer proof from prover, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	log.Info("Aggregated blob outer proof generated")
+
+	proof.Proof = recursiveProof
+
+	// update the state by removing the 2 aggregated proofs and storing the
+	// newly generated recursive proof
+	dbTx, err := a.State.BeginStateTransaction(ctx)
+	if err != nil {
+		err = fmt.Errorf("failed to begin transaction to update blob outer proof aggregation state, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	err = a.State.DeleteBlobOuterProofs(ctx, proof1.BlobOuterNumber, proof2.BlobOuterNumberFinal, dbTx)
+	if err != nil {
+		if err := dbTx.Rollback(ctx); err != nil {
+			err := fmt.Errorf("failed to rollback blob outer proof aggregation state, %w", err)
+			log.Error(FirstToUpper(err.Error()))
+			return false, err
+		}
+		err = fmt.Errorf("failed to delete previously aggregated blob outer proofs, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	now := time.Now().Round(time.Microsecond)
+	proof.GeneratingSince = &now
+
+	err = a.State.AddBlobOuterProof(ctx, proof, dbTx)
+	if err != nil {
+		if err := dbTx.Rollback(ctx); err != nil {
+			err := fmt.Errorf("failed to rollback blob outer proof aggregation state, %w", err)
+			log.Error(FirstToUpper(err.Error()))
+			return false, err
+		}
+		err = fmt.Errorf("failed to store the aggregated blob outer proof, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	err = dbTx.Commit(ctx)
+	if err != nil {
+		err = fmt.Errorf("failed to store the aggregated blob outer proof, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	// The defer func is useless from now on, use a different variable
+	// name for errors (or shadow err in inner scopes) to not trigger it.
+
+	// state is up to date, check if we can send the final proof using the
+	// one just crafted.
+	finalProofBuilt, finalProofErr := a.tryBuildFinalBlobOuterProof(ctx, prover, proof)
+	if finalProofErr != nil {
+		// just log the error and continue to handle the aggregated proof
+		log.Errorf("Failed trying to check if aggregated blob outer proof can be verified: %v", finalProofErr)
+	}
+
+	// Prover is done, use a.ctx from now on
+
+	if !finalProofBuilt {
+		proof.GeneratingSince = nil
+
+		// final proof has not been generated, update the aggregated proof
+		err := a.State.UpdateBlobOuterProof(a.ctx, proof, nil)
+		if err != nil {
+			err = fmt.Errorf("failed to store blob outer proof result, %w", err)
+			log.Error(FirstToUpper(err.Error()))
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (a *Aggregator) getAndLockBlobOuterProofsToAggregate(ctx context.Context, prover proverInterface) (*state.BlobOuterProof, *state.BlobOuterProof, error) {
+	log := log.WithFields(
+		"prover", prover.Name(),
+		"proverId", prover.ID(),
+		"proverAddr", prover.Addr(),
+	)
+
+	a.StateDBMutex.Lock()
+	defer a.StateDBMutex.Unlock()
+
+	proof1, proof2, err := a.State.GetBlobOuterProofsToAggregate(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Set proofs in generating state in a single transaction
+	dbTx, err := a.State.BeginStateTransaction(ctx)
+	if err != nil {
+		log.Errorf("Failed to begin transaction to set blob outer proof aggregation state, err: %v", err)
+		return nil, nil, err
+	}
+
+	now := time.Now().Round(time.Microsecond)
+	proof1.GeneratingSince = &now
+	err = a.State.UpdateBlobOuterProof(ctx, proof1, dbTx)
+	if err == nil {
+		proof2.GeneratingSince = &now
+		err = a.State.UpdateBlobOuterProof(ctx, proof2, dbTx)
+	}
+
+	if err != nil {
+		if err := dbTx.Rollback(ctx); err != nil {
+			err := fmt.Errorf("failed to rollback blob outer proof aggregation state %w", err)
+			log.Error(FirstToUpper(err.Error()))
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("failed to set blob outer proof aggregation state %w", err)
+	}
+
+	err = dbTx.Commit(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to set blob outer proof aggregation state %w", err)
+	}
+
+	return proof1, proof2, nil
+}
+
+func (a *Aggregator) unlockBlobOuterProofsToAggregate(ctx context.Context, proof1 *state.BlobOuterProof, proof2 *state.BlobOuterProof) error {
+	// Release proofs from generating state in a single transaction
+	dbTx, err := a.State.BeginStateTransaction(ctx)
+	if err != nil {
+		log.Warnf("Failed to begin transaction to release blob outer proof aggregation state, err: %v", err)
+		return err
+	}
+
+	proof1.GeneratingSince = nil
+	err = a.State.UpdateBlobOuterProof(ctx, proof1, dbTx)
+	if err == nil {
+		proof2.GeneratingSince = nil
+		err = a.State.UpdateBlobOuterProof(ctx, proof2, dbTx)
+	}
+
+	if err != nil {
+		if err := dbTx.Rollback(ctx); err != nil {
+			err := fmt.Errorf("failed to rollback blob outer proof aggregation state: %w", err)
+			log.Error(FirstToUpper(err.Error()))
+			return err
+		}
+		return fmt.Errorf("failed to release blob outer proof aggregation state: %w", err)
+	}
+
+	err = dbTx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release blob outer proof aggregation state %w", err)
+	}
+
+	return nil
 }
@@ -46,13 +46,19 @@ type Config struct {
 	ProofStatePollingInterval types.Duration `mapstructure:"ProofStatePollingInterval"`
 
 	// TxProfitabilityCheckerType type for checking is it profitable for aggregator to validate batch
-	// possible values: base/acceptall
+	// possible values: base/acceptall/l1cost
 	TxProfitabilityCheckerType TxProfitabilityCheckerType `mapstructure:"TxProfitabilityCheckerType"`
 
 	// TxProfitabilityMinReward min reward for base tx profitability checker when aggregator will validate batch
 	// this parameter is used for the base tx profitability checker
 	TxProfitabilityMinReward TokenAmountWithDecimals `mapstructure:"TxProfitabilityMinReward"`
 
+	// TxProfitabilityVerifyBatchesGas is the gas of the L1 tx verifying the batches until the
+	// first one sent by the aggregator is mined, then the gas used by the last one mined is used,
+	// this parameter is used for the l1cost tx profitability checker to estimate the
+	// L1 cost of verifying the pending batches with the current L1 gas price
+	TxProfitabilityVerifyBatchesGas uint64 `mapstructure:"TxProfitabilityVerifyBatchesGas"`
+
 	// IntervalAfterWhichBatchConsolidateAnyway this is interval for the main sequencer, that will check if there is no transactions
 	IntervalAfterWhichBatchConsolidateAnyway types.Duration `mapstructure:"IntervalAfterWhichBatchConsolidateAnyway"`
 
@@ -91,4 +97,29 @@ type Config struct {
 
 	// BatchProofL1BlockConfirmations is number of L1 blocks to consider we can generate the proof for a virtual batch
 	BatchProofL1BlockConfirmations uint64 `mapstructure:"BatchProofL1BlockConfirmations"`
+
+	// ProverSchedulerType is the algorithm used to assign tasks to the provers
+	// possible values: greedy/latency
+	ProverSchedulerType ProverSchedulerType `mapstructure:"ProverSchedulerType"`
+
+	// FinalProofProvers are the names or IDs of the provers dedicated to build the final
+	// proofs. While any of them is connected the rest of provers don't build final proofs
+	FinalProofProvers []string `mapstructure:"FinalProofProvers"`
+
+	// Admin is the configuration of the admin API of the aggregator
+	Admin AdminCfg `mapstructure:"Admin"`
+}
+
+// AdminCfg contains the aggregator admin API configuration properties
+type AdminCfg struct {
+	// Enabled enables the admin API, it must only be reachable by the operators
+	// of the aggregator as it allows to cancel the jobs of the provers
+	Enabled bool `mapstructure:"Enabled"`
+	// Host for the admin http server
+	Host string `mapstructure:"Host"`
+	// Port for the admin http server
+	Port int `mapstructure:"Port"`
+	// AuthToken is the bearer token the requests to the admin API must provide
+	// in the Authorization header, it's required when the admin API is enabled
+	AuthToken string `mapstructure:"AuthToken"`
 }
@@ -56,7 +56,7 @@ func (a *Aggregator) tryBuildFinalProof(ctx context.Context, prover proverInterf
 		// we don't have a proof generating at the moment, check if we
 		// have a proof ready to verify
 
-		proof, err = a.getAndLockProofReadyForFinal(ctx, prover, lastVerifiedBatchNum)
+		proof, err = a.getAndLockProofReadyForFinal(ctx, proverID, lastVerifiedBatchNum)
 		if errors.Is(err, state.ErrNotFound) {
 			// nothing to verify, swallow the error
 			log.Debug("No proof ready to verify")
@@ -118,6 +118,123 @@ func (a *Aggregator) tryBuildFinalProof(ctx context.Context, prover proverInterf
 	return true, nil
 }
 
+// tryBuildFinalBlobOuterProof checks if the provided blob outer proof is
+// eligible to be used to build the final proof.  If no proof is provided it
+// looks for a previously generated blob outer proof.  If the proof is
+// eligible, then the final proof generation is triggered.
+func (a *Aggregator) tryBuildFinalBlobOuterProof(ctx context.Context, prover proverInterface, proof *state.BlobOuterProof) (bool, error) {
+	proverName := prover.Name()
+	proverID := prover.ID()
+
+	log := log.WithFields(
+		"prover", proverName,
+		"proverId", proverID,
+		"proverAddr", prover.Addr(),
+	)
+	log.Debug("tryBuildFinalBlobOuterProof start")
+
+	var err error
+	if !a.canVerifyProof() {
+		log.Debug("Time to verify proof not reached or proof verification in progress")
+		return false, nil
+	}
+	log.Debug("Send final proof time reached")
+
+	for !a.isSynced(ctx, nil) {
+		log.Info("Waiting for synchronizer to sync...")
+		time.Sleep(a.cfg.RetryTime.Duration)
+		continue
+	}
+
+	var lastVerifiedBatchNum uint64
+	lastVerifiedBatch, err := a.State.GetLastVerifiedBatch(ctx, nil)
+	if err != nil && !errors.Is(err, state.ErrNotFound) {
+		return false, fmt.Errorf("failed to get last verified batch, %w", err)
+	}
+	if lastVerifiedBatch != nil {
+		lastVerifiedBatchNum = lastVerifiedBatch.BatchNumber
+	}
+
+	if proof == nil {
+		// we don't have a proof generating at the moment, check if we
+		// have a proof ready to verify
+
+		proof, err = a.getAndLockBlobOuterProofReadyForFinal(ctx, lastVerifiedBatchNum)
+		if errors.Is(err, state.ErrNotFound) {
+			// nothing to verify, swallow the error
+			log.Debug("No blob outer proof ready to verify")
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		defer func() {
+			if err != nil {
+				// Set the generating state to false for the proof ("unlock" it)
+				proof.GeneratingSince = nil
+				err2 := a.State.UpdateBlobOuterProof(a.ctx, proof, nil)
+				if err2 != nil {
+					log.Errorf("Failed to unlock blob outer proof: %v", err2)
+				}
+			}
+		}()
+	} else {
+		// we do have a proof generating at the moment, check if it is
+		// eligible to be verified
+		eligible, err := a.validateEligibleFinalBlobOuterProof(ctx, proof, lastVerifiedBatchNum)
+		if err != nil {
+			return false, fmt.Errorf("failed to validate eligible final blob outer proof, %w", err)
+		}
+		if !eligible {
+			return false, nil
+		}
+	}
+
+	log = log.WithFields(
+		"proofId", *proof.ProofID,
+		"blobs", fmt.Sprintf("%d-%d", proof.BlobOuterNumber, proof.BlobOuterNumberFinal),
+		"batches", fmt.Sprintf("%d-%d", proof.BatchNumber, proof.BatchNumberFinal),
+	)
+
+	// the final proof is built and sent using the batch range covered by the
+	// blob outer proof
+	recursiveProof := &state.Proof{
+		BatchNumber:      proof.BatchNumber,
+		BatchNumberFinal: proof.BatchNumberFinal,
+		Proof:            proof.Proof,
+		ProofID:          proof.ProofID,
+		Prover:           proof.Prover,
+		ProverID:         proof.ProverID,
+		GeneratingSince:  proof.GeneratingSince,
+	}
+
+	// at this point we have an eligible proof, build the final one using it
+	finalProof, err := a.buildFinalProof(ctx, prover, recursiveProof)
+	if err != nil {
+		err = fmt.Errorf("failed to build final proof, %w", err)
+		log.Error(FirstToUpper(err.Error()))
+		return false, err
+	}
+
+	msg := finalProofMsg{
+		proverName:     proverName,
+		proverID:       proverID,
+		recursiveProof: recursiveProof,
+		blobOuterProof: proof,
+		finalProof:     finalProof,
+	}
+
+	select {
+	case <-a.ctx.Done():
+		return false, a.ctx.Err()
+	case a.finalProof <- msg:
+	}
+
+	log.Debug("tryBuildFinalBlobOuterProof end")
+	return true, nil
+}
+
 // buildFinalProof builds and return the final proof for an aggregated/batch proof.
 func (a *Aggregator) buildFinalProof(ctx context.Context, prover proverInterface, proof *state.Proof) (*prover.FinalProof, error) {
 	log := log.WithFields(
@@ -162,7 +279,7 @@ func (a *Aggregator) buildFinalProof(ctx context.Context, prover proverInterface
 	return finalProof, nil
 }
 
-func (a *Aggregator) getAndLockProofReadyForFinal(ctx context.Context, prover proverInterface, lastVerifiedBatchNum uint64) (*state.Proof, error) {
+func (a *Aggregator) getAndLockProofReadyForFinal(ctx context.Context, proverID string, lastVerifiedBatchNum uint64) (*state.Proof, error) {
 	a.StateDBMutex.Lock()
 	defer a.StateDBMutex.Unlock()
 
@@ -179,10 +296,60 @@ func (a *Aggregator) getAndLockProofReadyForFinal(ctx context.Context, prover pr
 	if err != nil {
 		return nil, err
 	}
+	a.provers.setJobBatchRange(proverID, proofToVerify.BatchNumber, proofToVerify.BatchNumberFinal)
+
+	return proofToVerify, nil
+}
+
+func (a *Aggregator) getAndLockBlobOuterProofReadyForFinal(ctx context.Context, lastVerifiedBatchNum uint64) (*state.BlobOuterProof, error) {
+	a.StateDBMutex.Lock()
+	defer a.StateDBMutex.Unlock()
+
+	// Get blob outer proof ready to be verified
+	proofToVerify, err := a.State.GetBlobOuterProofReadyForFinal(ctx, lastVerifiedBatchNum, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Round(time.Microsecond)
+	proofToVerify.GeneratingSince = &now
+
+	err = a.State.UpdateBlobOuterProof(ctx, proofToVerify, nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return proofToVerify, nil
 }
 
+func (a *Aggregator) validateEligibleFinalBlobOuterProof(ctx context.Context, proof *state.BlobOuterProof, lastVerifiedBatchNum uint64) (bool, error) {
+	batchNumberToVerify := lastVerifiedBatchNum + 1
+
+	if proof.BatchNumber != batchNumberToVerify {
+		if proof.BatchNumberFinal < batchNumberToVerify {
+			// We have a proof that contains batches below that the last batch verified, we need to delete this proof
+			log.Warnf("Blob outer proof %d-%d lower than next batch to verify %d. Deleting it", proof.BlobOuterNumber, proof.BlobOuterNumberFinal, batchNumberToVerify)
+			err := a.State.DeleteBlobOuterProofs(ctx, proof.BlobOuterNumber, proof.BlobOuterNumberFinal, nil)
+			if err != nil {
+				return false, fmt.Errorf("failed to delete discarded blob outer proof, err: %w", err)
+			}
+			return false, nil
+		}
+		log.Debugf("Blob outer proof batch number %d is not the following to last verfied batch number %d", proof.BatchNumber, lastVerifiedBatchNum)
+		return false, nil
+	}
+
+	bComplete, err := a.State.CheckBlobOuterProofContainsCompleteSequences(ctx, proof, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if blob outer proof contains complete sequences, %w", err)
+	}
+	if !bComplete {
+		log.Infof("Blob outer proof %d-%d not eligible to be verified: not containing complete sequences", proof.BlobOuterNumber, proof.BlobOuterNumberFinal)
+		return false, nil
+	}
+	return true, nil
+}
+
 func (a *Aggregator) validateEligibleFinalProof(ctx context.Context, proof *state.Proof, lastVerifiedBatchNum uint64) (bool, error) {
 	batchNumberToVerify := lastVerifiedBatchNum + 1
 
@@ -254,7 +421,7 @@ func (a *Aggregator) sendFinalProof() {
 			to, data, err := a.Ethman.BuildTrustedVerifyBatchesTxData(proof.BatchNumber-1, proof.BatchNumberFinal, &inputs, sender)
 			if err != nil {
 				log.Errorf("Error estimating batch verification to add to eth tx manager: %v", err)
-				a.handleErrorSendFinalProof(ctx, proof)
+				a.handleErrorSendFinalProof(ctx, msg)
 				continue
 			}
 			monitoredTxID := buildMonitoredTxID(proof.BatchNumber, proof.BatchNumberFinal)
@@ -262,7 +429,7 @@ func (a *Aggregator) sendFinalProof() {
 			if err != nil {
 				mTxLogger := ethtxmanager.CreateLogger(ethTxManagerOwner, monitoredTxID, sender, to)
 				mTxLogger.Errorf("Error to add batch verification tx to eth tx manager: %v", err)
-				a.handleErrorSendFinalProof(ctx, proof)
+				a.handleErrorSendFinalProof(ctx, msg)
 				continue
 			}
 
@@ -277,10 +444,17 @@ func (a *Aggregator) sendFinalProof() {
 	}
 }
 
-func (a *Aggregator) handleErrorSendFinalProof(ctx context.Context, proof *state.Proof) {
+func (a *Aggregator) handleErrorSendFinalProof(ctx context.Context, msg finalProofMsg) {
+	proof := msg.recursiveProof
 	log := log.WithFields("proofId", proof.ProofID, "batches", fmt.Sprintf("%d-%d", proof.BatchNumber, proof.BatchNumberFinal))
-	proof.GeneratingSince = nil
-	err := a.State.UpdateBatchProof(ctx, proof, nil)
+	var err error
+	if msg.blobOuterProof != nil {
+		msg.blobOuterProof.GeneratingSince = nil
+		err = a.State.UpdateBlobOuterProof(ctx, msg.blobOuterProof, nil)
+	} else {
+		proof.GeneratingSince = nil
+		err = a.State.UpdateBatchProof(ctx, proof, nil)
+	}
 	if err != nil {
 		log.Errorf("Failed updating proof state (false): %v", err)
 	}
@@ -23,8 +23,12 @@ type proverInterface interface {
 	BatchProof(input *prover.InputProver) (*string, error)
 	AggregatedProof(inputProof1, inputProof2 string) (*string, error)
 	FinalProof(inputProof string, aggregatorAddr string) (*string, error)
+	BlobInnerProof(input *prover.InputBlobInnerProver) (*string, error)
+	BlobOuterProof(batchProof, blobInnerProof string) (*string, error)
+	AggregatedBlobOuterProof(inputProof1, inputProof2 string) (*string, error)
 	WaitRecursiveProof(ctx context.Context, proofID string) (string, error)
 	WaitFinalProof(ctx context.Context, proofID string) (*prover.FinalProof, error)
+	CancelProofRequest(proofID string) error
 }
 
 // ethTxManager contains the methods required to send txs to
@@ -41,6 +45,7 @@ type etherman interface {
 	GetLatestVerifiedBatchNum() (uint64, error)
 	BuildTrustedVerifyBatchesTxData(lastVerifiedBatch, newVerifiedBatch uint64, inputs *ethmanTypes.FinalProofInputs, beneficiary common.Address) (to *common.Address, data []byte, err error)
 	GetLatestBlockHeader(ctx context.Context) (*types.Header, error)
+	GetL1GasPrice(ctx context.Context) *big.Int
 }
 
 // aggregatorTxProfitabilityChecker interface for different profitability
@@ -49,11 +54,19 @@ type aggregatorTxProfitabilityChecker interface {
 	IsProfitable(context.Context, *big.Int) (bool, error)
 }
 
+// verifyBatchesGasTracker is implemented by the profitability checkers that
+// estimate the L1 cost with the gas used by the verify batches txs mined
+type verifyBatchesGasTracker interface {
+	SetVerifyBatchesGasUsed(gasUsed uint64)
+}
+
 // stateInterface gathers the methods to interact with the state.
 type stateInterface interface {
 	BeginStateTransaction(ctx context.Context) (pgx.Tx, error)
 	CheckProofContainsCompleteSequences(ctx context.Context, proof *state.Proof, dbTx pgx.Tx) (bool, error)
 	GetLastVerifiedBatch(ctx context.Context, dbTx pgx.Tx) (*state.VerifiedBatch, error)
+	GetLastVirtualBatchNum(ctx context.Context, dbTx pgx.Tx) (uint64, error)
+	GetBatchesFees(ctx context.Context, fromBatchNumber, toBatchNumber uint64, dbTx pgx.Tx) (*big.Int, error)
 	GetProofReadyForFinal(ctx context.Context, lastVerfiedBatchNumber uint64, dbTx pgx.Tx) (*state.Proof, error)
 	GetVirtualBatchToProve(ctx context.Context, lastVerfiedBatchNumber uint64, maxL1Block uint64, dbTx pgx.Tx) (*state.Batch, error)
 	GetBatchProofsToAggregate(ctx context.Context, dbTx pgx.Tx) (*state.Proof, *state.Proof, error)
@@ -62,6 +75,8 @@ type stateInterface interface {
 	UpdateBatchProof(ctx context.Context, proof *state.Proof, dbTx pgx.Tx) error
 	DeleteBatchProofs(ctx context.Context, batchNumber uint64, batchNumberFinal uint64, dbTx pgx.Tx) error
 	DeleteUngeneratedBatchProofs(ctx context.Context, dbTx pgx.Tx) error
+	GetBatchProofs(ctx context.Context, dbTx pgx.Tx) ([]*state.Proof, error)
+	UnlockBatchProofs(ctx context.Context, batchNumber uint64, batchNumberFinal uint64, dbTx pgx.Tx) (int64, error)
 	CleanupBatchProofs(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) error
 	CleanupLockedBatchProofs(ctx context.Context, duration string, dbTx pgx.Tx) (int64, error)
 	GetL1InfoRootLeafByIndex(ctx context.Context, l1InfoTreeIndex uint32, dbTx pgx.Tx) (state.L1InfoTreeExitRootStorageEntry, error)
@@ -69,4 +84,20 @@ type stateInterface interface {
 	GetVirtualBatchParentHash(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) (common.Hash, error)
 	GetForcedBatchParentHash(ctx context.Context, forcedBatchNumber uint64, dbTx pgx.Tx) (common.Hash, error)
 	GetVirtualBatch(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) (*state.VirtualBatch, error)
+	GetBlobInner(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx) (*state.BlobInner, error)
+	GetBlobInnerToProve(ctx context.Context, lastVerfiedBatchNumber uint64, maxL1Block uint64, dbTx pgx.Tx) (*state.BlobInner, error)
+	GetBlobInnerBatchRange(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx) (uint64, uint64, error)
+	AddBlobInnerProof(ctx context.Context, proof *state.BlobInnerProof, dbTx pgx.Tx) error
+	UpdateBlobInnerProof(ctx context.Context, proof *state.BlobInnerProof, dbTx pgx.Tx) error
+	DeleteBlobInnerProof(ctx context.Context, blobInnerNumber uint64, dbTx pgx.Tx) error
+	GetProofsToBlobOuter(ctx context.Context, dbTx pgx.Tx) (*state.Proof, *state.BlobInnerProof, error)
+	AddBlobOuterProof(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx) error
+	UpdateBlobOuterProof(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx) error
+	DeleteBlobOuterProofs(ctx context.Context, blobOuterNumber uint64, blobOuterNumberFinal uint64, dbTx pgx.Tx) error
+	GetBlobOuterProofsToAggregate(ctx context.Context, dbTx pgx.Tx) (*state.BlobOuterProof, *state.BlobOuterProof, error)
+	GetBlobOuterProofReadyForFinal(ctx context.Context, lastVerfiedBatchNumber uint64, dbTx pgx.Tx) (*state.BlobOuterProof, error)
+	CheckBlobOuterProofContainsCompleteSequences(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx) (bool, error)
+	CleanupBlobProofs(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) error
+	CleanupLockedBlobProofs(ctx context.Context, duration string, dbTx pgx.Tx) (int64, error)
+	DeleteUngeneratedBlobProofs(ctx context.Context, dbTx pgx.Tx) error
 }
@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/0xPolygonHermez/zkevm-node/metrics"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -9,6 +11,12 @@ const (
 	prefix                      = "aggregator_"
 	currentConnectedProversName = prefix + "current_connected_provers"
 	currentWorkingProversName   = prefix + "current_working_provers"
+	scheduledTasksName          = prefix + "scheduled_tasks"
+	proverQueueDepthName        = prefix + "prover_queue_depth"
+	proofLatencyName            = prefix + "proof_latency"
+
+	taskLabelName   = "task"
+	proverLabelName = "prover"
 )
 
 // Register the metrics for the sequencer package.
@@ -24,7 +32,40 @@ func Register() {
 		},
 	}
 
+	counterVecs := []metrics.CounterVecOpts{
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: scheduledTasksName,
+				Help: "[AGGREGATOR] number of tasks run by the provers",
+			},
+			Labels: []string{taskLabelName},
+		},
+	}
+
+	gaugeVecs := []metrics.GaugeVecOpts{
+		{
+			GaugeOpts: prometheus.GaugeOpts{
+				Name: proverQueueDepthName,
+				Help: "[AGGREGATOR] number of tasks queued for each prover",
+			},
+			Labels: []string{proverLabelName},
+		},
+	}
+
+	histogramVecs := []metrics.HistogramVecOpts{
+		{
+			HistogramOpts: prometheus.HistogramOpts{
+				Name: proofLatencyName,
+				Help: "[AGGREGATOR] time in seconds taken by the provers to complete each type of task",
+			},
+			Labels: []string{taskLabelName},
+		},
+	}
+
 	metrics.RegisterGauges(gauges...)
+	metrics.RegisterCounterVecs(counterVecs...)
+	metrics.RegisterGaugeVecs(gaugeVecs...)
+	metrics.RegisterHistogramVecs(histogramVecs...)
 }
 
 // ConnectedProver increments the gauge for the current number of connected
@@ -49,3 +90,25 @@ func WorkingProver() {
 func IdlingProver() {
 	metrics.GaugeDec(currentWorkingProversName)
 }
+
+// ScheduledTask increments the counter of the tasks of the given type run by
+// the provers.
+func ScheduledTask(task string) {
+	metrics.CounterVecInc(scheduledTasksName, task)
+}
+
+// ProverQueueDepth sets the number of tasks queued for the given prover.
+func ProverQueueDepth(proverID string, depth int) {
+	metrics.GaugeVecSet(proverQueueDepthName, proverID, float64(depth))
+}
+
+// RemoveProverQueueDepth stops exporting the queue depth of the given prover.
+func RemoveProverQueueDepth(proverID string) {
+	metrics.GaugeVecDelete(proverQueueDepthName, proverID)
+}
+
+// ProofLatency observes the time taken by a prover to complete a task of the
+// given type.
+func ProofLatency(task string, latency time.Duration) {
+	metrics.HistogramVecObserve(proofLatencyName, task, latency.Seconds())
+}
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	big "math/big"
+
 	common "github.com/ethereum/go-ethereum/common"
 
 	coretypes "github.com/ethereum/go-ethereum/core/types"
@@ -58,6 +60,26 @@ func (_m *Etherman) BuildTrustedVerifyBatchesTxData(lastVerifiedBatch uint64, ne
 	return r0, r1, r2
 }
 
+// GetL1GasPrice provides a mock function with given fields: ctx
+func (_m *Etherman) GetL1GasPrice(ctx context.Context) *big.Int {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetL1GasPrice")
+	}
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(context.Context) *big.Int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
 // GetLatestBlockHeader provides a mock function with given fields: ctx
 func (_m *Etherman) GetLatestBlockHeader(ctx context.Context) (*coretypes.Header, error) {
 	ret := _m.Called(ctx)
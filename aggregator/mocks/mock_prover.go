@@ -32,6 +32,36 @@ func (_m *ProverMock) Addr() string {
 	return r0
 }
 
+// AggregatedBlobOuterProof provides a mock function with given fields: inputProof1, inputProof2
+func (_m *ProverMock) AggregatedBlobOuterProof(inputProof1 string, inputProof2 string) (*string, error) {
+	ret := _m.Called(inputProof1, inputProof2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AggregatedBlobOuterProof")
+	}
+
+	var r0 *string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*string, error)); ok {
+		return rf(inputProof1, inputProof2)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *string); ok {
+		r0 = rf(inputProof1, inputProof2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(inputProof1, inputProof2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AggregatedProof provides a mock function with given fields: inputProof1, inputProof2
 func (_m *ProverMock) AggregatedProof(inputProof1 string, inputProof2 string) (*string, error) {
 	ret := _m.Called(inputProof1, inputProof2)
@@ -92,6 +122,84 @@ func (_m *ProverMock) BatchProof(input *prover.InputProver) (*string, error) {
 	return r0, r1
 }
 
+// BlobInnerProof provides a mock function with given fields: input
+func (_m *ProverMock) BlobInnerProof(input *prover.InputBlobInnerProver) (*string, error) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlobInnerProof")
+	}
+
+	var r0 *string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*prover.InputBlobInnerProver) (*string, error)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(*prover.InputBlobInnerProver) *string); ok {
+		r0 = rf(input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*prover.InputBlobInnerProver) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlobOuterProof provides a mock function with given fields: batchProof, blobInnerProof
+func (_m *ProverMock) BlobOuterProof(batchProof string, blobInnerProof string) (*string, error) {
+	ret := _m.Called(batchProof, blobInnerProof)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlobOuterProof")
+	}
+
+	var r0 *string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*string, error)); ok {
+		return rf(batchProof, blobInnerProof)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *string); ok {
+		r0 = rf(batchProof, blobInnerProof)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(batchProof, blobInnerProof)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelProofRequest provides a mock function with given fields: proofID
+func (_m *ProverMock) CancelProofRequest(proofID string) error {
+	ret := _m.Called(proofID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelProofRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(proofID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FinalProof provides a mock function with given fields: inputProof, aggregatorAddr
 func (_m *ProverMock) FinalProof(inputProof string, aggregatorAddr string) (*string, error) {
 	ret := _m.Called(inputProof, aggregatorAddr)
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	big "math/big"
+
 	common "github.com/ethereum/go-ethereum/common"
 
 	mock "github.com/stretchr/testify/mock"
@@ -37,6 +39,42 @@ func (_m *StateMock) AddBatchProof(ctx context.Context, proof *state.Proof, dbTx
 	return r0
 }
 
+// AddBlobInnerProof provides a mock function with given fields: ctx, proof, dbTx
+func (_m *StateMock) AddBlobInnerProof(ctx context.Context, proof *state.BlobInnerProof, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, proof, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBlobInnerProof")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *state.BlobInnerProof, pgx.Tx) error); ok {
+		r0 = rf(ctx, proof, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddBlobOuterProof provides a mock function with given fields: ctx, proof, dbTx
+func (_m *StateMock) AddBlobOuterProof(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, proof, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBlobOuterProof")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *state.BlobOuterProof, pgx.Tx) error); ok {
+		r0 = rf(ctx, proof, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // BeginStateTransaction provides a mock function with given fields: ctx
 func (_m *StateMock) BeginStateTransaction(ctx context.Context) (pgx.Tx, error) {
 	ret := _m.Called(ctx)
@@ -67,6 +105,34 @@ func (_m *StateMock) BeginStateTransaction(ctx context.Context) (pgx.Tx, error)
 	return r0, r1
 }
 
+// CheckBlobOuterProofContainsCompleteSequences provides a mock function with given fields: ctx, proof, dbTx
+func (_m *StateMock) CheckBlobOuterProofContainsCompleteSequences(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx) (bool, error) {
+	ret := _m.Called(ctx, proof, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBlobOuterProofContainsCompleteSequences")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *state.BlobOuterProof, pgx.Tx) (bool, error)); ok {
+		return rf(ctx, proof, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *state.BlobOuterProof, pgx.Tx) bool); ok {
+		r0 = rf(ctx, proof, dbTx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *state.BlobOuterProof, pgx.Tx) error); ok {
+		r1 = rf(ctx, proof, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CheckProofContainsCompleteSequences provides a mock function with given fields: ctx, proof, dbTx
 func (_m *StateMock) CheckProofContainsCompleteSequences(ctx context.Context, proof *state.Proof, dbTx pgx.Tx) (bool, error) {
 	ret := _m.Called(ctx, proof, dbTx)
@@ -113,6 +179,24 @@ func (_m *StateMock) CleanupBatchProofs(ctx context.Context, batchNumber uint64,
 	return r0
 }
 
+// CleanupBlobProofs provides a mock function with given fields: ctx, batchNumber, dbTx
+func (_m *StateMock) CleanupBlobProofs(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, batchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupBlobProofs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r0 = rf(ctx, batchNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CleanupLockedBatchProofs provides a mock function with given fields: ctx, duration, dbTx
 func (_m *StateMock) CleanupLockedBatchProofs(ctx context.Context, duration string, dbTx pgx.Tx) (int64, error) {
 	ret := _m.Called(ctx, duration, dbTx)
@@ -141,6 +225,34 @@ func (_m *StateMock) CleanupLockedBatchProofs(ctx context.Context, duration stri
 	return r0, r1
 }
 
+// CleanupLockedBlobProofs provides a mock function with given fields: ctx, duration, dbTx
+func (_m *StateMock) CleanupLockedBlobProofs(ctx context.Context, duration string, dbTx pgx.Tx) (int64, error) {
+	ret := _m.Called(ctx, duration, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupLockedBlobProofs")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, pgx.Tx) (int64, error)); ok {
+		return rf(ctx, duration, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, pgx.Tx) int64); ok {
+		r0 = rf(ctx, duration, dbTx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, pgx.Tx) error); ok {
+		r1 = rf(ctx, duration, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteBatchProofs provides a mock function with given fields: ctx, batchNumber, batchNumberFinal, dbTx
 func (_m *StateMock) DeleteBatchProofs(ctx context.Context, batchNumber uint64, batchNumberFinal uint64, dbTx pgx.Tx) error {
 	ret := _m.Called(ctx, batchNumber, batchNumberFinal, dbTx)
@@ -159,6 +271,42 @@ func (_m *StateMock) DeleteBatchProofs(ctx context.Context, batchNumber uint64,
 	return r0
 }
 
+// DeleteBlobInnerProof provides a mock function with given fields: ctx, blobInnerNumber, dbTx
+func (_m *StateMock) DeleteBlobInnerProof(ctx context.Context, blobInnerNumber uint64, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, blobInnerNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBlobInnerProof")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r0 = rf(ctx, blobInnerNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteBlobOuterProofs provides a mock function with given fields: ctx, blobOuterNumber, blobOuterNumberFinal, dbTx
+func (_m *StateMock) DeleteBlobOuterProofs(ctx context.Context, blobOuterNumber uint64, blobOuterNumberFinal uint64, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, blobOuterNumber, blobOuterNumberFinal, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBlobOuterProofs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) error); ok {
+		r0 = rf(ctx, blobOuterNumber, blobOuterNumberFinal, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteUngeneratedBatchProofs provides a mock function with given fields: ctx, dbTx
 func (_m *StateMock) DeleteUngeneratedBatchProofs(ctx context.Context, dbTx pgx.Tx) error {
 	ret := _m.Called(ctx, dbTx)
@@ -177,6 +325,24 @@ func (_m *StateMock) DeleteUngeneratedBatchProofs(ctx context.Context, dbTx pgx.
 	return r0
 }
 
+// DeleteUngeneratedBlobProofs provides a mock function with given fields: ctx, dbTx
+func (_m *StateMock) DeleteUngeneratedBlobProofs(ctx context.Context, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUngeneratedBlobProofs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) error); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetBatchByNumber provides a mock function with given fields: ctx, batchNumber, dbTx
 func (_m *StateMock) GetBatchByNumber(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) (*state.Batch, error) {
 	ret := _m.Called(ctx, batchNumber, dbTx)
@@ -207,6 +373,36 @@ func (_m *StateMock) GetBatchByNumber(ctx context.Context, batchNumber uint64, d
 	return r0, r1
 }
 
+// GetBatchProofs provides a mock function with given fields: ctx, dbTx
+func (_m *StateMock) GetBatchProofs(ctx context.Context, dbTx pgx.Tx) ([]*state.Proof, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBatchProofs")
+	}
+
+	var r0 []*state.Proof
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) ([]*state.Proof, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) []*state.Proof); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*state.Proof)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBatchProofsToAggregate provides a mock function with given fields: ctx, dbTx
 func (_m *StateMock) GetBatchProofsToAggregate(ctx context.Context, dbTx pgx.Tx) (*state.Proof, *state.Proof, error) {
 	ret := _m.Called(ctx, dbTx)
@@ -246,6 +442,200 @@ func (_m *StateMock) GetBatchProofsToAggregate(ctx context.Context, dbTx pgx.Tx)
 	return r0, r1, r2
 }
 
+// GetBatchesFees provides a mock function with given fields: ctx, fromBatchNumber, toBatchNumber, dbTx
+func (_m *StateMock) GetBatchesFees(ctx context.Context, fromBatchNumber uint64, toBatchNumber uint64, dbTx pgx.Tx) (*big.Int, error) {
+	ret := _m.Called(ctx, fromBatchNumber, toBatchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBatchesFees")
+	}
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) (*big.Int, error)); ok {
+		return rf(ctx, fromBatchNumber, toBatchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) *big.Int); ok {
+		r0 = rf(ctx, fromBatchNumber, toBatchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, fromBatchNumber, toBatchNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlobInner provides a mock function with given fields: ctx, blobInnerNum, dbTx
+func (_m *StateMock) GetBlobInner(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx) (*state.BlobInner, error) {
+	ret := _m.Called(ctx, blobInnerNum, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobInner")
+	}
+
+	var r0 *state.BlobInner
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) (*state.BlobInner, error)); ok {
+		return rf(ctx, blobInnerNum, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) *state.BlobInner); ok {
+		r0 = rf(ctx, blobInnerNum, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.BlobInner)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, blobInnerNum, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlobInnerBatchRange provides a mock function with given fields: ctx, blobInnerNum, dbTx
+func (_m *StateMock) GetBlobInnerBatchRange(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx) (uint64, uint64, error) {
+	ret := _m.Called(ctx, blobInnerNum, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobInnerBatchRange")
+	}
+
+	var r0 uint64
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) (uint64, uint64, error)); ok {
+		return rf(ctx, blobInnerNum, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) uint64); ok {
+		r0 = rf(ctx, blobInnerNum, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) uint64); ok {
+		r1 = rf(ctx, blobInnerNum, dbTx)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r2 = rf(ctx, blobInnerNum, dbTx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetBlobInnerToProve provides a mock function with given fields: ctx, lastVerfiedBatchNumber, maxL1Block, dbTx
+func (_m *StateMock) GetBlobInnerToProve(ctx context.Context, lastVerfiedBatchNumber uint64, maxL1Block uint64, dbTx pgx.Tx) (*state.BlobInner, error) {
+	ret := _m.Called(ctx, lastVerfiedBatchNumber, maxL1Block, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobInnerToProve")
+	}
+
+	var r0 *state.BlobInner
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) (*state.BlobInner, error)); ok {
+		return rf(ctx, lastVerfiedBatchNumber, maxL1Block, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) *state.BlobInner); ok {
+		r0 = rf(ctx, lastVerfiedBatchNumber, maxL1Block, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.BlobInner)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, lastVerfiedBatchNumber, maxL1Block, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlobOuterProofReadyForFinal provides a mock function with given fields: ctx, lastVerfiedBatchNumber, dbTx
+func (_m *StateMock) GetBlobOuterProofReadyForFinal(ctx context.Context, lastVerfiedBatchNumber uint64, dbTx pgx.Tx) (*state.BlobOuterProof, error) {
+	ret := _m.Called(ctx, lastVerfiedBatchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobOuterProofReadyForFinal")
+	}
+
+	var r0 *state.BlobOuterProof
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) (*state.BlobOuterProof, error)); ok {
+		return rf(ctx, lastVerfiedBatchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) *state.BlobOuterProof); ok {
+		r0 = rf(ctx, lastVerfiedBatchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.BlobOuterProof)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, lastVerfiedBatchNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlobOuterProofsToAggregate provides a mock function with given fields: ctx, dbTx
+func (_m *StateMock) GetBlobOuterProofsToAggregate(ctx context.Context, dbTx pgx.Tx) (*state.BlobOuterProof, *state.BlobOuterProof, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobOuterProofsToAggregate")
+	}
+
+	var r0 *state.BlobOuterProof
+	var r1 *state.BlobOuterProof
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) (*state.BlobOuterProof, *state.BlobOuterProof, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) *state.BlobOuterProof); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.BlobOuterProof)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) *state.BlobOuterProof); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*state.BlobOuterProof)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pgx.Tx) error); ok {
+		r2 = rf(ctx, dbTx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetForcedBatchParentHash provides a mock function with given fields: ctx, forcedBatchNumber, dbTx
 func (_m *StateMock) GetForcedBatchParentHash(ctx context.Context, forcedBatchNumber uint64, dbTx pgx.Tx) (common.Hash, error) {
 	ret := _m.Called(ctx, forcedBatchNumber, dbTx)
@@ -334,6 +724,34 @@ func (_m *StateMock) GetLastVerifiedBatch(ctx context.Context, dbTx pgx.Tx) (*st
 	return r0, r1
 }
 
+// GetLastVirtualBatchNum provides a mock function with given fields: ctx, dbTx
+func (_m *StateMock) GetLastVirtualBatchNum(ctx context.Context, dbTx pgx.Tx) (uint64, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastVirtualBatchNum")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) (uint64, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) uint64); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLeavesByL1InfoRoot provides a mock function with given fields: ctx, l1InfoRoot, dbTx
 func (_m *StateMock) GetLeavesByL1InfoRoot(ctx context.Context, l1InfoRoot common.Hash, dbTx pgx.Tx) ([]state.L1InfoTreeExitRootStorageEntry, error) {
 	ret := _m.Called(ctx, l1InfoRoot, dbTx)
@@ -394,6 +812,45 @@ func (_m *StateMock) GetProofReadyForFinal(ctx context.Context, lastVerfiedBatch
 	return r0, r1
 }
 
+// GetProofsToBlobOuter provides a mock function with given fields: ctx, dbTx
+func (_m *StateMock) GetProofsToBlobOuter(ctx context.Context, dbTx pgx.Tx) (*state.Proof, *state.BlobInnerProof, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProofsToBlobOuter")
+	}
+
+	var r0 *state.Proof
+	var r1 *state.BlobInnerProof
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) (*state.Proof, *state.BlobInnerProof, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) *state.Proof); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.Proof)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) *state.BlobInnerProof); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*state.BlobInnerProof)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pgx.Tx) error); ok {
+		r2 = rf(ctx, dbTx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetVirtualBatch provides a mock function with given fields: ctx, batchNumber, dbTx
 func (_m *StateMock) GetVirtualBatch(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) (*state.VirtualBatch, error) {
 	ret := _m.Called(ctx, batchNumber, dbTx)
@@ -484,6 +941,34 @@ func (_m *StateMock) GetVirtualBatchToProve(ctx context.Context, lastVerfiedBatc
 	return r0, r1
 }
 
+// UnlockBatchProofs provides a mock function with given fields: ctx, batchNumber, batchNumberFinal, dbTx
+func (_m *StateMock) UnlockBatchProofs(ctx context.Context, batchNumber uint64, batchNumberFinal uint64, dbTx pgx.Tx) (int64, error) {
+	ret := _m.Called(ctx, batchNumber, batchNumberFinal, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockBatchProofs")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) (int64, error)); ok {
+		return rf(ctx, batchNumber, batchNumberFinal, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) int64); ok {
+		r0 = rf(ctx, batchNumber, batchNumberFinal, dbTx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, batchNumber, batchNumberFinal, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateBatchProof provides a mock function with given fields: ctx, proof, dbTx
 func (_m *StateMock) UpdateBatchProof(ctx context.Context, proof *state.Proof, dbTx pgx.Tx) error {
 	ret := _m.Called(ctx, proof, dbTx)
@@ -502,6 +987,42 @@ func (_m *StateMock) UpdateBatchProof(ctx context.Context, proof *state.Proof, d
 	return r0
 }
 
+// UpdateBlobInnerProof provides a mock function with given fields: ctx, proof, dbTx
+func (_m *StateMock) UpdateBlobInnerProof(ctx context.Context, proof *state.BlobInnerProof, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, proof, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBlobInnerProof")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *state.BlobInnerProof, pgx.Tx) error); ok {
+		r0 = rf(ctx, proof, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateBlobOuterProof provides a mock function with given fields: ctx, proof, dbTx
+func (_m *StateMock) UpdateBlobOuterProof(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, proof, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBlobOuterProof")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *state.BlobOuterProof, pgx.Tx) error); ok {
+		r0 = rf(ctx, proof, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewStateMock creates a new instance of StateMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewStateMock(t interface {
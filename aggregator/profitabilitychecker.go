@@ -2,8 +2,16 @@ package aggregator
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
+	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/event"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/state"
 )
 
 // TxProfitabilityCheckerType checks profitability of batch validation
@@ -14,6 +22,9 @@ const (
 	ProfitabilityBase = "base"
 	// ProfitabilityAcceptAll validate batch anyway and don't check anything
 	ProfitabilityAcceptAll = "acceptall"
+	// ProfitabilityL1Cost checks the fees collected by the pending batches cover
+	// the L1 cost of verifying them
+	ProfitabilityL1Cost = "l1cost"
 )
 
 // TxProfitabilityCheckerBase checks pol collateral with min reward
@@ -76,6 +87,155 @@ func (pc *TxProfitabilityCheckerAcceptAll) IsProfitable(ctx context.Context, pol
 	return true, nil
 }
 
+// TxProfitabilityCheckerL1Cost checks the fees collected by the batches pending
+// to be verified cover the L1 cost of verifying them
+type TxProfitabilityCheckerL1Cost struct {
+	State                             stateInterface
+	Etherman                          etherman
+	EventLog                          *event.EventLog
+	IntervalAfterWhichBatchSentAnyway time.Duration
+	// VerifyBatchesGas is the gas estimated for the verify batches tx until
+	// the first one sent by the aggregator is mined
+	VerifyBatchesGas uint64
+
+	// verifyBatchesGasUsed is the gas used by the last verify batches tx mined
+	verifyBatchesGasUsed atomic.Uint64
+
+	lastLoggedDecision      *profitabilityDecision
+	lastLoggedDecisionMutex sync.Mutex
+}
+
+// profitabilityDecision identifies a profitability decision for a range of batches
+type profitabilityDecision struct {
+	firstBatchNumber uint64
+	lastBatchNumber  uint64
+	profitable       bool
+}
+
+// NewTxProfitabilityCheckerL1Cost init tx profitability checker based on the L1 cost
+func NewTxProfitabilityCheckerL1Cost(state stateInterface, etherman etherman, eventLog *event.EventLog, interval time.Duration, verifyBatchesGas uint64) *TxProfitabilityCheckerL1Cost {
+	return &TxProfitabilityCheckerL1Cost{
+		State:                             state,
+		Etherman:                          etherman,
+		EventLog:                          eventLog,
+		IntervalAfterWhichBatchSentAnyway: interval,
+		VerifyBatchesGas:                  verifyBatchesGas,
+	}
+}
+
+// SetVerifyBatchesGasUsed sets the gas used by the last verify batches tx
+// mined, it's used to estimate the L1 cost of the next one
+func (pc *TxProfitabilityCheckerL1Cost) SetVerifyBatchesGasUsed(gasUsed uint64) {
+	pc.verifyBatchesGasUsed.Store(gasUsed)
+}
+
+// verifyBatchesGas returns the estimated gas of the verify batches tx
+func (pc *TxProfitabilityCheckerL1Cost) verifyBatchesGas() uint64 {
+	if gasUsed := pc.verifyBatchesGasUsed.Load(); gasUsed != 0 {
+		return gasUsed
+	}
+	return pc.VerifyBatchesGas
+}
+
+// profitabilityCheck is the information of a profitability decision stored in the event log
+type profitabilityCheck struct {
+	FirstBatchNumber uint64 `json:"firstBatchNumber"`
+	LastBatchNumber  uint64 `json:"lastBatchNumber"`
+	Fees             string `json:"fees"`
+	L1GasPrice       string `json:"l1GasPrice"`
+	VerifyBatchesGas uint64 `json:"verifyBatchesGas"`
+	L1Cost           string `json:"l1Cost"`
+	Profitable       bool   `json:"profitable"`
+	Reason           string `json:"reason"`
+}
+
+// IsProfitable estimates the L1 cost of verifying the pending batches with the
+// current L1 gas price and compares it with the fees collected by the batches.
+// The pol collateral is ignored as it's not paid in the L1 native currency. If
+// the batches are not profitable they are verified anyway once the interval has
+// passed since the oldest of them was created. It's not profitable when there
+// are no batches pending to be verified
+func (pc *TxProfitabilityCheckerL1Cost) IsProfitable(ctx context.Context, polCollateral *big.Int) (bool, error) {
+	var lastVerifiedBatchNum uint64
+	lastVerifiedBatch, err := pc.State.GetLastVerifiedBatch(ctx, nil)
+	if err != nil && !errors.Is(err, state.ErrNotFound) {
+		return false, fmt.Errorf("failed to get last verified batch, %w", err)
+	}
+	if lastVerifiedBatch != nil {
+		lastVerifiedBatchNum = lastVerifiedBatch.BatchNumber
+	}
+	lastVirtualBatchNum, err := pc.State.GetLastVirtualBatchNum(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get last virtual batch number, %w", err)
+	}
+	if lastVirtualBatchNum <= lastVerifiedBatchNum {
+		log.Debugf("no batches pending to be verified, last virtual batch %d, last verified batch %d", lastVirtualBatchNum, lastVerifiedBatchNum)
+		return false, nil
+	}
+	firstBatchNum := lastVerifiedBatchNum + 1
+
+	fees, err := pc.State.GetBatchesFees(ctx, firstBatchNum, lastVirtualBatchNum, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get fees of batches %d-%d, %w", firstBatchNum, lastVirtualBatchNum, err)
+	}
+
+	l1GasPrice := pc.Etherman.GetL1GasPrice(ctx)
+	verifyBatchesGas := pc.verifyBatchesGas()
+	l1Cost := new(big.Int).Mul(l1GasPrice, new(big.Int).SetUint64(verifyBatchesGas))
+
+	check := profitabilityCheck{
+		FirstBatchNumber: firstBatchNum,
+		LastBatchNumber:  lastVirtualBatchNum,
+		Fees:             fees.String(),
+		L1GasPrice:       l1GasPrice.String(),
+		VerifyBatchesGas: verifyBatchesGas,
+		L1Cost:           l1Cost.String(),
+	}
+
+	if fees.Cmp(l1Cost) >= 0 {
+		check.Profitable = true
+		check.Reason = "the fees cover the L1 cost"
+	} else if pc.IntervalAfterWhichBatchSentAnyway != 0 {
+		batch, err := pc.State.GetBatchByNumber(ctx, firstBatchNum, nil)
+		if errors.Is(err, state.ErrNotFound) {
+			log.Debugf("batch %d not found to check its age, it's not synced yet", firstBatchNum)
+		} else if err != nil {
+			return false, fmt.Errorf("failed to get batch %d, %w", firstBatchNum, err)
+		} else if time.Since(batch.Timestamp) >= pc.IntervalAfterWhichBatchSentAnyway {
+			check.Profitable = true
+			check.Reason = fmt.Sprintf("the oldest pending batch is older than %s", pc.IntervalAfterWhichBatchSentAnyway)
+		}
+	}
+	if !check.Profitable {
+		check.Reason = "the fees don't cover the L1 cost"
+	}
+
+	pc.logCheck(ctx, check)
+	return check.Profitable, nil
+}
+
+// logCheck stores the profitability decision in the event log once for each range
+// of batches and decision, the checks are run every time a prover asks for a job
+func (pc *TxProfitabilityCheckerL1Cost) logCheck(ctx context.Context, check profitabilityCheck) {
+	description := fmt.Sprintf("Batches %d-%d profitable: %t, %s. Fees: %s, L1 cost: %s",
+		check.FirstBatchNumber, check.LastBatchNumber, check.Profitable, check.Reason, check.Fees, check.L1Cost)
+
+	decision := profitabilityDecision{
+		firstBatchNumber: check.FirstBatchNumber,
+		lastBatchNumber:  check.LastBatchNumber,
+		profitable:       check.Profitable,
+	}
+	pc.lastLoggedDecisionMutex.Lock()
+	logged := pc.lastLoggedDecision != nil && *pc.lastLoggedDecision == decision
+	pc.lastLoggedDecision = &decision
+	pc.lastLoggedDecisionMutex.Unlock()
+	if logged {
+		log.Debug(description)
+		return
+	}
+	pc.EventLog.LogInfoEvent(ctx, event.Component_Aggregator, event.EventID_AggregatorProfitabilityCheck, description, check)
+}
+
 // TODO: now it's impossible to check, when batch got consolidated, bcs it's not saved
 //func isConsolidatedBatchAppeared(ctx context.Context, state stateInterface, intervalAfterWhichBatchConsolidatedAnyway time.Duration) (bool, error) {
 //	batch, err := state.GetLastVerifiedBatch(ctx, nil)
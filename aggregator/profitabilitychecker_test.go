@@ -0,0 +1,179 @@
+package aggregator
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/aggregator/mocks"
+	"github.com/0xPolygonHermez/zkevm-node/event"
+	"github.com/0xPolygonHermez/zkevm-node/event/nileventstorage"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxProfitabilityCheckerL1Cost(t *testing.T) {
+	const verifyBatchesGas = 300000
+	l1GasPrice := big.NewInt(10)
+	l1Cost := big.NewInt(10 * verifyBatchesGas)
+
+	testCases := []struct {
+		name          string
+		fees          *big.Int
+		polCollateral *big.Int
+		interval      time.Duration
+		batchAge      time.Duration
+		batchNotFound bool
+		expected      bool
+	}{
+		{
+			name:          "fees cover the L1 cost",
+			fees:          l1Cost,
+			polCollateral: big.NewInt(0),
+			expected:      true,
+		},
+		{
+			name:          "pol collateral isn't added to the fees",
+			fees:          big.NewInt(1),
+			polCollateral: new(big.Int).Sub(l1Cost, big.NewInt(1)),
+			expected:      false,
+		},
+		{
+			name:          "fees don't cover the L1 cost without interval",
+			fees:          big.NewInt(1),
+			polCollateral: big.NewInt(0),
+			expected:      false,
+		},
+		{
+			name:          "fees don't cover the L1 cost before the interval",
+			fees:          big.NewInt(1),
+			polCollateral: big.NewInt(0),
+			interval:      time.Hour,
+			batchAge:      time.Minute,
+			expected:      false,
+		},
+		{
+			name:          "fees don't cover the L1 cost after the interval",
+			fees:          big.NewInt(1),
+			polCollateral: big.NewInt(0),
+			interval:      time.Hour,
+			batchAge:      2 * time.Hour,
+			expected:      true,
+		},
+		{
+			name:          "fees don't cover the L1 cost and the oldest batch is not synced",
+			fees:          big.NewInt(1),
+			polCollateral: big.NewInt(0),
+			interval:      time.Hour,
+			batchNotFound: true,
+			expected:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			stateMock := mocks.NewStateMock(t)
+			ethermanMock := mocks.NewEtherman(t)
+			eventStorage, err := nileventstorage.NewNilEventStorage()
+			require.NoError(t, err)
+			eventLog := event.NewEventLog(event.Config{}, eventStorage)
+
+			stateMock.On("GetLastVerifiedBatch", mock.Anything, nil).Return(&state.VerifiedBatch{BatchNumber: 10}, nil).Once()
+			stateMock.On("GetLastVirtualBatchNum", mock.Anything, nil).Return(uint64(15), nil).Once()
+			stateMock.On("GetBatchesFees", mock.Anything, uint64(11), uint64(15), nil).Return(tc.fees, nil).Once()
+			ethermanMock.On("GetL1GasPrice", mock.Anything).Return(l1GasPrice).Once()
+			if tc.batchNotFound {
+				stateMock.On("GetBatchByNumber", mock.Anything, uint64(11), nil).Return(nil, state.ErrNotFound).Once()
+			} else if tc.interval != 0 {
+				stateMock.On("GetBatchByNumber", mock.Anything, uint64(11), nil).Return(&state.Batch{BatchNumber: 11, Timestamp: time.Now().Add(-tc.batchAge)}, nil).Once()
+			}
+
+			pc := NewTxProfitabilityCheckerL1Cost(stateMock, ethermanMock, eventLog, tc.interval, verifyBatchesGas)
+			profitable, err := pc.IsProfitable(ctx, tc.polCollateral)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, profitable)
+		})
+	}
+}
+
+func TestTxProfitabilityCheckerL1CostNoVerifiedBatch(t *testing.T) {
+	stateMock := mocks.NewStateMock(t)
+	ethermanMock := mocks.NewEtherman(t)
+	eventStorage, err := nileventstorage.NewNilEventStorage()
+	require.NoError(t, err)
+	eventLog := event.NewEventLog(event.Config{}, eventStorage)
+
+	stateMock.On("GetLastVerifiedBatch", mock.Anything, nil).Return(nil, state.ErrNotFound).Once()
+	stateMock.On("GetLastVirtualBatchNum", mock.Anything, nil).Return(uint64(5), nil).Once()
+	stateMock.On("GetBatchesFees", mock.Anything, uint64(1), uint64(5), nil).Return(big.NewInt(3000), nil).Once()
+	ethermanMock.On("GetL1GasPrice", mock.Anything).Return(big.NewInt(10)).Once()
+
+	pc := NewTxProfitabilityCheckerL1Cost(stateMock, ethermanMock, eventLog, 0, 300)
+	profitable, err := pc.IsProfitable(context.Background(), big.NewInt(0))
+	require.NoError(t, err)
+	assert.True(t, profitable)
+}
+
+func TestTxProfitabilityCheckerL1CostNoPendingBatches(t *testing.T) {
+	stateMock := mocks.NewStateMock(t)
+	ethermanMock := mocks.NewEtherman(t)
+	eventStorage, err := nileventstorage.NewNilEventStorage()
+	require.NoError(t, err)
+	eventLog := event.NewEventLog(event.Config{}, eventStorage)
+
+	stateMock.On("GetLastVerifiedBatch", mock.Anything, nil).Return(&state.VerifiedBatch{BatchNumber: 10}, nil).Once()
+	stateMock.On("GetLastVirtualBatchNum", mock.Anything, nil).Return(uint64(10), nil).Once()
+
+	pc := NewTxProfitabilityCheckerL1Cost(stateMock, ethermanMock, eventLog, time.Hour, 300)
+	profitable, err := pc.IsProfitable(context.Background(), big.NewInt(0))
+	require.NoError(t, err)
+	assert.False(t, profitable)
+}
+
+func TestTxProfitabilityCheckerL1CostLogCheck(t *testing.T) {
+	eventStorage, err := nileventstorage.NewNilEventStorage()
+	require.NoError(t, err)
+	pc := NewTxProfitabilityCheckerL1Cost(nil, nil, event.NewEventLog(event.Config{}, eventStorage), 0, 300)
+	ctx := context.Background()
+
+	check := profitabilityCheck{FirstBatchNumber: 11, LastBatchNumber: 15, Profitable: false}
+	pc.logCheck(ctx, check)
+	assert.Equal(t, profitabilityDecision{firstBatchNumber: 11, lastBatchNumber: 15}, *pc.lastLoggedDecision)
+
+	// a new batch range is logged even if the decision doesn't change
+	check.LastBatchNumber = 16
+	pc.logCheck(ctx, check)
+	assert.Equal(t, profitabilityDecision{firstBatchNumber: 11, lastBatchNumber: 16}, *pc.lastLoggedDecision)
+
+	check.Profitable = true
+	pc.logCheck(ctx, check)
+	assert.Equal(t, profitabilityDecision{firstBatchNumber: 11, lastBatchNumber: 16, profitable: true}, *pc.lastLoggedDecision)
+}
+
+func TestTxProfitabilityCheckerL1CostVerifyBatchesGasUsed(t *testing.T) {
+	stateMock := mocks.NewStateMock(t)
+	ethermanMock := mocks.NewEtherman(t)
+	eventStorage, err := nileventstorage.NewNilEventStorage()
+	require.NoError(t, err)
+	eventLog := event.NewEventLog(event.Config{}, eventStorage)
+
+	stateMock.On("GetLastVerifiedBatch", mock.Anything, nil).Return(&state.VerifiedBatch{BatchNumber: 10}, nil)
+	stateMock.On("GetLastVirtualBatchNum", mock.Anything, nil).Return(uint64(15), nil)
+	stateMock.On("GetBatchesFees", mock.Anything, uint64(11), uint64(15), nil).Return(big.NewInt(3000), nil)
+	ethermanMock.On("GetL1GasPrice", mock.Anything).Return(big.NewInt(10))
+
+	// the configured gas is used until a verify batches tx is mined
+	pc := NewTxProfitabilityCheckerL1Cost(stateMock, ethermanMock, eventLog, 0, 500)
+	profitable, err := pc.IsProfitable(context.Background(), big.NewInt(0))
+	require.NoError(t, err)
+	assert.False(t, profitable)
+
+	pc.SetVerifyBatchesGasUsed(300)
+	profitable, err = pc.IsProfitable(context.Background(), big.NewInt(0))
+	require.NoError(t, err)
+	assert.True(t, profitable)
+}
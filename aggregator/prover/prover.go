@@ -212,6 +212,121 @@ func (p *Prover) FinalProof(inputProof string, aggregatorAddr string) (*string,
 	return nil, fmt.Errorf("%w, wanted %T, got %T", ErrBadProverResponse, &ProverMessage_GenFinalProofResponse{}, res.Response)
 }
 
+// BlobInnerProof instructs the prover to generate a blob inner proof for the
+// provided input. It returns the ID of the proof being computed.
+func (p *Prover) BlobInnerProof(input *InputBlobInnerProver) (*string, error) {
+	metrics.WorkingProver()
+
+	req := &AggregatorMessage{
+		Request: &AggregatorMessage_GenBlobInnerProofRequest{
+			GenBlobInnerProofRequest: &GenBlobInnerProofRequest{Input: input},
+		},
+	}
+	res, err := p.call(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg, ok := res.Response.(*ProverMessage_GenBlobInnerProofResponse); ok {
+		switch msg.GenBlobInnerProofResponse.Result {
+		case Result_RESULT_UNSPECIFIED:
+			return nil, fmt.Errorf("failed to generate blob inner proof %s, %w, input %v", msg.GenBlobInnerProofResponse.String(), ErrUnspecified, input)
+		case Result_RESULT_OK:
+			return &msg.GenBlobInnerProofResponse.Id, nil
+		case Result_RESULT_ERROR:
+			return nil, fmt.Errorf("failed to generate blob inner proof %s, %w, input %v", msg.GenBlobInnerProofResponse.String(), ErrBadRequest, input)
+		case Result_RESULT_INTERNAL_ERROR:
+			return nil, fmt.Errorf("failed to generate blob inner proof %s, %w, input %v", msg.GenBlobInnerProofResponse.String(), ErrProverInternalError, input)
+		default:
+			return nil, fmt.Errorf("failed to generate blob inner proof %s, %w, input %v", msg.GenBlobInnerProofResponse.String(), ErrUnknown, input)
+		}
+	}
+
+	return nil, fmt.Errorf("%w, wanted %T, got %T", ErrBadProverResponse, &ProverMessage_GenBlobInnerProofResponse{}, res.Response)
+}
+
+// BlobOuterProof instructs the prover to generate a blob outer proof from the
+// aggregated batch proof and the blob inner proof of the same blob inner. It
+// returns the ID of the proof being computed.
+func (p *Prover) BlobOuterProof(batchProof, blobInnerProof string) (*string, error) {
+	metrics.WorkingProver()
+
+	req := &AggregatorMessage{
+		Request: &AggregatorMessage_GenBlobOuterProofRequest{
+			GenBlobOuterProofRequest: &GenBlobOuterProofRequest{
+				BatchProof:     batchProof,
+				BlobInnerProof: blobInnerProof,
+			},
+		},
+	}
+	res, err := p.call(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg, ok := res.Response.(*ProverMessage_GenBlobOuterProofResponse); ok {
+		switch msg.GenBlobOuterProofResponse.Result {
+		case Result_RESULT_UNSPECIFIED:
+			return nil, fmt.Errorf("failed to generate blob outer proof %s, %w, batch proof %s, blob inner proof %s",
+				msg.GenBlobOuterProofResponse.String(), ErrUnspecified, batchProof, blobInnerProof)
+		case Result_RESULT_OK:
+			return &msg.GenBlobOuterProofResponse.Id, nil
+		case Result_RESULT_ERROR:
+			return nil, fmt.Errorf("failed to generate blob outer proof %s, %w, batch proof %s, blob inner proof %s",
+				msg.GenBlobOuterProofResponse.String(), ErrBadRequest, batchProof, blobInnerProof)
+		case Result_RESULT_INTERNAL_ERROR:
+			return nil, fmt.Errorf("failed to generate blob outer proof %s, %w, batch proof %s, blob inner proof %s",
+				msg.GenBlobOuterProofResponse.String(), ErrProverInternalError, batchProof, blobInnerProof)
+		default:
+			return nil, fmt.Errorf("failed to generate blob outer proof %s, %w, batch proof %s, blob inner proof %s",
+				msg.GenBlobOuterProofResponse.String(), ErrUnknown, batchProof, blobInnerProof)
+		}
+	}
+
+	return nil, fmt.Errorf("%w, wanted %T, got %T", ErrBadProverResponse, &ProverMessage_GenBlobOuterProofResponse{}, res.Response)
+}
+
+// AggregatedBlobOuterProof instructs the prover to generate an aggregated
+// proof from the two blob outer proofs provided. It returns the ID of the
+// proof being computed.
+func (p *Prover) AggregatedBlobOuterProof(inputProof1, inputProof2 string) (*string, error) {
+	metrics.WorkingProver()
+
+	req := &AggregatorMessage{
+		Request: &AggregatorMessage_GenAggregatedBlobOuterProofRequest{
+			GenAggregatedBlobOuterProofRequest: &GenAggregatedBlobOuterProofRequest{
+				BlobOuterProof_1: inputProof1,
+				BlobOuterProof_2: inputProof2,
+			},
+		},
+	}
+	res, err := p.call(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg, ok := res.Response.(*ProverMessage_GenAggregatedBlobOuterProofResponse); ok {
+		switch msg.GenAggregatedBlobOuterProofResponse.Result {
+		case Result_RESULT_UNSPECIFIED:
+			return nil, fmt.Errorf("failed to aggregate blob outer proofs %s, %w, input 1 %s, input 2 %s",
+				msg.GenAggregatedBlobOuterProofResponse.String(), ErrUnspecified, inputProof1, inputProof2)
+		case Result_RESULT_OK:
+			return &msg.GenAggregatedBlobOuterProofResponse.Id, nil
+		case Result_RESULT_ERROR:
+			return nil, fmt.Errorf("failed to aggregate blob outer proofs %s, %w, input 1 %s, input 2 %s",
+				msg.GenAggregatedBlobOuterProofResponse.String(), ErrBadRequest, inputProof1, inputProof2)
+		case Result_RESULT_INTERNAL_ERROR:
+			return nil, fmt.Errorf("failed to aggregate blob outer proofs %s, %w, input 1 %s, input 2 %s",
+				msg.GenAggregatedBlobOuterProofResponse.String(), ErrProverInternalError, inputProof1, inputProof2)
+		default:
+			return nil, fmt.Errorf("failed to aggregate blob outer proofs %s, %w, input 1 %s, input 2 %s",
+				msg.GenAggregatedBlobOuterProofResponse.String(), ErrUnknown, inputProof1, inputProof2)
+		}
+	}
+
+	return nil, fmt.Errorf("%w, wanted %T, got %T", ErrBadProverResponse, &ProverMessage_GenAggregatedBlobOuterProofResponse{}, res.Response)
+}
+
 // CancelProofRequest asks the prover to stop the generation of the proof
 // matching the provided proofID.
 func (p *Prover) CancelProofRequest(proofID string) error {
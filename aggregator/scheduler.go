@@ -0,0 +1,304 @@
+package aggregator
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/aggregator/metrics"
+)
+
+// ProverSchedulerType selects the algorithm used to assign tasks to the provers
+type ProverSchedulerType string
+
+const (
+	// SchedulerGreedy makes every prover try the tasks it supports always in
+	// the same order: final proof, aggregations and then new proofs
+	SchedulerGreedy ProverSchedulerType = "greedy"
+	// SchedulerLatency takes into account the proof latency observed for each
+	// prover, the faster provers try first the tasks closer to the final proof
+	// while the slower ones try first the tasks further from it, so they don't
+	// race for the same work
+	SchedulerLatency ProverSchedulerType = "latency"
+)
+
+// proverTask is a type of work that can be assigned to a prover
+type proverTask string
+
+const (
+	taskFinalProof          proverTask = "final"
+	taskFinalBlobOuterProof proverTask = "final_blob_outer"
+	taskAggregateBlobOuter  proverTask = "aggregate_blob_outer"
+	taskGenerateBlobOuter   proverTask = "blob_outer"
+	taskGenerateBlobInner   proverTask = "blob_inner"
+	taskAggregateBatch      proverTask = "aggregate_batch"
+	taskGenerateBatch       proverTask = "batch"
+
+	// latencySmoothingFactor is the weight of the last observed latency in the
+	// moving average of the latency of each prover
+	latencySmoothingFactor = 0.2
+)
+
+var (
+	// finalTasks are the tasks building a final proof, in the order they are tried
+	finalTasks = []proverTask{taskFinalProof, taskFinalBlobOuterProof}
+	// proofTasks are the tasks generating a recursive proof, in the order they are
+	// tried by default, from the closest to the furthest from the final proof
+	proofTasks = []proverTask{taskAggregateBlobOuter, taskGenerateBlobOuter, taskGenerateBlobInner, taskAggregateBatch, taskGenerateBatch}
+
+	// taskForkIDs are the fork ids supported by the provers able to run each task,
+	// the batch proofs require provers of the fork id of the batches, the blob
+	// proofs are also supported by the provers of the blob fork id
+	taskForkIDs = map[proverTask][]uint64{
+		taskFinalProof:          {forkId9},
+		taskFinalBlobOuterProof: {forkId9, forkId10},
+		taskAggregateBlobOuter:  {forkId9, forkId10},
+		taskGenerateBlobOuter:   {forkId9, forkId10},
+		taskGenerateBlobInner:   {forkId9, forkId10},
+		taskAggregateBatch:      {forkId9},
+		taskGenerateBatch:       {forkId9},
+	}
+
+	// ErrProverNotSupported is returned when a prover connects without supporting
+	// any of the fork ids required by the tasks of the aggregator
+	ErrProverNotSupported = errors.New("prover does not support required fork ID")
+)
+
+// isFinal returns true if the task builds a final proof
+func (t proverTask) isFinal() bool {
+	return t == taskFinalProof || t == taskFinalBlobOuterProof
+}
+
+// supportedBy returns true if the task can be run by a prover supporting
+// the provided fork ids
+func (t proverTask) supportedBy(forkIDs []uint64) bool {
+	for _, required := range taskForkIDs[t] {
+		for _, forkID := range forkIDs {
+			if forkID == required {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// proverScheduler decides the tasks assigned to each prover every time it is
+// idle. The tasks are tried in order until a recursive proof is generated
+type proverScheduler interface {
+	// AddProver registers a connected prover and the fork ids it supports
+	AddProver(proverID, proverName string, forkIDs []uint64) error
+	// RemoveProver unregisters a disconnected prover
+	RemoveProver(proverID string)
+	// NextTasks returns the tasks queued for the prover, in the order they
+	// must be tried
+	NextTasks(proverID string) []proverTask
+	// TaskDone removes the task from the queue of the prover, if a proof
+	// has been generated the time taken to generate it is provided
+	TaskDone(proverID string, task proverTask, generated bool, latency time.Duration)
+}
+
+// newProverScheduler creates the scheduler of the provided type
+func newProverScheduler(schedulerType ProverSchedulerType, finalProofProvers []string) proverScheduler {
+	base := newBaseScheduler(finalProofProvers)
+	if schedulerType == SchedulerLatency {
+		return &latencyScheduler{baseScheduler: base}
+	}
+	return &greedyScheduler{baseScheduler: base}
+}
+
+// scheduledProver holds the information the scheduler keeps for each prover
+type scheduledProver struct {
+	id        string
+	name      string
+	forkIDs   []uint64
+	finalOnly bool
+	// latency is the moving average of the time taken to generate each type of proof
+	latency map[proverTask]time.Duration
+	queue   []proverTask
+}
+
+// baseScheduler keeps track of the connected provers, their queues and their
+// latencies, the concrete schedulers only decide the order of the tasks
+type baseScheduler struct {
+	mutex             sync.Mutex
+	provers           map[string]*scheduledProver
+	finalProofProvers map[string]struct{}
+}
+
+func newBaseScheduler(finalProofProvers []string) *baseScheduler {
+	s := &baseScheduler{
+		provers:           make(map[string]*scheduledProver),
+		finalProofProvers: make(map[string]struct{}, len(finalProofProvers)),
+	}
+	for _, p := range finalProofProvers {
+		s.finalProofProvers[p] = struct{}{}
+	}
+	return s
+}
+
+// AddProver registers a connected prover and the fork ids it supports
+func (s *baseScheduler) AddProver(proverID, proverName string, forkIDs []uint64) error {
+	p := &scheduledProver{
+		id:      proverID,
+		name:    proverName,
+		forkIDs: forkIDs,
+		latency: make(map[proverTask]time.Duration),
+	}
+	_, dedicatedByID := s.finalProofProvers[proverID]
+	_, dedicatedByName := s.finalProofProvers[proverName]
+	p.finalOnly = dedicatedByID || dedicatedByName
+
+	supported := false
+	for _, task := range finalTasks {
+		supported = supported || task.supportedBy(forkIDs)
+	}
+	for _, task := range proofTasks {
+		supported = supported || (!p.finalOnly && task.supportedBy(forkIDs))
+	}
+	if !supported {
+		return ErrProverNotSupported
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.provers[proverID] = p
+	metrics.ProverQueueDepth(proverID, 0)
+	return nil
+}
+
+// RemoveProver unregisters a disconnected prover
+func (s *baseScheduler) RemoveProver(proverID string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.provers, proverID)
+	metrics.RemoveProverQueueDepth(proverID)
+}
+
+// TaskDone removes the task from the queue of the prover, if a proof has been
+// generated the latency of the prover for the task is updated
+func (s *baseScheduler) TaskDone(proverID string, task proverTask, generated bool, latency time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	p, found := s.provers[proverID]
+	if !found {
+		return
+	}
+
+	for i, queued := range p.queue {
+		if queued == task {
+			p.queue = append(p.queue[:i], p.queue[i+1:]...)
+			break
+		}
+	}
+	metrics.ProverQueueDepth(proverID, len(p.queue))
+
+	if !generated {
+		return
+	}
+	metrics.ProofLatency(string(task), latency)
+	if previous, found := p.latency[task]; found {
+		latency = time.Duration(latencySmoothingFactor*float64(latency) + (1-latencySmoothingFactor)*float64(previous))
+	}
+	p.latency[task] = latency
+}
+
+// candidateTasks returns the final and proof tasks the prover can be assigned,
+// in the default order. It must be called with the mutex locked
+func (s *baseScheduler) candidateTasks(p *scheduledProver) (final []proverTask, proofs []proverTask) {
+	// when there are dedicated final proof provers connected, the rest of
+	// the provers don't build final proofs
+	dedicatedConnected := false
+	for _, other := range s.provers {
+		if other.finalOnly {
+			dedicatedConnected = true
+			break
+		}
+	}
+
+	for _, task := range finalTasks {
+		if task.supportedBy(p.forkIDs) && (p.finalOnly || !dedicatedConnected) {
+			final = append(final, task)
+		}
+	}
+	if p.finalOnly {
+		return final, nil
+	}
+	for _, task := range proofTasks {
+		if task.supportedBy(p.forkIDs) {
+			proofs = append(proofs, task)
+		}
+	}
+	return final, proofs
+}
+
+// enqueue sets the queue of the prover. It must be called with the mutex locked
+func (s *baseScheduler) enqueue(p *scheduledProver, tasks []proverTask) []proverTask {
+	p.queue = append([]proverTask{}, tasks...)
+	metrics.ProverQueueDepth(p.id, len(p.queue))
+	return tasks
+}
+
+// greedyScheduler makes every prover try all the tasks it supports always in
+// the same order
+type greedyScheduler struct {
+	*baseScheduler
+}
+
+// NextTasks returns the tasks queued for the prover, in the order they must be tried
+func (s *greedyScheduler) NextTasks(proverID string) []proverTask {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	p, found := s.provers[proverID]
+	if !found {
+		return nil
+	}
+
+	final, proofs := s.candidateTasks(p)
+	return s.enqueue(p, append(final, proofs...))
+}
+
+// latencyScheduler sorts the proof tasks of each prover depending on how fast
+// the prover generates proofs compared to the rest of the connected provers
+type latencyScheduler struct {
+	*baseScheduler
+}
+
+// NextTasks returns the tasks queued for the prover, in the order they must be tried
+func (s *latencyScheduler) NextTasks(proverID string) []proverTask {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	p, found := s.provers[proverID]
+	if !found {
+		return nil
+	}
+
+	final, proofs := s.candidateTasks(p)
+	if s.isSlow(p) {
+		// the slow provers start by the tasks furthest from the final proof
+		for i, j := 0, len(proofs)-1; i < j; i, j = i+1, j-1 {
+			proofs[i], proofs[j] = proofs[j], proofs[i]
+		}
+	}
+	return s.enqueue(p, append(final, proofs...))
+}
+
+// isSlow returns true if the batch proof latency of the prover is higher than
+// the median latency of the provers with known latency. The provers without
+// known latency are considered fast. It must be called with the mutex locked
+func (s *latencyScheduler) isSlow(p *scheduledProver) bool {
+	latency, found := p.latency[taskGenerateBatch]
+	if !found {
+		return false
+	}
+
+	latencies := make([]time.Duration, 0, len(s.provers))
+	for _, other := range s.provers {
+		if l, found := other.latency[taskGenerateBatch]; found {
+			latencies = append(latencies, l)
+		}
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	median := latencies[(len(latencies)-1)/2] // nolint:gomnd
+	return latency > median
+}
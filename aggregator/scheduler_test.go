@@ -0,0 +1,84 @@
+package aggregator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulerAddProver(t *testing.T) {
+	s := newProverScheduler(SchedulerGreedy, []string{"final"})
+
+	assert.ErrorIs(t, s.AddProver("id1", "prover1", []uint64{1}), ErrProverNotSupported)
+	assert.Nil(t, s.NextTasks("id1"))
+
+	require.NoError(t, s.AddProver("id2", "prover2", []uint64{forkId10}))
+	assert.Equal(t, []proverTask{taskFinalBlobOuterProof, taskAggregateBlobOuter, taskGenerateBlobOuter, taskGenerateBlobInner}, s.NextTasks("id2"))
+
+	require.NoError(t, s.AddProver("id3", "prover3", []uint64{forkId9}))
+	assert.Equal(t, append(append([]proverTask{}, finalTasks...), proofTasks...), s.NextTasks("id3"))
+
+	s.RemoveProver("id3")
+	assert.Nil(t, s.NextTasks("id3"))
+}
+
+func TestSchedulerFinalProofProvers(t *testing.T) {
+	s := newProverScheduler(SchedulerGreedy, []string{"final", "id2"})
+
+	require.NoError(t, s.AddProver("id1", "prover1", []uint64{forkId9}))
+	assert.Equal(t, append(append([]proverTask{}, finalTasks...), proofTasks...), s.NextTasks("id1"))
+
+	// dedicated provers, by name and by id, only build final proofs
+	require.NoError(t, s.AddProver("id2", "prover2", []uint64{forkId9}))
+	require.NoError(t, s.AddProver("id3", "final", []uint64{forkId10}))
+	assert.Equal(t, finalTasks, s.NextTasks("id2"))
+	assert.Equal(t, []proverTask{taskFinalBlobOuterProof}, s.NextTasks("id3"))
+
+	// the rest of the provers don't build final proofs while a dedicated one is connected
+	assert.Equal(t, proofTasks, s.NextTasks("id1"))
+	s.RemoveProver("id2")
+	s.RemoveProver("id3")
+	assert.Equal(t, append(append([]proverTask{}, finalTasks...), proofTasks...), s.NextTasks("id1"))
+}
+
+func TestSchedulerTaskDone(t *testing.T) {
+	s := newBaseScheduler(nil)
+	greedy := &greedyScheduler{baseScheduler: s}
+
+	require.NoError(t, greedy.AddProver("id1", "prover1", []uint64{forkId9}))
+	tasks := greedy.NextTasks("id1")
+	require.Len(t, s.provers["id1"].queue, len(tasks))
+
+	greedy.TaskDone("id1", taskFinalProof, false, 0)
+	assert.NotContains(t, s.provers["id1"].queue, taskFinalProof)
+	assert.Len(t, s.provers["id1"].queue, len(tasks)-1)
+	assert.Empty(t, s.provers["id1"].latency)
+
+	greedy.TaskDone("id1", taskGenerateBatch, true, 10*time.Second)
+	assert.Equal(t, 10*time.Second, s.provers["id1"].latency[taskGenerateBatch])
+	greedy.TaskDone("id1", taskGenerateBatch, true, 20*time.Second)
+	assert.Equal(t, 12*time.Second, s.provers["id1"].latency[taskGenerateBatch])
+
+	// unknown provers are ignored
+	greedy.TaskDone("id2", taskGenerateBatch, true, time.Second)
+}
+
+func TestLatencyScheduler(t *testing.T) {
+	s := newProverScheduler(SchedulerLatency, nil)
+
+	require.NoError(t, s.AddProver("fast", "fast", []uint64{forkId9}))
+	require.NoError(t, s.AddProver("slow", "slow", []uint64{forkId9}))
+	defaultOrder := append(append([]proverTask{}, finalTasks...), proofTasks...)
+
+	// without known latencies all the provers use the default order
+	assert.Equal(t, defaultOrder, s.NextTasks("fast"))
+	assert.Equal(t, defaultOrder, s.NextTasks("slow"))
+
+	s.TaskDone("fast", taskGenerateBatch, true, time.Minute)
+	s.TaskDone("slow", taskGenerateBatch, true, 5*time.Minute)
+
+	assert.Equal(t, defaultOrder, s.NextTasks("fast"))
+	assert.Equal(t, []proverTask{taskFinalProof, taskFinalBlobOuterProof, taskGenerateBatch, taskAggregateBatch, taskGenerateBlobInner, taskGenerateBlobOuter, taskAggregateBlobOuter}, s.NextTasks("slow"))
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/0xPolygonHermez/zkevm-node/config"
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
 	"github.com/0xPolygonHermez/zkevm-node/encoding"
 	"github.com/0xPolygonHermez/zkevm-node/log"
 	"github.com/ethereum/go-ethereum/common"
@@ -27,8 +28,16 @@ func approveTokens(ctx *cli.Context) error {
 		amount = new(big.Int).Sub(new(big.Int).Lsh(common.Big1, bitSize), common.Big1)
 	}
 
-	addrKeyStorePath := ctx.String(config.FlagKeyStorePath)
-	addrPassword := ctx.String(config.FlagPassword)
+	privateKey := types.KeystoreFileConfig{
+		Path:            ctx.String(config.FlagKeyStorePath),
+		Password:        ctx.String(config.FlagPassword),
+		RemoteSignerURL: ctx.String(config.FlagRemoteSignerURL),
+		Address:         ctx.String(config.FlagAddress),
+	}
+	if privateKey.RemoteSignerURL == "" && (privateKey.Path == "" || privateKey.Password == "") {
+		fmt.Println("Please, introduce the key store path and password or the remote signer URL and address")
+		return nil
+	}
 
 	c, err := config.Load(ctx, true)
 	if err != nil {
@@ -57,8 +66,8 @@ func approveTokens(ctx *cli.Context) error {
 		return err
 	}
 
-	// load auth from keystore file
-	auth, err := etherman.LoadAuthFromKeyStore(addrKeyStorePath, addrPassword)
+	// load auth from keystore file or remote signer
+	auth, err := etherman.LoadAuth(privateKey)
 	if err != nil {
 		log.Fatal(err)
 		return err
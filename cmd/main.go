@@ -128,13 +128,25 @@ func main() {
 					Name:     config.FlagKeyStorePath,
 					Aliases:  []string{""},
 					Usage:    "the path of the key store file containing the private key of the account going to sign and approve the tokens",
-					Required: true,
+					Required: false,
 				},
 				&cli.StringFlag{
 					Name:     config.FlagPassword,
 					Aliases:  []string{"pw"},
 					Usage:    "the password do decrypt the key store file",
-					Required: true,
+					Required: false,
+				},
+				&cli.StringFlag{
+					Name:     config.FlagRemoteSignerURL,
+					Aliases:  []string{"rs"},
+					Usage:    "the URL of the remote signer holding the private key of the account going to sign and approve the tokens, used instead of the key store file",
+					Required: false,
+				},
+				&cli.StringFlag{
+					Name:     config.FlagAddress,
+					Aliases:  []string{"addr"},
+					Usage:    "the address of the account held by the remote signer",
+					Required: false,
 				},
 				&cli.StringFlag{
 					Name:     config.FlagAmount,
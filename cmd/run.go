@@ -16,6 +16,7 @@ import (
 	"github.com/0xPolygonHermez/zkevm-node"
 	"github.com/0xPolygonHermez/zkevm-node/aggregator"
 	"github.com/0xPolygonHermez/zkevm-node/config"
+	"github.com/0xPolygonHermez/zkevm-node/dataavailability"
 	"github.com/0xPolygonHermez/zkevm-node/db"
 	"github.com/0xPolygonHermez/zkevm-node/etherman"
 	"github.com/0xPolygonHermez/zkevm-node/ethtxmanager"
@@ -156,7 +157,7 @@ func start(cliCtx *cli.Context) error {
 			if err != nil {
 				log.Fatal(err)
 			}
-			go runAggregator(cliCtx.Context, c.Aggregator, etherman, etm, st)
+			go runAggregator(cliCtx.Context, c.Aggregator, etherman, etm, st, eventLog)
 		case SEQUENCER:
 			c.Sequencer.StreamServer.Log = datastreamerlog.Config{
 				Environment: datastreamerlog.LogEnvironment(c.Log.Environment),
@@ -206,7 +207,7 @@ func start(cliCtx *cli.Context) error {
 				apis[a] = true
 			}
 			st, _ := newState(cliCtx.Context, c, etherman, l2ChainID, stateSqlDB, eventLog, needsExecutor, needsStateTree, true)
-			go runJSONRPCServer(*c, etherman, l2ChainID, poolInstance, st, apis)
+			go runJSONRPCServer(*c, etherman, l2ChainID, poolInstance, st, stateSqlDB, apis)
 		case SYNCHRONIZER:
 			ev.Component = event.Component_Synchronizer
 			ev.Description = "Running synchronizer"
@@ -317,6 +318,10 @@ func runSynchronizer(cfg config.Config, etherman *etherman.Client, ethTxManagerS
 		log.Infof("skipping creating L2 ethereum client because URL is empty")
 	}
 	zkEVMClient := client.NewClient(trustedSequencerURL)
+	var dataAvailability syncinterfaces.BatchDataProvider
+	if cfg.DataAvailability.Enabled {
+		dataAvailability = newDataAvailability(cfg, st, trustedSequencerURL)
+	}
 	etherManForL1 := []syncinterfaces.EthermanFullInterface{}
 	// If synchronizer are using sequential mode, we only need one etherman client
 	if cfg.Synchronizer.L1SynchronizationMode == synchronizer.ParallelMode {
@@ -331,7 +336,7 @@ func runSynchronizer(cfg config.Config, etherman *etherman.Client, ethTxManagerS
 	etm := ethtxmanager.New(cfg.EthTxManager, etherman, ethTxManagerStorage, st)
 	sy, err := synchronizer.NewSynchronizer(
 		cfg.IsTrustedSequencer, etherman, etherManForL1, st, pool, etm,
-		zkEVMClient, ethClientForL2, eventLog, cfg.NetworkConfig.Genesis, cfg.Synchronizer, cfg.Log.Environment == "development",
+		zkEVMClient, ethClientForL2, eventLog, dataAvailability, cfg.NetworkConfig.Genesis, cfg.Synchronizer, cfg.Log.Environment == "development",
 	)
 	if err != nil {
 		log.Fatal(err)
@@ -341,9 +346,12 @@ func runSynchronizer(cfg config.Config, etherman *etherman.Client, ethTxManagerS
 	}
 }
 
-func runJSONRPCServer(c config.Config, etherman *etherman.Client, chainID uint64, pool *pool.Pool, st *state.State, apis map[string]bool) {
+func runJSONRPCServer(c config.Config, etherman *etherman.Client, chainID uint64, pool *pool.Pool, st *state.State, stateSqlDB *pgxpool.Pool, apis map[string]bool) {
 	var err error
-	storage := jsonrpc.NewStorage()
+	storage, err := jsonrpc.NewFilterStorage(c.RPC, stateSqlDB)
+	if err != nil {
+		log.Fatal(err)
+	}
 	c.RPC.MaxCumulativeGasUsed = c.State.Batch.Constraints.MaxCumulativeGasUsed
 	c.RPC.L2Coinbase = c.SequenceSender.L2Coinbase
 	c.RPC.ZKCountersLimits = jsonrpc.ZKCountersLimits{
@@ -392,7 +400,7 @@ func runJSONRPCServer(c config.Config, etherman *etherman.Client, chainID uint64
 	if _, ok := apis[jsonrpc.APITxPool]; ok {
 		services = append(services, jsonrpc.Service{
 			Name:    jsonrpc.APITxPool,
-			Service: &jsonrpc.TxPoolEndpoints{},
+			Service: jsonrpc.NewTxPoolEndpoints(c.RPC, pool),
 		})
 	}
 
@@ -429,7 +437,7 @@ func createSequenceSender(cfg config.Config, pool *pool.Pool, etmStorage *ethtxm
 		log.Fatal(err)
 	}
 
-	auth, err := etherman.LoadAuthFromKeyStore(cfg.SequenceSender.PrivateKey.Path, cfg.SequenceSender.PrivateKey.Password)
+	auth, err := etherman.LoadAuth(cfg.SequenceSender.PrivateKey)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -439,7 +447,12 @@ func createSequenceSender(cfg config.Config, pool *pool.Pool, etmStorage *ethtxm
 
 	ethTxManager := ethtxmanager.New(cfg.EthTxManager, etherman, etmStorage, st)
 
-	seqSender, err := sequencesender.New(cfg.SequenceSender, st, etherman, ethTxManager, eventLog)
+	var seqSender *sequencesender.SequenceSender
+	if cfg.DataAvailability.Enabled {
+		seqSender, err = sequencesender.New(cfg.SequenceSender, st, etherman, ethTxManager, eventLog, newDataAvailability(cfg, st, ""))
+	} else {
+		seqSender, err = sequencesender.New(cfg.SequenceSender, st, etherman, ethTxManager, eventLog, nil)
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -447,8 +460,21 @@ func createSequenceSender(cfg config.Config, pool *pool.Pool, etmStorage *ethtxm
 	return seqSender
 }
 
-func runAggregator(ctx context.Context, c aggregator.Config, etherman *etherman.Client, ethTxManager *ethtxmanager.Client, st *state.State) {
-	agg, err := aggregator.New(c, st, ethTxManager, etherman)
+// newDataAvailability creates the client of the data availability committee, the batches data missing
+// in the committee is requested to the trusted node when its URL is provided
+func newDataAvailability(cfg config.Config, st *state.State, trustedSequencerURL string) *dataavailability.DataAvailability {
+	committee, err := dataavailability.NewCommitteeFromConfig(cfg.DataAvailability)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if trustedSequencerURL == "" {
+		return dataavailability.New(committee, st, nil)
+	}
+	return dataavailability.New(committee, st, client.NewClient(trustedSequencerURL))
+}
+
+func runAggregator(ctx context.Context, c aggregator.Config, etherman *etherman.Client, ethTxManager *ethtxmanager.Client, st *state.State, eventLog *event.EventLog) {
+	agg, err := aggregator.New(c, st, ethTxManager, etherman, eventLog)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -554,7 +580,7 @@ func createEthTxManager(cfg config.Config, etmStorage *ethtxmanager.PostgresStor
 	}
 
 	for _, privateKey := range cfg.EthTxManager.PrivateKeys {
-		_, err := etherman.LoadAuthFromKeyStore(privateKey.Path, privateKey.Password)
+		_, err := etherman.LoadAuth(privateKey)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/0xPolygonHermez/zkevm-node/aggregator"
+	"github.com/0xPolygonHermez/zkevm-node/dataavailability"
 	"github.com/0xPolygonHermez/zkevm-node/db"
 	"github.com/0xPolygonHermez/zkevm-node/etherman"
 	"github.com/0xPolygonHermez/zkevm-node/ethtxmanager"
@@ -47,6 +48,10 @@ const (
 	FlagKeyStorePath = "key-store-path"
 	// FlagPassword is the password needed to decrypt the key store
 	FlagPassword = "password"
+	// FlagRemoteSignerURL is the URL of the remote signer holding the private key of the account going to sign and approve the tokens
+	FlagRemoteSignerURL = "remote-signer-url"
+	// FlagAddress is the address of the account held by the remote signer
+	FlagAddress = "address"
 	// FlagMigrations is the flag for migrations.
 	FlagMigrations = "migrations"
 	// FlagOutputFile is the flag for the output file
@@ -117,6 +122,8 @@ type Config struct {
 	HashDB db.Config
 	// State service configuration
 	State state.Config
+	// Configuration of the data availability committee used in validium mode
+	DataAvailability dataavailability.Config
 }
 
 // Default parses the default configuration values.
@@ -13,6 +13,8 @@ import (
 	"github.com/0xPolygonHermez/zkevm-node/config"
 	"github.com/0xPolygonHermez/zkevm-node/config/types"
 	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/sequencer"
+	"github.com/0xPolygonHermez/zkevm-node/sequencesender"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -64,6 +66,14 @@ func Test_Defaults(t *testing.T) {
 			path:          "Synchronizer.L2Synchronization.CheckLastL2BlockHashOnCloseBatch",
 			expectedValue: true,
 		},
+		{
+			path:          "Synchronizer.L2Synchronization.SyncMode",
+			expectedValue: "jsonrpc",
+		},
+		{
+			path:          "Synchronizer.L2Synchronization.DataStreamServer",
+			expectedValue: "",
+		},
 
 		{
 			path:          "Sequencer.DeletePoolTxsL1BlockConfirmations",
@@ -129,6 +139,30 @@ func Test_Defaults(t *testing.T) {
 			path:          "Sequencer.Finalizer.BatchMaxDeltaTimestamp",
 			expectedValue: types.NewDuration(10 * time.Second),
 		},
+		{
+			path:          "Sequencer.Finalizer.TxOrderingPolicy",
+			expectedValue: sequencer.TxOrderingPolicyType(sequencer.TxOrderingGasPrice),
+		},
+		{
+			path:          "Sequencer.Finalizer.TxOrderingPriorityAddresses",
+			expectedValue: []common.Address{},
+		},
+		{
+			path:          "Sequencer.Admin.Enabled",
+			expectedValue: false,
+		},
+		{
+			path:          "Sequencer.Admin.Host",
+			expectedValue: "127.0.0.1",
+		},
+		{
+			path:          "Sequencer.Admin.Port",
+			expectedValue: 50083,
+		},
+		{
+			path:          "Sequencer.Admin.AuthToken",
+			expectedValue: "",
+		},
 		{
 			path:          "Sequencer.Finalizer.Metrics.Interval",
 			expectedValue: types.NewDuration(60 * time.Minute),
@@ -173,6 +207,26 @@ func Test_Defaults(t *testing.T) {
 			path:          "SequenceSender.GasOffset",
 			expectedValue: uint64(80000),
 		},
+		{
+			path:          "DataAvailability.Enabled",
+			expectedValue: false,
+		},
+		{
+			path:          "DataAvailability.RequiredSignatures",
+			expectedValue: uint64(0),
+		},
+		{
+			path:          "DataAvailability.Timeout",
+			expectedValue: types.NewDuration(30 * time.Second),
+		},
+		{
+			path:          "SequenceSender.SequenceMode",
+			expectedValue: sequencesender.SequenceModeCalldata,
+		},
+		{
+			path:          "SequenceSender.MaxBlobsPerTx",
+			expectedValue: uint64(6),
+		},
 		{
 			path:          "Etherman.URL",
 			expectedValue: "http://localhost:8545",
@@ -197,6 +251,26 @@ func Test_Defaults(t *testing.T) {
 			path:          "Etherman.MultiGasProvider",
 			expectedValue: false,
 		},
+		{
+			path:          "Etherman.FailoverURLs",
+			expectedValue: []string{},
+		},
+		{
+			path:          "Etherman.Failover.HealthCheckInterval",
+			expectedValue: types.NewDuration(5 * time.Second),
+		},
+		{
+			path:          "Etherman.Failover.MaxHeadLag",
+			expectedValue: uint64(5),
+		},
+		{
+			path:          "Etherman.Failover.MaxErrorRate",
+			expectedValue: 0.5,
+		},
+		{
+			path:          "Etherman.Failover.HedgeDelay",
+			expectedValue: types.NewDuration(1 * time.Second),
+		},
 		{
 			path:          "EthTxManager.FrequencyToMonitorTxs",
 			expectedValue: types.NewDuration(1 * time.Second),
@@ -221,6 +295,26 @@ func Test_Defaults(t *testing.T) {
 			path:          "EthTxManager.MaxGasPriceLimit",
 			expectedValue: uint64(0),
 		},
+		{
+			path:          "EthTxManager.DynamicFeeTxs",
+			expectedValue: false,
+		},
+		{
+			path:          "EthTxManager.FeeHistoryBlocks",
+			expectedValue: uint64(10),
+		},
+		{
+			path:          "EthTxManager.FeeHistoryRewardPercentile",
+			expectedValue: float64(50),
+		},
+		{
+			path:          "EthTxManager.PriceBumpPercent",
+			expectedValue: uint64(10),
+		},
+		{
+			path:          "EthTxManager.MaxBlobGasPrice",
+			expectedValue: uint64(0),
+		},
 		{
 			path:          "L2GasPriceSuggester.DefaultGasPriceWei",
 			expectedValue: uint64(2000000000),
@@ -294,6 +388,30 @@ func Test_Defaults(t *testing.T) {
 			path:          "Pool.GlobalQueue",
 			expectedValue: uint64(1024),
 		},
+		{
+			path:          "Pool.Quotas.PerSender.MaxTxs",
+			expectedValue: uint64(0),
+		},
+		{
+			path:          "Pool.Quotas.PerSender.MaxBytes",
+			expectedValue: uint64(0),
+		},
+		{
+			path:          "Pool.Quotas.PerSender.MaxReservedBatches",
+			expectedValue: float64(0),
+		},
+		{
+			path:          "Pool.Quotas.PerIP.MaxTxs",
+			expectedValue: uint64(0),
+		},
+		{
+			path:          "Pool.Quotas.PerIP.MaxBytes",
+			expectedValue: uint64(0),
+		},
+		{
+			path:          "Pool.Quotas.PerIP.MaxReservedBatches",
+			expectedValue: float64(0),
+		},
 		{
 			path:          "Pool.EffectiveGasPrice.Enabled",
 			expectedValue: false,
@@ -410,6 +528,26 @@ func Test_Defaults(t *testing.T) {
 			path:          "RPC.EnableHttpLog",
 			expectedValue: true,
 		},
+		{
+			path:          "RPC.FeeHistoryMaxBlockCount",
+			expectedValue: uint64(1024),
+		},
+		{
+			path:          "RPC.L2BaseFee",
+			expectedValue: uint64(0),
+		},
+		{
+			path:          "RPC.FilterStorage",
+			expectedValue: "memory",
+		},
+		{
+			path:          "RPC.FilterTimeout",
+			expectedValue: types.NewDuration(5 * time.Minute),
+		},
+		{
+			path:          "RPC.TxPoolContentLimit",
+			expectedValue: uint64(5000),
+		},
 		{
 			path:          "RPC.WebSockets.Enabled",
 			expectedValue: true,
@@ -426,6 +564,14 @@ func Test_Defaults(t *testing.T) {
 			path:          "RPC.WebSockets.ReadLimit",
 			expectedValue: int64(104857600),
 		},
+		{
+			path:          "RPC.WebSockets.PendingTxsPollInterval",
+			expectedValue: types.NewDuration(1 * time.Second),
+		},
+		{
+			path:          "RPC.WebSockets.SyncingPollInterval",
+			expectedValue: types.NewDuration(5 * time.Second),
+		},
 		{
 			path:          "Executor.URI",
 			expectedValue: "zkevm-prover:50071",
@@ -478,6 +624,10 @@ func Test_Defaults(t *testing.T) {
 			path:          "Aggregator.TxProfitabilityMinReward",
 			expectedValue: aggregator.TokenAmountWithDecimals{Int: big.NewInt(1100000000000000000)},
 		},
+		{
+			path:          "Aggregator.TxProfitabilityVerifyBatchesGas",
+			expectedValue: uint64(350000),
+		},
 		{
 			path:          "Aggregator.ProofStatePollingInterval",
 			expectedValue: types.NewDuration(5 * time.Second),
@@ -502,6 +652,30 @@ func Test_Defaults(t *testing.T) {
 			path:          "Aggregator.BatchProofL1BlockConfirmations",
 			expectedValue: uint64(2),
 		},
+		{
+			path:          "Aggregator.ProverSchedulerType",
+			expectedValue: aggregator.ProverSchedulerType(aggregator.SchedulerGreedy),
+		},
+		{
+			path:          "Aggregator.FinalProofProvers",
+			expectedValue: []string{},
+		},
+		{
+			path:          "Aggregator.Admin.Enabled",
+			expectedValue: false,
+		},
+		{
+			path:          "Aggregator.Admin.Host",
+			expectedValue: "127.0.0.1",
+		},
+		{
+			path:          "Aggregator.Admin.Port",
+			expectedValue: 50082,
+		},
+		{
+			path:          "Aggregator.Admin.AuthToken",
+			expectedValue: "",
+		},
 		{
 			path:          "State.Batch.Constraints.MaxTxsPerBatch",
 			expectedValue: uint64(300),
@@ -44,6 +44,14 @@ MinAllowedGasPriceInterval = "5m"
 PollMinAllowedGasPriceInterval = "15s"
 AccountQueue = 64
 GlobalQueue = 1024
+	[Pool.Quotas.PerSender]
+	MaxTxs = 0
+	MaxBytes = 0
+	MaxReservedBatches = 0
+	[Pool.Quotas.PerIP]
+	MaxTxs = 0
+	MaxBytes = 0
+	MaxReservedBatches = 0
     [Pool.EffectiveGasPrice]
 	Enabled = false
 	L1GasPriceFactor = 0.25
@@ -68,8 +76,14 @@ GlobalQueue = 1024
 URL = "http://localhost:8545"
 ForkIDChunkSize = 20000
 MultiGasProvider = false
+FailoverURLs = []
 	[Etherman.Etherscan]
 		ApiKey = ""
+	[Etherman.Failover]
+		HealthCheckInterval = "5s"
+		MaxHeadLag = 5
+		MaxErrorRate = 0.5
+		HedgeDelay = "1s"
 
 [EthTxManager]
 FrequencyToMonitorTxs = "1s"
@@ -77,6 +91,11 @@ WaitTxToBeMined = "2m"
 ForcedGas = 0
 GasPriceMarginFactor = 1
 MaxGasPriceLimit = 0
+DynamicFeeTxs = false
+FeeHistoryBlocks = 10
+FeeHistoryRewardPercentile = 50
+PriceBumpPercent = 10
+MaxBlobGasPrice = 0
 
 [RPC]
 Host = "0.0.0.0"
@@ -92,11 +111,18 @@ MaxLogsCount = 10000
 MaxLogsBlockRange = 10000
 MaxNativeBlockHashBlockRange = 60000
 EnableHttpLog = true
+FeeHistoryMaxBlockCount = 1024
+L2BaseFee = 0
+FilterStorage = "memory"
+FilterTimeout = "5m"
+TxPoolContentLimit = 5000
 	[RPC.WebSockets]
 		Enabled = true
 		Host = "0.0.0.0"
 		Port = 8546
 		ReadLimit = 104857600
+		PendingTxsPollInterval = "1s"
+		SyncingPollInterval = "5s"
 
 [Synchronizer]
 SyncInterval = "1s"
@@ -123,6 +149,8 @@ L1SyncCheckL2BlockNumberhModulus = 30
 		AcceptEmptyClosedBatches = false
 		ReprocessFullBatchOnClose = true
 		CheckLastL2BlockHashOnCloseBatch = true
+		SyncMode = "jsonrpc"
+		DataStreamServer = ""
 
 [Sequencer]
 DeletePoolTxsL1BlockConfirmations = 100
@@ -144,6 +172,8 @@ StateConsistencyCheckInterval = "5s"
 		HaltOnBatchNumber = 0
 		SequentialBatchSanityCheck = false
 		SequentialProcessL2Block = true
+		TxOrderingPolicy = "gasprice"
+		TxOrderingPriorityAddresses = []
 	[Sequencer.Finalizer.Metrics]
 		Interval = "60m"
 		EnableLog = true
@@ -152,6 +182,11 @@ StateConsistencyCheckInterval = "5s"
 		Filename = ""
 		Version = 0
 		Enabled = false
+	[Sequencer.Admin]
+		Enabled = false
+		Host = "127.0.0.1"
+		Port = 50083
+		AuthToken = ""
 
 [SequenceSender]
 WaitPeriodSendSequence = "5s"
@@ -161,6 +196,8 @@ MaxTxSizeForL1 = 131072
 L2Coinbase = "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266"
 PrivateKey = {Path = "/pk/sequencer.keystore", Password = "testonly"}
 GasOffset = 80000
+SequenceMode = "calldata"
+MaxBlobsPerTx = 6
 
 [Aggregator]
 Host = "0.0.0.0"
@@ -169,12 +206,20 @@ RetryTime = "5s"
 VerifyProofInterval = "90s"
 TxProfitabilityCheckerType = "acceptall"
 TxProfitabilityMinReward = "1.1"
+TxProfitabilityVerifyBatchesGas = 350000
 ProofStatePollingInterval = "5s"
 CleanupLockedProofsInterval = "2m"
 GeneratingProofCleanupThreshold = "10m"
 GasOffset = 0
 UpgradeEtrogBatchNumber = 0
 BatchProofL1BlockConfirmations = 2
+ProverSchedulerType = "greedy"
+FinalProofProvers = []
+	[Aggregator.Admin]
+		Enabled = false
+		Host = "127.0.0.1"
+		Port = 50082
+		AuthToken = ""
 
 [L2GasPriceSuggester]
 Type = "follower"
@@ -207,4 +252,9 @@ Host = "zkevm-state-db"
 Port = "5432"
 EnableLog = false
 MaxConns = 200
+
+[DataAvailability]
+Enabled = false
+RequiredSignatures = 0
+Timeout = "30s"
 `
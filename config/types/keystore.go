@@ -1,10 +1,18 @@
 package types
 
 // KeystoreFileConfig has all the information needed to load a private key from a key store file
+// or to sign with a private key held by a remote signer
 type KeystoreFileConfig struct {
 	// Path is the file path for the key store file
 	Path string `mapstructure:"Path"`
 
 	// Password is the password to decrypt the key store file
 	Password string `mapstructure:"Password"`
+
+	// RemoteSignerURL is the URL of a Web3Signer or Clef JSON-RPC endpoint that holds the private key,
+	// when it is set the txs are signed by the remote signer and Path and Password are ignored
+	RemoteSignerURL string `mapstructure:"RemoteSignerURL"`
+
+	// Address is the address of the account whose private key is held by the remote signer
+	Address string `mapstructure:"Address"`
 }
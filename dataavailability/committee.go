@@ -0,0 +1,139 @@
+package dataavailability
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// ErrNotEnoughSignatures is returned when less than the required committee members sign a sequence
+	ErrNotEnoughSignatures = errors.New("not enough committee members signed the sequence")
+	// ErrOffChainDataNotFound is returned when no committee member returns the data with the requested hash
+	ErrOffChainDataNotFound = errors.New("off-chain data not found in the committee")
+)
+
+// Committee is the DACClient that collects the signatures of the members of the data availability committee
+type Committee struct {
+	members            []CommitteeMember
+	requiredSignatures uint64
+	timeout            time.Duration
+}
+
+// NewCommittee creates a new Committee, the members are sorted by address as required by the smart contract
+func NewCommittee(members []CommitteeMember, requiredSignatures uint64, timeout time.Duration) (*Committee, error) {
+	if requiredSignatures == 0 || requiredSignatures > uint64(len(members)) {
+		return nil, fmt.Errorf("invalid required signatures %d for a committee of %d members", requiredSignatures, len(members))
+	}
+	sorted := make([]CommitteeMember, len(members))
+	copy(sorted, members)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Address().Bytes(), sorted[j].Address().Bytes()) < 0
+	})
+	return &Committee{
+		members:            sorted,
+		requiredSignatures: requiredSignatures,
+		timeout:            timeout,
+	}, nil
+}
+
+// NewCommitteeFromConfig creates a new Committee whose members are reached through their JSON-RPC endpoints
+func NewCommitteeFromConfig(cfg Config) (*Committee, error) {
+	members := make([]CommitteeMember, 0, len(cfg.Members))
+	for _, member := range cfg.Members {
+		members = append(members, NewRPCMember(member.Address, member.URL))
+	}
+	return NewCommittee(members, cfg.RequiredSignatures, cfg.Timeout.Duration)
+}
+
+type signatureResult struct {
+	member    int
+	signature []byte
+	err       error
+}
+
+// PostSequence sends the batches data to all the committee members and waits until the required number of them
+// have signed the hash of the sequence
+func (c *Committee) PostSequence(ctx context.Context, batchesData [][]byte) ([]byte, error) {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	hash := SequenceHash(batchesData)
+	results := make(chan signatureResult, len(c.members))
+	for i, member := range c.members {
+		go func(i int, member CommitteeMember) {
+			signature, err := member.SignSequence(ctx, batchesData)
+			if err == nil {
+				var signer common.Address
+				signer, err = RecoverSigner(hash, signature)
+				if err == nil && signer != member.Address() {
+					err = fmt.Errorf("%w: signed by %s", ErrInvalidSignature, signer.String())
+				}
+			}
+			results <- signatureResult{member: i, signature: signature, err: err}
+		}(i, member)
+	}
+
+	signatures := make([][]byte, len(c.members))
+	collected := uint64(0)
+	for range c.members {
+		var result signatureResult
+		select {
+		case result = <-results:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %d of %d signatures collected: %v", ErrNotEnoughSignatures, collected, c.requiredSignatures, ctx.Err())
+		}
+		if result.err != nil {
+			log.Warnf("committee member %s failed to sign the sequence %s: %v", c.members[result.member].Address().String(), hash.String(), result.err)
+			continue
+		}
+		signatures[result.member] = result.signature
+		collected++
+		if collected == c.requiredSignatures {
+			break
+		}
+	}
+	if collected < c.requiredSignatures {
+		return nil, fmt.Errorf("%w: %d of %d signatures collected", ErrNotEnoughSignatures, collected, c.requiredSignatures)
+	}
+
+	// The signatures and the addresses must follow the order of the committee members
+	sortedSignatures := make([][]byte, 0, collected)
+	addresses := make([]common.Address, 0, len(c.members))
+	for i, member := range c.members {
+		if signatures[i] != nil {
+			sortedSignatures = append(sortedSignatures, signatures[i])
+		}
+		addresses = append(addresses, member.Address())
+	}
+	return encodeMessage(sortedSignatures, addresses), nil
+}
+
+// GetOffChainData returns the batch data with the provided hash from the first committee member that has it
+func (c *Committee) GetOffChainData(ctx context.Context, hash common.Hash) ([]byte, error) {
+	for _, member := range c.members {
+		data, err := member.GetOffChainData(ctx, hash)
+		if err != nil {
+			log.Warnf("failed to get off-chain data %s from committee member %s: %v", hash.String(), member.Address().String(), err)
+			continue
+		}
+		if crypto.Keccak256Hash(data) != hash {
+			log.Warnf("committee member %s returned off-chain data that doesn't match the hash %s", member.Address().String(), hash.String())
+			continue
+		}
+		return data, nil
+	}
+	return nil, ErrOffChainDataNotFound
+}
@@ -0,0 +1,27 @@
+package dataavailability
+
+import (
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Config is the configuration of the data availability committee used in validium mode
+type Config struct {
+	// Enabled enables the validium mode: the sequence sender posts the batches data to the
+	// data availability committee instead of L1, and the synchronizer retrieves it by hash
+	Enabled bool `mapstructure:"Enabled"`
+	// RequiredSignatures is the number of committee members that must sign a sequence
+	RequiredSignatures uint64 `mapstructure:"RequiredSignatures"`
+	// Members are the members of the data availability committee
+	Members []MemberConfig `mapstructure:"Members"`
+	// Timeout is the max time to wait for the committee members to store and sign a sequence
+	Timeout types.Duration `mapstructure:"Timeout"`
+}
+
+// MemberConfig is the configuration of a member of the data availability committee
+type MemberConfig struct {
+	// Address used by the member to sign the sequences
+	Address common.Address `mapstructure:"Address"`
+	// URL of the JSON-RPC endpoint of the member
+	URL string `mapstructure:"URL"`
+}
@@ -0,0 +1,87 @@
+package dataavailability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/jackc/pgx/v4"
+)
+
+// ErrBatchDataNotFound is returned when the data of a batch can't be retrieved from any source
+var ErrBatchDataNotFound = errors.New("batch data not found")
+
+// DataAvailability posts the batches data to the data availability committee and retrieves the data
+// of the batches sequenced in validium mode by its hash
+type DataAvailability struct {
+	client      DACClient
+	state       stateInterface
+	trustedNode trustedNodeInterface
+}
+
+// New creates a new DataAvailability. The trustedNode is optional, when it's set the batches data
+// missing in the committee is requested to the trusted node
+func New(client DACClient, state stateInterface, trustedNode trustedNodeInterface) *DataAvailability {
+	return &DataAvailability{
+		client:      client,
+		state:       state,
+		trustedNode: trustedNode,
+	}
+}
+
+// PostSequence posts the batches data to the committee and returns the data availability message
+// that must be sent to L1 along with the hashes of the batches
+func (d *DataAvailability) PostSequence(ctx context.Context, batchesData [][]byte) ([]byte, error) {
+	return d.client.PostSequence(ctx, batchesData)
+}
+
+// GetBatchL2Data returns the BatchL2Data of the batches whose transactions hashes were posted on L1. The data
+// is looked for in the trusted state, then in the committee and finally in the trusted node
+func (d *DataAvailability) GetBatchL2Data(ctx context.Context, batchNumbers []uint64, hashes []common.Hash, dbTx pgx.Tx) ([][]byte, error) {
+	if len(batchNumbers) != len(hashes) {
+		return nil, fmt.Errorf("%d batch numbers and %d hashes provided", len(batchNumbers), len(hashes))
+	}
+	batchesData := make([][]byte, 0, len(batchNumbers))
+	for i, batchNumber := range batchNumbers {
+		data, err := d.getBatchL2Data(ctx, batchNumber, hashes[i], dbTx)
+		if err != nil {
+			return nil, err
+		}
+		batchesData = append(batchesData, data)
+	}
+	return batchesData, nil
+}
+
+func (d *DataAvailability) getBatchL2Data(ctx context.Context, batchNumber uint64, hash common.Hash, dbTx pgx.Tx) ([]byte, error) {
+	batch, err := d.state.GetBatchByNumber(ctx, batchNumber, dbTx)
+	if err != nil && !errors.Is(err, state.ErrNotFound) {
+		return nil, err
+	}
+	if batch != nil && crypto.Keccak256Hash(batch.BatchL2Data) == hash {
+		return batch.BatchL2Data, nil
+	}
+
+	data, err := d.client.GetOffChainData(ctx, hash)
+	if err == nil {
+		return data, nil
+	}
+	log.Warnf("failed to get the data of batch %d from the committee: %v", batchNumber, err)
+
+	if d.trustedNode != nil {
+		trustedBatch, err := d.trustedNode.BatchByNumber(ctx, new(big.Int).SetUint64(batchNumber))
+		if err != nil {
+			log.Warnf("failed to get the data of batch %d from the trusted node: %v", batchNumber, err)
+		} else if trustedBatch != nil && crypto.Keccak256Hash(trustedBatch.BatchL2Data) == hash {
+			return trustedBatch.BatchL2Data, nil
+		} else {
+			log.Warnf("the trusted node returned data of batch %d that doesn't match the hash %s", batchNumber, hash.String())
+		}
+	}
+
+	return nil, fmt.Errorf("%w: batch %d with hash %s", ErrBatchDataNotFound, batchNumber, hash.String())
+}
@@ -0,0 +1,187 @@
+package dataavailability
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/types"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingMember struct {
+	CommitteeMember
+}
+
+func (m *failingMember) SignSequence(ctx context.Context, batchesData [][]byte) ([]byte, error) {
+	return nil, errors.New("member down")
+}
+
+// stuckMember doesn't answer until it's released, whatever the context
+type stuckMember struct {
+	CommitteeMember
+	release chan struct{}
+}
+
+func (m *stuckMember) SignSequence(ctx context.Context, batchesData [][]byte) ([]byte, error) {
+	<-m.release
+	return nil, errors.New("member released")
+}
+
+type stateMock struct {
+	batches map[uint64]*state.Batch
+}
+
+func (s *stateMock) GetBatchByNumber(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) (*state.Batch, error) {
+	batch, found := s.batches[batchNumber]
+	if !found {
+		return nil, state.ErrNotFound
+	}
+	return batch, nil
+}
+
+type trustedNodeMock struct {
+	batches map[uint64][]byte
+}
+
+func (n *trustedNodeMock) BatchByNumber(ctx context.Context, number *big.Int) (*types.Batch, error) {
+	return &types.Batch{BatchL2Data: n.batches[number.Uint64()]}, nil
+}
+
+func newKeys(t *testing.T, n int) []*ecdsa.PrivateKey {
+	keys := make([]*ecdsa.PrivateKey, 0, n)
+	for i := 0; i < n; i++ {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// parseMessage splits the data availability message into the signatures and the addresses of the members
+func parseMessage(t *testing.T, message []byte, members int) ([][]byte, []common.Address) {
+	addressesLen := members * common.AddressLength
+	require.Zero(t, (len(message)-addressesLen)%crypto.SignatureLength)
+	var signatures [][]byte
+	for i := 0; i < len(message)-addressesLen; i += crypto.SignatureLength {
+		signatures = append(signatures, message[i:i+crypto.SignatureLength])
+	}
+	var addresses []common.Address
+	for i := len(message) - addressesLen; i < len(message); i += common.AddressLength {
+		addresses = append(addresses, common.BytesToAddress(message[i:i+common.AddressLength]))
+	}
+	return signatures, addresses
+}
+
+func TestSequenceHash(t *testing.T) {
+	batchesData := [][]byte{{0x01, 0x02}, {0x03}, {}}
+
+	// accumulatedNonForcedTransactionsHash = keccak256(abi.encodePacked(accumulatedNonForcedTransactionsHash, transactionsHash))
+	expected := make([]byte, common.HashLength)
+	for _, batchData := range batchesData {
+		packed := append(bytes.Clone(expected), crypto.Keccak256(batchData)...)
+		expected = crypto.Keccak256(packed)
+	}
+	assert.Equal(t, common.BytesToHash(expected), SequenceHash(batchesData))
+	assert.Equal(t, common.Hash{}, SequenceHash(nil))
+}
+
+func TestCommitteePostSequence(t *testing.T) {
+	ctx := context.Background()
+	batchesData := [][]byte{{0x01, 0x02}, {0x03}}
+	hash := SequenceHash(batchesData)
+
+	committee, err := NewLocalCommittee(newKeys(t, 3), 2)
+	require.NoError(t, err)
+
+	message, err := committee.PostSequence(ctx, batchesData)
+	require.NoError(t, err)
+
+	signatures, addresses := parseMessage(t, message, 3)
+	require.Len(t, signatures, 2)
+	for i := 1; i < len(addresses); i++ {
+		assert.Equal(t, -1, bytes.Compare(addresses[i-1].Bytes(), addresses[i].Bytes()))
+	}
+	// the signatures follow the order of the members
+	signerIndex := -1
+	for _, signature := range signatures {
+		assert.GreaterOrEqual(t, signature[crypto.RecoveryIDOffset], byte(signatureRecoveryIDOffset))
+		signer, err := RecoverSigner(hash, signature)
+		require.NoError(t, err)
+		index := -1
+		for i, address := range addresses {
+			if address == signer {
+				index = i
+			}
+		}
+		assert.Greater(t, index, signerIndex)
+		signerIndex = index
+	}
+
+	data, err := committee.GetOffChainData(ctx, crypto.Keccak256Hash(batchesData[0]))
+	require.NoError(t, err)
+	assert.Equal(t, batchesData[0], data)
+
+	_, err = committee.GetOffChainData(ctx, common.HexToHash("0x1"))
+	assert.ErrorIs(t, err, ErrOffChainDataNotFound)
+}
+
+func TestCommitteeNotEnoughSignatures(t *testing.T) {
+	keys := newKeys(t, 2)
+	members := []CommitteeMember{NewLocalMember(keys[0]), &failingMember{NewLocalMember(keys[1])}}
+	committee, err := NewCommittee(members, 2, 0)
+	require.NoError(t, err)
+
+	_, err = committee.PostSequence(context.Background(), [][]byte{{0x01}})
+	assert.ErrorIs(t, err, ErrNotEnoughSignatures)
+
+	_, err = NewCommittee(members, 3, 0)
+	assert.Error(t, err)
+}
+
+func TestCommitteePostSequenceTimeout(t *testing.T) {
+	keys := newKeys(t, 2)
+	stuck := &stuckMember{CommitteeMember: NewLocalMember(keys[1]), release: make(chan struct{})}
+	defer close(stuck.release)
+	members := []CommitteeMember{NewLocalMember(keys[0]), stuck}
+	committee, err := NewCommittee(members, 2, 100*time.Millisecond)
+	require.NoError(t, err)
+
+	_, err = committee.PostSequence(context.Background(), [][]byte{{0x01}})
+	assert.ErrorIs(t, err, ErrNotEnoughSignatures)
+	assert.ErrorContains(t, err, context.DeadlineExceeded.Error())
+}
+
+func TestGetBatchL2Data(t *testing.T) {
+	ctx := context.Background()
+	committeeData := []byte{0x01}
+	trustedData := []byte{0x02}
+	stateData := []byte{0x03}
+
+	committee, err := NewLocalCommittee(newKeys(t, 1), 1)
+	require.NoError(t, err)
+	_, err = committee.PostSequence(ctx, [][]byte{committeeData})
+	require.NoError(t, err)
+
+	st := &stateMock{batches: map[uint64]*state.Batch{3: {BatchNumber: 3, BatchL2Data: stateData}}}
+	trustedNode := &trustedNodeMock{batches: map[uint64][]byte{2: trustedData, 4: {0xff}}}
+	da := New(committee, st, trustedNode)
+
+	batchesData, err := da.GetBatchL2Data(ctx, []uint64{1, 2, 3},
+		[]common.Hash{crypto.Keccak256Hash(committeeData), crypto.Keccak256Hash(trustedData), crypto.Keccak256Hash(stateData)}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{committeeData, trustedData, stateData}, batchesData)
+
+	// the data returned by the trusted node must match the hash
+	_, err = da.GetBatchL2Data(ctx, []uint64{4}, []common.Hash{crypto.Keccak256Hash([]byte{0x04})}, nil)
+	assert.ErrorIs(t, err, ErrBatchDataNotFound)
+}
@@ -0,0 +1,40 @@
+package dataavailability
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/types"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+// DACClient is the client of the data availability committee
+type DACClient interface {
+	// PostSequence stores the batches data in the committee members and returns the data
+	// availability message with the signatures of the members over the hash of the sequence
+	PostSequence(ctx context.Context, batchesData [][]byte) ([]byte, error)
+	// GetOffChainData returns the batch data with the provided hash from the committee
+	GetOffChainData(ctx context.Context, hash common.Hash) ([]byte, error)
+}
+
+// CommitteeMember is a member of the data availability committee
+type CommitteeMember interface {
+	// Address returns the address used by the member to sign the sequences
+	Address() common.Address
+	// SignSequence stores the batches data and returns the signature of the hash of the sequence
+	SignSequence(ctx context.Context, batchesData [][]byte) ([]byte, error)
+	// GetOffChainData returns the batch data with the provided hash
+	GetOffChainData(ctx context.Context, hash common.Hash) ([]byte, error)
+}
+
+// stateInterface gathers the methods required to interact with the state.
+type stateInterface interface {
+	GetBatchByNumber(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) (*state.Batch, error)
+}
+
+// trustedNodeInterface contains the methods required to interact with the trusted node.
+type trustedNodeInterface interface {
+	BatchByNumber(ctx context.Context, number *big.Int) (*types.Batch, error)
+}
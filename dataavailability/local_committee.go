@@ -0,0 +1,63 @@
+package dataavailability
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// LocalMember is an in-process committee member that keeps the batches data in memory, it's intended for testing
+type LocalMember struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+
+	mutex sync.RWMutex
+	data  map[common.Hash][]byte
+}
+
+// NewLocalMember creates a new LocalMember that signs the sequences with the provided key
+func NewLocalMember(key *ecdsa.PrivateKey) *LocalMember {
+	return &LocalMember{
+		key:     key,
+		address: crypto.PubkeyToAddress(key.PublicKey),
+		data:    make(map[common.Hash][]byte),
+	}
+}
+
+// NewLocalCommittee creates a new Committee of in-process members with the provided keys
+func NewLocalCommittee(keys []*ecdsa.PrivateKey, requiredSignatures uint64) (*Committee, error) {
+	members := make([]CommitteeMember, 0, len(keys))
+	for _, key := range keys {
+		members = append(members, NewLocalMember(key))
+	}
+	return NewCommittee(members, requiredSignatures, 0)
+}
+
+// Address returns the address used by the member to sign the sequences
+func (m *LocalMember) Address() common.Address {
+	return m.address
+}
+
+// SignSequence stores the batches data and returns the signature of the hash of the sequence
+func (m *LocalMember) SignSequence(ctx context.Context, batchesData [][]byte) ([]byte, error) {
+	m.mutex.Lock()
+	for _, batchData := range batchesData {
+		m.data[crypto.Keccak256Hash(batchData)] = batchData
+	}
+	m.mutex.Unlock()
+	return SignSequence(m.key, batchesData)
+}
+
+// GetOffChainData returns the batch data with the provided hash
+func (m *LocalMember) GetOffChainData(ctx context.Context, hash common.Hash) ([]byte, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	data, found := m.data[hash]
+	if !found {
+		return nil, ErrOffChainDataNotFound
+	}
+	return data, nil
+}
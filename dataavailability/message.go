@@ -0,0 +1,68 @@
+package dataavailability
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// signatureRecoveryIDOffset is added to the recovery id of the signatures to be verified with ecrecover
+const signatureRecoveryIDOffset = 27
+
+// ErrInvalidSignature is returned when a signature doesn't belong to the expected committee member
+var ErrInvalidSignature = errors.New("invalid committee member signature")
+
+// SequenceHash returns the hash of the sequence signed by the committee members. It's accumulated like the
+// smart contract does, acc = keccak256(acc ‖ transactionsHash) starting from the zero hash, over the
+// transactions hashes posted on L1. The batchesData must only contain the data of the non-forced batches
+func SequenceHash(batchesData [][]byte) common.Hash {
+	var acc common.Hash
+	for _, batchData := range batchesData {
+		acc = crypto.Keccak256Hash(acc.Bytes(), crypto.Keccak256(batchData))
+	}
+	return acc
+}
+
+// SignSequence signs the hash of the sequence with the key of a committee member
+func SignSequence(key *ecdsa.PrivateKey, batchesData [][]byte) ([]byte, error) {
+	hash := SequenceHash(batchesData)
+	signature, err := crypto.Sign(hash.Bytes(), key)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += signatureRecoveryIDOffset
+	return signature, nil
+}
+
+// RecoverSigner returns the address of the committee member that signed the hash
+func RecoverSigner(hash common.Hash, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("%w: invalid length %d", ErrInvalidSignature, len(signature))
+	}
+	sig := bytes.Clone(signature)
+	if sig[crypto.RecoveryIDOffset] >= signatureRecoveryIDOffset {
+		sig[crypto.RecoveryIDOffset] -= signatureRecoveryIDOffset
+	}
+	pubKey, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// encodeMessage builds the data availability message verified by the smart contract: the signatures
+// sorted by the address of the signer, followed by the addresses of all the committee members
+func encodeMessage(signatures [][]byte, members []common.Address) []byte {
+	message := make([]byte, 0, len(signatures)*crypto.SignatureLength+len(members)*common.AddressLength)
+	for _, signature := range signatures {
+		message = append(message, signature...)
+	}
+	for _, member := range members {
+		message = append(message, member.Bytes()...)
+	}
+	return message
+}
@@ -0,0 +1,62 @@
+package dataavailability
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/client"
+	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RPCMember is a committee member reached through its JSON-RPC endpoint
+type RPCMember struct {
+	address common.Address
+	url     string
+}
+
+// NewRPCMember creates a new RPCMember
+func NewRPCMember(address common.Address, url string) *RPCMember {
+	return &RPCMember{
+		address: address,
+		url:     url,
+	}
+}
+
+// Address returns the address used by the member to sign the sequences
+func (m *RPCMember) Address() common.Address {
+	return m.address
+}
+
+// SignSequence stores the batches data and returns the signature of the hash of the sequence
+func (m *RPCMember) SignSequence(ctx context.Context, batchesData [][]byte) ([]byte, error) {
+	params := make([]types.ArgBytes, 0, len(batchesData))
+	for _, batchData := range batchesData {
+		params = append(params, batchData)
+	}
+	return m.call(ctx, "dac_signSequence", params)
+}
+
+// GetOffChainData returns the batch data with the provided hash
+func (m *RPCMember) GetOffChainData(ctx context.Context, hash common.Hash) ([]byte, error) {
+	return m.call(ctx, "dac_getOffChainData", hash)
+}
+
+func (m *RPCMember) call(ctx context.Context, method string, parameters ...interface{}) ([]byte, error) {
+	response, err := client.JSONRPCCallWithContext(ctx, m.url, method, parameters...)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil {
+		return nil, response.Error.RPCError()
+	}
+
+	var result types.ArgBytes
+	err = json.Unmarshal(response.Result, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,7 @@
+-- +migrate Up
+CREATE INDEX IF NOT EXISTS idx_transaction_from_status ON pool.transaction (from_address, status);
+CREATE INDEX IF NOT EXISTS idx_transaction_ip_status ON pool.transaction (ip, status);
+
+-- +migrate Down
+DROP INDEX IF EXISTS pool.idx_transaction_from_status;
+DROP INDEX IF EXISTS pool.idx_transaction_ip_status;
@@ -0,0 +1,48 @@
+package pool_migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// this migration adds the indexes used to compute the pool quotas per sender and per IP
+type migrationTest0014 struct{}
+
+func (m migrationTest0014) InsertData(db *sql.DB) error {
+	return nil
+}
+
+var indexesMigration14 = []string{
+	"idx_transaction_from_status",
+	"idx_transaction_ip_status",
+}
+
+func (m migrationTest0014) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	// Check indexes adding
+	for _, idx := range indexesMigration14 {
+		// getIndex
+		const getIndex = `SELECT count(*) FROM pg_indexes WHERE indexname = $1;`
+		row := db.QueryRow(getIndex, idx)
+		var result int
+		assert.NoError(t, row.Scan(&result))
+		assert.Equal(t, 1, result)
+	}
+}
+
+func (m migrationTest0014) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	// Check indexes removing
+	for _, idx := range indexesMigration14 {
+		// getIndex
+		const getIndex = `SELECT count(*) FROM pg_indexes WHERE indexname = $1;`
+		row := db.QueryRow(getIndex, idx)
+		var result int
+		assert.NoError(t, row.Scan(&result))
+		assert.Equal(t, 0, result)
+	}
+}
+
+func TestMigration0014(t *testing.T) {
+	runMigrationTest(t, 14, migrationTest0014{})
+}
@@ -0,0 +1,9 @@
+-- +migrate Up
+ALTER TABLE pool.transaction
+    ADD COLUMN id BIGSERIAL;
+CREATE INDEX IF NOT EXISTS idx_transaction_id ON pool.transaction (id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS pool.idx_transaction_id;
+ALTER TABLE pool.transaction
+    DROP COLUMN id;
@@ -0,0 +1,77 @@
+package pool_migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// this migration adds the id used as the cursor to poll the new txs
+type migrationTest0015 struct{}
+
+func (m migrationTest0015) InsertData(db *sql.DB) error {
+	const insertTx = `
+		INSERT INTO pool.transaction (hash, ip, received_at, from_address)
+		VALUES ('0x0001', '127.0.0.1', '2023-12-07', '0x0011')`
+
+	_, err := db.Exec(insertTx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var indexesMigration15 = []string{
+	"idx_transaction_id",
+}
+
+func (m migrationTest0015) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	// Check indexes adding
+	for _, idx := range indexesMigration15 {
+		// getIndex
+		const getIndex = `SELECT count(*) FROM pg_indexes WHERE indexname = $1;`
+		row := db.QueryRow(getIndex, idx)
+		var result int
+		assert.NoError(t, row.Scan(&result))
+		assert.Equal(t, 1, result)
+	}
+
+	const insertTx = `
+		INSERT INTO pool.transaction (hash, ip, received_at, from_address)
+		VALUES ('0x0002', '127.0.0.1', '2023-12-07', '0x0022')`
+
+	_, err := db.Exec(insertTx)
+	assert.NoError(t, err)
+
+	// the existing txs and the new ones get an increasing id
+	const getIDs = `SELECT id FROM pool.transaction WHERE hash IN ('0x0001', '0x0002') ORDER BY hash;`
+	rows, err := db.Query(getIDs)
+	assert.NoError(t, err)
+	defer rows.Close()
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		assert.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	assert.Len(t, ids, 2)
+	assert.Less(t, ids[0], ids[1])
+}
+
+func (m migrationTest0015) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	// Check indexes removing
+	for _, idx := range indexesMigration15 {
+		// getIndex
+		const getIndex = `SELECT count(*) FROM pg_indexes WHERE indexname = $1;`
+		row := db.QueryRow(getIndex, idx)
+		var result int
+		assert.NoError(t, row.Scan(&result))
+		assert.Equal(t, 0, result)
+	}
+}
+
+func TestMigration0015(t *testing.T) {
+	runMigrationTest(t, 15, migrationTest0015{})
+}
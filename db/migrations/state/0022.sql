@@ -0,0 +1,27 @@
+-- +migrate Up
+ALTER TABLE state.blob_inner
+    ADD COLUMN IF NOT EXISTS blob_sequence_index     BIGINT,
+    ADD COLUMN IF NOT EXISTS blob_type               SMALLINT,
+    ADD COLUMN IF NOT EXISTS max_sequence_timestamp  TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS zk_gas_limit            BIGINT,
+    ADD COLUMN IF NOT EXISTS l1_info_tree_leaf_index BIGINT,
+    ADD COLUMN IF NOT EXISTS point_z                 BYTEA,
+    ADD COLUMN IF NOT EXISTS point_y                 BYTEA,
+    ADD COLUMN IF NOT EXISTS blob_state_root         VARCHAR,
+    ADD COLUMN IF NOT EXISTS blob_acc_input_hash     VARCHAR;
+
+CREATE INDEX IF NOT EXISTS idx_virtual_batch_blob_inner_num ON state.virtual_batch (blob_inner_num);
+
+-- +migrate Down
+DROP INDEX IF EXISTS state.idx_virtual_batch_blob_inner_num;
+
+ALTER TABLE state.blob_inner
+    DROP COLUMN IF EXISTS blob_sequence_index,
+    DROP COLUMN IF EXISTS blob_type,
+    DROP COLUMN IF EXISTS max_sequence_timestamp,
+    DROP COLUMN IF EXISTS zk_gas_limit,
+    DROP COLUMN IF EXISTS l1_info_tree_leaf_index,
+    DROP COLUMN IF EXISTS point_z,
+    DROP COLUMN IF EXISTS point_y,
+    DROP COLUMN IF EXISTS blob_state_root,
+    DROP COLUMN IF EXISTS blob_acc_input_hash;
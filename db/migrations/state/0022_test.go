@@ -0,0 +1,73 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type migrationTest0022 struct {
+	migrationBase
+}
+
+func (m migrationTest0022) InsertData(db *sql.DB) error {
+	const addBlock = "INSERT INTO state.block (block_num, received_at, block_hash) VALUES ($1, $2, $3)"
+	if _, err := db.Exec(addBlock, 1, time.Now(), "0x29e885edaf8e4b51e1d2e05f9da28161d2fb4f6b1d53827d9b80a23cf2d7d9f1"); err != nil {
+		return err
+	}
+	const addBlobInner = "INSERT INTO state.blob_inner (blob_inner_num, data, block_num) VALUES ($1, $2, $3)"
+	if _, err := db.Exec(addBlobInner, 1, []byte{0x01}, 1); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m migrationTest0022) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	m.AssertNewAndRemovedItemsAfterMigrationUp(t, db)
+
+	const addBlobInner = `
+		INSERT INTO state.blob_inner (blob_inner_num, data, block_num, blob_sequence_index, blob_type, max_sequence_timestamp, zk_gas_limit,
+			l1_info_tree_leaf_index, point_z, point_y, blob_state_root, blob_acc_input_hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+	_, err := db.Exec(addBlobInner, 2, []byte{0x02}, 1, 1, 1, time.Now().UTC(), 1000000, 5, []byte{0x03}, []byte{0x04},
+		"0xadb91a6a1fce56eaea561002bc9a993f4e65a7710bd72f4eee3067cbd73a743c", "0x5bf4af1a651a2a74b36e6eb208481f94c69fc959f756223dfa49608061937585")
+	assert.NoError(t, err)
+
+	var count int
+	err = db.QueryRow("SELECT COUNT(*) FROM state.blob_inner").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func (m migrationTest0022) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	m.AssertNewAndRemovedItemsAfterMigrationDown(t, db)
+
+	var count int
+	err := db.QueryRow("SELECT COUNT(*) FROM state.blob_inner").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestMigration0022(t *testing.T) {
+	m := migrationTest0022{
+		migrationBase: migrationBase{
+			newIndexes: []string{
+				"idx_virtual_batch_blob_inner_num",
+			},
+			newColumns: []columnMetadata{
+				{"state", "blob_inner", "blob_sequence_index"},
+				{"state", "blob_inner", "blob_type"},
+				{"state", "blob_inner", "max_sequence_timestamp"},
+				{"state", "blob_inner", "zk_gas_limit"},
+				{"state", "blob_inner", "l1_info_tree_leaf_index"},
+				{"state", "blob_inner", "point_z"},
+				{"state", "blob_inner", "point_y"},
+				{"state", "blob_inner", "blob_state_root"},
+				{"state", "blob_inner", "blob_acc_input_hash"},
+			},
+		},
+	}
+	runMigrationTest(t, 22, m)
+}
@@ -0,0 +1,13 @@
+-- +migrate Up
+ALTER TABLE state.monitored_txs
+    ADD COLUMN IF NOT EXISTS gas_tip_cap    DECIMAL(78, 0),
+    ADD COLUMN IF NOT EXISTS blob_sidecar   BYTEA,
+    ADD COLUMN IF NOT EXISTS blob_gas       DECIMAL(78, 0),
+    ADD COLUMN IF NOT EXISTS blob_gas_price DECIMAL(78, 0);
+
+-- +migrate Down
+ALTER TABLE state.monitored_txs
+    DROP COLUMN IF EXISTS gas_tip_cap,
+    DROP COLUMN IF EXISTS blob_sidecar,
+    DROP COLUMN IF EXISTS blob_gas,
+    DROP COLUMN IF EXISTS blob_gas_price;
@@ -0,0 +1,72 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// this migration adds the blob tx fields to the monitored txs
+type migrationTest0023 struct{}
+
+func (m migrationTest0023) InsertData(db *sql.DB) error {
+	addMonitoredTx := `
+        INSERT INTO state.monitored_txs (owner, id, from_addr, to_addr, nonce, value, data, gas, gas_offset, gas_price, status, history, block_num, created_at, updated_at) 
+                                VALUES (   $1, $2,        $3,      $4,    $5,    $6,   $7,  $8,         $9,       $10,    $11,     $12,       $13,        $14,        $15);`
+
+	args := []interface{}{
+		"owner", "id1", common.HexToAddress("0x111").String(), common.HexToAddress("0x222").String(), 333, 444,
+		[]byte{5, 5, 5}, 666, 0, 777, "status", []string{common.HexToHash("0x888").String()}, 999, time.Now(), time.Now(),
+	}
+	if _, err := db.Exec(addMonitoredTx, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m migrationTest0023) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	addMonitoredTx := `
+	INSERT INTO state.monitored_txs (owner, id, from_addr, to_addr, nonce, value, data, gas, gas_offset, gas_price, status, history, block_num, created_at, updated_at, gas_tip_cap, blob_sidecar, blob_gas, blob_gas_price) 
+                            VALUES (   $1, $2,        $3,      $4,    $5,    $6,   $7,  $8,         $9,       $10,    $11,     $12,       $13,        $14,        $15,         $16,          $17,      $18,            $19);`
+
+	args := []interface{}{
+		"owner", "id2", common.HexToAddress("0x111").String(), common.HexToAddress("0x222").String(), 333, 444,
+		[]byte{5, 5, 5}, 666, 0, 777, "status", []string{common.HexToHash("0x888").String()}, 999, time.Now(), time.Now(),
+		101, []byte{1, 2, 3}, 131072, 202,
+	}
+	_, err := db.Exec(addMonitoredTx, args...)
+	assert.NoError(t, err)
+
+	getBlobFieldsQuery := `SELECT gas_tip_cap, blob_sidecar, blob_gas, blob_gas_price FROM state.monitored_txs WHERE id = $1`
+
+	var gasTipCap, blobGas, blobGasPrice *uint64
+	var blobSidecar []byte
+	err = db.QueryRow(getBlobFieldsQuery, "id1").Scan(&gasTipCap, &blobSidecar, &blobGas, &blobGasPrice)
+	assert.NoError(t, err)
+	assert.Nil(t, gasTipCap)
+	assert.Nil(t, blobSidecar)
+	assert.Nil(t, blobGas)
+	assert.Nil(t, blobGasPrice)
+
+	err = db.QueryRow(getBlobFieldsQuery, "id2").Scan(&gasTipCap, &blobSidecar, &blobGas, &blobGasPrice)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(101), *gasTipCap)
+	assert.Equal(t, []byte{1, 2, 3}, blobSidecar)
+	assert.Equal(t, uint64(131072), *blobGas)
+	assert.Equal(t, uint64(202), *blobGasPrice)
+}
+
+func (m migrationTest0023) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	getBlobSidecarQuery := `SELECT blob_sidecar FROM state.monitored_txs WHERE id = $1`
+	var blobSidecar []byte
+	err := db.QueryRow(getBlobSidecarQuery, "id1").Scan(&blobSidecar)
+	assert.Error(t, err)
+}
+
+func TestMigration0023(t *testing.T) {
+	runMigrationTest(t, 23, migrationTest0023{})
+}
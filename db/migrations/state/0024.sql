@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS state.rpc_filter
+(
+    id         VARCHAR NOT NULL PRIMARY KEY,
+    type       VARCHAR NOT NULL,
+    parameters JSONB,
+    last_poll  TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS rpc_filter_last_poll_idx ON state.rpc_filter (last_poll);
+
+-- +migrate Down
+DROP INDEX IF EXISTS state.rpc_filter_last_poll_idx;
+DROP TABLE IF EXISTS state.rpc_filter;
@@ -0,0 +1,39 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// this migration adds the table used to store the RPC filters
+type migrationTest0024 struct{}
+
+func (m migrationTest0024) InsertData(db *sql.DB) error {
+	return nil
+}
+
+func (m migrationTest0024) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	addFilter := `INSERT INTO state.rpc_filter (id, type, parameters, last_poll) VALUES ($1, $2, $3, $4);`
+	_, err := db.Exec(addFilter, "0x1", "log", `{"fromBlock":"0x1"}`, time.Now())
+	assert.NoError(t, err)
+	_, err = db.Exec(addFilter, "0x2", "block", nil, time.Now())
+	assert.NoError(t, err)
+
+	var filterType string
+	err = db.QueryRow(`SELECT type FROM state.rpc_filter WHERE id = $1`, "0x1").Scan(&filterType)
+	assert.NoError(t, err)
+	assert.Equal(t, "log", filterType)
+}
+
+func (m migrationTest0024) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	var count int
+	err := db.QueryRow(`SELECT COUNT(*) FROM state.rpc_filter`).Scan(&count)
+	assert.Error(t, err)
+}
+
+func TestMigration0024(t *testing.T) {
+	runMigrationTest(t, 24, migrationTest0024{})
+}
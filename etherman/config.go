@@ -1,6 +1,9 @@
 package etherman
 
-import "github.com/0xPolygonHermez/zkevm-node/etherman/etherscan"
+import (
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/0xPolygonHermez/zkevm-node/etherman/etherscan"
+)
 
 // Config represents the configuration of the etherman
 type Config struct {
@@ -16,4 +19,25 @@ type Config struct {
 	MultiGasProvider bool `mapstructure:"MultiGasProvider"`
 	// Configuration for use Etherscan as used as gas provider, basically it needs the API-KEY
 	Etherscan etherscan.Config
+
+	// FailoverURLs are the URLs of additional Ethereum nodes for L1. When they are set the requests
+	// are routed between URL and these endpoints accordingly to their health
+	FailoverURLs []string `mapstructure:"FailoverURLs"`
+	// Configuration of the health scoring and the routing of the requests between the L1 endpoints
+	Failover FailoverConfig `mapstructure:"Failover"`
+}
+
+// FailoverConfig represents the configuration of the routing of the requests between several L1 endpoints
+type FailoverConfig struct {
+	// HealthCheckInterval is the interval to refresh the head of each endpoint
+	HealthCheckInterval types.Duration `mapstructure:"HealthCheckInterval"`
+	// MaxHeadLag is the max number of blocks an endpoint can be behind the highest head
+	// of all the endpoints to be considered healthy
+	MaxHeadLag uint64 `mapstructure:"MaxHeadLag"`
+	// MaxErrorRate is the max rate, between 0 and 1, of failed requests over the recent requests
+	// of an endpoint to be considered healthy
+	MaxErrorRate float64 `mapstructure:"MaxErrorRate"`
+	// HedgeDelay is the time to wait for the response of a read before sending it also to the next
+	// healthiest endpoint, the first response is used. 0 disables the hedged reads
+	HedgeDelay types.Duration `mapstructure:"HedgeDelay"`
 }
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	beaconclient "github.com/0xPolygonHermez/zkevm-node/beacon_client"
+	cfgTypes "github.com/0xPolygonHermez/zkevm-node/config/types"
 	"github.com/0xPolygonHermez/zkevm-node/encoding"
 	"github.com/0xPolygonHermez/zkevm-node/etherman/eip4844"
 	"github.com/0xPolygonHermez/zkevm-node/etherman/etherscan"
@@ -160,6 +161,8 @@ type ethereumClient interface {
 	ethereum.ContractCaller
 	ethereum.GasEstimator
 	ethereum.GasPricer
+	ethereum.GasPricer1559
+	ethereum.FeeHistoryReader
 	ethereum.LogFilterer
 	ethereum.TransactionReader
 	ethereum.TransactionSender
@@ -214,9 +217,8 @@ type Client struct {
 // NewClient creates a new etherman.
 func NewClient(cfg Config, l1Config L1Config) (*Client, error) {
 	// Connect to ethereum node
-	ethClient, err := ethclient.Dial(cfg.URL)
+	ethClient, err := newEthClient(cfg)
 	if err != nil {
-		log.Errorf("error connecting to %s: %+v", cfg.URL, err)
 		return nil, err
 	}
 	if cfg.ConsensusL1URL == "" {
@@ -317,12 +319,25 @@ func NewClient(cfg Config, l1Config L1Config) (*Client, error) {
 	}
 	if feijoaEnabled {
 		eventFeijoaManager := NewEventManager(client, NewCallDataExtratorGeth(ethClient))
-		eventFeijoaManager.AddProcessor(NewEventFeijoaSequenceBlobsProcessor(feijoaContracts))
+		eventFeijoaManager.AddProcessor(NewEventFeijoaSequenceBlobsProcessor(feijoaContracts, eip4844))
 		client.eventFeijoaManager = eventFeijoaManager
 	}
 	return client, nil
 }
 
+// newEthClient connects to the L1 node, or to all the L1 endpoints when failover URLs are configured
+func newEthClient(cfg Config) (ethereumClient, error) {
+	if len(cfg.FailoverURLs) > 0 {
+		return NewMultiClient(append([]string{cfg.URL}, cfg.FailoverURLs...), cfg.Failover)
+	}
+	ethClient, err := ethclient.Dial(cfg.URL)
+	if err != nil {
+		log.Errorf("error connecting to %s: %+v", cfg.URL, err)
+		return nil, err
+	}
+	return ethClient, nil
+}
+
 // VerifyGenBlockNumber verifies if the genesis Block Number is valid
 func (etherMan *Client) VerifyGenBlockNumber(ctx context.Context, genBlockNumber uint64) (bool, error) {
 	start := time.Now()
@@ -1308,6 +1323,11 @@ func (etherMan *Client) sequencedBatchesEvent(ctx context.Context, vLog types.Lo
 			if err != nil {
 				return fmt.Errorf("error decoding the sequences (elderberry): %v", err)
 			}
+		} else if bytes.Equal(methodId, methodIDSequenceBatchesValidium) {
+			sequences, err = decodeSequencesValidium(tx.Data(), sb.NumBatch, msg.From, vLog.TxHash, msg.Nonce, sb.L1InfoRoot)
+			if err != nil {
+				return fmt.Errorf("error decoding the sequences (validium): %v", err)
+			}
 		} else {
 			return fmt.Errorf("error decoding the sequences: methodId %s unknown", common.Bytes2Hex(methodId))
 		}
@@ -1890,6 +1910,31 @@ func (etherMan *Client) EstimateGas(ctx context.Context, from common.Address, to
 	})
 }
 
+// SuggestedGasTipCap retrieves the currently suggested gas tip cap (max priority fee per gas)
+func (etherMan *Client) SuggestedGasTipCap(ctx context.Context) (*big.Int, error) {
+	return etherMan.EthClient.SuggestGasTipCap(ctx)
+}
+
+// FeeHistory retrieves the fee market history of the last blockCount blocks until lastBlock,
+// if lastBlock is nil the latest block is used
+func (etherMan *Client) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	return etherMan.EthClient.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+}
+
+// EstimateGasBlobTx returns the estimated gas for the blob tx
+func (etherMan *Client) EstimateGasBlobTx(ctx context.Context, from common.Address, to *common.Address, gasFeeCap *big.Int, gasTipCap *big.Int, blobGasPrice *big.Int, value *big.Int, data []byte, blobHashes []common.Hash) (uint64, error) {
+	return etherMan.EthClient.EstimateGas(ctx, ethereum.CallMsg{
+		From:          from,
+		To:            to,
+		GasFeeCap:     gasFeeCap,
+		GasTipCap:     gasTipCap,
+		Value:         value,
+		Data:          data,
+		BlobGasFeeCap: blobGasPrice,
+		BlobHashes:    blobHashes,
+	})
+}
+
 // DepositCount returns deposits count
 func (etherman *Client) DepositCount(ctx context.Context, blockNumber *uint64) (*big.Int, error) {
 	var opts *bind.CallOpts
@@ -1966,6 +2011,40 @@ func (etherMan *Client) LoadAuthFromKeyStore(path, password string) (*bind.Trans
 	return &auth, nil
 }
 
+// LoadAuthFromSigner loads an authorization that signs the txs with the provided signer
+func (etherMan *Client) LoadAuthFromSigner(signer Signer) *bind.TransactOpts {
+	auth := bind.TransactOpts{
+		From: signer.Address(),
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != signer.Address() {
+				return nil, bind.ErrNotAuthorized
+			}
+			return signer.SignTx(context.Background(), tx)
+		},
+	}
+
+	log.Infof("loaded authorization for address: %v", auth.From.String())
+	etherMan.auth[auth.From] = auth
+	return &auth
+}
+
+// LoadAuth loads an authorization from a key store file or, when the remote signer URL
+// is configured, an authorization that signs the txs with the remote signer
+func (etherMan *Client) LoadAuth(cfg cfgTypes.KeystoreFileConfig) (*bind.TransactOpts, error) {
+	if cfg.RemoteSignerURL == "" {
+		return etherMan.LoadAuthFromKeyStore(cfg.Path, cfg.Password)
+	}
+
+	if !common.IsHexAddress(cfg.Address) {
+		return nil, fmt.Errorf("invalid remote signer address %q", cfg.Address)
+	}
+	signer, err := NewRemoteSigner(cfg.RemoteSignerURL, common.HexToAddress(cfg.Address), etherMan.l1Cfg.L1ChainID)
+	if err != nil {
+		return nil, err
+	}
+	return etherMan.LoadAuthFromSigner(signer), nil
+}
+
 // newKeyFromKeystore creates an instance of a keystore key from a keystore file
 func newKeyFromKeystore(path, password string) (*keystore.Key, error) {
 	if path == "" && password == "" {
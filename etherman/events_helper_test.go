@@ -52,7 +52,7 @@ func TestEventManagerEventToProcessHappyPathIntegratedWithSequenceBlobs(t *testi
 	data.sut = etherman.NewEventManager(data.mockBlockRetiever, etherman.NewCallDataExtratorGeth(mockChainReader))
 	contracts, err := etherman.NewFeijoaContracts(nil, etherman.L1Config{})
 	require.NoError(t, err)
-	processor := etherman.NewEventFeijoaSequenceBlobsProcessor(contracts)
+	processor := etherman.NewEventFeijoaSequenceBlobsProcessor(contracts, nil)
 	data.sut.AddProcessor(processor)
 	block := etherman.Block{
 		BlockHash:   common.HexToHash("0x1"),
@@ -6,9 +6,11 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"strings"
 
 	"github.com/0xPolygonHermez/zkevm-node/etherman/smartcontracts/feijoapolygonzkevm"
+	"github.com/0xPolygonHermez/zkevm-node/hex"
 	"github.com/0xPolygonHermez/zkevm-node/log"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -29,15 +31,23 @@ var (
 	eventSequenceBlobsSignatureHash = crypto.Keccak256Hash([]byte("SequenceBlobs(uint64)"))
 )
 
+// BlobSidecarFetcher is the interface required to retrieve the data of a blob
+// stored in the consensus layer (EIP-4844)
+type BlobSidecarFetcher interface {
+	GetBlobSidecar(ctx context.Context, blockTime uint64, kzgCommitment string) ([]byte, error)
+}
+
 // EventFeijoaSequenceBlobsProcessor is the processor for event SequenceBlobs(uint64)
 type EventFeijoaSequenceBlobsProcessor struct {
-	contracts *FeijoaContracts
+	contracts   *FeijoaContracts
+	blobFetcher BlobSidecarFetcher
 }
 
 // NewEventFeijoaSequenceBlobsProcessor creates a new EventFeijoaSequenceBlobsProcessor
-func NewEventFeijoaSequenceBlobsProcessor(contracts *FeijoaContracts) *EventFeijoaSequenceBlobsProcessor {
+func NewEventFeijoaSequenceBlobsProcessor(contracts *FeijoaContracts, blobFetcher BlobSidecarFetcher) *EventFeijoaSequenceBlobsProcessor {
 	return &EventFeijoaSequenceBlobsProcessor{
-		contracts: contracts,
+		contracts:   contracts,
+		blobFetcher: blobFetcher,
 	}
 }
 
@@ -65,20 +75,46 @@ func (e *EventFeijoaSequenceBlobsProcessor) AddEventDataToBlock(ctx context.Cont
 	inputData.EventData = &SequenceBlobsEventData{
 		LastBlobSequenced: eventData.LastBlobSequenced,
 	}
+	inputData.TxHash = vLog.TxHash
+	inputData.SequencerAddr = callData.From()
 
 	if inputData.thereIsAnyBlobType() {
-		// TODO:Retrieve blobs
-		return nil, fmt.Errorf("data-availability in blobs: not supported yet")
+		err = e.retrieveBlobsData(ctx, inputData, uint64(block.ReceivedAt.Unix()))
+		if err != nil {
+			return nil, err
+		}
 	}
 	// Add the blobs to the block list
 	block.SequenceBlobs = append(block.SequenceBlobs, *inputData)
 	order := Order{
-		Name: SequenceBatchesOrder,
+		Name: SequenceBlobsOrder,
 		Pos:  len(block.SequenceBlobs) - 1,
 	}
 
 	return &order, nil
-	// Extract Calldata
+}
+
+// retrieveBlobsData fills the Data of the blobs stored in the consensus layer
+func (e *EventFeijoaSequenceBlobsProcessor) retrieveBlobsData(ctx context.Context, seqBlobs *SequenceBlobs, blockTime uint64) error {
+	if e.blobFetcher == nil {
+		return fmt.Errorf("data-availability in blobs: no blob fetcher configured")
+	}
+	for idx := range seqBlobs.Blobs {
+		blob := &seqBlobs.Blobs[idx]
+		if blob.Type != TypeBlobTransaction {
+			continue
+		}
+		if blob.BlobBlobTypeParams == nil {
+			return fmt.Errorf("blob %d: missing blobTypeParams for blobType 'BlobTransaction'", idx)
+		}
+		kzgCommitment := hex.EncodeToHex(blob.BlobBlobTypeParams.Commitment[:])
+		data, err := e.blobFetcher.GetBlobSidecar(ctx, blockTime, kzgCommitment)
+		if err != nil {
+			return fmt.Errorf("blob %d: error retrieving blob sidecar (commitment: %s). Err: %w", idx, kzgCommitment, err)
+		}
+		blob.Data = data
+	}
+	return nil
 }
 
 func (e *EventFeijoaSequenceBlobsProcessor) parseCallData(callData *CallData) (*SequenceBlobs, error) {
@@ -120,7 +156,10 @@ func (e *EventFeijoaSequenceBlobsProcessor) parseCallData(callData *CallData) (*
 				return nil, err
 			}
 		case TypeBlobTransaction:
-			return nil, fmt.Errorf("blobType 'BlobTransaction' not supported yet")
+			blobTypeParams, blobBlobTypeParams, err = parseBlobBlobTypeParams(blobsRaw[i].BlobTypeParams)
+			if err != nil {
+				return nil, err
+			}
 		default:
 			return nil, fmt.Errorf("blobType not supported")
 		}
@@ -160,3 +199,35 @@ func parseBlobCallDataTypeParams(data []byte) (*BlobCommonParams, []byte, error)
 	transactionData := buf.Bytes()
 	return result, transactionData, nil
 }
+
+// returns the common params and the specific ones of a blob stored on a blob transaction
+// the data of the blob is not included in the calldata, must be retrieved from the consensus layer
+func parseBlobBlobTypeParams(data []byte) (*BlobCommonParams, *BlobBlobTypeParams, error) {
+	// https://github.com/0xPolygonHermez/zkevm-contracts/blob/feature/feijoa/contracts/v2/lib/PolygonRollupBaseFeijoa.sol
+	// case: if (currentBlob.blobType == BLOBTX_BLOB_TYPE)
+	//
+	//		maxSequenceTimestamp uint64
+	//		zkGasLimit           uint64
+	//		l1InfoLeafIndex      uint32
+	//		blobIndex            uint256
+	//		z                    bytes32
+	//		y                    bytes32
+	//		commitmentAndProof   bytes (48 bytes commitment + 48 bytes proof)
+	var raw blobTypeParams
+	buf := bytes.NewBuffer(data)
+	err := binary.Read(buf, binary.LittleEndian, &raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	if buf.Len() != 0 {
+		return nil, nil, fmt.Errorf("unexpected %d extra bytes on blobTypeParams for blobType 'BlobTransaction'", buf.Len())
+	}
+	commonParams := raw.BlobCommonParams
+	return &commonParams, &BlobBlobTypeParams{
+		BlobIndex:  new(big.Int).SetBytes(raw.BlobIndex[:]),
+		Z:          raw.Z[:],
+		Y:          raw.Y[:],
+		Commitment: raw.Commitment,
+		Proof:      raw.Proof,
+	}, nil
+}
@@ -57,6 +57,8 @@ type SequenceBlobs struct {
 	L2Coinbase        common.Address // from Calldata
 	FinalAccInputHash common.Hash
 	EventData         *SequenceBlobsEventData
+	TxHash            common.Hash    // L1 tx that sequenced the blobs
+	SequencerAddr     common.Address // sender of the L1 tx
 }
 
 // SequenceBlobsEventData is the data in the event SequenceBlobs
@@ -0,0 +1,193 @@
+package etherman
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygonHermez/zkevm-node/etherman/smartcontracts/feijoapolygonzkevm"
+	ethmanTypes "github.com/0xPolygonHermez/zkevm-node/etherman/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	// BlobDataHeaderSize is the size of the header of the blob data: compression type (1 byte) and body length (4 bytes)
+	BlobDataHeaderSize = 5
+	// BlobBatchHeaderSize is the size of the header of each batch inside the blob data: batch length (4 bytes)
+	BlobBatchHeaderSize = 4
+	// MaxBlobDataSize is the max size of the blob data that fits in a blob, the first byte of each field
+	// element is left to zero to keep the field element lower than the BLS modulus
+	MaxBlobDataSize = params.BlobTxFieldElementsPerBlob * (params.BlobTxBytesPerFieldElement - 1)
+
+	blobCompressionTypeNone = 0
+	blsModulusMask          = 0x3f
+)
+
+var (
+	// ErrBlobDataTooBig is returned when the data of the batches doesn't fit in a blob
+	ErrBlobDataTooBig = fmt.Errorf("blob data exceeds the max size of %d bytes", MaxBlobDataSize)
+	// ErrFeijoaNotEnabled is returned when a feijoa operation is requested without the feijoa contracts
+	ErrFeijoaNotEnabled = errors.New("feijoa contracts are not enabled")
+)
+
+// BlobDataSize returns the size of the blob data that contains batches with the provided sizes
+func BlobDataSize(batchL2DataSizes ...int) int {
+	size := BlobDataHeaderSize
+	for _, batchSize := range batchL2DataSizes {
+		size += BlobBatchHeaderSize + batchSize
+	}
+	return size
+}
+
+// EncodeBlobData encodes the batches into the blob data format processed by the executor:
+// compression type (1 byte), body length (4 bytes) and the body, a sequence of batch length (4 bytes) and batchL2Data
+func EncodeBlobData(batchesL2Data [][]byte) []byte {
+	var body bytes.Buffer
+	for _, batchL2Data := range batchesL2Data {
+		body.Write(binary.BigEndian.AppendUint32(nil, uint32(len(batchL2Data))))
+		body.Write(batchL2Data)
+	}
+
+	data := make([]byte, 0, BlobDataHeaderSize+body.Len())
+	data = append(data, blobCompressionTypeNone)
+	data = binary.BigEndian.AppendUint32(data, uint32(body.Len()))
+	return append(data, body.Bytes()...)
+}
+
+// NewBlob stores the blob data into the field elements of a blob
+func NewBlob(data []byte) (kzg4844.Blob, error) {
+	var blob kzg4844.Blob
+	if len(data) > MaxBlobDataSize {
+		return blob, ErrBlobDataTooBig
+	}
+	for i := 0; len(data) > 0; i++ {
+		n := copy(blob[i*params.BlobTxBytesPerFieldElement+1:(i+1)*params.BlobTxBytesPerFieldElement], data)
+		data = data[n:]
+	}
+	return blob, nil
+}
+
+// BuildSequenceBlobsTxData builds the data of the sequenceBlobs call of the feijoa PoE smart contract.
+// When blobType is TypeBlobTransaction the data of each blob is stored in the returned sidecar, that must
+// be sent in the same blob tx, otherwise the data is stored in the calldata and the sidecar is nil
+func (etherMan *Client) BuildSequenceBlobsTxData(blobs []ethmanTypes.BlobData, blobType BlobType, l2Coinbase common.Address) (to *common.Address, data []byte, sidecar *types.BlobTxSidecar, err error) {
+	if etherMan.FeijoaContracts == nil {
+		return nil, nil, nil, ErrFeijoaNotEnabled
+	}
+
+	blobsRaw, sidecar, err := EncodeSequenceBlobs(blobs, blobType)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	a, err := feijoapolygonzkevm.FeijoapolygonzkevmMetaData.GetAbi()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// The contract skips the check of the final accInputHash when it's zero
+	data, err = a.Pack("sequenceBlobs", blobsRaw, l2Coinbase, common.Hash{})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return &etherMan.FeijoaContracts.FeijoaZKEVMAddress, data, sidecar, nil
+}
+
+// EncodeSequenceBlobs encodes the blobs into the blobs param of the sequenceBlobs call, with the same format
+// parsed by the EventFeijoaSequenceBlobsProcessor
+func EncodeSequenceBlobs(blobs []ethmanTypes.BlobData, blobType BlobType) ([]feijoapolygonzkevm.PolygonRollupBaseFeijoaBlobData, *types.BlobTxSidecar, error) {
+	var sidecar *types.BlobTxSidecar
+	if blobType == TypeBlobTransaction {
+		sidecar = &types.BlobTxSidecar{}
+	} else if blobType != TypeCallData {
+		return nil, nil, fmt.Errorf("blobType %d not supported", blobType)
+	}
+
+	blobsRaw := make([]feijoapolygonzkevm.PolygonRollupBaseFeijoaBlobData, 0, len(blobs))
+	for idx, blob := range blobs {
+		commonParams := BlobCommonParams{
+			MaxSequenceTimestamp: blob.MaxSequenceTimestamp,
+			ZkGasLimit:           blob.ZkGasLimit,
+			L1InfoLeafIndex:      blob.L1InfoLeafIndex,
+		}
+		blobData := EncodeBlobData(blob.BatchesL2Data)
+
+		var typeParams bytes.Buffer
+		if blobType == TypeCallData {
+			if err := binary.Write(&typeParams, binary.LittleEndian, commonParams); err != nil {
+				return nil, nil, err
+			}
+			typeParams.Write(blobData)
+		} else {
+			raw, err := newBlobTypeParams(commonParams, idx, blobData, sidecar)
+			if err != nil {
+				return nil, nil, fmt.Errorf("blob %d: %w", idx, err)
+			}
+			if err := binary.Write(&typeParams, binary.LittleEndian, raw); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		blobsRaw = append(blobsRaw, feijoapolygonzkevm.PolygonRollupBaseFeijoaBlobData{
+			BlobType:       uint8(blobType),
+			BlobTypeParams: typeParams.Bytes(),
+		})
+	}
+
+	return blobsRaw, sidecar, nil
+}
+
+// blobTypeParams is the layout of the blobTypeParams of a blob stored on a blob transaction
+type blobTypeParams struct {
+	BlobCommonParams
+	BlobIndex  [32]byte
+	Z          [32]byte
+	Y          [32]byte
+	Commitment kzg4844.Commitment
+	Proof      kzg4844.Proof
+}
+
+// newBlobTypeParams stores the blob data in a new blob of the sidecar and returns the params required
+// by the smart contract to verify the KZG point evaluation of the blob
+func newBlobTypeParams(commonParams BlobCommonParams, blobIndex int, blobData []byte, sidecar *types.BlobTxSidecar) (*blobTypeParams, error) {
+	blob, err := NewBlob(blobData)
+	if err != nil {
+		return nil, err
+	}
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	if err != nil {
+		return nil, fmt.Errorf("error computing the KZG commitment: %w", err)
+	}
+	blobProof, err := kzg4844.ComputeBlobProof(blob, commitment)
+	if err != nil {
+		return nil, fmt.Errorf("error computing the KZG blob proof: %w", err)
+	}
+
+	// The evaluation point is derived from the commitment and kept lower than the BLS modulus
+	z := kzg4844.Point(crypto.Keccak256Hash(commitment[:]))
+	z[0] &= blsModulusMask
+	proof, y, err := kzg4844.ComputeProof(blob, z)
+	if err != nil {
+		return nil, fmt.Errorf("error computing the KZG proof: %w", err)
+	}
+
+	sidecar.Blobs = append(sidecar.Blobs, blob)
+	sidecar.Commitments = append(sidecar.Commitments, commitment)
+	sidecar.Proofs = append(sidecar.Proofs, blobProof)
+
+	raw := &blobTypeParams{
+		BlobCommonParams: commonParams,
+		Z:                z,
+		Y:                y,
+		Commitment:       commitment,
+		Proof:            proof,
+	}
+	new(big.Int).SetInt64(int64(blobIndex)).FillBytes(raw.BlobIndex[:])
+	return raw, nil
+}
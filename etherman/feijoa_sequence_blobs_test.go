@@ -0,0 +1,126 @@
+package etherman
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	ethmanTypes "github.com/0xPolygonHermez/zkevm-node/etherman/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+var testBlobs = []ethmanTypes.BlobData{
+	{
+		BatchesL2Data:        [][]byte{{0x01, 0x02, 0x03}, {}, bytes.Repeat([]byte{0xff}, 100)},
+		MaxSequenceTimestamp: 1700000000,
+		ZkGasLimit:           30000000,
+		L1InfoLeafIndex:      12,
+	},
+	{
+		BatchesL2Data:        [][]byte{{0x04}},
+		MaxSequenceTimestamp: 1700000010,
+		ZkGasLimit:           10000000,
+		L1InfoLeafIndex:      13,
+	},
+}
+
+// decodeBlobData decodes the batches of the blob data
+func decodeBlobData(t *testing.T, data []byte) [][]byte {
+	require.Equal(t, byte(blobCompressionTypeNone), data[0])
+	bodyLen := binary.BigEndian.Uint32(data[1:BlobDataHeaderSize])
+	body := data[BlobDataHeaderSize : BlobDataHeaderSize+bodyLen]
+	batches := [][]byte{}
+	for len(body) > 0 {
+		batchLen := binary.BigEndian.Uint32(body[:BlobBatchHeaderSize])
+		batches = append(batches, body[BlobBatchHeaderSize:BlobBatchHeaderSize+batchLen])
+		body = body[BlobBatchHeaderSize+batchLen:]
+	}
+	return batches
+}
+
+func TestEncodeBlobData(t *testing.T) {
+	batches := testBlobs[0].BatchesL2Data
+	data := EncodeBlobData(batches)
+	require.Equal(t, BlobDataSize(len(batches[0]), len(batches[1]), len(batches[2])), len(data))
+	require.Equal(t, batches, decodeBlobData(t, data))
+}
+
+func TestNewBlob(t *testing.T) {
+	data := bytes.Repeat([]byte{0xff}, MaxBlobDataSize)
+	blob, err := NewBlob(data)
+	require.NoError(t, err)
+	for i := 0; i < params.BlobTxFieldElementsPerBlob; i++ {
+		fieldElement := blob[i*params.BlobTxBytesPerFieldElement : (i+1)*params.BlobTxBytesPerFieldElement]
+		require.Equal(t, byte(0), fieldElement[0])
+		require.Equal(t, data[i*31:(i+1)*31], fieldElement[1:])
+	}
+
+	_, err = NewBlob(append(data, 0xff))
+	require.ErrorIs(t, err, ErrBlobDataTooBig)
+}
+
+func TestEncodeSequenceBlobsCallData(t *testing.T) {
+	blobsRaw, sidecar, err := EncodeSequenceBlobs(testBlobs, TypeCallData)
+	require.NoError(t, err)
+	require.Nil(t, sidecar)
+	require.Len(t, blobsRaw, len(testBlobs))
+
+	for i, blobRaw := range blobsRaw {
+		require.Equal(t, uint8(TypeCallData), blobRaw.BlobType)
+		commonParams, data, err := parseBlobCallDataTypeParams(blobRaw.BlobTypeParams)
+		require.NoError(t, err)
+		require.Equal(t, testBlobs[i].MaxSequenceTimestamp, commonParams.MaxSequenceTimestamp)
+		require.Equal(t, testBlobs[i].ZkGasLimit, commonParams.ZkGasLimit)
+		require.Equal(t, testBlobs[i].L1InfoLeafIndex, commonParams.L1InfoLeafIndex)
+		require.Equal(t, testBlobs[i].BatchesL2Data, decodeBlobData(t, data))
+	}
+}
+
+func TestEncodeSequenceBlobsBlobTransaction(t *testing.T) {
+	blobsRaw, sidecar, err := EncodeSequenceBlobs(testBlobs, TypeBlobTransaction)
+	require.NoError(t, err)
+	require.NotNil(t, sidecar)
+	require.Len(t, sidecar.Blobs, len(testBlobs))
+
+	for i, blobRaw := range blobsRaw {
+		require.Equal(t, uint8(TypeBlobTransaction), blobRaw.BlobType)
+		commonParams, blobParams, err := parseBlobBlobTypeParams(blobRaw.BlobTypeParams)
+		require.NoError(t, err)
+		require.Equal(t, testBlobs[i].MaxSequenceTimestamp, commonParams.MaxSequenceTimestamp)
+		require.Equal(t, testBlobs[i].L1InfoLeafIndex, commonParams.L1InfoLeafIndex)
+		require.Equal(t, int64(i), blobParams.BlobIndex.Int64())
+		require.Equal(t, sidecar.Commitments[i], blobParams.Commitment)
+		require.NoError(t, kzg4844.VerifyBlobProof(sidecar.Blobs[i], sidecar.Commitments[i], sidecar.Proofs[i]))
+		require.NoError(t, kzg4844.VerifyProof(blobParams.Commitment, kzg4844.Point(blobParams.Z), kzg4844.Claim(blobParams.Y), blobParams.Proof))
+
+		expectedBlob, err := NewBlob(EncodeBlobData(testBlobs[i].BatchesL2Data))
+		require.NoError(t, err)
+		require.Equal(t, expectedBlob, sidecar.Blobs[i])
+	}
+}
+
+func TestBuildSequenceBlobsTxData(t *testing.T) {
+	etherMan := &Client{FeijoaContracts: &FeijoaContracts{FeijoaZKEVMAddress: common.HexToAddress("0x1")}}
+	l2Coinbase := common.HexToAddress("0x2")
+
+	to, data, sidecar, err := etherMan.BuildSequenceBlobsTxData(testBlobs, TypeCallData, l2Coinbase)
+	require.NoError(t, err)
+	require.Nil(t, sidecar)
+	require.Equal(t, common.HexToAddress("0x1"), *to)
+
+	// the tx data must be parsed by the SequenceBlobs event processor
+	seqBlobs, err := (&EventFeijoaSequenceBlobsProcessor{}).parseCallData(NewCallData(data, 0, common.Address{}))
+	require.NoError(t, err)
+	require.Equal(t, l2Coinbase, seqBlobs.L2Coinbase)
+	require.Len(t, seqBlobs.Blobs, len(testBlobs))
+	for i, blob := range seqBlobs.Blobs {
+		require.Equal(t, TypeCallData, blob.Type)
+		require.Equal(t, testBlobs[i].BatchesL2Data, decodeBlobData(t, blob.Data))
+	}
+
+	_, _, _, err = (&Client{}).BuildSequenceBlobsTxData(testBlobs, TypeCallData, l2Coinbase)
+	require.ErrorIs(t, err, ErrFeijoaNotEnabled)
+}
@@ -31,6 +31,32 @@ const (
 
 	// EventCounterName is the name of the label to count the processed events.
 	EventCounterName = Prefix + "processed_events_counter"
+
+	// EndpointRequestsName is the name of the label to count the requests sent to each L1 endpoint.
+	EndpointRequestsName = Prefix + "endpoint_requests"
+
+	// EndpointErrorsName is the name of the label to count the failed requests of each L1 endpoint.
+	EndpointErrorsName = Prefix + "endpoint_errors"
+
+	// EndpointLatencyName is the name of the label of the latency of the requests of each L1 endpoint.
+	EndpointLatencyName = Prefix + "endpoint_latency"
+
+	// EndpointFailoversName is the name of the label to count the failovers away from each L1 endpoint.
+	EndpointFailoversName = Prefix + "endpoint_failovers"
+
+	// EndpointHedgedReadsName is the name of the label to count the hedged reads sent to each L1 endpoint.
+	EndpointHedgedReadsName = Prefix + "endpoint_hedged_reads"
+
+	// EndpointHeadLagName is the name of the label of the number of blocks each L1 endpoint is behind the highest head.
+	EndpointHeadLagName = Prefix + "endpoint_head_lag"
+
+	// EndpointErrorRateName is the name of the label of the recent error rate of each L1 endpoint.
+	EndpointErrorRateName = Prefix + "endpoint_error_rate"
+
+	// EndpointHealthyName is the name of the label of the health of each L1 endpoint.
+	EndpointHealthyName = Prefix + "endpoint_healthy"
+
+	endpointLabelName = "endpoint"
 )
 
 // Register the metrics for the etherman package.
@@ -74,8 +100,76 @@ func Register() {
 		},
 	}
 
+	counterVecs := []metrics.CounterVecOpts{
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: EndpointRequestsName,
+				Help: "[ETHERMAN] count requests sent to each L1 endpoint",
+			},
+			Labels: []string{endpointLabelName},
+		},
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: EndpointErrorsName,
+				Help: "[ETHERMAN] count failed requests of each L1 endpoint",
+			},
+			Labels: []string{endpointLabelName},
+		},
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: EndpointFailoversName,
+				Help: "[ETHERMAN] count failovers away from each L1 endpoint",
+			},
+			Labels: []string{endpointLabelName},
+		},
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: EndpointHedgedReadsName,
+				Help: "[ETHERMAN] count hedged reads sent to each L1 endpoint",
+			},
+			Labels: []string{endpointLabelName},
+		},
+	}
+
+	histogramVecs := []metrics.HistogramVecOpts{
+		{
+			HistogramOpts: prometheus.HistogramOpts{
+				Name: EndpointLatencyName,
+				Help: "[ETHERMAN] latency of the requests of each L1 endpoint",
+			},
+			Labels: []string{endpointLabelName},
+		},
+	}
+
+	gaugeVecs := []metrics.GaugeVecOpts{
+		{
+			GaugeOpts: prometheus.GaugeOpts{
+				Name: EndpointHeadLagName,
+				Help: "[ETHERMAN] number of blocks each L1 endpoint is behind the highest head",
+			},
+			Labels: []string{endpointLabelName},
+		},
+		{
+			GaugeOpts: prometheus.GaugeOpts{
+				Name: EndpointErrorRateName,
+				Help: "[ETHERMAN] recent error rate of each L1 endpoint",
+			},
+			Labels: []string{endpointLabelName},
+		},
+		{
+			GaugeOpts: prometheus.GaugeOpts{
+				Name: EndpointHealthyName,
+				Help: "[ETHERMAN] health of each L1 endpoint, 1 if healthy",
+			},
+			Labels: []string{endpointLabelName},
+		},
+	}
+
 	metrics.RegisterCounters(counters...)
 	metrics.RegisterHistograms(histograms...)
+	metrics.RegisterCounterVecs(counterVecs...)
+	metrics.RegisterHistogramVecs(histogramVecs...)
+	metrics.RegisterGaugeVecs(gaugeVecs...)
 }
 
 // ReadAndProcessAllEventsTime observes the time read and process all event on the histogram.
@@ -118,3 +212,34 @@ func VerifyGenBlockTime(lastProcessTime time.Duration) {
 func EventCounter() {
 	metrics.CounterInc(EventCounterName)
 }
+
+// EndpointRequest increases the counters of the requests of the L1 endpoint and observes its latency on the histogram.
+func EndpointRequest(endpoint string, latency time.Duration, failed bool) {
+	metrics.CounterVecInc(EndpointRequestsName, endpoint)
+	if failed {
+		metrics.CounterVecInc(EndpointErrorsName, endpoint)
+		return
+	}
+	metrics.HistogramVecObserve(EndpointLatencyName, endpoint, float64(latency)/float64(time.Second))
+}
+
+// EndpointFailover increases the counter of the failovers away from the L1 endpoint.
+func EndpointFailover(endpoint string) {
+	metrics.CounterVecInc(EndpointFailoversName, endpoint)
+}
+
+// EndpointHedgedRead increases the counter of the hedged reads sent to the L1 endpoint.
+func EndpointHedgedRead(endpoint string) {
+	metrics.CounterVecInc(EndpointHedgedReadsName, endpoint)
+}
+
+// EndpointHealth sets the gauges of the head lag, the error rate and the health of the L1 endpoint.
+func EndpointHealth(endpoint string, headLag uint64, errorRate float64, healthy bool) {
+	metrics.GaugeVecSet(EndpointHeadLagName, endpoint, float64(headLag))
+	metrics.GaugeVecSet(EndpointErrorRateName, endpoint, errorRate)
+	healthyValue := 0.0
+	if healthy {
+		healthyValue = 1
+	}
+	metrics.GaugeVecSet(EndpointHealthyName, endpoint, healthyValue)
+}
@@ -4,6 +4,7 @@ package mockseth
 
 import (
 	context "context"
+
 	big "math/big"
 
 	common "github.com/ethereum/go-ethereum/common"
@@ -383,6 +384,67 @@ func (_c *ethereumClient_EstimateGas_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// FeeHistory provides a mock function with given fields: ctx, blockCount, lastBlock, rewardPercentiles
+func (_m *ethereumClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	ret := _m.Called(ctx, blockCount, lastBlock, rewardPercentiles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeHistory")
+	}
+
+	var r0 *ethereum.FeeHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *big.Int, []float64) (*ethereum.FeeHistory, error)); ok {
+		return rf(ctx, blockCount, lastBlock, rewardPercentiles)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *big.Int, []float64) *ethereum.FeeHistory); ok {
+		r0 = rf(ctx, blockCount, lastBlock, rewardPercentiles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ethereum.FeeHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *big.Int, []float64) error); ok {
+		r1 = rf(ctx, blockCount, lastBlock, rewardPercentiles)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ethereumClient_FeeHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FeeHistory'
+type ethereumClient_FeeHistory_Call struct {
+	*mock.Call
+}
+
+// FeeHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blockCount uint64
+//   - lastBlock *big.Int
+//   - rewardPercentiles []float64
+func (_e *ethereumClient_Expecter) FeeHistory(ctx interface{}, blockCount interface{}, lastBlock interface{}, rewardPercentiles interface{}) *ethereumClient_FeeHistory_Call {
+	return &ethereumClient_FeeHistory_Call{Call: _e.mock.On("FeeHistory", ctx, blockCount, lastBlock, rewardPercentiles)}
+}
+
+func (_c *ethereumClient_FeeHistory_Call) Run(run func(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64)) *ethereumClient_FeeHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(*big.Int), args[3].([]float64))
+	})
+	return _c
+}
+
+func (_c *ethereumClient_FeeHistory_Call) Return(_a0 *ethereum.FeeHistory, _a1 error) *ethereumClient_FeeHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ethereumClient_FeeHistory_Call) RunAndReturn(run func(context.Context, uint64, *big.Int, []float64) (*ethereum.FeeHistory, error)) *ethereumClient_FeeHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FilterLogs provides a mock function with given fields: ctx, q
 func (_m *ethereumClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
 	ret := _m.Called(ctx, q)
@@ -1194,6 +1256,64 @@ func (_c *ethereumClient_SuggestGasPrice_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// SuggestGasTipCap provides a mock function with given fields: ctx
+func (_m *ethereumClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuggestGasTipCap")
+	}
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*big.Int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *big.Int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ethereumClient_SuggestGasTipCap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuggestGasTipCap'
+type ethereumClient_SuggestGasTipCap_Call struct {
+	*mock.Call
+}
+
+// SuggestGasTipCap is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ethereumClient_Expecter) SuggestGasTipCap(ctx interface{}) *ethereumClient_SuggestGasTipCap_Call {
+	return &ethereumClient_SuggestGasTipCap_Call{Call: _e.mock.On("SuggestGasTipCap", ctx)}
+}
+
+func (_c *ethereumClient_SuggestGasTipCap_Call) Run(run func(ctx context.Context)) *ethereumClient_SuggestGasTipCap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ethereumClient_SuggestGasTipCap_Call) Return(_a0 *big.Int, _a1 error) *ethereumClient_SuggestGasTipCap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ethereumClient_SuggestGasTipCap_Call) RunAndReturn(run func(context.Context) (*big.Int, error)) *ethereumClient_SuggestGasTipCap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TransactionByHash provides a mock function with given fields: ctx, txHash
 func (_m *ethereumClient) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
 	ret := _m.Called(ctx, txHash)
@@ -1436,9 +1556,9 @@ func (_c *ethereumClient_TransactionReceipt_Call) RunAndReturn(run func(context.
 	return _c
 }
 
-// newEthereumClient creates a new instance of ethereumClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewethereumClient creates a new instance of ethereumClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func newEthereumClient(t interface {
+func NewethereumClient(t interface {
 	mock.TestingT
 	Cleanup(func())
 }) *ethereumClient {
@@ -0,0 +1,603 @@
+package etherman
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/etherman/metrics"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// weights of the last request in the moving averages of the error rate and the latency of the endpoints
+	errorRateWeight = 0.1
+	latencyWeight   = 0.2
+	// headLagScore is the score, in seconds of latency, of each block an endpoint is behind the highest head
+	headLagScore = 1.0
+	// limitExceededErrorCode is the JSON-RPC error code returned by the L1 providers when the rate limit is exceeded
+	limitExceededErrorCode = -32005
+)
+
+// ErrNoEndpoints is returned when a MultiClient is created without endpoints
+var ErrNoEndpoints = errors.New("no L1 endpoints configured")
+
+// endpoint is an L1 endpoint of the MultiClient with the stats used to score its health
+type endpoint struct {
+	name   string
+	client ethereumClient
+
+	mutex     sync.RWMutex
+	head      uint64
+	errorRate float64
+	latency   time.Duration
+}
+
+// recordRequest updates the error rate and the latency of the endpoint with the result of a request
+func (e *endpoint) recordRequest(latency time.Duration, failed bool) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	result := 0.0
+	if failed {
+		result = 1
+	}
+	e.errorRate = e.errorRate*(1-errorRateWeight) + result*errorRateWeight
+	if !failed {
+		e.latency = time.Duration(float64(e.latency)*(1-latencyWeight) + float64(latency)*latencyWeight)
+	}
+	metrics.EndpointRequest(e.name, latency, failed)
+}
+
+func (e *endpoint) setHead(head uint64) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.head = head
+}
+
+// endpointHealth is the health of an endpoint at the moment the endpoints are ranked
+type endpointHealth struct {
+	endpoint *endpoint
+	headLag  uint64
+	healthy  bool
+	score    float64
+}
+
+// MultiClient is an L1 client that routes the requests between several endpoints accordingly to their
+// health, scored by the lag of their head, their error rate and their latency. The reads fail over to the
+// next healthiest endpoint when an endpoint fails and are hedged when it doesn't answer in time, while the
+// nonce-sensitive requests of each account are routed to the same endpoint while it's healthy, so the
+// pending nonce is read from the same mempool the txs are sent to
+type MultiClient struct {
+	cfg       FailoverConfig
+	endpoints []*endpoint
+
+	mutex  sync.Mutex
+	sticky map[common.Address]*endpoint
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// NewMultiClient connects to the L1 endpoints and starts checking their health until the client is closed.
+// The endpoints that can't be connected are reported as unhealthy and left out, it only fails when none
+// of the endpoints can be connected
+func NewMultiClient(urls []string, cfg FailoverConfig) (*MultiClient, error) {
+	clients := make([]ethereumClient, 0, len(urls))
+	names := make([]string, 0, len(urls))
+	var unavailable []string
+	var dialErr error
+	for i, rawURL := range urls {
+		name := endpointName(i, rawURL)
+		client, err := ethclient.Dial(rawURL)
+		if err != nil {
+			log.Errorf("error connecting to the L1 endpoint %s, it's left out as unhealthy: %v", name, err)
+			unavailable = append(unavailable, name)
+			dialErr = err
+			continue
+		}
+		clients = append(clients, client)
+		names = append(names, name)
+	}
+	if len(clients) == 0 && dialErr != nil {
+		return nil, fmt.Errorf("failed to connect to any of the L1 endpoints: %w", dialErr)
+	}
+	c, err := newMultiClient(clients, names, cfg)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range unavailable {
+		metrics.EndpointHealth(name, 0, 1, false)
+	}
+	if cfg.HealthCheckInterval.Duration > 0 {
+		go c.checkHealthLoop()
+	}
+	return c, nil
+}
+
+func newMultiClient(clients []ethereumClient, names []string, cfg FailoverConfig) (*MultiClient, error) {
+	if len(clients) == 0 {
+		return nil, ErrNoEndpoints
+	}
+	c := &MultiClient{
+		cfg:    cfg,
+		sticky: map[common.Address]*endpoint{},
+		done:   make(chan struct{}),
+	}
+	for i, client := range clients {
+		c.endpoints = append(c.endpoints, &endpoint{name: names[i], client: client})
+	}
+	metrics.Register()
+	return c, nil
+}
+
+// endpointName returns the name of the endpoint used in the logs and metrics, only the host
+// of the URL is kept because the path and query usually contain the API key of the provider
+func endpointName(index int, rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return fmt.Sprintf("endpoint-%d", index)
+	}
+	return u.Host
+}
+
+// Close stops checking the health of the endpoints and closes their connections
+func (c *MultiClient) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		for _, e := range c.endpoints {
+			if closer, ok := e.client.(interface{ Close() }); ok {
+				closer.Close()
+			}
+		}
+	})
+}
+
+func (c *MultiClient) checkHealthLoop() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-c.done
+		cancel()
+	}()
+
+	ticker := time.NewTicker(c.cfg.HealthCheckInterval.Duration)
+	defer ticker.Stop()
+	for {
+		c.checkHealth(ctx)
+		select {
+		case <-ticker.C:
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// checkHealth refreshes the head of all the endpoints and updates their health metrics
+func (c *MultiClient) checkHealth(ctx context.Context) {
+	if c.cfg.HealthCheckInterval.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.HealthCheckInterval.Duration)
+		defer cancel()
+	}
+
+	var wg sync.WaitGroup
+	for _, e := range c.endpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+			start := time.Now()
+			header, err := e.client.HeaderByNumber(ctx, nil)
+			e.recordRequest(time.Since(start), err != nil)
+			if err != nil {
+				log.Warnf("failed to get the head of the L1 endpoint %s: %v", e.name, err)
+				return
+			}
+			e.setHead(header.Number.Uint64())
+		}(e)
+	}
+	wg.Wait()
+
+	for _, h := range c.rankEndpoints() {
+		h.endpoint.mutex.RLock()
+		errorRate := h.endpoint.errorRate
+		h.endpoint.mutex.RUnlock()
+		metrics.EndpointHealth(h.endpoint.name, h.headLag, errorRate, h.healthy)
+	}
+}
+
+// rankEndpoints returns the health of the endpoints sorted from the healthiest to the least healthy one.
+// The healthy endpoints go first, and the endpoints with the same health are sorted by their score,
+// the latency weighted by the error rate plus the head lag
+func (c *MultiClient) rankEndpoints() []endpointHealth {
+	ranking := make([]endpointHealth, 0, len(c.endpoints))
+	var maxHead uint64
+	for _, e := range c.endpoints {
+		e.mutex.RLock()
+		if e.head > maxHead {
+			maxHead = e.head
+		}
+		e.mutex.RUnlock()
+	}
+	for _, e := range c.endpoints {
+		e.mutex.RLock()
+		headLag := maxHead - e.head
+		ranking = append(ranking, endpointHealth{
+			endpoint: e,
+			headLag:  headLag,
+			healthy:  headLag <= c.cfg.MaxHeadLag && e.errorRate <= c.cfg.MaxErrorRate,
+			score:    e.latency.Seconds()*(1+e.errorRate) + float64(headLag)*headLagScore,
+		})
+		e.mutex.RUnlock()
+	}
+	sort.SliceStable(ranking, func(i, j int) bool {
+		if ranking[i].healthy != ranking[j].healthy {
+			return ranking[i].healthy
+		}
+		return ranking[i].score < ranking[j].score
+	})
+	return ranking
+}
+
+// rankedEndpoints returns the endpoints sorted from the healthiest to the least healthy one
+func (c *MultiClient) rankedEndpoints() []*endpoint {
+	ranking := c.rankEndpoints()
+	endpoints := make([]*endpoint, 0, len(ranking))
+	for _, h := range ranking {
+		endpoints = append(endpoints, h.endpoint)
+	}
+	return endpoints
+}
+
+// stickyEndpoints returns the endpoints sorted to route a nonce-sensitive request of the account,
+// the endpoint used by the last requests of the account goes first while it's healthy
+func (c *MultiClient) stickyEndpoints(account common.Address) []*endpoint {
+	ranking := c.rankEndpoints()
+	endpoints := make([]*endpoint, 0, len(ranking))
+	for _, h := range ranking {
+		endpoints = append(endpoints, h.endpoint)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if sticky, found := c.sticky[account]; found {
+		for i, h := range ranking {
+			if h.endpoint == sticky && h.healthy {
+				return append([]*endpoint{sticky}, append(endpoints[:i:i], endpoints[i+1:]...)...)
+			}
+		}
+	}
+	c.sticky[account] = endpoints[0]
+	return endpoints
+}
+
+func (c *MultiClient) setStickyEndpoint(account common.Address, e *endpoint) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.sticky[account] = e
+}
+
+// isEndpointError returns true when the error is caused by the endpoint and the request can be retried in
+// another endpoint. The errors returned by the node for the request itself, like a reverted call or a not
+// found tx, are returned to the caller
+func isEndpointError(err error) bool {
+	if errors.Is(err, ethereum.NotFound) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr.ErrorCode() == limitExceededErrorCode
+	}
+	return true
+}
+
+// endpointResult is the result of a request sent to an endpoint
+type endpointResult[T any] struct {
+	value    T
+	err      error
+	endpoint *endpoint
+}
+
+// request sends the request to the first endpoint and fails over to the next ones when the endpoint fails.
+// When hedge is set and the endpoint doesn't answer after the hedge delay, the request is also sent to the
+// next endpoint and the first answer is returned
+func request[T any](ctx context.Context, c *MultiClient, endpoints []*endpoint, hedge bool, do func(context.Context, ethereumClient) (T, error)) (T, *endpoint, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan endpointResult[T], len(endpoints))
+	next, pending := 0, 0
+	send := func() {
+		e := endpoints[next]
+		next++
+		pending++
+		go func() {
+			start := time.Now()
+			value, err := do(ctx, e.client)
+			// the requests cancelled because another endpoint answered first don't count for the health
+			if ctx.Err() == nil {
+				e.recordRequest(time.Since(start), err != nil && isEndpointError(err))
+			}
+			results <- endpointResult[T]{value: value, err: err, endpoint: e}
+		}()
+	}
+
+	var hedgeTimer *time.Timer
+	var hedgeC <-chan time.Time
+	resetHedge := func() {
+		if !hedge || c.cfg.HedgeDelay.Duration <= 0 || next >= len(endpoints) {
+			hedgeC = nil
+			return
+		}
+		if hedgeTimer == nil {
+			hedgeTimer = time.NewTimer(c.cfg.HedgeDelay.Duration)
+		} else {
+			if !hedgeTimer.Stop() {
+				select {
+				case <-hedgeTimer.C:
+				default:
+				}
+			}
+			hedgeTimer.Reset(c.cfg.HedgeDelay.Duration)
+		}
+		hedgeC = hedgeTimer.C
+	}
+	defer func() {
+		if hedgeTimer != nil {
+			hedgeTimer.Stop()
+		}
+	}()
+
+	send()
+	resetHedge()
+	var lastResult endpointResult[T]
+	for pending > 0 {
+		select {
+		case result := <-results:
+			pending--
+			if result.err == nil || ctx.Err() != nil || !isEndpointError(result.err) {
+				return result.value, result.endpoint, result.err
+			}
+			lastResult = result
+			if next < len(endpoints) {
+				log.Warnf("L1 endpoint %s failed, failing over to %s: %v", result.endpoint.name, endpoints[next].name, result.err)
+				metrics.EndpointFailover(result.endpoint.name)
+				send()
+				resetHedge()
+			}
+		case <-hedgeC:
+			metrics.EndpointHedgedRead(endpoints[next].name)
+			send()
+			resetHedge()
+		}
+	}
+	return lastResult.value, lastResult.endpoint, lastResult.err
+}
+
+// read sends a read request to the healthiest endpoint, with failover and hedging
+func read[T any](ctx context.Context, c *MultiClient, do func(context.Context, ethereumClient) (T, error)) (T, error) {
+	value, _, err := request(ctx, c, c.rankedEndpoints(), true, do)
+	return value, err
+}
+
+// nonceSensitive sends a nonce-sensitive request of the account to its sticky endpoint, failing over
+// to the healthiest endpoints when it fails, and keeps the endpoint that answered as the sticky one
+func nonceSensitive[T any](ctx context.Context, c *MultiClient, account common.Address, do func(context.Context, ethereumClient) (T, error)) (T, error) {
+	value, e, err := request(ctx, c, c.stickyEndpoints(account), false, do)
+	if e != nil && (err == nil || !isEndpointError(err)) {
+		c.setStickyEndpoint(account, e)
+	}
+	return value, err
+}
+
+// subscribe subscribes in the healthiest endpoint, failing over to the next ones when it fails
+func subscribe(ctx context.Context, c *MultiClient, do func(context.Context, ethereumClient) (ethereum.Subscription, error)) (ethereum.Subscription, error) {
+	sub, _, err := request(ctx, c, c.rankedEndpoints(), false, do)
+	return sub, err
+}
+
+// BlockByHash returns the given full block
+func (c *MultiClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (*types.Block, error) {
+		return client.BlockByHash(ctx, hash)
+	})
+}
+
+// BlockByNumber returns a block from the current canonical chain, nil returns the latest block
+func (c *MultiClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (*types.Block, error) {
+		return client.BlockByNumber(ctx, number)
+	})
+}
+
+// HeaderByHash returns the block header with the given hash
+func (c *MultiClient) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (*types.Header, error) {
+		return client.HeaderByHash(ctx, hash)
+	})
+}
+
+// HeaderByNumber returns a block header from the current canonical chain, nil returns the latest header
+func (c *MultiClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (*types.Header, error) {
+		return client.HeaderByNumber(ctx, number)
+	})
+}
+
+// TransactionCount returns the total number of transactions in the given block
+func (c *MultiClient) TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (uint, error) {
+		return client.TransactionCount(ctx, blockHash)
+	})
+}
+
+// TransactionInBlock returns a single transaction at index in the given block
+func (c *MultiClient) TransactionInBlock(ctx context.Context, blockHash common.Hash, index uint) (*types.Transaction, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (*types.Transaction, error) {
+		return client.TransactionInBlock(ctx, blockHash, index)
+	})
+}
+
+// SubscribeNewHead subscribes to notifications about the current blockchain head
+func (c *MultiClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return subscribe(ctx, c, func(ctx context.Context, client ethereumClient) (ethereum.Subscription, error) {
+		return client.SubscribeNewHead(ctx, ch)
+	})
+}
+
+// BalanceAt returns the wei balance of the given account
+func (c *MultiClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (*big.Int, error) {
+		return client.BalanceAt(ctx, account, blockNumber)
+	})
+}
+
+// StorageAt returns the value of key in the contract storage of the given account
+func (c *MultiClient) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) ([]byte, error) {
+		return client.StorageAt(ctx, account, key, blockNumber)
+	})
+}
+
+// CodeAt returns the contract code of the given account
+func (c *MultiClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) ([]byte, error) {
+		return client.CodeAt(ctx, account, blockNumber)
+	})
+}
+
+// NonceAt returns the account nonce of the given account
+func (c *MultiClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (uint64, error) {
+		return client.NonceAt(ctx, account, blockNumber)
+	})
+}
+
+// CallContract executes a message call transaction
+func (c *MultiClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) ([]byte, error) {
+		return client.CallContract(ctx, call, blockNumber)
+	})
+}
+
+// EstimateGas tries to estimate the gas needed to execute a specific transaction
+func (c *MultiClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (uint64, error) {
+		return client.EstimateGas(ctx, call)
+	})
+}
+
+// SuggestGasPrice retrieves the currently suggested gas price
+func (c *MultiClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (*big.Int, error) {
+		return client.SuggestGasPrice(ctx)
+	})
+}
+
+// SuggestGasTipCap retrieves the currently suggested gas tip cap
+func (c *MultiClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (*big.Int, error) {
+		return client.SuggestGasTipCap(ctx)
+	})
+}
+
+// FeeHistory retrieves the fee market history
+func (c *MultiClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (*ethereum.FeeHistory, error) {
+		return client.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+	})
+}
+
+// FilterLogs executes a filter query
+func (c *MultiClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) ([]types.Log, error) {
+		return client.FilterLogs(ctx, q)
+	})
+}
+
+// SubscribeFilterLogs subscribes to the results of a streaming filter query
+func (c *MultiClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return subscribe(ctx, c, func(ctx context.Context, client ethereumClient) (ethereum.Subscription, error) {
+		return client.SubscribeFilterLogs(ctx, q, ch)
+	})
+}
+
+// txByHash is the result of TransactionByHash
+type txByHash struct {
+	tx        *types.Transaction
+	isPending bool
+}
+
+// TransactionByHash returns the transaction with the given hash
+func (c *MultiClient) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	result, err := read(ctx, c, func(ctx context.Context, client ethereumClient) (txByHash, error) {
+		tx, isPending, err := client.TransactionByHash(ctx, txHash)
+		return txByHash{tx: tx, isPending: isPending}, err
+	})
+	return result.tx, result.isPending, err
+}
+
+// TransactionReceipt returns the receipt of a transaction by transaction hash
+func (c *MultiClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (*types.Receipt, error) {
+		return client.TransactionReceipt(ctx, txHash)
+	})
+}
+
+// SendTransaction injects a signed transaction into the pending pool for execution, using the
+// sticky endpoint of the sender
+func (c *MultiClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return err
+	}
+	_, err = nonceSensitive(ctx, c, sender, func(ctx context.Context, client ethereumClient) (struct{}, error) {
+		return struct{}{}, client.SendTransaction(ctx, tx)
+	})
+	return err
+}
+
+// PendingBalanceAt returns the wei balance of the given account in the pending state
+func (c *MultiClient) PendingBalanceAt(ctx context.Context, account common.Address) (*big.Int, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (*big.Int, error) {
+		return client.PendingBalanceAt(ctx, account)
+	})
+}
+
+// PendingStorageAt returns the value of key in the contract storage of the given account in the pending state
+func (c *MultiClient) PendingStorageAt(ctx context.Context, account common.Address, key common.Hash) ([]byte, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) ([]byte, error) {
+		return client.PendingStorageAt(ctx, account, key)
+	})
+}
+
+// PendingCodeAt returns the contract code of the given account in the pending state
+func (c *MultiClient) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) ([]byte, error) {
+		return client.PendingCodeAt(ctx, account)
+	})
+}
+
+// PendingNonceAt returns the account nonce of the given account in the pending state, using the
+// sticky endpoint of the account
+func (c *MultiClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return nonceSensitive(ctx, c, account, func(ctx context.Context, client ethereumClient) (uint64, error) {
+		return client.PendingNonceAt(ctx, account)
+	})
+}
+
+// PendingTransactionCount returns the total number of transactions in the pending state
+func (c *MultiClient) PendingTransactionCount(ctx context.Context) (uint, error) {
+	return read(ctx, c, func(ctx context.Context, client ethereumClient) (uint, error) {
+		return client.PendingTransactionCount(ctx)
+	})
+}
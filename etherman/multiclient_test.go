@@ -0,0 +1,166 @@
+package etherman
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEndpointClient is an L1 client that answers with the configured head after the configured delay
+type fakeEndpointClient struct {
+	ethereumClient
+	head     uint64
+	nonce    uint64
+	delay    time.Duration
+	err      error
+	requests atomic.Int32
+}
+
+func (f *fakeEndpointClient) HeaderByNumber(ctx context.Context, number *big.Int) (*ethTypes.Header, error) {
+	f.requests.Add(1)
+	select {
+	case <-time.After(f.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ethTypes.Header{Number: new(big.Int).SetUint64(f.head)}, nil
+}
+
+func (f *fakeEndpointClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	f.requests.Add(1)
+	if f.err != nil {
+		return 0, f.err
+	}
+	return f.nonce, nil
+}
+
+func newTestMultiClient(t *testing.T, cfg FailoverConfig, clients ...*fakeEndpointClient) *MultiClient {
+	ethClients := make([]ethereumClient, 0, len(clients))
+	names := make([]string, 0, len(clients))
+	for i, client := range clients {
+		ethClients = append(ethClients, client)
+		names = append(names, endpointName(i, ""))
+	}
+	c, err := newMultiClient(ethClients, names, cfg)
+	require.NoError(t, err)
+	return c
+}
+
+func TestMultiClientFailover(t *testing.T) {
+	ctx := context.Background()
+	failing := &fakeEndpointClient{err: errors.New("connection refused")}
+	healthy := &fakeEndpointClient{head: 100}
+	c := newTestMultiClient(t, FailoverConfig{MaxHeadLag: 5, MaxErrorRate: 0.5}, failing, healthy)
+
+	header, err := c.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), header.Number.Uint64())
+	assert.Equal(t, int32(1), failing.requests.Load())
+	assert.Greater(t, c.endpoints[0].errorRate, 0.0)
+
+	// the errors returned by the node for the request are not retried
+	notFound := &fakeEndpointClient{err: ethereum.NotFound}
+	other := &fakeEndpointClient{head: 100}
+	c = newTestMultiClient(t, FailoverConfig{MaxHeadLag: 5, MaxErrorRate: 0.5}, notFound, other)
+	_, err = c.HeaderByNumber(ctx, nil)
+	assert.ErrorIs(t, err, ethereum.NotFound)
+	assert.Equal(t, int32(0), other.requests.Load())
+}
+
+func TestMultiClientRanking(t *testing.T) {
+	lagging := &fakeEndpointClient{head: 100}
+	slow := &fakeEndpointClient{head: 110, delay: 20 * time.Millisecond}
+	fast := &fakeEndpointClient{head: 110}
+	c := newTestMultiClient(t, FailoverConfig{MaxHeadLag: 5, MaxErrorRate: 0.5}, lagging, slow, fast)
+
+	c.checkHealth(context.Background())
+	ranking := c.rankEndpoints()
+	assert.Equal(t, c.endpoints[2], ranking[0].endpoint)
+	assert.Equal(t, c.endpoints[1], ranking[1].endpoint)
+	assert.Equal(t, c.endpoints[0], ranking[2].endpoint)
+	assert.False(t, ranking[2].healthy)
+	assert.Equal(t, uint64(10), ranking[2].headLag)
+}
+
+func TestMultiClientHedgedRead(t *testing.T) {
+	slow := &fakeEndpointClient{head: 100, delay: time.Minute}
+	fast := &fakeEndpointClient{head: 101}
+	c := newTestMultiClient(t, FailoverConfig{MaxHeadLag: 5, MaxErrorRate: 0.5, HedgeDelay: types.NewDuration(10 * time.Millisecond)}, slow, fast)
+
+	start := time.Now()
+	header, err := c.HeaderByNumber(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(101), header.Number.Uint64())
+	assert.Less(t, time.Since(start), time.Minute)
+	// the cancelled request doesn't count as a failure of the slow endpoint
+	assert.Equal(t, 0.0, c.endpoints[0].errorRate)
+}
+
+func TestMultiClientStickyRouting(t *testing.T) {
+	ctx := context.Background()
+	account := common.HexToAddress("0x1")
+	first := &fakeEndpointClient{nonce: 1}
+	second := &fakeEndpointClient{nonce: 2}
+	c := newTestMultiClient(t, FailoverConfig{MaxHeadLag: 5, MaxErrorRate: 0.5}, first, second)
+
+	nonce, err := c.PendingNonceAt(ctx, account)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), nonce)
+
+	// the sticky endpoint is kept while it's healthy even if other endpoint is better
+	c.endpoints[0].latency = time.Second
+	nonce, err = c.PendingNonceAt(ctx, account)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), nonce)
+
+	// and it's replaced when it's unhealthy
+	c.endpoints[0].errorRate = 1
+	nonce, err = c.PendingNonceAt(ctx, account)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), nonce)
+	assert.Equal(t, c.endpoints[1], c.sticky[account])
+}
+
+func TestNewMultiClientUnavailableEndpoint(t *testing.T) {
+	// the URLs with an unknown scheme fail to dial
+	c, err := NewMultiClient([]string{"unknown://primary", "http://localhost:8545"}, FailoverConfig{})
+	require.NoError(t, err)
+	defer c.Close()
+	require.Len(t, c.endpoints, 1)
+	assert.Equal(t, "localhost:8545", c.endpoints[0].name)
+
+	_, err = NewMultiClient([]string{"unknown://primary", "unknown://failover"}, FailoverConfig{})
+	assert.Error(t, err)
+}
+
+func TestMultiClientClose(t *testing.T) {
+	endpoint := &fakeEndpointClient{head: 10}
+	c := newTestMultiClient(t, FailoverConfig{HealthCheckInterval: types.NewDuration(5 * time.Millisecond)}, endpoint)
+	stopped := make(chan struct{})
+	go func() {
+		c.checkHealthLoop()
+		close(stopped)
+	}()
+	require.Eventually(t, func() bool { return endpoint.requests.Load() > 1 }, time.Second, time.Millisecond)
+
+	c.Close()
+	c.Close()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("the health check loop didn't stop after closing the client")
+	}
+}
@@ -0,0 +1,196 @@
+package etherman
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	remoteSignerTimeout = 30 * time.Second
+
+	// legacy txs encode the recovery id in v as 27 + recoveryID or, when they are
+	// protected by EIP-155, as chainID * 2 + 35 + recoveryID
+	legacyRecoveryIDOffset = 27
+	eip155RecoveryIDOffset = 35
+)
+
+var (
+	// ErrInvalidRemoteSignature is returned when the signature returned by the remote signer
+	// doesn't belong to the account for the tx requested to be signed
+	ErrInvalidRemoteSignature = errors.New("invalid signature returned by the remote signer")
+)
+
+// Signer signs the L1 txs of an account
+type Signer interface {
+	// Address returns the address of the account
+	Address() common.Address
+	// SignTx signs the tx with the private key of the account
+	SignTx(ctx context.Context, tx *types.Transaction) (*types.Transaction, error)
+}
+
+// RemoteSigner signs the txs with a private key held by a Web3Signer or Clef remote signer
+// through its eth_signTransaction JSON-RPC method, so the private key never reaches the node
+type RemoteSigner struct {
+	client  *rpc.Client
+	address common.Address
+	signer  types.Signer
+}
+
+// NewRemoteSigner creates a new RemoteSigner for the account with the provided address
+func NewRemoteSigner(url string, address common.Address, chainID uint64) (*RemoteSigner, error) {
+	client, err := rpc.DialHTTP(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the remote signer: %w", err)
+	}
+	return &RemoteSigner{
+		client:  client,
+		address: address,
+		signer:  types.LatestSignerForChainID(new(big.Int).SetUint64(chainID)),
+	}, nil
+}
+
+// Address returns the address of the account held by the remote signer
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+// remoteSignerTxArgs are the tx fields sent to the eth_signTransaction method
+type remoteSignerTxArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to,omitempty"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big      `json:"value"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data"`
+	ChainID              *hexutil.Big      `json:"chainId"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+	MaxFeePerBlobGas     *hexutil.Big      `json:"maxFeePerBlobGas,omitempty"`
+	BlobVersionedHashes  []common.Hash     `json:"blobVersionedHashes,omitempty"`
+}
+
+// SignTx requests the remote signer to sign the tx. The signature is applied to the provided tx,
+// so the blob sidecar is kept, and it is checked to belong to the account before returning the tx
+func (s *RemoteSigner) SignTx(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
+	args, err := s.newTxArgs(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, remoteSignerTimeout)
+	defer cancel()
+	var result json.RawMessage
+	err = s.client.CallContext(ctx, &result, "eth_signTransaction", args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign tx with the remote signer: %w", err)
+	}
+
+	rawTx, err := parseSignTransactionResult(result)
+	if err != nil {
+		return nil, err
+	}
+	remoteTx := new(types.Transaction)
+	err = remoteTx.UnmarshalBinary(rawTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the tx signed by the remote signer: %w", err)
+	}
+
+	sig, err := txSignature(remoteTx)
+	if err != nil {
+		return nil, err
+	}
+	signedTx, err := tx.WithSignature(s.signer, sig)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := types.Sender(s.signer, signedTx)
+	if err != nil {
+		return nil, err
+	}
+	if sender != s.address {
+		return nil, ErrInvalidRemoteSignature
+	}
+	return signedTx, nil
+}
+
+func (s *RemoteSigner) newTxArgs(tx *types.Transaction) (*remoteSignerTxArgs, error) {
+	args := &remoteSignerTxArgs{
+		From:    s.address,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(s.signer.ChainID()),
+	}
+	accessList := tx.AccessList()
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+		args.AccessList = &accessList
+	case types.DynamicFeeTxType, types.BlobTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		args.AccessList = &accessList
+		if tx.Type() == types.BlobTxType {
+			args.MaxFeePerBlobGas = (*hexutil.Big)(tx.BlobGasFeeCap())
+			args.BlobVersionedHashes = tx.BlobHashes()
+		}
+	default:
+		return nil, fmt.Errorf("tx type %d not supported by the remote signer", tx.Type())
+	}
+	return args, nil
+}
+
+// parseSignTransactionResult returns the raw signed tx from the result of eth_signTransaction:
+// Web3Signer returns the raw tx while Clef returns an object with the raw tx and its json representation
+func parseSignTransactionResult(result json.RawMessage) ([]byte, error) {
+	var rawTx hexutil.Bytes
+	if err := json.Unmarshal(result, &rawTx); err == nil {
+		return rawTx, nil
+	}
+	var clefResult struct {
+		Raw hexutil.Bytes `json:"raw"`
+	}
+	if err := json.Unmarshal(result, &clefResult); err != nil || len(clefResult.Raw) == 0 {
+		return nil, fmt.Errorf("unexpected eth_signTransaction result from the remote signer: %s", string(result))
+	}
+	return clefResult.Raw, nil
+}
+
+// txSignature returns the signature of the tx in the [R || S || V] format, where V is the recovery id
+func txSignature(tx *types.Transaction) ([]byte, error) {
+	v, r, s := tx.RawSignatureValues()
+	recoveryID := new(big.Int).Set(v)
+	if tx.Type() == types.LegacyTxType {
+		if tx.Protected() {
+			recoveryID.Sub(recoveryID, new(big.Int).Lsh(tx.ChainId(), 1))
+			recoveryID.Sub(recoveryID, big.NewInt(eip155RecoveryIDOffset))
+		} else {
+			recoveryID.Sub(recoveryID, big.NewInt(legacyRecoveryIDOffset))
+		}
+	}
+	if !recoveryID.IsUint64() || recoveryID.Uint64() > 1 || r.BitLen() > common.HashLength*8 || s.BitLen() > common.HashLength*8 { //nolint:gomnd
+		return nil, ErrInvalidRemoteSignature
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:common.HashLength])
+	s.FillBytes(sig[common.HashLength:crypto.RecoveryIDOffset])
+	sig[crypto.RecoveryIDOffset] = byte(recoveryID.Uint64())
+	return sig, nil
+}
@@ -0,0 +1,166 @@
+package etherman
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	cfgTypes "github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const remoteSignerChainID = 1337
+
+// remoteSignerStub is a stub of a remote signer that signs the requested txs with its private key
+type remoteSignerStub struct {
+	key *ecdsa.PrivateKey
+	// clefResult returns the result with the Clef format instead of the Web3Signer one
+	clefResult bool
+}
+
+func (s *remoteSignerStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage      `json:"id"`
+		Method string               `json:"method"`
+		Params []remoteSignerTxArgs `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "eth_signTransaction" || len(req.Params) != 1 {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+
+	args := req.Params[0]
+	var txData types.TxData
+	switch {
+	case args.MaxFeePerBlobGas != nil:
+		txData = &types.BlobTx{
+			ChainID:    uint256.MustFromBig(args.ChainID.ToInt()),
+			Nonce:      uint64(args.Nonce),
+			GasTipCap:  uint256.MustFromBig(args.MaxPriorityFeePerGas.ToInt()),
+			GasFeeCap:  uint256.MustFromBig(args.MaxFeePerGas.ToInt()),
+			Gas:        uint64(args.Gas),
+			To:         *args.To,
+			Value:      uint256.MustFromBig(args.Value.ToInt()),
+			Data:       args.Data,
+			BlobFeeCap: uint256.MustFromBig(args.MaxFeePerBlobGas.ToInt()),
+			BlobHashes: args.BlobVersionedHashes,
+		}
+	case args.MaxFeePerGas != nil:
+		txData = &types.DynamicFeeTx{
+			ChainID:   args.ChainID.ToInt(),
+			Nonce:     uint64(args.Nonce),
+			GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+			GasFeeCap: args.MaxFeePerGas.ToInt(),
+			Gas:       uint64(args.Gas),
+			To:        args.To,
+			Value:     args.Value.ToInt(),
+			Data:      args.Data,
+		}
+	default:
+		txData = &types.LegacyTx{
+			Nonce:    uint64(args.Nonce),
+			GasPrice: args.GasPrice.ToInt(),
+			Gas:      uint64(args.Gas),
+			To:       args.To,
+			Value:    args.Value.ToInt(),
+			Data:     args.Data,
+		}
+	}
+	tx, err := types.SignNewTx(s.key, types.LatestSignerForChainID(args.ChainID.ToInt()), txData)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rawTx, err := tx.MarshalBinary()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var result interface{} = hexutil.Bytes(rawTx)
+	if s.clefResult {
+		result = map[string]interface{}{"raw": hexutil.Bytes(rawTx), "tx": tx}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+func newRemoteSignerTestTxs(t *testing.T) []*types.Transaction {
+	to := common.HexToAddress("0x1")
+	var blob kzg4844.Blob
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	require.NoError(t, err)
+	proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+	require.NoError(t, err)
+	sidecar := &types.BlobTxSidecar{Blobs: []kzg4844.Blob{blob}, Commitments: []kzg4844.Commitment{commitment}, Proofs: []kzg4844.Proof{proof}}
+
+	return []*types.Transaction{
+		types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(1)}),
+		types.NewTx(&types.DynamicFeeTx{ChainID: big.NewInt(remoteSignerChainID), Nonce: 2, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 100000, To: &to, Value: big.NewInt(0), Data: []byte{0x01, 0x02}}),
+		types.NewTx(&types.BlobTx{ChainID: uint256.NewInt(remoteSignerChainID), Nonce: 3, GasTipCap: uint256.NewInt(1), GasFeeCap: uint256.NewInt(10), Gas: 100000, To: to, Value: uint256.NewInt(0),
+			BlobFeeCap: uint256.NewInt(5), BlobHashes: sidecar.BlobHashes(), Sidecar: sidecar}),
+	}
+}
+
+func TestRemoteSignerSignTx(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	signer := types.LatestSignerForChainID(big.NewInt(remoteSignerChainID))
+
+	for _, clefResult := range []bool{false, true} {
+		server := httptest.NewServer(&remoteSignerStub{key: key, clefResult: clefResult})
+		defer server.Close()
+
+		etherMan := &Client{auth: map[common.Address]bind.TransactOpts{}, l1Cfg: L1Config{L1ChainID: remoteSignerChainID}}
+		auth, err := etherMan.LoadAuth(cfgTypes.KeystoreFileConfig{RemoteSignerURL: server.URL, Address: address.String()})
+		require.NoError(t, err)
+		assert.Equal(t, address, auth.From)
+
+		for _, tx := range newRemoteSignerTestTxs(t) {
+			signedTx, err := etherMan.SignTx(context.Background(), address, tx)
+			require.NoError(t, err)
+
+			expectedTx, err := types.SignTx(tx, signer, key)
+			require.NoError(t, err)
+			assert.Equal(t, expectedTx.Hash(), signedTx.Hash())
+			sender, err := types.Sender(signer, signedTx)
+			require.NoError(t, err)
+			assert.Equal(t, address, sender)
+			assert.Equal(t, tx.BlobTxSidecar(), signedTx.BlobTxSidecar())
+		}
+	}
+}
+
+func TestRemoteSignerInvalidSignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	// the remote signer signs with a private key that doesn't belong to the account
+	server := httptest.NewServer(&remoteSignerStub{key: otherKey})
+	defer server.Close()
+
+	remoteSigner, err := NewRemoteSigner(server.URL, crypto.PubkeyToAddress(key.PublicKey), remoteSignerChainID)
+	require.NoError(t, err)
+	for _, tx := range newRemoteSignerTestTxs(t) {
+		_, err = remoteSigner.SignTx(context.Background(), tx)
+		assert.ErrorIs(t, err, ErrInvalidRemoteSignature)
+	}
+
+	etherMan := &Client{auth: map[common.Address]bind.TransactOpts{}}
+	_, err = etherMan.LoadAuth(cfgTypes.KeystoreFileConfig{RemoteSignerURL: server.URL, Address: "invalid"})
+	assert.Error(t, err)
+}
@@ -41,6 +41,13 @@ type SequencedBatchElderberryData struct {
 	InitSequencedBatchNumber uint64 // Last sequenced batch number
 }
 
+// SequencedBatchValidiumData represents a validium sequenced batch data, the transactions
+// of the batch are kept off-chain by the data availability committee
+type SequencedBatchValidiumData struct {
+	TransactionsHash        common.Hash
+	DataAvailabilityMessage []byte
+}
+
 // SequencedBatch represents virtual batch
 type SequencedBatch struct {
 	BatchNumber   uint64
@@ -55,6 +62,8 @@ type SequencedBatch struct {
 	*etrogpolygonzkevm.PolygonRollupBaseEtrogBatchData
 	// Struct used in Elderberry
 	*SequencedBatchElderberryData
+	// Struct used in validium mode
+	*SequencedBatchValidiumData
 }
 
 // UpdateEtrogSequence represents the first etrog sequence
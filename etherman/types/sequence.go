@@ -23,3 +23,11 @@ type Sequence struct {
 func (s Sequence) IsEmpty() bool {
 	return reflect.DeepEqual(s, Sequence{})
 }
+
+// BlobData represents the data of a blob sent to the PoE smart contract in a sequenceBlobs call.
+type BlobData struct {
+	BatchesL2Data        [][]byte
+	MaxSequenceTimestamp uint64
+	ZkGasLimit           uint64
+	L1InfoLeafIndex      uint32
+}
@@ -0,0 +1,129 @@
+package etherman
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/0xPolygonHermez/zkevm-node/etherman/smartcontracts/etrogpolygonzkevm"
+	ethmanTypes "github.com/0xPolygonHermez/zkevm-node/etherman/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// validiumABI is the ABI of the sequenceBatchesValidium method of the validium PoE smart contract
+const validiumABI = `[{"inputs":[{"components":[{"internalType":"bytes32","name":"transactionsHash","type":"bytes32"},{"internalType":"bytes32","name":"forcedGlobalExitRoot","type":"bytes32"},{"internalType":"uint64","name":"forcedTimestamp","type":"uint64"},{"internalType":"bytes32","name":"forcedBlockHashL1","type":"bytes32"}],"internalType":"struct PolygonValidiumEtrog.ValidiumBatchData[]","name":"batches","type":"tuple[]"},{"internalType":"uint64","name":"maxSequenceTimestamp","type":"uint64"},{"internalType":"uint64","name":"initSequencedBatch","type":"uint64"},{"internalType":"address","name":"l2Coinbase","type":"address"},{"internalType":"bytes","name":"dataAvailabilityMessage","type":"bytes"}],"name":"sequenceBatchesValidium","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+const sequenceBatchesValidiumMethod = "sequenceBatchesValidium"
+
+// methodIDSequenceBatchesValidium: MethodID for sequenceBatchesValidium
+// sequenceBatchesValidium((bytes32,bytes32,uint64,bytes32)[],uint64,uint64,address,bytes)
+var methodIDSequenceBatchesValidium = crypto.Keccak256([]byte("sequenceBatchesValidium((bytes32,bytes32,uint64,bytes32)[],uint64,uint64,address,bytes)"))[:4]
+
+// ValidiumBatchData is the data of a batch sequenced in validium mode, only the hash of the
+// transactions is posted on L1, the transactions are kept by the data availability committee.
+// The hashes are byte arrays, like in the contract bindings, so the unpacked calldata can be decoded
+type ValidiumBatchData struct {
+	TransactionsHash     [32]byte `json:"transactionsHash"`
+	ForcedGlobalExitRoot [32]byte `json:"forcedGlobalExitRoot"`
+	ForcedTimestamp      uint64   `json:"forcedTimestamp"`
+	ForcedBlockHashL1    [32]byte `json:"forcedBlockHashL1"`
+}
+
+// BuildSequenceBatchesValidiumTxData builds a []bytes to be sent to the validium PoE SC method sequenceBatchesValidium.
+// The BatchL2Data of the sequences is replaced by its hash, and the dataAvailabilityMessage contains the
+// signatures of the data availability committee members over the hash of the sequence
+func (etherMan *Client) BuildSequenceBatchesValidiumTxData(sequences []ethmanTypes.Sequence, maxSequenceTimestamp uint64, lastSequencedBatchNumber uint64, l2Coinbase common.Address, dataAvailabilityMessage []byte) (to *common.Address, data []byte, err error) {
+	data, err = EncodeSequenceBatchesValidium(sequences, maxSequenceTimestamp, lastSequencedBatchNumber, l2Coinbase, dataAvailabilityMessage)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &etherMan.l1Cfg.ZkEVMAddr, data, nil
+}
+
+// EncodeSequenceBatchesValidium encodes the calldata of the sequenceBatchesValidium method
+func EncodeSequenceBatchesValidium(sequences []ethmanTypes.Sequence, maxSequenceTimestamp uint64, lastSequencedBatchNumber uint64, l2Coinbase common.Address, dataAvailabilityMessage []byte) ([]byte, error) {
+	smcAbi, err := abi.JSON(strings.NewReader(validiumABI))
+	if err != nil {
+		return nil, err
+	}
+
+	batches := make([]ValidiumBatchData, 0, len(sequences))
+	for _, seq := range sequences {
+		var ger common.Hash
+		if seq.ForcedBatchTimestamp > 0 {
+			ger = seq.GlobalExitRoot
+		}
+		batches = append(batches, ValidiumBatchData{
+			TransactionsHash:     crypto.Keccak256Hash(seq.BatchL2Data),
+			ForcedGlobalExitRoot: ger,
+			ForcedTimestamp:      uint64(seq.ForcedBatchTimestamp),
+			ForcedBlockHashL1:    seq.PrevBlockHash,
+		})
+	}
+
+	return smcAbi.Pack(sequenceBatchesValidiumMethod, batches, maxSequenceTimestamp, lastSequencedBatchNumber, l2Coinbase, dataAvailabilityMessage)
+}
+
+func decodeSequencesValidium(txData []byte, lastBatchNumber uint64, sequencer common.Address, txHash common.Hash, nonce uint64, l1InfoRoot common.Hash) ([]SequencedBatch, error) {
+	// Load contract ABI
+	smcAbi, err := abi.JSON(strings.NewReader(validiumABI))
+	if err != nil {
+		return nil, err
+	}
+
+	// Recover Method from signature and ABI
+	method, err := smcAbi.MethodById(txData[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	// Unpack method inputs
+	data, err := method.Inputs.Unpack(txData[4:])
+	if err != nil {
+		return nil, err
+	}
+	var sequences []ValidiumBatchData
+	bytedata, err := json.Marshal(data[0])
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(bytedata, &sequences)
+	if err != nil {
+		return nil, err
+	}
+	maxSequenceTimestamp := data[1].(uint64)
+	initSequencedBatchNumber := data[2].(uint64)
+	coinbase := (data[3]).(common.Address)
+	dataAvailabilityMessage := (data[4]).([]byte)
+	sequencedBatches := make([]SequencedBatch, len(sequences))
+	for i, seq := range sequences {
+		elderberry := SequencedBatchElderberryData{
+			MaxSequenceTimestamp:     maxSequenceTimestamp,
+			InitSequencedBatchNumber: initSequencedBatchNumber,
+		}
+		validium := SequencedBatchValidiumData{
+			TransactionsHash:        common.Hash(seq.TransactionsHash),
+			DataAvailabilityMessage: dataAvailabilityMessage,
+		}
+		bn := lastBatchNumber - uint64(len(sequences)-(i+1))
+		// The transactions are retrieved from the data availability backend while the sequence is processed
+		sequencedBatches[i] = SequencedBatch{
+			BatchNumber:   bn,
+			L1InfoRoot:    &l1InfoRoot,
+			SequencerAddr: sequencer,
+			TxHash:        txHash,
+			Nonce:         nonce,
+			Coinbase:      coinbase,
+			PolygonRollupBaseEtrogBatchData: &etrogpolygonzkevm.PolygonRollupBaseEtrogBatchData{
+				ForcedGlobalExitRoot: seq.ForcedGlobalExitRoot,
+				ForcedTimestamp:      seq.ForcedTimestamp,
+				ForcedBlockHashL1:    seq.ForcedBlockHashL1,
+			},
+			SequencedBatchElderberryData: &elderberry,
+			SequencedBatchValidiumData:   &validium,
+		}
+	}
+
+	return sequencedBatches, nil
+}
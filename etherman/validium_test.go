@@ -0,0 +1,54 @@
+package etherman
+
+import (
+	"testing"
+
+	ethmanTypes "github.com/0xPolygonHermez/zkevm-node/etherman/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeSequenceBatchesValidium(t *testing.T) {
+	sequences := []ethmanTypes.Sequence{
+		{
+			BatchL2Data: []byte{0x01, 0x02, 0x03},
+			BatchNumber: 11,
+		},
+		{
+			BatchL2Data:          []byte{0x04},
+			BatchNumber:          12,
+			GlobalExitRoot:       common.HexToHash("0x1"),
+			ForcedBatchTimestamp: 1700000000,
+			PrevBlockHash:        common.HexToHash("0x2"),
+		},
+	}
+	l2Coinbase := common.HexToAddress("0x3")
+	message := []byte{0xaa, 0xbb}
+
+	data, err := EncodeSequenceBatchesValidium(sequences, 1700000010, 10, l2Coinbase, message)
+	require.NoError(t, err)
+	require.Equal(t, methodIDSequenceBatchesValidium, data[:4])
+
+	sequencer := common.HexToAddress("0x4")
+	txHash := common.HexToHash("0x5")
+	l1InfoRoot := common.HexToHash("0x6")
+	sequencedBatches, err := decodeSequencesValidium(data, 12, sequencer, txHash, 1, l1InfoRoot)
+	require.NoError(t, err)
+	require.Len(t, sequencedBatches, len(sequences))
+	for i, sbatch := range sequencedBatches {
+		require.Equal(t, sequences[i].BatchNumber, sbatch.BatchNumber)
+		require.Equal(t, sequencer, sbatch.SequencerAddr)
+		require.Equal(t, l2Coinbase, sbatch.Coinbase)
+		require.Equal(t, l1InfoRoot, *sbatch.L1InfoRoot)
+		require.Equal(t, uint64(1700000010), sbatch.SequencedBatchElderberryData.MaxSequenceTimestamp)
+		require.Equal(t, uint64(10), sbatch.SequencedBatchElderberryData.InitSequencedBatchNumber)
+		require.Equal(t, crypto.Keccak256Hash(sequences[i].BatchL2Data), sbatch.SequencedBatchValidiumData.TransactionsHash)
+		require.Equal(t, message, sbatch.SequencedBatchValidiumData.DataAvailabilityMessage)
+		require.Nil(t, sbatch.PolygonRollupBaseEtrogBatchData.Transactions)
+		require.Equal(t, uint64(sequences[i].ForcedBatchTimestamp), sbatch.PolygonRollupBaseEtrogBatchData.ForcedTimestamp)
+	}
+	require.Equal(t, common.Hash{}, common.Hash(sequencedBatches[0].PolygonRollupBaseEtrogBatchData.ForcedGlobalExitRoot))
+	require.Equal(t, sequences[1].GlobalExitRoot, common.Hash(sequencedBatches[1].PolygonRollupBaseEtrogBatchData.ForcedGlobalExitRoot))
+	require.Equal(t, sequences[1].PrevBlockHash, common.Hash(sequencedBatches[1].PolygonRollupBaseEtrogBatchData.ForcedBlockHashL1))
+}
@@ -48,4 +48,42 @@ type Config struct {
 	// max gas price limit: 110
 	// tx gas price = 110
 	MaxGasPriceLimit uint64 `mapstructure:"MaxGasPriceLimit"`
+
+	// DynamicFeeTxs enables sending the L1 txs as EIP-1559 dynamic fee txs instead of legacy txs.
+	// The tip cap is computed from the L1 fee history and the fee cap is set as twice the base
+	// fee of the next block plus the tip cap, default value is false.
+	DynamicFeeTxs bool `mapstructure:"DynamicFeeTxs"`
+
+	// FeeHistoryBlocks is the number of L1 blocks taken from the fee history
+	// to compute the tip cap of the dynamic fee txs
+	FeeHistoryBlocks uint64 `mapstructure:"FeeHistoryBlocks"`
+
+	// FeeHistoryRewardPercentile is the percentile of the priority fees paid in each
+	// L1 block of the fee history used to compute the tip cap of the dynamic fee txs.
+	// The tip cap is the average of the percentile of all the blocks, adjusted by the
+	// GasPriceMarginFactor
+	FeeHistoryRewardPercentile float64 `mapstructure:"FeeHistoryRewardPercentile"`
+
+	// PriceBumpPercent is the minimum increase, in percent, applied to the tip and fee caps
+	// of a dynamic fee tx already sent when it needs to be replaced by a new one with higher
+	// fees. The L1 nodes reject replacements that don't increase the fees at least by this
+	// percent, default value is 10
+	PriceBumpPercent uint64 `mapstructure:"PriceBumpPercent"`
+
+	// MaxFeeCapByOwner defines the max fee cap allowed for the dynamic fee and blob txs of each owner
+	// (e.g. sequencer, aggregator). If the owner is not configured or its value is 0, the
+	// MaxGasPriceLimit is used as the ceiling.
+	//
+	// ex:
+	//
+	// [EthTxManager.MaxFeeCapByOwner]
+	// sequencer = 100000000000
+	// aggregator = 200000000000
+	MaxFeeCapByOwner map[string]uint64 `mapstructure:"MaxFeeCapByOwner"`
+
+	// MaxBlobGasPrice helps avoiding blob txs to be sent over an specified blob gas price,
+	// default value is 0, which means no limit. The blob gas price of the blob txs is limited
+	// to this value, and a blob tx already sent is not replaced when its fees can't be bumped
+	// under this limit
+	MaxBlobGasPrice uint64 `mapstructure:"MaxBlobGasPrice"`
 }
@@ -16,13 +16,22 @@ import (
 	"github.com/0xPolygonHermez/zkevm-node/state"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/jackc/pgx/v4"
 )
 
 const (
 	failureIntervalInSeconds = 5
 	// maxHistorySize           = 10
+
+	// blobTxPriceBumpPercent is the minimum increase of the fees, in percent, required
+	// by the L1 nodes to replace a blob tx that is already in the blob pool
+	blobTxPriceBumpPercent = 100
+
+	// percentBase is used to apply the percent bumps to the fees
+	percentBase = 100
 )
 
 var (
@@ -34,6 +43,17 @@ var (
 	// ErrExecutionReverted returned when trying to get the revert message
 	// but the call fails without revealing the revert reason
 	ErrExecutionReverted = errors.New("execution reverted")
+
+	// ErrBlobTxWithoutBlobs when trying to add a blob tx without blobs
+	ErrBlobTxWithoutBlobs = errors.New("blob tx without blobs")
+	// ErrBlobTxWithoutTo when trying to add a blob tx without a recipient, blob txs
+	// can't be used to create contracts
+	ErrBlobTxWithoutTo = errors.New("blob tx without recipient")
+	// ErrDynamicFeeTxNotSupported when the L1 network doesn't provide the fee history
+	// required to compute the fees of the dynamic fee txs
+	ErrDynamicFeeTxNotSupported = errors.New("dynamic fee txs are not supported by the L1 network")
+	// ErrBlobTxNotSupported when the L1 network doesn't support blob txs
+	ErrBlobTxNotSupported = errors.New("blob txs are not supported by the L1 network")
 )
 
 // Client for eth tx manager
@@ -109,26 +129,98 @@ func (c *Client) Add(ctx context.Context, owner, id string, from common.Address,
 		}
 	}
 
-	// get gas price
-	gasPrice, err := c.suggestedGasPrice(ctx)
+	// get gas price, for dynamic fee txs the gas price is used as the fee cap
+	var gasPrice, gasTipCap *big.Int
+	if c.cfg.DynamicFeeTxs {
+		gasTipCap, gasPrice, err = c.suggestedDynamicFees(ctx, owner)
+		if err != nil {
+			err := fmt.Errorf("failed to get suggested dynamic fees: %w", err)
+			log.Errorf(err.Error())
+			return err
+		}
+	} else {
+		gasPrice, err = c.suggestedGasPrice(ctx)
+		if err != nil {
+			err := fmt.Errorf("failed to get suggested gas price: %w", err)
+			log.Errorf(err.Error())
+			return err
+		}
+	}
+
+	// create monitored tx
+	mTx := monitoredTx{
+		owner: owner, id: id, from: from, to: to,
+		nonce: nonce, value: value, data: data,
+		gas: gas, gasOffset: gasOffset, gasPrice: gasPrice, gasTipCap: gasTipCap,
+		status: MonitoredTxStatusCreated,
+	}
+
+	// add to storage
+	err = c.storage.Add(ctx, mTx, dbTx)
 	if err != nil {
-		err := fmt.Errorf("failed to get suggested gas price: %w", err)
+		err := fmt.Errorf("failed to add tx to get monitored: %w", err)
+		log.Errorf(err.Error())
+		return err
+	}
+
+	mTxLog := log.WithFields("monitoredTx", mTx.id, "createdAt", mTx.createdAt)
+	mTxLog.Infof("created")
+
+	return nil
+}
+
+// AddBlobTx adds a blob transaction (EIP-4844) to be sent and monitored
+func (c *Client) AddBlobTx(ctx context.Context, owner, id string, from common.Address, to *common.Address, value *big.Int, data []byte, gasOffset uint64, sidecar *types.BlobTxSidecar, dbTx pgx.Tx) error {
+	if to == nil {
+		log.Error(ErrBlobTxWithoutTo.Error())
+		return ErrBlobTxWithoutTo
+	}
+	if sidecar == nil || len(sidecar.Blobs) == 0 {
+		log.Error(ErrBlobTxWithoutBlobs.Error())
+		return ErrBlobTxWithoutBlobs
+	}
+
+	// get nonce
+	nonce, err := c.getTxNonce(ctx, from)
+	if err != nil {
+		err := fmt.Errorf("failed to get nonce: %w", err)
+		log.Errorf(err.Error())
+		return err
+	}
+
+	// get fees
+	gasTipCap, gasFeeCap, blobGasPrice, err := c.suggestedBlobTxFees(ctx, owner)
+	if err != nil {
+		err := fmt.Errorf("failed to get suggested blob tx fees: %w", err)
 		log.Errorf(err.Error())
 		return err
 	}
 
+	// get gas
+	gas, err := c.etherman.EstimateGasBlobTx(ctx, from, to, gasFeeCap, gasTipCap, blobGasPrice, value, data, sidecar.BlobHashes())
+	if err != nil {
+		err := fmt.Errorf("failed to estimate gas: %w, data: %v", err, common.Bytes2Hex(data))
+		log.Error(err.Error())
+		if c.cfg.ForcedGas > 0 {
+			gas = c.cfg.ForcedGas
+		} else {
+			return err
+		}
+	}
+
 	// create monitored tx
 	mTx := monitoredTx{
 		owner: owner, id: id, from: from, to: to,
 		nonce: nonce, value: value, data: data,
-		gas: gas, gasOffset: gasOffset, gasPrice: gasPrice,
+		gas: gas, gasOffset: gasOffset, gasPrice: gasFeeCap, gasTipCap: gasTipCap,
+		blobSidecar: sidecar, blobGas: uint64(len(sidecar.Blobs)) * params.BlobTxBlobGasPerBlob, blobGasPrice: blobGasPrice,
 		status: MonitoredTxStatusCreated,
 	}
 
 	// add to storage
 	err = c.storage.Add(ctx, mTx, dbTx)
 	if err != nil {
-		err := fmt.Errorf("failed to add tx to get monitored: %w", err)
+		err := fmt.Errorf("failed to add blob tx to get monitored: %w", err)
 		log.Errorf(err.Error())
 		return err
 	}
@@ -555,6 +647,10 @@ func (c *Client) shouldContinueToMonitorThisTx(ctx context.Context, receipt type
 // accordingly to the current information stored and the current
 // state of the blockchain
 func (c *Client) reviewMonitoredTx(ctx context.Context, mTx *monitoredTx, mTxLogger *log.Logger) error {
+	if mTx.isBlobTx() {
+		return c.reviewMonitoredBlobTx(ctx, mTx, mTxLogger)
+	}
+
 	mTxLogger.Debug("reviewing")
 	// get gas
 	gas, err := c.etherman.EstimateGas(ctx, mTx.from, mTx.to, mTx.value, mTx.data)
@@ -570,6 +666,10 @@ func (c *Client) reviewMonitoredTx(ctx context.Context, mTx *monitoredTx, mTxLog
 		mTx.gas = gas
 	}
 
+	if mTx.isDynamicFeeTx() {
+		return c.reviewMonitoredDynamicFeeTx(ctx, mTx, mTxLogger)
+	}
+
 	// get gas price
 	gasPrice, err := c.suggestedGasPrice(ctx)
 	if err != nil {
@@ -586,6 +686,141 @@ func (c *Client) reviewMonitoredTx(ctx context.Context, mTx *monitoredTx, mTxLog
 	return nil
 }
 
+// reviewMonitoredDynamicFeeTx checks if the tip and fee caps of a dynamic fee tx
+// need to be updated accordingly to the current L1 fee history.
+//
+// A dynamic fee tx already sent can only be replaced if its tip and fee caps are
+// increased by PriceBumpPercent, so when any of the suggested fees is over the
+// current one, both of them are bumped and limited by the owner max fee cap. If the
+// limited fees are not enough to replace the tx, the current fees are kept
+func (c *Client) reviewMonitoredDynamicFeeTx(ctx context.Context, mTx *monitoredTx, mTxLogger *log.Logger) error {
+	gasTipCap, gasFeeCap, err := c.suggestedDynamicFees(ctx, mTx.owner)
+	if err != nil {
+		err := fmt.Errorf("failed to get suggested dynamic fees: %w", err)
+		mTxLogger.Errorf(err.Error())
+		return err
+	}
+
+	// check fees
+	if gasTipCap.Cmp(mTx.gasTipCap) <= 0 && gasFeeCap.Cmp(mTx.gasPrice) <= 0 {
+		return nil
+	}
+
+	newGasTipCap := bumpFee(mTx.gasTipCap, gasTipCap, c.cfg.PriceBumpPercent)
+	newGasFeeCap := bumpFee(mTx.gasPrice, gasFeeCap, c.cfg.PriceBumpPercent)
+	if maxFeeCap := c.maxFeeCap(mTx.owner); maxFeeCap != nil && newGasFeeCap.Cmp(maxFeeCap) == 1 {
+		mTxLogger.Warnf("monitored tx gas fee cap %v limited by the max fee cap %v", newGasFeeCap.String(), maxFeeCap.String())
+		newGasFeeCap = maxFeeCap
+	}
+	if newGasTipCap.Cmp(newGasFeeCap) == 1 {
+		newGasTipCap = big.NewInt(0).Set(newGasFeeCap)
+	}
+	if !isReplacementFee(mTx.gasTipCap, newGasTipCap, c.cfg.PriceBumpPercent) || !isReplacementFee(mTx.gasPrice, newGasFeeCap, c.cfg.PriceBumpPercent) {
+		mTxLogger.Warnf("monitored tx fees can't be bumped by %v%% under the max fee cap, keeping gas tip cap %v and gas fee cap %v",
+			c.cfg.PriceBumpPercent, mTx.gasTipCap.String(), mTx.gasPrice.String())
+		return nil
+	}
+
+	mTxLogger.Infof("monitored tx gas tip cap updated from %v to %v", mTx.gasTipCap.String(), newGasTipCap.String())
+	mTxLogger.Infof("monitored tx gas fee cap updated from %v to %v", mTx.gasPrice.String(), newGasFeeCap.String())
+	mTx.gasTipCap = newGasTipCap
+	mTx.gasPrice = newGasFeeCap
+
+	return nil
+}
+
+// reviewMonitoredBlobTx checks if some field needs to be updated
+// accordingly to the current information stored and the current
+// state of the blockchain for a blob tx.
+//
+// A blob tx already in the L1 blob pool can only be replaced if all its fees
+// (tip cap, fee cap and blob fee cap) are increased by blobTxPriceBumpPercent,
+// so when any of the suggested fees is over the current one, all of them are bumped
+// and limited by the owner max fee cap and the MaxBlobGasPrice. If the limited fees
+// are not enough to replace the tx, the current fees are kept
+func (c *Client) reviewMonitoredBlobTx(ctx context.Context, mTx *monitoredTx, mTxLogger *log.Logger) error {
+	mTxLogger.Debug("reviewing blob tx")
+	// get fees
+	gasTipCap, gasFeeCap, blobGasPrice, err := c.suggestedBlobTxFees(ctx, mTx.owner)
+	if err != nil {
+		err := fmt.Errorf("failed to get suggested blob tx fees: %w", err)
+		mTxLogger.Errorf(err.Error())
+		return err
+	}
+
+	// get gas
+	gas, err := c.etherman.EstimateGasBlobTx(ctx, mTx.from, mTx.to, gasFeeCap, gasTipCap, blobGasPrice, mTx.value, mTx.data, mTx.blobSidecar.BlobHashes())
+	if err != nil {
+		err := fmt.Errorf("failed to estimate gas: %w", err)
+		mTxLogger.Errorf(err.Error())
+		return err
+	}
+
+	// check gas
+	if gas > mTx.gas {
+		mTxLogger.Infof("monitored blob tx gas updated from %v to %v", mTx.gas, gas)
+		mTx.gas = gas
+	}
+
+	// check fees
+	if gasTipCap.Cmp(mTx.gasTipCap) <= 0 && gasFeeCap.Cmp(mTx.gasPrice) <= 0 && blobGasPrice.Cmp(mTx.blobGasPrice) <= 0 {
+		return nil
+	}
+
+	newGasTipCap := bumpFee(mTx.gasTipCap, gasTipCap, blobTxPriceBumpPercent)
+	newGasFeeCap := bumpFee(mTx.gasPrice, gasFeeCap, blobTxPriceBumpPercent)
+	newBlobGasPrice := bumpFee(mTx.blobGasPrice, blobGasPrice, blobTxPriceBumpPercent)
+	if maxFeeCap := c.maxFeeCap(mTx.owner); maxFeeCap != nil && newGasFeeCap.Cmp(maxFeeCap) == 1 {
+		mTxLogger.Warnf("monitored blob tx gas fee cap %v limited by the max fee cap %v", newGasFeeCap.String(), maxFeeCap.String())
+		newGasFeeCap = maxFeeCap
+	}
+	if newGasTipCap.Cmp(newGasFeeCap) == 1 {
+		newGasTipCap = big.NewInt(0).Set(newGasFeeCap)
+	}
+	if maxBlobGasPrice := c.maxBlobGasPrice(); maxBlobGasPrice != nil && newBlobGasPrice.Cmp(maxBlobGasPrice) == 1 {
+		mTxLogger.Warnf("monitored blob tx blob gas price %v limited by the max blob gas price %v", newBlobGasPrice.String(), maxBlobGasPrice.String())
+		newBlobGasPrice = maxBlobGasPrice
+	}
+	if !isReplacementFee(mTx.gasTipCap, newGasTipCap, blobTxPriceBumpPercent) || !isReplacementFee(mTx.gasPrice, newGasFeeCap, blobTxPriceBumpPercent) ||
+		!isReplacementFee(mTx.blobGasPrice, newBlobGasPrice, blobTxPriceBumpPercent) {
+		mTxLogger.Warnf("monitored blob tx fees can't be bumped by %v%% under the max fee cap and max blob gas price, keeping gas tip cap %v, gas fee cap %v and blob gas price %v",
+			blobTxPriceBumpPercent, mTx.gasTipCap.String(), mTx.gasPrice.String(), mTx.blobGasPrice.String())
+		return nil
+	}
+
+	mTxLogger.Infof("monitored blob tx gas tip cap updated from %v to %v", mTx.gasTipCap.String(), newGasTipCap.String())
+	mTxLogger.Infof("monitored blob tx gas fee cap updated from %v to %v", mTx.gasPrice.String(), newGasFeeCap.String())
+	mTxLogger.Infof("monitored blob tx blob gas price updated from %v to %v", mTx.blobGasPrice.String(), newBlobGasPrice.String())
+	mTx.gasTipCap = newGasTipCap
+	mTx.gasPrice = newGasFeeCap
+	mTx.blobGasPrice = newBlobGasPrice
+
+	return nil
+}
+
+// bumpFee returns the max between the suggested fee and the current fee
+// increased by bumpPercent
+func bumpFee(current, suggested *big.Int, bumpPercent uint64) *big.Int {
+	bumped := replacementMinFee(current, bumpPercent)
+	if suggested.Cmp(bumped) == 1 {
+		return big.NewInt(0).Set(suggested)
+	}
+	return bumped
+}
+
+// replacementMinFee returns the min fee accepted by the L1 nodes to replace
+// a tx paying the current fee, the current fee increased by bumpPercent
+func replacementMinFee(current *big.Int, bumpPercent uint64) *big.Int {
+	minFee := big.NewInt(0).Mul(current, big.NewInt(0).SetUint64(percentBase+bumpPercent))
+	return minFee.Div(minFee, big.NewInt(percentBase))
+}
+
+// isReplacementFee returns true if the new fee is enough to replace a tx
+// paying the current fee
+func isReplacementFee(current, newFee *big.Int, bumpPercent uint64) bool {
+	return newFee.Cmp(replacementMinFee(current, bumpPercent)) >= 0
+}
+
 // reviewMonitoredTxNonce checks if the nonce needs to be updated accordingly to
 // the current nonce of the sender account.
 //
@@ -633,6 +868,124 @@ func (c *Client) suggestedGasPrice(ctx context.Context) (*big.Int, error) {
 	return adjustedGasPrice, nil
 }
 
+// suggestedDynamicFees returns the gas tip cap and the gas fee cap to be used by a
+// dynamic fee tx of the given owner. The tip cap is the average of the configured
+// reward percentile of the L1 fee history adjusted by the margin factor, and the
+// fee cap is twice the base fee of the next L1 block plus the tip cap, limited by
+// the owner max fee cap
+func (c *Client) suggestedDynamicFees(ctx context.Context, owner string) (gasTipCap, gasFeeCap *big.Int, err error) {
+	feeHistory, err := c.etherman.FeeHistory(ctx, c.cfg.FeeHistoryBlocks, nil, []float64{c.cfg.FeeHistoryRewardPercentile})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(feeHistory.BaseFee) == 0 {
+		return nil, nil, ErrDynamicFeeTxNotSupported
+	}
+
+	// compute the average of the rewards of the fee history
+	rewardsSum := big.NewInt(0)
+	rewardsCount := int64(0)
+	for _, blockRewards := range feeHistory.Reward {
+		if len(blockRewards) == 0 || blockRewards[0] == nil {
+			continue
+		}
+		rewardsSum.Add(rewardsSum, blockRewards[0])
+		rewardsCount++
+	}
+	gasTipCap = big.NewInt(0)
+	if rewardsCount > 0 {
+		gasTipCap.Div(rewardsSum, big.NewInt(rewardsCount))
+	}
+
+	// adjust the tip cap by the margin factor
+	marginFactor := big.NewFloat(0).SetFloat64(c.cfg.GasPriceMarginFactor)
+	fGasTipCap := big.NewFloat(0).SetInt(gasTipCap)
+	gasTipCap, _ = big.NewFloat(0).Mul(fGasTipCap, marginFactor).Int(big.NewInt(0))
+
+	// the last base fee of the fee history is the base fee of the next block
+	nextBaseFee := feeHistory.BaseFee[len(feeHistory.BaseFee)-1]
+	gasFeeCap = big.NewInt(0).Mul(nextBaseFee, big.NewInt(2)) //nolint:gomnd
+	gasFeeCap.Add(gasFeeCap, gasTipCap)
+
+	// if there is a max fee cap configured and the current
+	// fee cap is over this limit, set the fee cap as the limit
+	if maxFeeCap := c.maxFeeCap(owner); maxFeeCap != nil && gasFeeCap.Cmp(maxFeeCap) == 1 {
+		gasFeeCap.Set(maxFeeCap)
+		if gasTipCap.Cmp(gasFeeCap) == 1 {
+			gasTipCap.Set(gasFeeCap)
+		}
+	}
+
+	return gasTipCap, gasFeeCap, nil
+}
+
+// maxFeeCap returns the max fee cap allowed for the dynamic fee txs of the given
+// owner, nil means no limit
+func (c *Client) maxFeeCap(owner string) *big.Int {
+	if maxFeeCap, found := c.cfg.MaxFeeCapByOwner[owner]; found && maxFeeCap > 0 {
+		return big.NewInt(0).SetUint64(maxFeeCap)
+	}
+	if c.cfg.MaxGasPriceLimit > 0 {
+		return big.NewInt(0).SetUint64(c.cfg.MaxGasPriceLimit)
+	}
+	return nil
+}
+
+// maxBlobGasPrice returns the max blob gas price allowed for the blob txs,
+// nil means no limit
+func (c *Client) maxBlobGasPrice() *big.Int {
+	if c.cfg.MaxBlobGasPrice > 0 {
+		return big.NewInt(0).SetUint64(c.cfg.MaxBlobGasPrice)
+	}
+	return nil
+}
+
+// suggestedBlobTxFees returns the gas tip cap, the gas fee cap and the blob gas price
+// to be used by a blob tx of the given owner, the gas fee cap and the blob gas price
+// are adjusted by the margin factor and limited by the owner max fee cap and the
+// MaxBlobGasPrice
+func (c *Client) suggestedBlobTxFees(ctx context.Context, owner string) (gasTipCap, gasFeeCap, blobGasPrice *big.Int, err error) {
+	gasTipCap, err = c.etherman.SuggestedGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	gasFeeCap, err = c.suggestedGasPrice(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// the fee cap can't be lower than the tip cap
+	if gasTipCap.Cmp(gasFeeCap) == 1 {
+		gasFeeCap = big.NewInt(0).Set(gasTipCap)
+	}
+
+	header, err := c.etherman.GetLatestBlockHeader(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if header.ExcessBlobGas == nil {
+		return nil, nil, nil, ErrBlobTxNotSupported
+	}
+	blobFee := eip4844.CalcBlobFee(*header.ExcessBlobGas)
+
+	// adjust the blob gas price by the margin factor
+	marginFactor := big.NewFloat(0).SetFloat64(c.cfg.GasPriceMarginFactor)
+	fBlobFee := big.NewFloat(0).SetInt(blobFee)
+	blobGasPrice, _ = big.NewFloat(0).Mul(fBlobFee, marginFactor).Int(big.NewInt(0))
+
+	if maxFeeCap := c.maxFeeCap(owner); maxFeeCap != nil && gasFeeCap.Cmp(maxFeeCap) == 1 {
+		gasFeeCap = maxFeeCap
+		if gasTipCap.Cmp(gasFeeCap) == 1 {
+			gasTipCap = big.NewInt(0).Set(gasFeeCap)
+		}
+	}
+	if maxBlobGasPrice := c.maxBlobGasPrice(); maxBlobGasPrice != nil && blobGasPrice.Cmp(maxBlobGasPrice) == 1 {
+		blobGasPrice = maxBlobGasPrice
+	}
+
+	return gasTipCap, gasFeeCap, blobGasPrice, nil
+}
+
 // logErrorAndWait used when an error is detected before trying again
 func (c *Client) logErrorAndWait(msg string, err error) {
 	log.Errorf(msg, err)
@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -982,3 +983,176 @@ func TestFailedToEstimateTxWithForcedGasGetMined(t *testing.T) {
 	require.Equal(t, receipt, result.Txs[signedTx.Hash()].Receipt)
 	require.Equal(t, "", result.Txs[signedTx.Hash()].RevertMessage)
 }
+
+func TestReviewMonitoredBlobTx(t *testing.T) {
+	etherman := newEthermanMock(t)
+	ethTxManagerClient := New(defaultEthTxmanagerConfigForTests, etherman, nil, nil)
+
+	ctx := context.Background()
+	from := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+	sidecar := &ethTypes.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{{}},
+		Commitments: []kzg4844.Commitment{{}},
+		Proofs:      []kzg4844.Proof{{}},
+	}
+	excessBlobGas := uint64(0)
+
+	mTx := monitoredTx{
+		owner: "owner", id: "unique_id", from: from, to: &to,
+		gas: 100, gasPrice: big.NewInt(10), gasTipCap: big.NewInt(2),
+		blobSidecar: sidecar, blobGasPrice: big.NewInt(1),
+	}
+
+	// suggested fees are lower than the current ones, nothing changes
+	etherman.EXPECT().SuggestedGasTipCap(ctx).Return(big.NewInt(1), nil).Once()
+	etherman.EXPECT().SuggestedGasPrice(ctx).Return(big.NewInt(5), nil).Once()
+	etherman.EXPECT().GetLatestBlockHeader(ctx).Return(&ethTypes.Header{ExcessBlobGas: &excessBlobGas}, nil).Once()
+	etherman.EXPECT().EstimateGasBlobTx(ctx, from, &to, big.NewInt(5), big.NewInt(1), big.NewInt(1), (*big.Int)(nil), []byte(nil), sidecar.BlobHashes()).Return(uint64(90), nil).Once()
+
+	err := ethTxManagerClient.reviewMonitoredTx(ctx, &mTx, createMonitoredTxLogger(mTx))
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), mTx.gas)
+	require.Equal(t, big.NewInt(10), mTx.gasPrice)
+	require.Equal(t, big.NewInt(2), mTx.gasTipCap)
+	require.Equal(t, big.NewInt(1), mTx.blobGasPrice)
+
+	// suggested gas price is higher, all the fees are bumped
+	etherman.EXPECT().SuggestedGasTipCap(ctx).Return(big.NewInt(1), nil).Once()
+	etherman.EXPECT().SuggestedGasPrice(ctx).Return(big.NewInt(30), nil).Once()
+	etherman.EXPECT().GetLatestBlockHeader(ctx).Return(&ethTypes.Header{ExcessBlobGas: &excessBlobGas}, nil).Once()
+	etherman.EXPECT().EstimateGasBlobTx(ctx, from, &to, big.NewInt(30), big.NewInt(1), big.NewInt(1), (*big.Int)(nil), []byte(nil), sidecar.BlobHashes()).Return(uint64(110), nil).Once()
+
+	err = ethTxManagerClient.reviewMonitoredTx(ctx, &mTx, createMonitoredTxLogger(mTx))
+	require.NoError(t, err)
+	require.Equal(t, uint64(110), mTx.gas)
+	require.Equal(t, big.NewInt(30), mTx.gasPrice)
+	require.Equal(t, big.NewInt(4), mTx.gasTipCap)
+	require.Equal(t, big.NewInt(2), mTx.blobGasPrice)
+}
+
+func TestReviewMonitoredBlobTxFeeLimits(t *testing.T) {
+	cfg := defaultEthTxmanagerConfigForTests
+	cfg.MaxFeeCapByOwner = map[string]uint64{"owner": 50}
+	cfg.MaxBlobGasPrice = 3
+
+	etherman := newEthermanMock(t)
+	ethTxManagerClient := New(cfg, etherman, nil, nil)
+
+	ctx := context.Background()
+	from := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+	sidecar := &ethTypes.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{{}},
+		Commitments: []kzg4844.Commitment{{}},
+		Proofs:      []kzg4844.Proof{{}},
+	}
+	excessBlobGas := uint64(0)
+	mockFees := func(gasPrice, expectedGasFeeCap int64) {
+		etherman.EXPECT().SuggestedGasTipCap(ctx).Return(big.NewInt(1), nil).Once()
+		etherman.EXPECT().SuggestedGasPrice(ctx).Return(big.NewInt(gasPrice), nil).Once()
+		etherman.EXPECT().GetLatestBlockHeader(ctx).Return(&ethTypes.Header{ExcessBlobGas: &excessBlobGas}, nil).Once()
+		etherman.EXPECT().EstimateGasBlobTx(ctx, from, &to, big.NewInt(expectedGasFeeCap), big.NewInt(1), big.NewInt(1), (*big.Int)(nil), []byte(nil), sidecar.BlobHashes()).Return(uint64(100), nil).Once()
+	}
+
+	mTx := monitoredTx{
+		owner: "owner", id: "unique_id", from: from, to: &to,
+		gas: 100, gasPrice: big.NewInt(10), gasTipCap: big.NewInt(2),
+		blobSidecar: sidecar, blobGasPrice: big.NewInt(1),
+	}
+
+	// the bumped fees are under the limits
+	mockFees(30, 30)
+	err := ethTxManagerClient.reviewMonitoredTx(ctx, &mTx, createMonitoredTxLogger(mTx))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(30), mTx.gasPrice)
+	require.Equal(t, big.NewInt(4), mTx.gasTipCap)
+	require.Equal(t, big.NewInt(2), mTx.blobGasPrice)
+
+	// the suggested fee cap is limited by the owner max fee cap, which is not
+	// enough to replace the tx, so the current fees are kept
+	mockFees(100, 50)
+	err = ethTxManagerClient.reviewMonitoredTx(ctx, &mTx, createMonitoredTxLogger(mTx))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(30), mTx.gasPrice)
+	require.Equal(t, big.NewInt(4), mTx.gasTipCap)
+	require.Equal(t, big.NewInt(2), mTx.blobGasPrice)
+
+	// the bumped blob gas price is over the max blob gas price, so the current fees are kept
+	mTx.gasPrice = big.NewInt(20)
+	mockFees(30, 30)
+	err = ethTxManagerClient.reviewMonitoredTx(ctx, &mTx, createMonitoredTxLogger(mTx))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(20), mTx.gasPrice)
+	require.Equal(t, big.NewInt(4), mTx.gasTipCap)
+	require.Equal(t, big.NewInt(2), mTx.blobGasPrice)
+}
+
+func TestReviewMonitoredDynamicFeeTx(t *testing.T) {
+	cfg := defaultEthTxmanagerConfigForTests
+	cfg.DynamicFeeTxs = true
+	cfg.FeeHistoryBlocks = 2
+	cfg.FeeHistoryRewardPercentile = 50
+	cfg.PriceBumpPercent = 10
+	cfg.MaxFeeCapByOwner = map[string]uint64{"owner": 250}
+
+	etherman := newEthermanMock(t)
+	ethTxManagerClient := New(cfg, etherman, nil, nil)
+
+	ctx := context.Background()
+	to := common.HexToAddress("0x2")
+	mTx := monitoredTx{
+		owner: "owner", id: "unique_id", to: &to,
+		gas: 100, gasPrice: big.NewInt(100), gasTipCap: big.NewInt(10),
+	}
+
+	// suggested fees are lower than the current ones, nothing changes
+	etherman.EXPECT().FeeHistory(ctx, uint64(2), (*big.Int)(nil), []float64{50}).Return(&ethereum.FeeHistory{
+		Reward:  [][]*big.Int{{big.NewInt(4)}, {big.NewInt(6)}},
+		BaseFee: []*big.Int{big.NewInt(30), big.NewInt(40), big.NewInt(45)},
+	}, nil).Once()
+	etherman.EXPECT().EstimateGas(ctx, mTx.from, &to, (*big.Int)(nil), []byte(nil)).Return(uint64(100), nil).Once()
+
+	err := ethTxManagerClient.reviewMonitoredTx(ctx, &mTx, createMonitoredTxLogger(mTx))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), mTx.gasPrice)
+	require.Equal(t, big.NewInt(10), mTx.gasTipCap)
+
+	// suggested fee cap is a bit higher, both fees are bumped by the price bump percent
+	etherman.EXPECT().FeeHistory(ctx, uint64(2), (*big.Int)(nil), []float64{50}).Return(&ethereum.FeeHistory{
+		Reward:  [][]*big.Int{{big.NewInt(4)}, {big.NewInt(6)}},
+		BaseFee: []*big.Int{big.NewInt(30), big.NewInt(40), big.NewInt(50)},
+	}, nil).Once()
+	etherman.EXPECT().EstimateGas(ctx, mTx.from, &to, (*big.Int)(nil), []byte(nil)).Return(uint64(100), nil).Once()
+
+	err = ethTxManagerClient.reviewMonitoredTx(ctx, &mTx, createMonitoredTxLogger(mTx))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(110), mTx.gasPrice)
+	require.Equal(t, big.NewInt(11), mTx.gasTipCap)
+
+	// suggested fee cap is over the owner max fee cap, the fee cap is limited
+	etherman.EXPECT().FeeHistory(ctx, uint64(2), (*big.Int)(nil), []float64{50}).Return(&ethereum.FeeHistory{
+		Reward:  [][]*big.Int{{big.NewInt(4)}, {big.NewInt(6)}},
+		BaseFee: []*big.Int{big.NewInt(30), big.NewInt(40), big.NewInt(500)},
+	}, nil).Once()
+	etherman.EXPECT().EstimateGas(ctx, mTx.from, &to, (*big.Int)(nil), []byte(nil)).Return(uint64(100), nil).Once()
+
+	err = ethTxManagerClient.reviewMonitoredTx(ctx, &mTx, createMonitoredTxLogger(mTx))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(250), mTx.gasPrice)
+	require.Equal(t, big.NewInt(12), mTx.gasTipCap)
+
+	// the fee cap limited by the owner max fee cap is not enough to replace
+	// the tx, the current fees are kept
+	mTx.gasPrice = big.NewInt(240)
+	etherman.EXPECT().FeeHistory(ctx, uint64(2), (*big.Int)(nil), []float64{50}).Return(&ethereum.FeeHistory{
+		Reward:  [][]*big.Int{{big.NewInt(4)}, {big.NewInt(6)}},
+		BaseFee: []*big.Int{big.NewInt(30), big.NewInt(40), big.NewInt(500)},
+	}, nil).Once()
+	etherman.EXPECT().EstimateGas(ctx, mTx.from, &to, (*big.Int)(nil), []byte(nil)).Return(uint64(100), nil).Once()
+
+	err = ethTxManagerClient.reviewMonitoredTx(ctx, &mTx, createMonitoredTxLogger(mTx))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(240), mTx.gasPrice)
+	require.Equal(t, big.NewInt(12), mTx.gasTipCap)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/jackc/pgx/v4"
@@ -19,7 +20,11 @@ type ethermanInterface interface {
 	PendingNonce(ctx context.Context, account common.Address) (uint64, error)
 	CurrentNonce(ctx context.Context, account common.Address) (uint64, error)
 	SuggestedGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestedGasTipCap(ctx context.Context) (*big.Int, error)
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+	GetLatestBlockHeader(ctx context.Context) (*types.Header, error)
 	EstimateGas(ctx context.Context, from common.Address, to *common.Address, value *big.Int, data []byte) (uint64, error)
+	EstimateGasBlobTx(ctx context.Context, from common.Address, to *common.Address, gasFeeCap *big.Int, gasTipCap *big.Int, blobGasPrice *big.Int, value *big.Int, data []byte, blobHashes []common.Hash) (uint64, error)
 	CheckTxWasMined(ctx context.Context, txHash common.Hash) (bool, *types.Receipt, error)
 	SignTx(ctx context.Context, sender common.Address, tx *types.Transaction) (*types.Transaction, error)
 	GetRevertMessage(ctx context.Context, tx *types.Transaction) (string, error)
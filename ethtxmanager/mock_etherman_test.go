@@ -4,10 +4,13 @@ package ethtxmanager
 
 import (
 	context "context"
+
 	big "math/big"
 
 	common "github.com/ethereum/go-ethereum/common"
 
+	ethereum "github.com/ethereum/go-ethereum"
+
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
@@ -211,6 +214,189 @@ func (_c *ethermanMock_EstimateGas_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// EstimateGasBlobTx provides a mock function with given fields: ctx, from, to, gasFeeCap, gasTipCap, blobGasPrice, value, data, blobHashes
+func (_m *ethermanMock) EstimateGasBlobTx(ctx context.Context, from common.Address, to *common.Address, gasFeeCap *big.Int, gasTipCap *big.Int, blobGasPrice *big.Int, value *big.Int, data []byte, blobHashes []common.Hash) (uint64, error) {
+	ret := _m.Called(ctx, from, to, gasFeeCap, gasTipCap, blobGasPrice, value, data, blobHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EstimateGasBlobTx")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *common.Address, *big.Int, *big.Int, *big.Int, *big.Int, []byte, []common.Hash) (uint64, error)); ok {
+		return rf(ctx, from, to, gasFeeCap, gasTipCap, blobGasPrice, value, data, blobHashes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *common.Address, *big.Int, *big.Int, *big.Int, *big.Int, []byte, []common.Hash) uint64); ok {
+		r0 = rf(ctx, from, to, gasFeeCap, gasTipCap, blobGasPrice, value, data, blobHashes)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *common.Address, *big.Int, *big.Int, *big.Int, *big.Int, []byte, []common.Hash) error); ok {
+		r1 = rf(ctx, from, to, gasFeeCap, gasTipCap, blobGasPrice, value, data, blobHashes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ethermanMock_EstimateGasBlobTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EstimateGasBlobTx'
+type ethermanMock_EstimateGasBlobTx_Call struct {
+	*mock.Call
+}
+
+// EstimateGasBlobTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from common.Address
+//   - to *common.Address
+//   - gasFeeCap *big.Int
+//   - gasTipCap *big.Int
+//   - blobGasPrice *big.Int
+//   - value *big.Int
+//   - data []byte
+//   - blobHashes []common.Hash
+func (_e *ethermanMock_Expecter) EstimateGasBlobTx(ctx interface{}, from interface{}, to interface{}, gasFeeCap interface{}, gasTipCap interface{}, blobGasPrice interface{}, value interface{}, data interface{}, blobHashes interface{}) *ethermanMock_EstimateGasBlobTx_Call {
+	return &ethermanMock_EstimateGasBlobTx_Call{Call: _e.mock.On("EstimateGasBlobTx", ctx, from, to, gasFeeCap, gasTipCap, blobGasPrice, value, data, blobHashes)}
+}
+
+func (_c *ethermanMock_EstimateGasBlobTx_Call) Run(run func(ctx context.Context, from common.Address, to *common.Address, gasFeeCap *big.Int, gasTipCap *big.Int, blobGasPrice *big.Int, value *big.Int, data []byte, blobHashes []common.Hash)) *ethermanMock_EstimateGasBlobTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*common.Address), args[3].(*big.Int), args[4].(*big.Int), args[5].(*big.Int), args[6].(*big.Int), args[7].([]byte), args[8].([]common.Hash))
+	})
+	return _c
+}
+
+func (_c *ethermanMock_EstimateGasBlobTx_Call) Return(_a0 uint64, _a1 error) *ethermanMock_EstimateGasBlobTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ethermanMock_EstimateGasBlobTx_Call) RunAndReturn(run func(context.Context, common.Address, *common.Address, *big.Int, *big.Int, *big.Int, *big.Int, []byte, []common.Hash) (uint64, error)) *ethermanMock_EstimateGasBlobTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FeeHistory provides a mock function with given fields: ctx, blockCount, lastBlock, rewardPercentiles
+func (_m *ethermanMock) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	ret := _m.Called(ctx, blockCount, lastBlock, rewardPercentiles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeHistory")
+	}
+
+	var r0 *ethereum.FeeHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *big.Int, []float64) (*ethereum.FeeHistory, error)); ok {
+		return rf(ctx, blockCount, lastBlock, rewardPercentiles)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *big.Int, []float64) *ethereum.FeeHistory); ok {
+		r0 = rf(ctx, blockCount, lastBlock, rewardPercentiles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ethereum.FeeHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *big.Int, []float64) error); ok {
+		r1 = rf(ctx, blockCount, lastBlock, rewardPercentiles)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ethermanMock_FeeHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FeeHistory'
+type ethermanMock_FeeHistory_Call struct {
+	*mock.Call
+}
+
+// FeeHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blockCount uint64
+//   - lastBlock *big.Int
+//   - rewardPercentiles []float64
+func (_e *ethermanMock_Expecter) FeeHistory(ctx interface{}, blockCount interface{}, lastBlock interface{}, rewardPercentiles interface{}) *ethermanMock_FeeHistory_Call {
+	return &ethermanMock_FeeHistory_Call{Call: _e.mock.On("FeeHistory", ctx, blockCount, lastBlock, rewardPercentiles)}
+}
+
+func (_c *ethermanMock_FeeHistory_Call) Run(run func(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64)) *ethermanMock_FeeHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(*big.Int), args[3].([]float64))
+	})
+	return _c
+}
+
+func (_c *ethermanMock_FeeHistory_Call) Return(_a0 *ethereum.FeeHistory, _a1 error) *ethermanMock_FeeHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ethermanMock_FeeHistory_Call) RunAndReturn(run func(context.Context, uint64, *big.Int, []float64) (*ethereum.FeeHistory, error)) *ethermanMock_FeeHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestBlockHeader provides a mock function with given fields: ctx
+func (_m *ethermanMock) GetLatestBlockHeader(ctx context.Context) (*types.Header, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestBlockHeader")
+	}
+
+	var r0 *types.Header
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*types.Header, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *types.Header); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Header)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ethermanMock_GetLatestBlockHeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestBlockHeader'
+type ethermanMock_GetLatestBlockHeader_Call struct {
+	*mock.Call
+}
+
+// GetLatestBlockHeader is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ethermanMock_Expecter) GetLatestBlockHeader(ctx interface{}) *ethermanMock_GetLatestBlockHeader_Call {
+	return &ethermanMock_GetLatestBlockHeader_Call{Call: _e.mock.On("GetLatestBlockHeader", ctx)}
+}
+
+func (_c *ethermanMock_GetLatestBlockHeader_Call) Run(run func(ctx context.Context)) *ethermanMock_GetLatestBlockHeader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ethermanMock_GetLatestBlockHeader_Call) Return(_a0 *types.Header, _a1 error) *ethermanMock_GetLatestBlockHeader_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ethermanMock_GetLatestBlockHeader_Call) RunAndReturn(run func(context.Context) (*types.Header, error)) *ethermanMock_GetLatestBlockHeader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRevertMessage provides a mock function with given fields: ctx, tx
 func (_m *ethermanMock) GetRevertMessage(ctx context.Context, tx *types.Transaction) (string, error) {
 	ret := _m.Called(ctx, tx)
@@ -615,6 +801,64 @@ func (_c *ethermanMock_SuggestedGasPrice_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// SuggestedGasTipCap provides a mock function with given fields: ctx
+func (_m *ethermanMock) SuggestedGasTipCap(ctx context.Context) (*big.Int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuggestedGasTipCap")
+	}
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*big.Int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *big.Int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ethermanMock_SuggestedGasTipCap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuggestedGasTipCap'
+type ethermanMock_SuggestedGasTipCap_Call struct {
+	*mock.Call
+}
+
+// SuggestedGasTipCap is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ethermanMock_Expecter) SuggestedGasTipCap(ctx interface{}) *ethermanMock_SuggestedGasTipCap_Call {
+	return &ethermanMock_SuggestedGasTipCap_Call{Call: _e.mock.On("SuggestedGasTipCap", ctx)}
+}
+
+func (_c *ethermanMock_SuggestedGasTipCap_Call) Run(run func(ctx context.Context)) *ethermanMock_SuggestedGasTipCap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ethermanMock_SuggestedGasTipCap_Call) Return(_a0 *big.Int, _a1 error) *ethermanMock_SuggestedGasTipCap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ethermanMock_SuggestedGasTipCap_Call) RunAndReturn(run func(context.Context) (*big.Int, error)) *ethermanMock_SuggestedGasTipCap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WaitTxToBeMined provides a mock function with given fields: ctx, tx, timeout
 func (_m *ethermanMock) WaitTxToBeMined(ctx context.Context, tx *types.Transaction, timeout time.Duration) (bool, error) {
 	ret := _m.Called(ctx, tx, timeout)
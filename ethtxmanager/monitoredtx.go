@@ -7,6 +7,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/holiman/uint256"
 )
 
 const (
@@ -76,9 +78,23 @@ type monitoredTx struct {
 	// tx gas offset
 	gasOffset uint64
 
-	// tx gas price
+	// tx gas price, for dynamic fee and blob txs it's used as the max fee per gas
 	gasPrice *big.Int
 
+	// tx gas tip cap (max priority fee per gas), only used by dynamic fee and blob txs,
+	// if nil the monitored tx is a legacy tx
+	gasTipCap *big.Int
+
+	// blobSidecar contains the blobs, commitments and proofs of a blob tx (EIP-4844),
+	// if nil the monitored tx is not a blob tx
+	blobSidecar *types.BlobTxSidecar
+
+	// blobGas is the amount of blob gas consumed by the blobs of the tx
+	blobGas uint64
+
+	// blobGasPrice is the max fee per blob gas of the tx
+	blobGasPrice *big.Int
+
 	// status of this monitoring
 	status MonitoredTxStatus
 
@@ -101,6 +117,13 @@ type monitoredTx struct {
 
 // Tx uses the current information to build a tx
 func (mTx monitoredTx) Tx() *types.Transaction {
+	if mTx.isBlobTx() {
+		return mTx.blobTx()
+	}
+	if mTx.isDynamicFeeTx() {
+		return mTx.dynamicFeeTx()
+	}
+
 	tx := types.NewTx(&types.LegacyTx{
 		To:       mTx.to,
 		Nonce:    mTx.nonce,
@@ -113,6 +136,57 @@ func (mTx monitoredTx) Tx() *types.Transaction {
 	return tx
 }
 
+// dynamicFeeTx builds a dynamic fee tx (EIP-1559) using the current information, the chain id
+// is not set because it's filled by the signer when signing the tx
+func (mTx monitoredTx) dynamicFeeTx() *types.Transaction {
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(0),
+		To:        mTx.to,
+		Nonce:     mTx.nonce,
+		Value:     mTx.value,
+		Data:      mTx.data,
+		Gas:       mTx.gas + mTx.gasOffset,
+		GasTipCap: mTx.gasTipCap,
+		GasFeeCap: mTx.gasPrice,
+	})
+}
+
+// blobTx builds a blob tx (EIP-4844) using the current information, the chain id
+// is not set because it's filled by the signer when signing the tx
+func (mTx monitoredTx) blobTx() *types.Transaction {
+	var to common.Address
+	if mTx.to != nil {
+		to = *mTx.to
+	}
+	value := uint256.NewInt(0)
+	if mTx.value != nil {
+		value = uint256.MustFromBig(mTx.value)
+	}
+	return types.NewTx(&types.BlobTx{
+		ChainID:    uint256.NewInt(0),
+		To:         to,
+		Nonce:      mTx.nonce,
+		Value:      value,
+		Data:       mTx.data,
+		Gas:        mTx.gas + mTx.gasOffset,
+		GasTipCap:  uint256.MustFromBig(mTx.gasTipCap),
+		GasFeeCap:  uint256.MustFromBig(mTx.gasPrice),
+		BlobFeeCap: uint256.MustFromBig(mTx.blobGasPrice),
+		BlobHashes: mTx.blobSidecar.BlobHashes(),
+		Sidecar:    mTx.blobSidecar,
+	})
+}
+
+// isDynamicFeeTx returns true if the monitored tx is a dynamic fee tx
+func (mTx monitoredTx) isDynamicFeeTx() bool {
+	return mTx.gasTipCap != nil && !mTx.isBlobTx()
+}
+
+// isBlobTx returns true if the monitored tx carries blobs
+func (mTx monitoredTx) isBlobTx() bool {
+	return mTx.blobSidecar != nil
+}
+
 // AddHistory adds a transaction to the monitoring history
 func (mTx monitoredTx) AddHistory(tx *types.Transaction) error {
 	if _, found := mTx.history[tx.Hash()]; found {
@@ -152,6 +226,44 @@ func (mTx *monitoredTx) dataStringPtr() *string {
 	return data
 }
 
+// gasTipCapU64Ptr returns the current gasTipCap field as a uint64 pointer
+func (mTx *monitoredTx) gasTipCapU64Ptr() *uint64 {
+	var gasTipCap *uint64
+	if mTx.gasTipCap != nil {
+		tmp := mTx.gasTipCap.Uint64()
+		gasTipCap = &tmp
+	}
+	return gasTipCap
+}
+
+// blobGasPriceU64Ptr returns the current blobGasPrice field as a uint64 pointer
+func (mTx *monitoredTx) blobGasPriceU64Ptr() *uint64 {
+	var blobGasPrice *uint64
+	if mTx.blobGasPrice != nil {
+		tmp := mTx.blobGasPrice.Uint64()
+		blobGasPrice = &tmp
+	}
+	return blobGasPrice
+}
+
+// blobGasU64Ptr returns the current blobGas field as a uint64 pointer, nil
+// when the monitored tx is not a blob tx
+func (mTx *monitoredTx) blobGasU64Ptr() *uint64 {
+	if !mTx.isBlobTx() {
+		return nil
+	}
+	blobGas := mTx.blobGas
+	return &blobGas
+}
+
+// blobSidecarBytes returns the current blobSidecar field RLP encoded
+func (mTx *monitoredTx) blobSidecarBytes() ([]byte, error) {
+	if mTx.blobSidecar == nil {
+		return nil, nil
+	}
+	return rlp.EncodeToBytes(mTx.blobSidecar)
+}
+
 // historyStringSlice returns the current history field as a string slice
 func (mTx *monitoredTx) historyStringSlice() []string {
 	history := make([]string, 0, len(mTx.history))
@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -36,3 +39,82 @@ func TestTx(t *testing.T) {
 	assert.Equal(t, gas+gasOffset, tx.Gas())
 	assert.Equal(t, gasPrice, tx.GasPrice())
 }
+
+func TestDynamicFeeTx(t *testing.T) {
+	to := common.HexToAddress("0x2")
+	nonce := uint64(1)
+	value := big.NewInt(2)
+	data := []byte("data")
+	gas := uint64(3)
+	gasOffset := uint64(4)
+	gasPrice := big.NewInt(5)
+	gasTipCap := big.NewInt(6)
+
+	mTx := monitoredTx{
+		to:        &to,
+		nonce:     nonce,
+		value:     value,
+		data:      data,
+		gas:       gas,
+		gasOffset: gasOffset,
+		gasPrice:  gasPrice,
+		gasTipCap: gasTipCap,
+	}
+
+	tx := mTx.Tx()
+
+	assert.Equal(t, uint8(types.DynamicFeeTxType), tx.Type())
+	assert.Equal(t, &to, tx.To())
+	assert.Equal(t, nonce, tx.Nonce())
+	assert.Equal(t, value, tx.Value())
+	assert.Equal(t, data, tx.Data())
+	assert.Equal(t, gas+gasOffset, tx.Gas())
+	assert.Equal(t, gasPrice, tx.GasFeeCap())
+	assert.Equal(t, gasTipCap, tx.GasTipCap())
+}
+
+func TestBlobTx(t *testing.T) {
+	to := common.HexToAddress("0x2")
+	nonce := uint64(1)
+	value := big.NewInt(2)
+	data := []byte("data")
+	gas := uint64(3)
+	gasOffset := uint64(4)
+	gasPrice := big.NewInt(5)
+	gasTipCap := big.NewInt(6)
+	blobGasPrice := big.NewInt(7)
+	sidecar := &types.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{{}},
+		Commitments: []kzg4844.Commitment{{}},
+		Proofs:      []kzg4844.Proof{{}},
+	}
+
+	mTx := monitoredTx{
+		to:           &to,
+		nonce:        nonce,
+		value:        value,
+		data:         data,
+		gas:          gas,
+		gasOffset:    gasOffset,
+		gasPrice:     gasPrice,
+		gasTipCap:    gasTipCap,
+		blobSidecar:  sidecar,
+		blobGas:      params.BlobTxBlobGasPerBlob,
+		blobGasPrice: blobGasPrice,
+	}
+
+	tx := mTx.Tx()
+
+	assert.Equal(t, uint8(types.BlobTxType), tx.Type())
+	assert.Equal(t, &to, tx.To())
+	assert.Equal(t, nonce, tx.Nonce())
+	assert.Equal(t, value, tx.Value())
+	assert.Equal(t, data, tx.Data())
+	assert.Equal(t, gas+gasOffset, tx.Gas())
+	assert.Equal(t, gasPrice, tx.GasFeeCap())
+	assert.Equal(t, gasTipCap, tx.GasTipCap())
+	assert.Equal(t, blobGasPrice, tx.BlobGasFeeCap())
+	assert.Equal(t, sidecar.BlobHashes(), tx.BlobHashes())
+	assert.Equal(t, uint64(params.BlobTxBlobGasPerBlob), tx.BlobGas())
+	assert.Equal(t, sidecar, tx.BlobTxSidecar())
+}
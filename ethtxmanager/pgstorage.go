@@ -9,6 +9,8 @@ import (
 
 	"github.com/0xPolygonHermez/zkevm-node/db"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
@@ -36,15 +38,21 @@ func NewPostgresStorage(dbCfg db.Config) (*PostgresStorage, error) {
 func (s *PostgresStorage) Add(ctx context.Context, mTx monitoredTx, dbTx pgx.Tx) error {
 	conn := s.dbConn(dbTx)
 	cmd := `
-        INSERT INTO state.monitored_txs (owner, id, from_addr, to_addr, nonce, value, data, gas, gas_offset, gas_price, status, block_num, history, created_at, updated_at)
-                                 VALUES (   $1, $2,        $3,      $4,    $5,    $6,   $7,  $8,         $9,       $10,    $11,       $12,     $13,        $14,        $15)`
+        INSERT INTO state.monitored_txs (owner, id, from_addr, to_addr, nonce, value, data, gas, gas_offset, gas_price, status, block_num, history, created_at, updated_at, gas_tip_cap, blob_sidecar, blob_gas, blob_gas_price)
+                                 VALUES (   $1, $2,        $3,      $4,    $5,    $6,   $7,  $8,         $9,       $10,    $11,       $12,     $13,        $14,        $15,         $16,          $17,      $18,            $19)`
 
-	_, err := conn.Exec(ctx, cmd, mTx.owner,
+	blobSidecar, err := mTx.blobSidecarBytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(ctx, cmd, mTx.owner,
 		mTx.id, mTx.from.String(), mTx.toStringPtr(),
 		mTx.nonce, mTx.valueU64Ptr(), mTx.dataStringPtr(),
 		mTx.gas, mTx.gasOffset, mTx.gasPrice.Uint64(), string(mTx.status), mTx.blockNumberU64Ptr(),
 		mTx.historyStringSlice(), time.Now().UTC().Round(time.Microsecond),
-		time.Now().UTC().Round(time.Microsecond))
+		time.Now().UTC().Round(time.Microsecond),
+		mTx.gasTipCapU64Ptr(), blobSidecar, mTx.blobGasU64Ptr(), mTx.blobGasPriceU64Ptr())
 
 	if err != nil {
 		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.ConstraintName == "monitored_txs_pkey" {
@@ -61,7 +69,7 @@ func (s *PostgresStorage) Add(ctx context.Context, mTx monitoredTx, dbTx pgx.Tx)
 func (s *PostgresStorage) Get(ctx context.Context, owner, id string, dbTx pgx.Tx) (monitoredTx, error) {
 	conn := s.dbConn(dbTx)
 	cmd := `
-        SELECT owner, id, from_addr, to_addr, nonce, value, data, gas, gas_offset, gas_price, status, block_num, history, created_at, updated_at
+        SELECT owner, id, from_addr, to_addr, nonce, value, data, gas, gas_offset, gas_price, status, block_num, history, created_at, updated_at, gas_tip_cap, blob_sidecar, blob_gas, blob_gas_price
           FROM state.monitored_txs
          WHERE owner = $1 
            AND id = $2`
@@ -85,7 +93,7 @@ func (s *PostgresStorage) GetByStatus(ctx context.Context, owner *string, status
 
 	conn := s.dbConn(dbTx)
 	cmd := `
-        SELECT owner, id, from_addr, to_addr, nonce, value, data, gas, gas_offset, gas_price, status, block_num, history, created_at, updated_at
+        SELECT owner, id, from_addr, to_addr, nonce, value, data, gas, gas_offset, gas_price, status, block_num, history, created_at, updated_at, gas_tip_cap, blob_sidecar, blob_gas, blob_gas_price
           FROM state.monitored_txs
          WHERE (owner = $1 OR $1 IS NULL)`
 	if hasStatusToFilter {
@@ -129,7 +137,7 @@ func (s *PostgresStorage) GetBySenderAndStatus(ctx context.Context, sender commo
 
 	conn := s.dbConn(dbTx)
 	cmd := `
-        SELECT owner, id, from_addr, to_addr, nonce, value, data, gas, gas_offset, gas_price, status, block_num, history, created_at, updated_at
+        SELECT owner, id, from_addr, to_addr, nonce, value, data, gas, gas_offset, gas_price, status, block_num, history, created_at, updated_at, gas_tip_cap, blob_sidecar, blob_gas, blob_gas_price
           FROM state.monitored_txs
          WHERE from_addr = $1`
 	if hasStatusToFilter {
@@ -172,7 +180,7 @@ func (s *PostgresStorage) GetBySenderAndStatus(ctx context.Context, sender commo
 func (s *PostgresStorage) GetByBlock(ctx context.Context, fromBlock, toBlock *uint64, dbTx pgx.Tx) ([]monitoredTx, error) {
 	conn := s.dbConn(dbTx)
 	cmd := `
-        SELECT owner, id, from_addr, to_addr, nonce, value, data, gas, gas_offset, gas_price, status, block_num, history, created_at, updated_at
+        SELECT owner, id, from_addr, to_addr, nonce, value, data, gas, gas_offset, gas_price, status, block_num, history, created_at, updated_at, gas_tip_cap, blob_sidecar, blob_gas, blob_gas_price
           FROM state.monitored_txs
          WHERE (block_num >= $1 OR $1 IS NULL)
            AND (block_num <= $2 OR $2 IS NULL)
@@ -232,6 +240,10 @@ func (s *PostgresStorage) Update(ctx context.Context, mTx monitoredTx, dbTx pgx.
              , block_num = $12
              , history = $13
              , updated_at = $14
+             , gas_tip_cap = $15
+             , blob_sidecar = $16
+             , blob_gas = $17
+             , blob_gas_price = $18
          WHERE owner = $1
            AND id = $2`
 
@@ -241,11 +253,17 @@ func (s *PostgresStorage) Update(ctx context.Context, mTx monitoredTx, dbTx pgx.
 		bn = &tmp
 	}
 
-	_, err := conn.Exec(ctx, cmd, mTx.owner,
+	blobSidecar, err := mTx.blobSidecarBytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Exec(ctx, cmd, mTx.owner,
 		mTx.id, mTx.from.String(), mTx.toStringPtr(),
 		mTx.nonce, mTx.valueU64Ptr(), mTx.dataStringPtr(),
 		mTx.gas, mTx.gasOffset, mTx.gasPrice.Uint64(), string(mTx.status), bn,
-		mTx.historyStringSlice(), time.Now().UTC().Round(time.Microsecond))
+		mTx.historyStringSlice(), time.Now().UTC().Round(time.Microsecond),
+		mTx.gasTipCapU64Ptr(), blobSidecar, mTx.blobGasU64Ptr(), mTx.blobGasPriceU64Ptr())
 
 	if err != nil {
 		return err
@@ -257,16 +275,19 @@ func (s *PostgresStorage) Update(ctx context.Context, mTx monitoredTx, dbTx pgx.
 // scanMtx scans a row and fill the provided instance of monitoredTx with
 // the row data
 func (s *PostgresStorage) scanMtx(row pgx.Row, mTx *monitoredTx) error {
-	// id, from, to, nonce, value, data, gas, gas_offset, gas_price, status, history, created_at, updated_at
+	// id, from, to, nonce, value, data, gas, gas_offset, gas_price, status, history, created_at, updated_at,
+	// gas_tip_cap, blob_sidecar, blob_gas, blob_gas_price
 	var from, status string
 	var to, data *string
 	var history []string
 	var value, blockNumber *uint64
 	var gasPrice uint64
+	var gasTipCap, blobGas, blobGasPrice *uint64
+	var blobSidecar []byte
 
 	err := row.Scan(&mTx.owner, &mTx.id, &from, &to, &mTx.nonce, &value,
 		&data, &mTx.gas, &mTx.gasOffset, &gasPrice, &status, &blockNumber, &history,
-		&mTx.createdAt, &mTx.updatedAt)
+		&mTx.createdAt, &mTx.updatedAt, &gasTipCap, &blobSidecar, &blobGas, &blobGasPrice)
 	if err != nil {
 		return err
 	}
@@ -296,6 +317,23 @@ func (s *PostgresStorage) scanMtx(row pgx.Row, mTx *monitoredTx) error {
 		mTx.blockNumber = big.NewInt(0).SetUint64(tmp)
 	}
 
+	if gasTipCap != nil {
+		mTx.gasTipCap = big.NewInt(0).SetUint64(*gasTipCap)
+	}
+	if len(blobSidecar) > 0 {
+		sidecar := &types.BlobTxSidecar{}
+		if err := rlp.DecodeBytes(blobSidecar, sidecar); err != nil {
+			return err
+		}
+		mTx.blobSidecar = sidecar
+	}
+	if blobGas != nil {
+		mTx.blobGas = *blobGas
+	}
+	if blobGasPrice != nil {
+		mTx.blobGasPrice = big.NewInt(0).SetUint64(*blobGasPrice)
+	}
+
 	h := make(map[common.Hash]bool, len(history))
 	for _, txHash := range history {
 		h[common.HexToHash(txHash)] = true
@@ -50,6 +50,12 @@ const (
 	EventID_ReservedZKCountersOverflow EventID = "RESERVED ZKCOUNTERS OVERFLOW"
 	// EventID_InvalidInfoRoot is triggered when an invalid l1InfoRoot was synced
 	EventID_InvalidInfoRoot EventID = "INVALID INFOROOT"
+	// EventID_SequencerAdminAction is triggered when an operator runs an action through the sequencer admin API
+	EventID_SequencerAdminAction EventID = "SEQUENCER ADMIN ACTION"
+	// EventID_AggregatorProfitabilityCheck is triggered when the aggregator decides if it is profitable to prove the pending batches
+	EventID_AggregatorProfitabilityCheck EventID = "AGGREGATOR PROFITABILITY CHECK"
+	// EventID_SequenceSenderBlobSequence is triggered when the sequence sender chooses the data mode of a blob sequence sent to L1
+	EventID_SequenceSenderBlobSequence EventID = "SEQUENCE SENDER BLOB SEQUENCE"
 	// Source_Node is the source of the event
 	Source_Node Source = "node"
 
@@ -28,6 +28,30 @@ func (e *EventLog) LogEvent(ctx context.Context, event *Event) error {
 	return e.storage.LogEvent(ctx, event)
 }
 
+// LogInfoEvent logs the description and stores it in the event log as an info event of the
+// node component, with the payload encoded as json
+func (e *EventLog) LogInfoEvent(ctx context.Context, component Component, eventID EventID, description string, payload interface{}) {
+	log.Info(description)
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		log.Errorf("error marshaling payload of event %s: %v", eventID, err)
+		return
+	}
+	event := &Event{
+		ReceivedAt:  time.Now(),
+		Source:      Source_Node,
+		Component:   component,
+		Level:       Level_Info,
+		EventID:     eventID,
+		Description: description,
+		Json:        string(b),
+	}
+	if err := e.storage.LogEvent(ctx, event); err != nil {
+		log.Errorf("error storing event %s: %v", eventID, err)
+	}
+}
+
 // LogExecutorError is used to store Executor error for runtime debugging
 func (e *EventLog) LogExecutorError(ctx context.Context, responseError executor.ExecutorError, processBatchRequest interface{}) {
 	timestamp := time.Now()
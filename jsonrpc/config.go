@@ -63,10 +63,44 @@ type Config struct {
 	// requests to be captured by the server.
 	EnableHttpLog bool `mapstructure:"EnableHttpLog"`
 
+	// FeeHistoryMaxBlockCount is the max number of blocks that can be requested
+	// in a single call to eth_feeHistory, bigger requests are truncated
+	FeeHistoryMaxBlockCount uint64 `mapstructure:"FeeHistoryMaxBlockCount"`
+
+	// L2BaseFee is the base fee per gas reported for the L2 blocks by eth_feeHistory
+	// and discounted from the gas price by eth_maxPriorityFeePerGas. The L2 blocks
+	// don't have a base fee, so it should be zero unless the chain charges one
+	L2BaseFee uint64 `mapstructure:"L2BaseFee"`
+
+	// FilterStorage defines where the filters created by eth_newFilter, eth_newBlockFilter and
+	// eth_newPendingTransactionFilter are stored:
+	// - memory: the filters are kept in memory, they are lost on restart and only the RPC
+	//   instance that created them can answer to eth_getFilterChanges
+	// - postgres: the filters are stored in the state DB, so they survive restarts and are
+	//   shared by all the RPC instances using the same DB
+	// The filters created by eth_subscribe are always kept in memory
+	FilterStorage string `mapstructure:"FilterStorage"`
+
+	// FilterTimeout is the time after which a filter stored in postgres that hasn't been
+	// polled is removed, if zero the filters are never removed
+	FilterTimeout types.Duration `mapstructure:"FilterTimeout"`
+
+	// TxPoolContentLimit is the max number of txs loaded to answer txpool_content and
+	// txpool_inspect, the ones left out are the txs with the highest nonces of the last
+	// senders. If zero all the pending txs are loaded
+	TxPoolContentLimit uint64 `mapstructure:"TxPoolContentLimit"`
+
 	// ZKCountersLimits defines the ZK Counter limits
 	ZKCountersLimits ZKCountersLimits
 }
 
+const (
+	// FilterStorageMemory stores the filters in memory
+	FilterStorageMemory = "memory"
+	// FilterStoragePostgres stores the filters in the state DB
+	FilterStoragePostgres = "postgres"
+)
+
 // ZKCountersLimits defines the ZK Counter limits
 type ZKCountersLimits struct {
 	MaxKeccakHashes     uint32
@@ -92,4 +126,12 @@ type WebSocketsConfig struct {
 
 	// ReadLimit defines the maximum size of a message read from the client (in bytes)
 	ReadLimit int64 `mapstructure:"ReadLimit"`
+
+	// PendingTxsPollInterval is the interval used to check the pool for new pending
+	// transactions to be sent to the newPendingTransactions subscriptions
+	PendingTxsPollInterval types.Duration `mapstructure:"PendingTxsPollInterval"`
+
+	// SyncingPollInterval is the interval used to check the syncing status of the node
+	// to notify the syncing subscriptions when the node enters or leaves the syncing status
+	SyncingPollInterval types.Duration `mapstructure:"SyncingPollInterval"`
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/0xPolygonHermez/zkevm-node/state"
 	"github.com/ethereum/go-ethereum/common"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/jackc/pgx/v4"
 )
 
@@ -114,6 +115,78 @@ func (d *DebugEndpoints) TraceBlockByHash(hash types.ArgHash, cfg *traceConfig)
 	})
 }
 
+// TraceBlock creates a response for debug_traceBlock request.
+// The block is provided RLP encoded and doesn't need to be stored in the state,
+// but its parent block must be.
+// See https://geth.ethereum.org/docs/interacting-with-geth/rpc/ns-debug#debugtraceblock
+func (d *DebugEndpoints) TraceBlock(input types.ArgBytes, cfg *traceConfig) (interface{}, types.Error) {
+	return d.txMan.NewDbTxScope(d.state, func(ctx context.Context, dbTx pgx.Tx) (interface{}, types.Error) {
+		var block ethTypes.Block
+		if err := rlp.DecodeBytes(input, &block); err != nil {
+			return RPCErrorResponse(types.InvalidParamsErrorCode, fmt.Sprintf("could not decode block: %v", err), nil, false)
+		}
+
+		results, err := d.state.DebugBlockTransactions(ctx, &block, newStateTraceConfig(cfg), dbTx)
+		if errors.Is(err, state.ErrNotFound) {
+			return nil, types.NewRPCError(types.DefaultErrorCode, fmt.Sprintf("parent block %s not found", block.ParentHash().String()))
+		} else if err != nil {
+			errorMessage := fmt.Sprintf("failed to get trace: %v", err.Error())
+			return nil, types.NewRPCError(types.DefaultErrorCode, errorMessage)
+		}
+
+		traces := make([]traceBlockTransactionResponse, 0, len(results))
+		for _, result := range results {
+			traces = append(traces, traceBlockTransactionResponse{
+				Result: result.TraceResult,
+			})
+		}
+
+		return traces, nil
+	})
+}
+
+// TraceCall creates a response for debug_traceCall request.
+// The call is executed on top of the provided block, as eth_call does, and its
+// trace is returned.
+// See https://geth.ethereum.org/docs/interacting-with-geth/rpc/ns-debug#debugtracecall
+func (d *DebugEndpoints) TraceCall(arg *types.TxArgs, blockArg *types.BlockNumberOrHash, cfg *traceConfig) (interface{}, types.Error) {
+	return d.txMan.NewDbTxScope(d.state, func(ctx context.Context, dbTx pgx.Tx) (interface{}, types.Error) {
+		if arg == nil {
+			return RPCErrorResponse(types.InvalidParamsErrorCode, "missing value for required argument 0", nil, false)
+		}
+		block, respErr := getBlockByArg(ctx, d.state, d.etherman, blockArg, dbTx)
+		if respErr != nil {
+			return nil, respErr
+		}
+
+		// If the caller didn't supply the gas limit in the message, then we set it to maximum possible => block gas limit
+		if arg.Gas == nil || uint64(*arg.Gas) <= 0 {
+			header, err := d.state.GetL2BlockHeaderByNumber(ctx, block.NumberU64(), dbTx)
+			if err != nil {
+				return RPCErrorResponse(types.DefaultErrorCode, "failed to get block header", err, true)
+			}
+
+			gas := types.ArgUint64(header.GasLimit)
+			arg.Gas = &gas
+		}
+
+		defaultSenderAddress := common.HexToAddress(state.DefaultSenderAddress)
+		sender, tx, err := arg.ToTransaction(ctx, d.state, state.MaxTxGasLimit, block.Root(), defaultSenderAddress, dbTx)
+		if err != nil {
+			return RPCErrorResponse(types.DefaultErrorCode, "failed to convert arguments into an unsigned transaction", err, false)
+		}
+
+		blockNumber := block.NumberU64()
+		result, err := d.state.DebugUnsignedTransaction(ctx, tx, sender, &blockNumber, newStateTraceConfig(cfg), dbTx)
+		if err != nil {
+			errorMessage := fmt.Sprintf("failed to get trace: %v", err.Error())
+			return nil, types.NewRPCError(types.DefaultErrorCode, errorMessage)
+		}
+
+		return result.TraceResult, nil
+	})
+}
+
 // TraceBatchByNumber creates a response for debug_traceBatchByNumber request.
 // this endpoint tries to help clients to get traces at once for all the transactions
 // attached to the same batch.
@@ -271,12 +344,26 @@ func (d *DebugEndpoints) buildTraceBlock(ctx context.Context, txs []*ethTypes.Tr
 }
 
 func (d *DebugEndpoints) buildTraceTransaction(ctx context.Context, hash common.Hash, cfg *traceConfig, dbTx pgx.Tx) (interface{}, types.Error) {
+	result, err := d.state.DebugTransaction(ctx, hash, newStateTraceConfig(cfg), dbTx)
+	if errors.Is(err, state.ErrNotFound) {
+		return RPCErrorResponse(types.DefaultErrorCode, "transaction not found", nil, false)
+	} else if err != nil {
+		errorMessage := fmt.Sprintf("failed to get trace: %v", err.Error())
+		return nil, types.NewRPCError(types.DefaultErrorCode, errorMessage)
+	}
+
+	return result.TraceResult, nil
+}
+
+// newStateTraceConfig converts the trace config received in the request
+// to the state trace config, using the default one if not provided
+func newStateTraceConfig(cfg *traceConfig) state.TraceConfig {
 	traceCfg := cfg
 	if traceCfg == nil {
 		traceCfg = defaultTraceConfig
 	}
 
-	stateTraceConfig := state.TraceConfig{
+	return state.TraceConfig{
 		DisableStack:     traceCfg.DisableStack,
 		DisableStorage:   traceCfg.DisableStorage,
 		EnableMemory:     traceCfg.EnableMemory,
@@ -284,15 +371,6 @@ func (d *DebugEndpoints) buildTraceTransaction(ctx context.Context, hash common.
 		Tracer:           traceCfg.Tracer,
 		TracerConfig:     traceCfg.TracerConfig,
 	}
-	result, err := d.state.DebugTransaction(ctx, hash, stateTraceConfig, dbTx)
-	if errors.Is(err, state.ErrNotFound) {
-		return RPCErrorResponse(types.DefaultErrorCode, "transaction not found", nil, false)
-	} else if err != nil {
-		errorMessage := fmt.Sprintf("failed to get trace: %v", err.Error())
-		return nil, types.NewRPCError(types.DefaultErrorCode, errorMessage)
-	}
-
-	return result.TraceResult, nil
 }
 
 // waitTimeout waits for the waitGroup for the specified max timeout.
@@ -0,0 +1,127 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-node/hex"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/0xPolygonHermez/zkevm-node/state/runtime"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newDebugTestBlock(t *testing.T) (*ethTypes.Block, []byte) {
+	to := common.HexToAddress("0x617b3a3528F9cDd6630fd3301B9c8911F7Bf063D")
+	txs := []*ethTypes.Transaction{
+		ethTypes.NewTransaction(0, to, big.NewInt(1), 21000, big.NewInt(1), nil),
+		ethTypes.NewTransaction(1, to, big.NewInt(2), 21000, big.NewInt(1), nil),
+	}
+	header := &ethTypes.Header{Number: big.NewInt(11), ParentHash: common.HexToHash("0xa"), Time: 1000}
+	block := ethTypes.NewBlock(header, txs, nil, nil, trie.NewStackTrie(nil))
+	encoded, err := rlp.EncodeToBytes(block)
+	require.NoError(t, err)
+	return block, encoded
+}
+
+func TestTraceBlock(t *testing.T) {
+	s, m, _ := newSequencerMockedServer(t)
+	defer s.Stop()
+
+	block, encoded := newDebugTestBlock(t)
+
+	m.DbTx.On("Commit", context.Background()).Return(nil).Once()
+	m.State.On("BeginStateTransaction", context.Background()).Return(m.DbTx, nil).Once()
+	m.State.
+		On("DebugBlockTransactions", context.Background(), mock.MatchedBy(func(b *ethTypes.Block) bool {
+			return b.Hash() == block.Hash() && len(b.Transactions()) == 2
+		}), mock.Anything, m.DbTx).
+		Return([]*runtime.ExecutionResult{
+			{TraceResult: json.RawMessage(`{"gas":21000,"failed":false}`)},
+			{TraceResult: json.RawMessage(`{"gas":21000,"failed":true}`)},
+		}, nil).
+		Once()
+
+	res, err := s.JSONRPCCall("debug_traceBlock", hex.EncodeToHex(encoded))
+	require.NoError(t, err)
+	require.Nil(t, res.Error)
+
+	var result []traceBlockTransactionResponse
+	require.NoError(t, json.Unmarshal(res.Result, &result))
+	require.Len(t, result, 2)
+	assert.Equal(t, map[string]interface{}{"gas": float64(21000), "failed": false}, result[0].Result)
+	assert.Equal(t, map[string]interface{}{"gas": float64(21000), "failed": true}, result[1].Result)
+}
+
+func TestTraceBlockErrors(t *testing.T) {
+	s, m, _ := newSequencerMockedServer(t)
+	defer s.Stop()
+
+	_, encoded := newDebugTestBlock(t)
+
+	// the block can't be decoded
+	m.DbTx.On("Rollback", context.Background()).Return(nil).Once()
+	m.State.On("BeginStateTransaction", context.Background()).Return(m.DbTx, nil).Once()
+	res, err := s.JSONRPCCall("debug_traceBlock", "0x1234")
+	require.NoError(t, err)
+	require.NotNil(t, res.Error)
+	assert.Equal(t, -32602, res.Error.Code)
+
+	// the parent block isn't in the state
+	m.DbTx.On("Rollback", context.Background()).Return(nil).Once()
+	m.State.On("BeginStateTransaction", context.Background()).Return(m.DbTx, nil).Once()
+	m.State.
+		On("DebugBlockTransactions", context.Background(), mock.Anything, mock.Anything, m.DbTx).
+		Return(nil, state.ErrNotFound).
+		Once()
+	res, err = s.JSONRPCCall("debug_traceBlock", hex.EncodeToHex(encoded))
+	require.NoError(t, err)
+	require.NotNil(t, res.Error)
+	assert.Equal(t, "parent block 0x000000000000000000000000000000000000000000000000000000000000000a not found", res.Error.Message)
+}
+
+func TestTraceCall(t *testing.T) {
+	s, m, _ := newSequencerMockedServer(t)
+	defer s.Stop()
+
+	to := common.HexToAddress("0x617b3a3528F9cDd6630fd3301B9c8911F7Bf063D")
+	block := state.NewL2BlockWithHeader(state.NewL2Header(&ethTypes.Header{Number: big.NewInt(10), Root: common.HexToHash("0x1")}))
+	blockNumber := uint64(10)
+
+	m.DbTx.On("Commit", context.Background()).Return(nil).Once()
+	m.State.On("BeginStateTransaction", context.Background()).Return(m.DbTx, nil).Once()
+	m.State.On("GetL2BlockByNumber", context.Background(), blockNumber, m.DbTx).Return(block, nil).Once()
+	m.State.
+		On("DebugUnsignedTransaction", context.Background(), mock.MatchedBy(func(tx *ethTypes.Transaction) bool {
+			return tx.To() != nil && *tx.To() == to && tx.Gas() == 30000 && tx.Value().Uint64() == 5
+		}), common.HexToAddress(state.DefaultSenderAddress), &blockNumber, mock.Anything, m.DbTx).
+		Return(&runtime.ExecutionResult{TraceResult: json.RawMessage(`{"gas":21000}`)}, nil).
+		Once()
+
+	args := map[string]interface{}{"to": to.String(), "gas": "0x7530", "value": "0x5"}
+	res, err := s.JSONRPCCall("debug_traceCall", args, "0xa")
+	require.NoError(t, err)
+	require.Nil(t, res.Error)
+	assert.JSONEq(t, `{"gas":21000}`, string(res.Result))
+
+	m.DbTx.On("Rollback", context.Background()).Return(nil).Once()
+	m.State.On("BeginStateTransaction", context.Background()).Return(m.DbTx, nil).Once()
+	m.State.On("GetL2BlockByNumber", context.Background(), blockNumber, m.DbTx).Return(block, nil).Once()
+	m.State.
+		On("DebugUnsignedTransaction", context.Background(), mock.Anything, mock.Anything, mock.Anything, mock.Anything, m.DbTx).
+		Return(nil, errors.New("executor failure")).
+		Once()
+
+	res, err = s.JSONRPCCall("debug_traceCall", args, "0xa")
+	require.NoError(t, err)
+	require.NotNil(t, res.Error)
+	assert.Equal(t, "failed to get trace: executor failure", res.Error.Message)
+}
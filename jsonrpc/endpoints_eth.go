@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"github.com/0xPolygonHermez/zkevm-node/state/runtime"
 	"github.com/0xPolygonHermez/zkevm-node/state/runtime/executor"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/jackc/pgx/v4"
 )
@@ -27,6 +29,14 @@ import (
 const (
 	// maxTopics is the max number of topics a log can have
 	maxTopics = 4
+	// maxRewardPercentile is the max reward percentile that can be requested to eth_feeHistory
+	maxRewardPercentile = 100
+	// defaultPendingTxsPollInterval is the interval used to check for new pending txs
+	// when the WebSockets.PendingTxsPollInterval is not set
+	defaultPendingTxsPollInterval = time.Second
+	// defaultSyncingPollInterval is the interval used to check the syncing status
+	// when the WebSockets.SyncingPollInterval is not set
+	defaultSyncingPollInterval = 5 * time.Second
 )
 
 // EthEndpoints contains implementations for the "eth" RPC endpoints
@@ -38,6 +48,9 @@ type EthEndpoints struct {
 	etherman types.EthermanInterface
 	storage  storageInterface
 	txMan    DBTxManager
+
+	pendingTxsWatcherOnce sync.Once
+	syncingWatcherOnce    sync.Once
 }
 
 // NewEthEndpoints creates an new instance of Eth
@@ -64,7 +77,9 @@ func (e *EthEndpoints) BlockNumber() (interface{}, types.Error) {
 // executed contract and potential error.
 // Note, this function doesn't make any changes in the state/blockchain and is
 // useful to execute view/pure methods and retrieve values.
-func (e *EthEndpoints) Call(arg *types.TxArgs, blockArg *types.BlockNumberOrHash) (interface{}, types.Error) {
+// The optional state and block overrides allow to execute the call against
+// hypothetical state and block context, without deploying anything.
+func (e *EthEndpoints) Call(arg *types.TxArgs, blockArg *types.BlockNumberOrHash, stateOverride *types.StateOverride, blockOverride *types.BlockOverride) (interface{}, types.Error) {
 	return e.txMan.NewDbTxScope(e.state, func(ctx context.Context, dbTx pgx.Tx) (interface{}, types.Error) {
 		if arg == nil {
 			return RPCErrorResponse(types.InvalidParamsErrorCode, "missing value for required argument 0", nil, false)
@@ -103,8 +118,10 @@ func (e *EthEndpoints) Call(arg *types.TxArgs, blockArg *types.BlockNumberOrHash
 			return RPCErrorResponse(types.DefaultErrorCode, "failed to convert arguments into an unsigned transaction", err, false)
 		}
 
-		result, err := e.state.ProcessUnsignedTransaction(ctx, tx, sender, blockToProcess, true, dbTx)
-		if err != nil {
+		result, err := e.state.ProcessUnsignedTransaction(ctx, tx, sender, blockToProcess, true, stateOverride.ToStateOverride(), blockOverride.ToBlockOverride(), dbTx)
+		if isOverrideError(err) {
+			return RPCErrorResponse(types.InvalidParamsErrorCode, err.Error(), nil, false)
+		} else if err != nil {
 			errMsg := fmt.Sprintf("failed to execute the unsigned transaction: %v", err.Error())
 			logError := !executor.IsROMOutOfCountersError(executor.RomErrorCode(err)) && !errors.Is(err, runtime.ErrOutOfGas)
 			return RPCErrorResponse(types.DefaultErrorCode, errMsg, nil, logError)
@@ -159,7 +176,9 @@ func (e *EthEndpoints) getCoinbaseFromSequencerNode() (interface{}, types.Error)
 // Note that the estimate may be significantly more than the amount of gas actually
 // used by the transaction, for a variety of reasons including EVM mechanics and
 // node performance.
-func (e *EthEndpoints) EstimateGas(arg *types.TxArgs, blockArg *types.BlockNumberOrHash) (interface{}, types.Error) {
+// The optional state and block overrides allow to estimate the gas against
+// hypothetical state and block context, without deploying anything.
+func (e *EthEndpoints) EstimateGas(arg *types.TxArgs, blockArg *types.BlockNumberOrHash, stateOverride *types.StateOverride, blockOverride *types.BlockOverride) (interface{}, types.Error) {
 	return e.txMan.NewDbTxScope(e.state, func(ctx context.Context, dbTx pgx.Tx) (interface{}, types.Error) {
 		if arg == nil {
 			return RPCErrorResponse(types.InvalidParamsErrorCode, "missing value for required argument 0", nil, false)
@@ -187,8 +206,10 @@ func (e *EthEndpoints) EstimateGas(arg *types.TxArgs, blockArg *types.BlockNumbe
 			return RPCErrorResponse(types.DefaultErrorCode, "failed to convert arguments into an unsigned transaction", err, false)
 		}
 
-		gasEstimation, returnValue, err := e.state.EstimateGas(tx, sender, blockToProcess, dbTx)
-		if errors.Is(err, runtime.ErrExecutionReverted) {
+		gasEstimation, returnValue, err := e.state.EstimateGas(tx, sender, blockToProcess, stateOverride.ToStateOverride(), blockOverride.ToBlockOverride(), dbTx)
+		if isOverrideError(err) {
+			return RPCErrorResponse(types.InvalidParamsErrorCode, err.Error(), nil, false)
+		} else if errors.Is(err, runtime.ErrExecutionReverted) {
 			data := make([]byte, len(returnValue))
 			copy(data, returnValue)
 			return nil, types.NewRPCErrorWithData(types.RevertedErrorCode, err.Error(), data)
@@ -199,6 +220,14 @@ func (e *EthEndpoints) EstimateGas(arg *types.TxArgs, blockArg *types.BlockNumbe
 	})
 }
 
+// isOverrideError checks if the error was caused by invalid state or block overrides
+func isOverrideError(err error) bool {
+	return errors.Is(err, state.ErrStateAndStateDiffOverride) ||
+		errors.Is(err, state.ErrInvalidTimestampOverride) ||
+		errors.Is(err, state.ErrInvalidBlockNumberOverride) ||
+		errors.Is(err, state.ErrBlockNumberOverrideNotSupported)
+}
+
 // GasPrice returns the average gas price based on the last x blocks
 func (e *EthEndpoints) GasPrice() (interface{}, types.Error) {
 	ctx := context.Background()
@@ -212,6 +241,150 @@ func (e *EthEndpoints) GasPrice() (interface{}, types.Error) {
 	return hex.EncodeUint64(gasPrices.L2GasPrice), nil
 }
 
+// MaxPriorityFeePerGas returns the suggested tip per gas for dynamic fee txs,
+// that is the gas price minus the L2 base fee
+func (e *EthEndpoints) MaxPriorityFeePerGas() (interface{}, types.Error) {
+	var gasPrice uint64
+	if e.cfg.SequencerNodeURI != "" {
+		res, rpcErr := e.getPriceFromSequencerNode()
+		if rpcErr != nil {
+			return nil, rpcErr
+		}
+		gasPrice = uint64(res.(types.ArgUint64))
+	} else {
+		gasPrices, err := e.pool.GetGasPrices(context.Background())
+		if err != nil {
+			return "0x0", nil
+		}
+		gasPrice = gasPrices.L2GasPrice
+	}
+
+	if gasPrice <= e.cfg.L2BaseFee {
+		return hex.EncodeUint64(0), nil
+	}
+	return hex.EncodeUint64(gasPrice - e.cfg.L2BaseFee), nil
+}
+
+// FeeHistory returns the base fee per gas, the gas used ratio and the requested
+// reward percentiles of the blockCount L2 blocks ending at newestBlock
+func (e *EthEndpoints) FeeHistory(blockCount math.HexOrDecimal64, newestBlock types.BlockNumber, rewardPercentiles []float64) (interface{}, types.Error) {
+	return e.txMan.NewDbTxScope(e.state, func(ctx context.Context, dbTx pgx.Tx) (interface{}, types.Error) {
+		for i, p := range rewardPercentiles {
+			if p < 0 || p > maxRewardPercentile {
+				return RPCErrorResponse(types.InvalidParamsErrorCode, fmt.Sprintf("invalid reward percentile: %v", p), nil, false)
+			}
+			if i > 0 && p < rewardPercentiles[i-1] {
+				return RPCErrorResponse(types.InvalidParamsErrorCode, fmt.Sprintf("invalid reward percentile: #%d:%v > #%d:%v", i-1, rewardPercentiles[i-1], i, p), nil, false)
+			}
+		}
+
+		newestBlockNumber, rpcErr := newestBlock.GetNumericBlockNumber(ctx, e.state, e.etherman, dbTx)
+		if rpcErr != nil {
+			return nil, rpcErr
+		}
+		lastBlockNumber, err := e.state.GetLastL2BlockNumber(ctx, dbTx)
+		if err != nil {
+			return RPCErrorResponse(types.DefaultErrorCode, "failed to get the last block number from state", err, true)
+		}
+		if newestBlockNumber > lastBlockNumber {
+			newestBlockNumber = lastBlockNumber
+		}
+
+		count := uint64(blockCount)
+		if e.cfg.FeeHistoryMaxBlockCount > 0 && count > e.cfg.FeeHistoryMaxBlockCount {
+			count = e.cfg.FeeHistoryMaxBlockCount
+		}
+		if count > newestBlockNumber+1 {
+			count = newestBlockNumber + 1
+		}
+
+		baseFee := new(big.Int).SetUint64(e.cfg.L2BaseFee)
+		feeHistory := types.FeeHistory{
+			OldestBlock:   types.ArgUint64(newestBlockNumber + 1 - count),
+			BaseFeePerGas: make([]types.ArgBig, 0, count+1),
+			GasUsedRatio:  make([]float64, 0, count),
+		}
+		if count == 0 {
+			return feeHistory, nil
+		}
+
+		blocks, err := e.state.GetL2BlocksFeeHistory(ctx, uint64(feeHistory.OldestBlock), newestBlockNumber, dbTx)
+		if err != nil {
+			return RPCErrorResponse(types.DefaultErrorCode, "failed to get fee history from state", err, true)
+		}
+		if uint64(len(blocks)) != count {
+			return RPCErrorResponse(types.DefaultErrorCode, fmt.Sprintf("failed to get blocks %v to %v from state", feeHistory.OldestBlock, newestBlockNumber), nil, true)
+		}
+
+		for _, block := range blocks {
+			gasUsedRatio := float64(0)
+			if block.GasLimit > 0 {
+				gasUsedRatio = float64(block.GasUsed) / float64(block.GasLimit)
+			}
+			feeHistory.BaseFeePerGas = append(feeHistory.BaseFeePerGas, types.ArgBig(*baseFee))
+			feeHistory.GasUsedRatio = append(feeHistory.GasUsedRatio, gasUsedRatio)
+
+			if len(rewardPercentiles) == 0 {
+				continue
+			}
+
+			rewards, percentages := feeHistoryRewards(block.TxsFeeInfo, baseFee, rewardPercentiles)
+			feeHistory.Reward = append(feeHistory.Reward, rewards)
+			feeHistory.EffectiveGasPricePercentage = append(feeHistory.EffectiveGasPricePercentage, percentages)
+		}
+		// the base fee of the next block
+		feeHistory.BaseFeePerGas = append(feeHistory.BaseFeePerGas, types.ArgBig(*baseFee))
+
+		return feeHistory, nil
+	})
+}
+
+// feeHistoryRewards computes the reward percentiles of a block weighted by the gas used
+// by its txs, along with the effective gas price percentage applied to each of them
+func feeHistoryRewards(txsFeeInfo []state.TxFeeInfo, baseFee *big.Int, rewardPercentiles []float64) ([]types.ArgBig, []types.ArgUint64) {
+	rewards := make([]types.ArgBig, len(rewardPercentiles))
+	percentages := make([]types.ArgUint64, len(rewardPercentiles))
+	if len(txsFeeInfo) == 0 {
+		// return an all zero rewards for empty blocks
+		for i := range rewards {
+			rewards[i] = types.ArgBig(*big.NewInt(0))
+		}
+		return rewards, percentages
+	}
+
+	type txReward struct {
+		gasUsed    uint64
+		reward     *big.Int
+		percentage uint8
+	}
+	var totalGasUsed uint64
+	txRewards := make([]txReward, len(txsFeeInfo))
+	for i, txFeeInfo := range txsFeeInfo {
+		reward := new(big.Int).Sub(txFeeInfo.EffectiveGasPrice, baseFee)
+		if reward.Sign() < 0 {
+			reward.SetUint64(0)
+		}
+		txRewards[i] = txReward{gasUsed: txFeeInfo.GasUsed, reward: reward, percentage: txFeeInfo.EffectivePercentage}
+		totalGasUsed += txFeeInfo.GasUsed
+	}
+	sort.SliceStable(txRewards, func(i, j int) bool {
+		return txRewards[i].reward.Cmp(txRewards[j].reward) < 0
+	})
+
+	var txIndex int
+	sumGasUsed := txRewards[0].gasUsed
+	for i, p := range rewardPercentiles {
+		thresholdGasUsed := uint64(float64(totalGasUsed) * p / maxRewardPercentile)
+		for sumGasUsed < thresholdGasUsed && txIndex < len(txRewards)-1 {
+			txIndex++
+			sumGasUsed += txRewards[txIndex].gasUsed
+		}
+		rewards[i] = types.ArgBig(*txRewards[txIndex].reward)
+		percentages[i] = types.ArgUint64(txRewards[txIndex].percentage)
+	}
+	return rewards, percentages
+}
+
 func (e *EthEndpoints) getPriceFromSequencerNode() (interface{}, types.Error) {
 	res, err := client.JSONRPCCall(e.cfg.SequencerNodeURI, "eth_gasPrice")
 	if err != nil {
@@ -247,6 +420,23 @@ func (e *EthEndpoints) getHighestL2BlockFromTrustedNode() (interface{}, types.Er
 	return uint64(highestBlockNum), nil
 }
 
+func (e *EthEndpoints) getLastBatchNumberFromTrustedNode() (interface{}, types.Error) {
+	res, err := client.JSONRPCCall(e.cfg.SequencerNodeURI, "zkevm_batchNumber")
+	if err != nil {
+		return RPCErrorResponse(types.DefaultErrorCode, "failed to get batch number from sequencer node", err, true)
+	}
+
+	if res.Error != nil {
+		return RPCErrorResponse(res.Error.Code, res.Error.Message, nil, false)
+	}
+	var lastBatchNum types.ArgUint64
+	err = json.Unmarshal(res.Result, &lastBatchNum)
+	if err != nil {
+		return RPCErrorResponse(types.DefaultErrorCode, "failed to read zkevm_batchNumber from sequencer node", err, true)
+	}
+	return uint64(lastBatchNum), nil
+}
+
 // GetBalance returns the account's balance at the referenced block
 func (e *EthEndpoints) GetBalance(address types.ArgAddress, blockArg *types.BlockNumberOrHash) (interface{}, types.Error) {
 	return e.txMan.NewDbTxScope(e.state, func(ctx context.Context, dbTx pgx.Tx) (interface{}, types.Error) {
@@ -267,9 +457,13 @@ func (e *EthEndpoints) GetBalance(address types.ArgAddress, blockArg *types.Bloc
 }
 
 func (e *EthEndpoints) getBlockByArg(ctx context.Context, blockArg *types.BlockNumberOrHash, dbTx pgx.Tx) (*state.L2Block, types.Error) {
+	return getBlockByArg(ctx, e.state, e.etherman, blockArg, dbTx)
+}
+
+func getBlockByArg(ctx context.Context, st types.StateInterface, etherman types.EthermanInterface, blockArg *types.BlockNumberOrHash, dbTx pgx.Tx) (*state.L2Block, types.Error) {
 	// If no block argument is provided, return the latest block
 	if blockArg == nil {
-		block, err := e.state.GetLastL2Block(ctx, dbTx)
+		block, err := st.GetLastL2Block(ctx, dbTx)
 		if err != nil {
 			return nil, types.NewRPCError(types.DefaultErrorCode, "failed to get the last block number from state")
 		}
@@ -278,7 +472,7 @@ func (e *EthEndpoints) getBlockByArg(ctx context.Context, blockArg *types.BlockN
 
 	// If we have a block hash, try to get the block by hash
 	if blockArg.IsHash() {
-		block, err := e.state.GetL2BlockByHash(ctx, blockArg.Hash().Hash(), dbTx)
+		block, err := st.GetL2BlockByHash(ctx, blockArg.Hash().Hash(), dbTx)
 		if errors.Is(err, state.ErrNotFound) {
 			return nil, types.NewRPCError(types.DefaultErrorCode, "header for hash not found")
 		} else if err != nil {
@@ -288,11 +482,11 @@ func (e *EthEndpoints) getBlockByArg(ctx context.Context, blockArg *types.BlockN
 	}
 
 	// Otherwise, try to get the block by number
-	blockNum, rpcErr := blockArg.Number().GetNumericBlockNumber(ctx, e.state, e.etherman, dbTx)
+	blockNum, rpcErr := blockArg.Number().GetNumericBlockNumber(ctx, st, etherman, dbTx)
 	if rpcErr != nil {
 		return nil, rpcErr
 	}
-	block, err := e.state.GetL2BlockByNumber(context.Background(), blockNum, dbTx)
+	block, err := st.GetL2BlockByNumber(context.Background(), blockNum, dbTx)
 	if errors.Is(err, state.ErrNotFound) || block == nil {
 		return nil, types.NewRPCError(types.DefaultErrorCode, "header not found")
 	} else if err != nil {
@@ -455,6 +649,16 @@ func (e *EthEndpoints) GetFilterChanges(filterID string) (interface{}, types.Err
 			if len(res) == 0 {
 				return nil, nil
 			}
+			if filterParameters, ok := filter.Parameters.(PendingTxFilter); ok && filterParameters.FullTx {
+				txs, err := e.getPendingTxs(context.Background(), res)
+				if err != nil {
+					return RPCErrorResponse(types.DefaultErrorCode, "failed to get pending transactions", err, true)
+				}
+				if len(txs) == 0 {
+					return nil, nil
+				}
+				return txs, nil
+			}
 			return res, nil
 		}
 	case FilterTypeLog:
@@ -784,6 +988,23 @@ func (e *EthEndpoints) getTransactionCountFromSequencerNode(address common.Addre
 	return nonce, nil
 }
 
+// GetBlockReceipts returns the receipts of all the transactions of a block
+func (e *EthEndpoints) GetBlockReceipts(blockArg types.BlockNumberOrHash) (interface{}, types.Error) {
+	return e.txMan.NewDbTxScope(e.state, func(ctx context.Context, dbTx pgx.Tx) (interface{}, types.Error) {
+		block, rpcErr := e.getBlockByArg(ctx, &blockArg, dbTx)
+		if rpcErr != nil {
+			return nil, rpcErr
+		}
+
+		receipts, txs, err := e.state.GetReceiptsByL2BlockNumber(ctx, block.NumberU64(), dbTx)
+		if err != nil {
+			return RPCErrorResponse(types.DefaultErrorCode, "failed to get block receipts from state", err, true)
+		}
+
+		return newReceipts(receipts, txs)
+	})
+}
+
 // GetBlockTransactionCountByHash returns the number of transactions in a
 // block from a block matching the given block hash.
 func (e *EthEndpoints) GetBlockTransactionCountByHash(hash types.ArgHash) (interface{}, types.Error) {
@@ -871,6 +1092,20 @@ func (e *EthEndpoints) GetTransactionReceipt(hash types.ArgHash) (interface{}, t
 	})
 }
 
+// newReceipts builds the receipts response for the provided receipts and
+// the txs that generated them
+func newReceipts(receipts []*ethTypes.Receipt, txs []ethTypes.Transaction) (interface{}, types.Error) {
+	res := make([]types.Receipt, 0, len(receipts))
+	for i, r := range receipts {
+		receipt, err := types.NewReceipt(txs[i], r, nil)
+		if err != nil {
+			return RPCErrorResponse(types.DefaultErrorCode, "failed to build the receipt response", err, true)
+		}
+		res = append(res, receipt)
+	}
+	return res, nil
+}
+
 // NewBlockFilter creates a filter in the node, to notify when
 // a new block arrives. To check if the state has changed,
 // call eth_getFilterChanges.
@@ -919,19 +1154,50 @@ func (e *EthEndpoints) newFilter(ctx context.Context, wsConn *concurrentWsConn,
 // NewPendingTransactionFilter creates a filter in the node, to
 // notify when new pending transactions arrive. To check if the
 // state has changed, call eth_getFilterChanges.
-func (e *EthEndpoints) NewPendingTransactionFilter() (interface{}, types.Error) {
-	return e.newPendingTransactionFilter(nil)
+// If fullTx is true the filter returns the full transactions
+// instead of only their hashes.
+func (e *EthEndpoints) NewPendingTransactionFilter(fullTx *bool) (interface{}, types.Error) {
+	return e.newPendingTransactionFilter(nil, fullTx != nil && *fullTx)
 }
 
 // internal
-func (e *EthEndpoints) newPendingTransactionFilter(wsConn *concurrentWsConn) (interface{}, types.Error) {
-	return nil, types.NewRPCError(types.DefaultErrorCode, "not supported yet")
-	// id, err := e.storage.NewPendingTransactionFilter(wsConn)
-	// if err != nil {
-	// 	return rpcErrorResponse(types.DefaultErrorCode, "failed to create new pending transaction filter", err)
-	// }
+func (e *EthEndpoints) newPendingTransactionFilter(wsConn *concurrentWsConn, fullTx bool) (interface{}, types.Error) {
+	id, err := e.storage.NewPendingTransactionFilter(wsConn, PendingTxFilter{FullTx: fullTx})
+	if err != nil {
+		return RPCErrorResponse(types.DefaultErrorCode, "failed to create new pending transaction filter", err, true)
+	}
+
+	if wsConn != nil {
+		// the pool doesn't notify about new txs, so they are polled
+		// once there is at least one subscription interested on them
+		e.pendingTxsWatcherOnce.Do(func() {
+			go state.InfiniteSafeRun(e.watchPendingTxs, "failed to watch pending transactions: %v", time.Second)
+		})
+	}
+
+	return id, nil
+}
 
-	// return id, nil
+// getPendingTxs loads from the pool the txs of the provided hashes that are still pending
+func (e *EthEndpoints) getPendingTxs(ctx context.Context, hashes []common.Hash) ([]*types.Transaction, error) {
+	txs := make([]*types.Transaction, 0, len(hashes))
+	for _, hash := range hashes {
+		poolTx, err := e.pool.GetTransactionByHash(ctx, hash)
+		if errors.Is(err, pool.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if poolTx.Status != pool.TxStatusPending {
+			continue
+		}
+		tx, err := types.NewTransaction(poolTx.Transaction, nil, false, nil)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
 }
 
 // SendRawTransaction has two different ways to handle new transactions:
@@ -1018,20 +1284,7 @@ func (e *EthEndpoints) Syncing() (interface{}, types.Error) {
 		if !syncInfo.IsSynchronizing {
 			return false, nil
 		}
-		if e.cfg.SequencerNodeURI != "" {
-			// If we have a trusted node we ask it for the highest l2 block
-			res, err := e.getHighestL2BlockFromTrustedNode()
-			if err != nil {
-				log.Warnf("failed to get highest l2 block from trusted node: %v", err)
-			} else {
-				highestL2BlockInTrusted := res.(uint64)
-				if highestL2BlockInTrusted > syncInfo.CurrentBlockNumber {
-					syncInfo.EstimatedHighestBlock = highestL2BlockInTrusted
-				} else {
-					log.Warnf("highest l2 block in trusted node (%d) is lower than the current block number in the state (%d)", highestL2BlockInTrusted, syncInfo.CurrentBlockNumber)
-				}
-			}
-		}
+		e.estimateHighestBlockFromTrustedNode(&syncInfo)
 		return struct {
 			S types.ArgUint64 `json:"startingBlock"`
 			C types.ArgUint64 `json:"currentBlock"`
@@ -1044,6 +1297,26 @@ func (e *EthEndpoints) Syncing() (interface{}, types.Error) {
 	})
 }
 
+// estimateHighestBlockFromTrustedNode updates the estimated highest block with the
+// highest l2 block of the trusted node, if there is one
+func (e *EthEndpoints) estimateHighestBlockFromTrustedNode(syncInfo *state.SyncingInfo) {
+	if e.cfg.SequencerNodeURI == "" {
+		return
+	}
+	// If we have a trusted node we ask it for the highest l2 block
+	res, err := e.getHighestL2BlockFromTrustedNode()
+	if err != nil {
+		log.Warnf("failed to get highest l2 block from trusted node: %v", err)
+		return
+	}
+	highestL2BlockInTrusted := res.(uint64)
+	if highestL2BlockInTrusted > syncInfo.CurrentBlockNumber {
+		syncInfo.EstimatedHighestBlock = highestL2BlockInTrusted
+	} else {
+		log.Warnf("highest l2 block in trusted node (%d) is lower than the current block number in the state (%d)", highestL2BlockInTrusted, syncInfo.CurrentBlockNumber)
+	}
+}
+
 // GetUncleByBlockHashAndIndex returns information about a uncle of a
 // block by hash and uncle index position
 func (e *EthEndpoints) GetUncleByBlockHashAndIndex(hash types.ArgHash, index types.Index) (interface{}, types.Error) {
@@ -1100,11 +1373,21 @@ func (e *EthEndpoints) updateFilterLastPoll(filterID string) types.Error {
 // The node will return a subscription id.
 // For each event that matches the subscription a notification with relevant
 // data is sent together with the subscription id.
-func (e *EthEndpoints) Subscribe(wsConn *concurrentWsConn, name string, logFilter *LogFilter) (interface{}, types.Error) {
+//
+// The params depend on the subscription: "logs" accepts a log filter and
+// "newPendingTransactions" accepts a boolean to receive the full transactions
+// instead of only their hashes.
+func (e *EthEndpoints) Subscribe(wsConn *concurrentWsConn, name string, params json.RawMessage) (interface{}, types.Error) {
 	switch name {
 	case "newHeads":
 		return e.newBlockFilter(wsConn)
 	case "logs":
+		var logFilter *LogFilter
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &logFilter); err != nil {
+				return RPCErrorResponse(types.InvalidParamsErrorCode, "invalid log filter", err, false)
+			}
+		}
 		return e.txMan.NewDbTxScope(e.state, func(ctx context.Context, dbTx pgx.Tx) (interface{}, types.Error) {
 			var lf LogFilter
 			if logFilter != nil {
@@ -1113,9 +1396,15 @@ func (e *EthEndpoints) Subscribe(wsConn *concurrentWsConn, name string, logFilte
 			return e.newFilter(ctx, wsConn, lf, dbTx)
 		})
 	case "pendingTransactions", "newPendingTransactions":
-		return e.newPendingTransactionFilter(wsConn)
+		var fullTx *bool
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &fullTx); err != nil {
+				return RPCErrorResponse(types.InvalidParamsErrorCode, "invalid full transaction flag", err, false)
+			}
+		}
+		return e.newPendingTransactionFilter(wsConn, fullTx != nil && *fullTx)
 	case "syncing":
-		return nil, types.NewRPCError(types.DefaultErrorCode, "not supported yet")
+		return e.newSyncingFilter(wsConn)
 	default:
 		return nil, types.NewRPCError(types.DefaultErrorCode, "invalid filter name")
 	}
@@ -1216,6 +1505,243 @@ func (e *EthEndpoints) notifyNewLogs(wg *sync.WaitGroup, event state.NewL2BlockE
 	log.Debugf("[notifyNewLogs] new l2 block event for block %v took %v to send all the messages for log filters", event.Block.NumberU64(), time.Since(start))
 }
 
+// watchPendingTxs checks the pool periodically for new pending txs and
+// sends them to the pending tx filters with a web socket connection
+func (e *EthEndpoints) watchPendingTxs() {
+	interval := e.cfg.WebSockets.PendingTxsPollInterval.Duration
+	if interval <= 0 {
+		interval = defaultPendingTxsPollInterval
+	}
+
+	// the txs are polled by the pool tx id instead of by the time they were
+	// received, so no tx is missed or repeated because of the clocks
+	lastTxID, err := e.pool.GetLastTxID(context.Background())
+	if err != nil {
+		log.Errorf("failed to get the last pool tx id: %v", err)
+		return
+	}
+	for {
+		time.Sleep(interval)
+		newLastTxID, err := e.notifyNewPendingTxs(lastTxID)
+		if err != nil {
+			// keep the same tx id to retry the txs on the next interval
+			log.Errorf("failed to notify new pending txs: %v", err)
+			continue
+		}
+		lastTxID = newLastTxID
+	}
+}
+
+// notifyNewPendingTxs sends the txs added to the pool after the tx with the
+// provided id to the pending tx filters with a web socket connection, it
+// returns the id of the last tx sent
+func (e *EthEndpoints) notifyNewPendingTxs(lastTxID uint64) (uint64, error) {
+	start := time.Now()
+
+	ctx := context.Background()
+	filters := e.storage.GetAllPendingTxFiltersWithWSConn()
+	if len(filters) == 0 {
+		// the txs added while there are no filters are skipped
+		return e.pool.GetLastTxID(ctx)
+	}
+
+	hashes, newLastTxID, err := e.pool.GetPendingTxHashesSinceID(ctx, lastTxID)
+	if err != nil {
+		return lastTxID, err
+	}
+	if len(hashes) == 0 {
+		return newLastTxID, nil
+	}
+
+	hashesData := make([][]byte, 0, len(hashes))
+	for _, hash := range hashes {
+		data, err := json.Marshal(hash)
+		if err != nil {
+			return lastTxID, err
+		}
+		hashesData = append(hashesData, data)
+	}
+
+	// the full txs are only loaded if some filter requires them
+	var txsData [][]byte
+	for _, filter := range filters {
+		if filterParameters, ok := filter.Parameters.(PendingTxFilter); ok && filterParameters.FullTx {
+			txs, err := e.getPendingTxs(ctx, hashes)
+			if err != nil {
+				return lastTxID, err
+			}
+			txsData = make([][]byte, 0, len(txs))
+			for _, tx := range txs {
+				data, err := json.Marshal(tx)
+				if err != nil {
+					return lastTxID, err
+				}
+				txsData = append(txsData, data)
+			}
+			break
+		}
+	}
+
+	const maxWorkers = 32
+	parallelize(maxWorkers, filters, func(worker int, filters []*Filter) {
+		for _, filter := range filters {
+			f := filter
+			data := hashesData
+			if filterParameters, ok := f.Parameters.(PendingTxFilter); ok && filterParameters.FullTx {
+				data = txsData
+			}
+			for _, d := range data {
+				f.EnqueueSubscriptionDataToBeSent(d)
+			}
+		}
+	})
+
+	log.Debugf("[notifyNewPendingTxs] took %v to send %v pending txs to the pending tx filters", time.Since(start), len(hashes))
+	return newLastTxID, nil
+}
+
+// syncingNotification is the data sent to the syncing subscriptions
+// every time the node enters or leaves the syncing status
+type syncingNotification struct {
+	Syncing bool          `json:"syncing"`
+	Status  syncingStatus `json:"status"`
+}
+
+// syncingStatus contains the progress of the synchronization, including
+// how many batches the node is behind the trusted sequencer and L1
+type syncingStatus struct {
+	StartingBlock      types.ArgUint64 `json:"startingBlock"`
+	CurrentBlock       types.ArgUint64 `json:"currentBlock"`
+	HighestBlock       types.ArgUint64 `json:"highestBlock"`
+	TrustedBatchesLag  types.ArgUint64 `json:"trustedBatchesLag"`
+	VirtualBatchesLag  types.ArgUint64 `json:"virtualBatchesLag"`
+	VerifiedBatchesLag types.ArgUint64 `json:"verifiedBatchesLag"`
+}
+
+// internal
+func (e *EthEndpoints) newSyncingFilter(wsConn *concurrentWsConn) (interface{}, types.Error) {
+	id, err := e.storage.NewSyncingFilter(wsConn)
+	if err != nil {
+		return RPCErrorResponse(types.DefaultErrorCode, "failed to create new syncing filter", err, true)
+	}
+
+	// the syncing status is computed from different sources, so it is
+	// polled once there is at least one subscription interested on it
+	e.syncingWatcherOnce.Do(func() {
+		go state.InfiniteSafeRun(e.watchSyncing, "failed to watch syncing status: %v", time.Second)
+	})
+
+	return id, nil
+}
+
+// watchSyncing checks periodically the syncing status of the node and
+// notifies the syncing filters with a web socket connection when it changes
+func (e *EthEndpoints) watchSyncing() {
+	interval := e.cfg.WebSockets.SyncingPollInterval.Duration
+	if interval <= 0 {
+		interval = defaultSyncingPollInterval
+	}
+
+	// last syncing status sent to each filter
+	notified := map[string]bool{}
+	for {
+		if err := e.notifySyncingStatus(notified); err != nil {
+			log.Errorf("failed to notify syncing status: %v", err)
+		}
+		time.Sleep(interval)
+	}
+}
+
+// notifySyncingStatus sends the current syncing status to the syncing filters
+// with a web socket connection that haven't been notified about it yet
+func (e *EthEndpoints) notifySyncingStatus(notified map[string]bool) error {
+	filters := e.storage.GetAllSyncingFiltersWithWSConn()
+
+	// forget the filters that were uninstalled
+	current := make(map[string]struct{}, len(filters))
+	for _, filter := range filters {
+		current[filter.ID] = struct{}{}
+	}
+	for id := range notified {
+		if _, found := current[id]; !found {
+			delete(notified, id)
+		}
+	}
+	if len(filters) == 0 {
+		return nil
+	}
+
+	notification, err := e.getSyncingNotification(context.Background())
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	for _, filter := range filters {
+		syncing, found := notified[filter.ID]
+		if found && syncing == notification.Syncing {
+			continue
+		}
+		filter.EnqueueSubscriptionDataToBeSent(data)
+		notified[filter.ID] = notification.Syncing
+	}
+
+	return nil
+}
+
+// getSyncingNotification builds the current syncing status of the node
+func (e *EthEndpoints) getSyncingNotification(ctx context.Context) (*syncingNotification, error) {
+	syncInfo, err := e.state.GetSyncingInfo(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get syncing info from state: %w", err)
+	}
+	if syncInfo.IsSynchronizing {
+		e.estimateHighestBlockFromTrustedNode(&syncInfo)
+	}
+
+	status := syncingStatus{
+		StartingBlock: types.ArgUint64(syncInfo.InitialSyncingBlock),
+		CurrentBlock:  types.ArgUint64(syncInfo.CurrentBlockNumber),
+		HighestBlock:  types.ArgUint64(syncInfo.EstimatedHighestBlock),
+	}
+
+	if e.cfg.SequencerNodeURI != "" {
+		res, rpcErr := e.getLastBatchNumberFromTrustedNode()
+		if rpcErr != nil {
+			log.Warnf("failed to get last batch number from trusted node: %v", rpcErr)
+		} else if lastTrustedBatchNumber := res.(uint64); lastTrustedBatchNumber > syncInfo.LastBatchNumber {
+			status.TrustedBatchesLag = types.ArgUint64(lastTrustedBatchNumber - syncInfo.LastBatchNumber)
+		}
+	}
+
+	lastVirtualBatchNumber, err := e.state.GetLastVirtualBatchNum(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last virtual batch number from state: %w", err)
+	}
+	if syncInfo.LastBatchNumberSeen > lastVirtualBatchNumber {
+		status.VirtualBatchesLag = types.ArgUint64(syncInfo.LastBatchNumberSeen - lastVirtualBatchNumber)
+	}
+
+	lastVerifiedBatchNumber := uint64(0)
+	lastVerifiedBatch, err := e.state.GetLastVerifiedBatch(ctx, nil)
+	if err != nil && !errors.Is(err, state.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get last verified batch from state: %w", err)
+	} else if lastVerifiedBatch != nil {
+		lastVerifiedBatchNumber = lastVerifiedBatch.BatchNumber
+	}
+	if syncInfo.LastBatchNumberConsolidated > lastVerifiedBatchNumber {
+		status.VerifiedBatchesLag = types.ArgUint64(syncInfo.LastBatchNumberConsolidated - lastVerifiedBatchNumber)
+	}
+
+	return &syncingNotification{
+		Syncing: syncInfo.IsSynchronizing,
+		Status:  status,
+	}, nil
+}
+
 // shouldSkipLogFilter checks if the log filter can be skipped while notifying new logs.
 // it checks the log filter information against the block in the event to decide if the
 // information in the event is required by the filter or can be ignored to save resources.
@@ -13,6 +13,7 @@ import (
 	"github.com/0xPolygonHermez/zkevm-node/encoding"
 	"github.com/0xPolygonHermez/zkevm-node/hex"
 	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/client"
+	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/mocks"
 	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/types"
 	"github.com/0xPolygonHermez/zkevm-node/pool"
 	"github.com/0xPolygonHermez/zkevm-node/state"
@@ -174,7 +175,7 @@ func TestCall(t *testing.T) {
 				m.State.On("GetL2BlockByNumber", context.Background(), blockNumOneUint64, m.DbTx).Return(block, nil).Once()
 				m.State.On("GetNonce", context.Background(), *txArgs.From, blockRoot).Return(nonce, nil).Once()
 				m.State.
-					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, &blockNumOneUint64, true, m.DbTx).
+					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, &blockNumOneUint64, true, state.StateOverride(nil), (*state.BlockOverride)(nil), m.DbTx).
 					Return(&runtime.ExecutionResult{ReturnValue: testCase.expectedResult}, nil).
 					Once()
 			},
@@ -218,7 +219,7 @@ func TestCall(t *testing.T) {
 				})
 				m.State.On("GetNonce", context.Background(), *txArgs.From, blockRoot).Return(nonce, nil).Once()
 				m.State.
-					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, &blockNumOneUint64, true, m.DbTx).
+					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, &blockNumOneUint64, true, state.StateOverride(nil), (*state.BlockOverride)(nil), m.DbTx).
 					Return(&runtime.ExecutionResult{ReturnValue: testCase.expectedResult}, nil).
 					Once()
 			},
@@ -260,7 +261,7 @@ func TestCall(t *testing.T) {
 				m.State.On("GetL2BlockByNumber", context.Background(), blockNumOneUint64, m.DbTx).Return(block, nil).Once()
 				m.State.On("GetNonce", context.Background(), *txArgs.From, blockRoot).Return(nonce, nil).Once()
 				m.State.
-					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, nilUint64, true, m.DbTx).
+					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, nilUint64, true, state.StateOverride(nil), (*state.BlockOverride)(nil), m.DbTx).
 					Return(&runtime.ExecutionResult{ReturnValue: testCase.expectedResult}, nil).
 					Once()
 			},
@@ -302,7 +303,7 @@ func TestCall(t *testing.T) {
 				})
 				m.State.On("GetNonce", context.Background(), *txArgs.From, blockRoot).Return(nonce, nil).Once()
 				m.State.
-					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, &blockNumTenUint64, true, m.DbTx).
+					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, &blockNumTenUint64, true, state.StateOverride(nil), (*state.BlockOverride)(nil), m.DbTx).
 					Return(&runtime.ExecutionResult{ReturnValue: testCase.expectedResult}, nil).
 					Once()
 			},
@@ -342,7 +343,7 @@ func TestCall(t *testing.T) {
 				m.State.On("GetL2BlockByNumber", context.Background(), blockNumTenUint64, m.DbTx).Return(block, nil).Once()
 				m.State.On("GetNonce", context.Background(), *txArgs.From, blockRoot).Return(nonce, nil).Once()
 				m.State.
-					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, &blockNumTenUint64, true, m.DbTx).
+					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, &blockNumTenUint64, true, state.StateOverride(nil), (*state.BlockOverride)(nil), m.DbTx).
 					Return(&runtime.ExecutionResult{ReturnValue: testCase.expectedResult}, nil).
 					Once()
 			},
@@ -381,7 +382,7 @@ func TestCall(t *testing.T) {
 				block := state.NewL2BlockWithHeader(state.NewL2Header(&ethTypes.Header{Number: blockNumOne, Root: blockRoot}))
 				m.State.On("GetL2BlockByNumber", context.Background(), blockNumOneUint64, m.DbTx).Return(block, nil).Once()
 				m.State.
-					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, common.HexToAddress(state.DefaultSenderAddress), nilUint64, true, m.DbTx).
+					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, common.HexToAddress(state.DefaultSenderAddress), nilUint64, true, state.StateOverride(nil), (*state.BlockOverride)(nil), m.DbTx).
 					Return(&runtime.ExecutionResult{ReturnValue: testCase.expectedResult}, nil).
 					Once()
 			},
@@ -420,7 +421,7 @@ func TestCall(t *testing.T) {
 				block := state.NewL2BlockWithHeader(state.NewL2Header(&ethTypes.Header{Number: blockNumOne, Root: blockRoot}))
 				m.State.On("GetL2BlockByNumber", context.Background(), blockNumOneUint64, m.DbTx).Return(block, nil).Once()
 				m.State.
-					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, common.HexToAddress(state.DefaultSenderAddress), nilUint64, true, m.DbTx).
+					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, common.HexToAddress(state.DefaultSenderAddress), nilUint64, true, state.StateOverride(nil), (*state.BlockOverride)(nil), m.DbTx).
 					Return(&runtime.ExecutionResult{ReturnValue: testCase.expectedResult}, nil).
 					Once()
 			},
@@ -484,7 +485,7 @@ func TestCall(t *testing.T) {
 				m.State.On("GetL2BlockByNumber", context.Background(), blockNumOneUint64, m.DbTx).Return(block, nil).Once()
 				m.State.On("GetNonce", context.Background(), *txArgs.From, blockRoot).Return(nonce, nil).Once()
 				m.State.
-					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, nilUint64, true, m.DbTx).
+					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, nilUint64, true, state.StateOverride(nil), (*state.BlockOverride)(nil), m.DbTx).
 					Return(&runtime.ExecutionResult{Err: errors.New("failed to process unsigned transaction")}, nil).
 					Once()
 			},
@@ -526,11 +527,128 @@ func TestCall(t *testing.T) {
 				m.State.On("GetL2BlockByNumber", context.Background(), blockNumOneUint64, m.DbTx).Return(block, nil).Once()
 				m.State.On("GetNonce", context.Background(), *txArgs.From, blockRoot).Return(nonce, nil).Once()
 				m.State.
-					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, nilUint64, true, m.DbTx).
+					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, nilUint64, true, state.StateOverride(nil), (*state.BlockOverride)(nil), m.DbTx).
 					Return(&runtime.ExecutionResult{Err: runtime.ErrExecutionReverted}, nil).
 					Once()
 			},
 		},
+		{
+			name: "Transaction with state and block overrides",
+			params: []interface{}{
+				types.TxArgs{
+					From:     state.HexToAddressPtr("0x1"),
+					To:       state.HexToAddressPtr("0x2"),
+					Gas:      types.ArgUint64Ptr(24000),
+					GasPrice: types.ArgBytesPtr(big.NewInt(1).Bytes()),
+					Value:    types.ArgBytesPtr(big.NewInt(2).Bytes()),
+					Data:     types.ArgBytesPtr([]byte("data")),
+				},
+				latest,
+				map[string]interface{}{
+					common.HexToAddress("0x1").String(): map[string]interface{}{
+						"balance": "0x3e8",
+						"nonce":   "0x9",
+					},
+					common.HexToAddress("0x2").String(): map[string]interface{}{
+						"code":      "0x6001",
+						"stateDiff": map[string]interface{}{common.HexToHash("0x1").String(): common.HexToHash("0x2").String()},
+					},
+				},
+				map[string]interface{}{
+					"time":     "0x64",
+					"coinbase": common.HexToAddress("0x3").String(),
+				},
+			},
+			expectedResult: []byte("hello world"),
+			expectedError:  nil,
+			setupMocks: func(c Config, m *mocksWrapper, testCase *testCase) {
+				nonce := uint64(7)
+				m.DbTx.On("Commit", context.Background()).Return(nil).Once()
+				m.State.On("BeginStateTransaction", context.Background()).Return(m.DbTx, nil).Once()
+				m.State.On("GetLastL2BlockNumber", context.Background(), m.DbTx).Return(blockNumOne.Uint64(), nil).Once()
+				txArgs := testCase.params[0].(types.TxArgs)
+				txMatchBy := mock.MatchedBy(func(tx *ethTypes.Transaction) bool {
+					return tx != nil && tx.To().Hex() == txArgs.To.Hex() && tx.Nonce() == nonce
+				})
+				overriddenNonce := uint64(9)
+				overriddenTime := uint64(100)
+				stateOverride := state.StateOverride{
+					common.HexToAddress("0x1"): state.OverrideAccount{
+						Balance: big.NewInt(1000),
+						Nonce:   &overriddenNonce,
+					},
+					common.HexToAddress("0x2"): state.OverrideAccount{
+						Code:      []byte{0x60, 0x01},
+						StateDiff: map[common.Hash]common.Hash{common.HexToHash("0x1"): common.HexToHash("0x2")},
+					},
+				}
+				blockOverride := &state.BlockOverride{
+					Time:     &overriddenTime,
+					Coinbase: state.HexToAddressPtr("0x3"),
+				}
+				block := state.NewL2BlockWithHeader(state.NewL2Header(&ethTypes.Header{Number: blockNumOne, Root: blockRoot}))
+				m.State.On("GetL2BlockByNumber", context.Background(), blockNumOneUint64, m.DbTx).Return(block, nil).Once()
+				m.State.On("GetNonce", context.Background(), *txArgs.From, blockRoot).Return(nonce, nil).Once()
+				m.State.
+					On("ProcessUnsignedTransaction", context.Background(), txMatchBy, *txArgs.From, nilUint64, true, stateOverride, blockOverride, m.DbTx).
+					Return(&runtime.ExecutionResult{ReturnValue: testCase.expectedResult}, nil).
+					Once()
+			},
+		},
+		{
+			name: "Transaction with invalid state override",
+			params: []interface{}{
+				types.TxArgs{
+					From:     state.HexToAddressPtr("0x1"),
+					To:       state.HexToAddressPtr("0x2"),
+					Gas:      types.ArgUint64Ptr(24000),
+					GasPrice: types.ArgBytesPtr(big.NewInt(1).Bytes()),
+					Value:    types.ArgBytesPtr(big.NewInt(2).Bytes()),
+					Data:     types.ArgBytesPtr([]byte("data")),
+				},
+				latest,
+				map[string]interface{}{
+					common.HexToAddress("0x2").String(): map[string]interface{}{
+						"state":     map[string]interface{}{},
+						"stateDiff": map[string]interface{}{},
+					},
+				},
+			},
+			expectedResult: nil,
+			expectedError:  types.NewRPCError(types.InvalidParamsErrorCode, state.ErrStateAndStateDiffOverride.Error()),
+			setupMocks: func(c Config, m *mocksWrapper, testCase *testCase) {
+				nonce := uint64(7)
+				m.DbTx.On("Rollback", context.Background()).Return(nil).Once()
+				m.State.On("BeginStateTransaction", context.Background()).Return(m.DbTx, nil).Once()
+				m.State.On("GetLastL2BlockNumber", context.Background(), m.DbTx).Return(blockNumOne.Uint64(), nil).Once()
+				txArgs := testCase.params[0].(types.TxArgs)
+				block := state.NewL2BlockWithHeader(state.NewL2Header(&ethTypes.Header{Number: blockNumOne, Root: blockRoot}))
+				m.State.On("GetL2BlockByNumber", context.Background(), blockNumOneUint64, m.DbTx).Return(block, nil).Once()
+				m.State.On("GetNonce", context.Background(), *txArgs.From, blockRoot).Return(nonce, nil).Once()
+				m.State.
+					On("ProcessUnsignedTransaction", context.Background(), mock.Anything, *txArgs.From, nilUint64, true, mock.Anything, (*state.BlockOverride)(nil), m.DbTx).
+					Return(nil, state.ErrStateAndStateDiffOverride).
+					Once()
+			},
+		},
+		{
+			name: "Transaction with invalid state override address",
+			params: []interface{}{
+				types.TxArgs{
+					From: state.HexToAddressPtr("0x1"),
+					To:   state.HexToAddressPtr("0x2"),
+				},
+				latest,
+				map[string]interface{}{
+					"0x2": map[string]interface{}{
+						"balance": hex.EncodeBig(big.NewInt(1)),
+					},
+				},
+			},
+			expectedResult: nil,
+			expectedError:  types.NewRPCError(types.InvalidParamsErrorCode, "Invalid Params"),
+			setupMocks:     func(c Config, m *mocksWrapper, testCase *testCase) {},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -688,7 +806,7 @@ func TestEstimateGas(t *testing.T) {
 					Return(nonce, nil).
 					Once()
 				m.State.
-					On("EstimateGas", txMatchBy, *txArgs.From, nilUint64, m.DbTx).
+					On("EstimateGas", txMatchBy, *txArgs.From, nilUint64, state.StateOverride(nil), (*state.BlockOverride)(nil), m.DbTx).
 					Return(*testCase.expectedResult, nil, nil).
 					Once()
 			},
@@ -729,11 +847,85 @@ func TestEstimateGas(t *testing.T) {
 				m.State.On("GetLastL2Block", context.Background(), m.DbTx).Return(block, nil).Once()
 
 				m.State.
-					On("EstimateGas", txMatchBy, common.HexToAddress(state.DefaultSenderAddress), nilUint64, m.DbTx).
+					On("EstimateGas", txMatchBy, common.HexToAddress(state.DefaultSenderAddress), nilUint64, state.StateOverride(nil), (*state.BlockOverride)(nil), m.DbTx).
 					Return(*testCase.expectedResult, nil, nil).
 					Once()
 			},
 		},
+		{
+			name: "Transaction with state and block overrides",
+			params: []interface{}{
+				types.TxArgs{
+					To:       state.HexToAddressPtr("0x2"),
+					GasPrice: types.ArgBytesPtr(big.NewInt(0).Bytes()),
+					Value:    types.ArgBytesPtr(big.NewInt(2).Bytes()),
+					Data:     types.ArgBytesPtr([]byte("data")),
+				},
+				latest,
+				map[string]interface{}{
+					common.HexToAddress("0x2").String(): map[string]interface{}{
+						"code": "0x6001",
+					},
+				},
+				map[string]interface{}{
+					"number": "0xb",
+				},
+			},
+			expectedResult: state.Ptr(uint64(100)),
+			setupMocks: func(c Config, m *mocksWrapper, testCase *testCase) {
+				txArgs := testCase.params[0].(types.TxArgs)
+				txMatchBy := mock.MatchedBy(func(tx *ethTypes.Transaction) bool {
+					return tx != nil && tx.To().Hex() == txArgs.To.Hex()
+				})
+				overriddenNumber := uint64(11)
+				stateOverride := state.StateOverride{
+					common.HexToAddress("0x2"): state.OverrideAccount{Code: []byte{0x60, 0x01}},
+				}
+				blockOverride := &state.BlockOverride{Number: &overriddenNumber}
+
+				m.DbTx.On("Commit", context.Background()).Return(nil).Once()
+				m.State.On("BeginStateTransaction", context.Background()).Return(m.DbTx, nil).Once()
+
+				block := state.NewL2BlockWithHeader(state.NewL2Header(&ethTypes.Header{Number: blockNumTen, Root: blockRoot}))
+				m.State.On("GetLastL2BlockNumber", context.Background(), m.DbTx).Return(blockNumTenUint64, nil).Once()
+				m.State.On("GetL2BlockByNumber", context.Background(), blockNumTenUint64, m.DbTx).Return(block, nil).Once()
+
+				m.State.
+					On("EstimateGas", txMatchBy, common.HexToAddress(state.DefaultSenderAddress), nilUint64, stateOverride, blockOverride, m.DbTx).
+					Return(*testCase.expectedResult, nil, nil).
+					Once()
+			},
+		},
+		{
+			name: "Transaction with invalid block override",
+			params: []interface{}{
+				types.TxArgs{
+					To:       state.HexToAddressPtr("0x2"),
+					GasPrice: types.ArgBytesPtr(big.NewInt(0).Bytes()),
+					Value:    types.ArgBytesPtr(big.NewInt(2).Bytes()),
+					Data:     types.ArgBytesPtr([]byte("data")),
+				},
+				latest,
+				nil,
+				map[string]interface{}{
+					"time": "0x1",
+				},
+			},
+			expectedError: types.NewRPCError(types.InvalidParamsErrorCode, state.ErrInvalidTimestampOverride.Error()),
+			setupMocks: func(c Config, m *mocksWrapper, testCase *testCase) {
+				m.DbTx.On("Rollback", context.Background()).Return(nil).Once()
+				m.State.On("BeginStateTransaction", context.Background()).Return(m.DbTx, nil).Once()
+
+				block := state.NewL2BlockWithHeader(state.NewL2Header(&ethTypes.Header{Number: blockNumTen, Root: blockRoot}))
+				m.State.On("GetLastL2BlockNumber", context.Background(), m.DbTx).Return(blockNumTenUint64, nil).Once()
+				m.State.On("GetL2BlockByNumber", context.Background(), blockNumTenUint64, m.DbTx).Return(block, nil).Once()
+
+				m.State.
+					On("EstimateGas", mock.Anything, common.HexToAddress(state.DefaultSenderAddress), nilUint64, state.StateOverride(nil), mock.Anything, m.DbTx).
+					Return(uint64(0), nil, state.ErrInvalidTimestampOverride).
+					Once()
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -799,6 +991,73 @@ func TestGasPrice(t *testing.T) {
 	}
 }
 
+func TestMaxPriorityFeePerGas(t *testing.T) {
+	s, m, c := newSequencerMockedServer(t)
+	defer s.Stop()
+
+	m.Pool.
+		On("GetGasPrices", context.Background()).
+		Return(pool.GasPrices{L2GasPrice: 50, L1GasPrice: 100}, nil).
+		Once()
+
+	tipCap, err := c.SuggestGasTipCap(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(50), tipCap.Uint64())
+}
+
+func TestFeeHistory(t *testing.T) {
+	s, m, c := newSequencerMockedServer(t)
+	defer s.Stop()
+
+	m.DbTx.
+		On("Commit", context.Background()).
+		Return(nil).
+		Once()
+
+	m.State.
+		On("BeginStateTransaction", context.Background()).
+		Return(m.DbTx, nil).
+		Once()
+
+	m.State.
+		On("GetLastL2BlockNumber", context.Background(), m.DbTx).
+		Return(blockNumTen.Uint64(), nil).
+		Once()
+
+	m.State.
+		On("GetL2BlocksFeeHistory", context.Background(), uint64(9), blockNumTen.Uint64(), m.DbTx).
+		Return([]state.L2BlockFeeHistory{
+			{
+				BlockNumber: 9,
+				GasUsed:     500000,
+				GasLimit:    1000000,
+				TxsFeeInfo: []state.TxFeeInfo{
+					{GasUsed: 100000, EffectiveGasPrice: big.NewInt(1000), EffectivePercentage: 255},
+					{GasUsed: 400000, EffectiveGasPrice: big.NewInt(500), EffectivePercentage: 127},
+				},
+			},
+			{BlockNumber: blockNumTen.Uint64(), GasLimit: 1000000, TxsFeeInfo: []state.TxFeeInfo{}},
+		}, nil).
+		Once()
+
+	feeHistory, err := c.FeeHistory(context.Background(), 2, blockNumTen, []float64{10, 90})
+	require.NoError(t, err)
+	assert.Equal(t, "9", feeHistory.OldestBlock.String())
+	assert.Equal(t, []float64{0.5, 0}, feeHistory.GasUsedRatio)
+	assert.Equal(t, []string{"0", "0", "0"}, bigIntsToStrings(feeHistory.BaseFee))
+	require.Equal(t, 2, len(feeHistory.Reward))
+	assert.Equal(t, []string{"500", "1000"}, bigIntsToStrings(feeHistory.Reward[0]))
+	assert.Equal(t, []string{"0", "0"}, bigIntsToStrings(feeHistory.Reward[1]))
+}
+
+func bigIntsToStrings(values []*big.Int) []string {
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		strs = append(strs, value.String())
+	}
+	return strs
+}
+
 func TestGetBalance(t *testing.T) {
 	s, m, _ := newSequencerMockedServer(t)
 	defer s.Stop()
@@ -3261,6 +3520,170 @@ func TestGetTransactionCount(t *testing.T) {
 	}
 }
 
+func TestGetBlockReceipts(t *testing.T) {
+	s, m, _ := newSequencerMockedServer(t)
+	defer s.Stop()
+
+	type testCase struct {
+		Name           string
+		BlockArg       string
+		ExpectedResult []types.Receipt
+		ExpectedError  *types.RPCError
+		SetupMocks     func(m *mocksWrapper, tc testCase)
+	}
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(1))
+	require.NoError(t, err)
+
+	block := state.NewL2BlockWithHeader(state.NewL2Header(&ethTypes.Header{Number: blockNumOne}))
+
+	txs := make([]ethTypes.Transaction, 0, 2)
+	receipts := make([]*ethTypes.Receipt, 0, 2)
+	rpcReceipts := make([]types.Receipt, 0, 2)
+	for i := 0; i < 2; i++ {
+		tx := ethTypes.NewTransaction(uint64(i), common.HexToAddress("0x111"), big.NewInt(2), 3, big.NewInt(4), []byte{5, 6, 7, 8})
+		signedTx, err := auth.Signer(auth.From, tx)
+		require.NoError(t, err)
+
+		receipt := &ethTypes.Receipt{
+			Type:              signedTx.Type(),
+			CumulativeGasUsed: uint64(i + 1),
+			BlockNumber:       blockNumOne,
+			GasUsed:           1,
+			TxHash:            signedTx.Hash(),
+			TransactionIndex:  uint(i),
+			Logs:              []*ethTypes.Log{{TxHash: signedTx.Hash(), TxIndex: uint(i), Topics: []common.Hash{common.HexToHash("0x1")}, Data: []byte{}}},
+			Status:            ethTypes.ReceiptStatusSuccessful,
+			EffectiveGasPrice: big.NewInt(4),
+			BlockHash:         block.Hash(),
+		}
+		receipt.Bloom = ethTypes.CreateBloom(ethTypes.Receipts{receipt})
+
+		txs = append(txs, *signedTx)
+		receipts = append(receipts, receipt)
+		rpcReceipts = append(rpcReceipts, types.Receipt{
+			CumulativeGasUsed: types.ArgUint64(receipt.CumulativeGasUsed),
+			LogsBloom:         receipt.Bloom,
+			Logs:              receipt.Logs,
+			Status:            types.ArgUint64(receipt.Status),
+			TxHash:            receipt.TxHash,
+			TxIndex:           types.ArgUint64(receipt.TransactionIndex),
+			BlockHash:         receipt.BlockHash,
+			BlockNumber:       types.ArgUint64(receipt.BlockNumber.Uint64()),
+			GasUsed:           types.ArgUint64(receipt.GasUsed),
+			FromAddr:          auth.From,
+			ToAddr:            signedTx.To(),
+			Type:              types.ArgUint64(receipt.Type),
+			EffectiveGasPrice: state.Ptr(types.ArgBig(*receipt.EffectiveGasPrice)),
+		})
+	}
+
+	testCases := []testCase{
+		{
+			Name:           "Get block receipts successfully",
+			BlockArg:       "0x1",
+			ExpectedResult: rpcReceipts,
+			ExpectedError:  nil,
+			SetupMocks: func(m *mocksWrapper, tc testCase) {
+				m.DbTx.
+					On("Commit", context.Background()).
+					Return(nil).
+					Once()
+
+				m.State.
+					On("BeginStateTransaction", context.Background()).
+					Return(m.DbTx, nil).
+					Once()
+
+				m.State.
+					On("GetL2BlockByNumber", context.Background(), blockNumOneUint64, m.DbTx).
+					Return(block, nil).
+					Once()
+
+				m.State.
+					On("GetReceiptsByL2BlockNumber", context.Background(), blockNumOneUint64, m.DbTx).
+					Return(receipts, txs, nil).
+					Once()
+			},
+		},
+		{
+			Name:           "Get block receipts but block not found",
+			BlockArg:       "0x1",
+			ExpectedResult: nil,
+			ExpectedError:  types.NewRPCError(types.DefaultErrorCode, "header not found"),
+			SetupMocks: func(m *mocksWrapper, tc testCase) {
+				m.DbTx.
+					On("Rollback", context.Background()).
+					Return(nil).
+					Once()
+
+				m.State.
+					On("BeginStateTransaction", context.Background()).
+					Return(m.DbTx, nil).
+					Once()
+
+				m.State.
+					On("GetL2BlockByNumber", context.Background(), blockNumOneUint64, m.DbTx).
+					Return(nil, state.ErrNotFound).
+					Once()
+			},
+		},
+		{
+			Name:           "Get block receipts but failed to get receipts",
+			BlockArg:       "0x1",
+			ExpectedResult: nil,
+			ExpectedError:  types.NewRPCError(types.DefaultErrorCode, "failed to get block receipts from state"),
+			SetupMocks: func(m *mocksWrapper, tc testCase) {
+				m.DbTx.
+					On("Rollback", context.Background()).
+					Return(nil).
+					Once()
+
+				m.State.
+					On("BeginStateTransaction", context.Background()).
+					Return(m.DbTx, nil).
+					Once()
+
+				m.State.
+					On("GetL2BlockByNumber", context.Background(), blockNumOneUint64, m.DbTx).
+					Return(block, nil).
+					Once()
+
+				m.State.
+					On("GetReceiptsByL2BlockNumber", context.Background(), blockNumOneUint64, m.DbTx).
+					Return(nil, nil, errors.New("failed to get receipts")).
+					Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			tc := testCase
+			tc.SetupMocks(m, tc)
+
+			res, err := s.JSONRPCCall("eth_getBlockReceipts", tc.BlockArg)
+			require.NoError(t, err)
+
+			if tc.ExpectedResult != nil {
+				require.Nil(t, res.Error)
+				var result []types.Receipt
+				err = json.Unmarshal(res.Result, &result)
+				require.NoError(t, err)
+				assert.Equal(t, tc.ExpectedResult, result)
+			}
+
+			if tc.ExpectedError != nil {
+				require.NotNil(t, res.Error)
+				assert.Equal(t, tc.ExpectedError.ErrorCode(), res.Error.Code)
+				assert.Equal(t, tc.ExpectedError.Error(), res.Error.Message)
+			}
+		})
+	}
+}
+
 func TestGetTransactionReceipt(t *testing.T) {
 	s, m, _ := newSequencerMockedServer(t)
 	defer s.Stop()
@@ -4051,39 +4474,46 @@ func TestNewPendingTransactionFilter(t *testing.T) {
 
 	type testCase struct {
 		Name           string
+		Params         []interface{}
 		ExpectedResult string
 		ExpectedError  types.Error
 		SetupMocks     func(m *mocksWrapper, tc testCase)
 	}
 
 	testCases := []testCase{
-		// {
-		// 	Name:           "New pending transaction filter created successfully",
-		// 	ExpectedResult: "1",
-		// 	ExpectedError:  nil,
-		// 	SetupMocks: func(m *mocksWrapper, tc testCase) {
-		// 		m.Storage.
-		// 			On("NewPendingTransactionFilter", mock.IsType(&concurrentWsConn{})).
-		// 			Return("1", nil).
-		// 			Once()
-		// 	},
-		// },
-		// {
-		// 	Name:           "failed to create new pending transaction filter",
-		// 	ExpectedResult: "",
-		// 	ExpectedError:  types.NewRPCError(types.DefaultErrorCode, "failed to create new pending transaction filter"),
-		// 	SetupMocks: func(m *mocksWrapper, tc testCase) {
-		// 		m.Storage.
-		// 			On("NewPendingTransactionFilter", mock.IsType(&concurrentWsConn{})).
-		// 			Return("", errors.New("failed to add new pending transaction filter")).
-		// 			Once()
-		// 	},
-		// },
-		{
-			Name:           "can't create pending tx filter",
+		{
+			Name:           "New pending transaction filter created successfully",
+			ExpectedResult: "1",
+			ExpectedError:  nil,
+			SetupMocks: func(m *mocksWrapper, tc testCase) {
+				m.Storage.
+					On("NewPendingTransactionFilter", mock.IsType(&concurrentWsConn{}), PendingTxFilter{FullTx: false}).
+					Return("1", nil).
+					Once()
+			},
+		},
+		{
+			Name:           "New pending transaction filter with full txs created successfully",
+			Params:         []interface{}{true},
+			ExpectedResult: "2",
+			ExpectedError:  nil,
+			SetupMocks: func(m *mocksWrapper, tc testCase) {
+				m.Storage.
+					On("NewPendingTransactionFilter", mock.IsType(&concurrentWsConn{}), PendingTxFilter{FullTx: true}).
+					Return("2", nil).
+					Once()
+			},
+		},
+		{
+			Name:           "failed to create new pending transaction filter",
 			ExpectedResult: "",
-			ExpectedError:  types.NewRPCError(types.DefaultErrorCode, "not supported yet"),
-			SetupMocks:     func(m *mocksWrapper, tc testCase) {},
+			ExpectedError:  types.NewRPCError(types.DefaultErrorCode, "failed to create new pending transaction filter"),
+			SetupMocks: func(m *mocksWrapper, tc testCase) {
+				m.Storage.
+					On("NewPendingTransactionFilter", mock.IsType(&concurrentWsConn{}), PendingTxFilter{FullTx: false}).
+					Return("", errors.New("failed to add new pending transaction filter")).
+					Once()
+			},
 		},
 	}
 
@@ -4092,7 +4522,7 @@ func TestNewPendingTransactionFilter(t *testing.T) {
 			tc := testCase
 			tc.SetupMocks(m, tc)
 
-			res, err := s.JSONRPCCall("eth_newPendingTransactionFilter")
+			res, err := s.JSONRPCCall("eth_newPendingTransactionFilter", tc.Params...)
 			require.NoError(t, err)
 
 			assert.Equal(t, float64(1), res.ID)
@@ -4725,6 +5155,53 @@ func TestGetFilterChanges(t *testing.T) {
 					Once()
 			},
 		},
+		{
+			Name: "Get pending tx filter changes with full txs successfully",
+			Prepare: func(t *testing.T, tc *testCase) {
+				tc.FilterID = "3"
+				tx := ethTypes.NewTransaction(1, common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(1), nil)
+				rpcTx, err := types.NewTransaction(*tx, nil, false, nil)
+				require.NoError(t, err)
+				tc.ExpectedResults = append(tc.ExpectedResults, []types.Transaction{*rpcTx})
+				tc.ExpectedErrors = append(tc.ExpectedErrors, nil)
+			},
+			SetupMocks: func(t *testing.T, m *mocksWrapper, tc testCase) {
+				filter := &Filter{
+					ID:         tc.FilterID,
+					Type:       FilterTypePendingTx,
+					LastPoll:   time.Now(),
+					Parameters: PendingTxFilter{FullTx: true},
+				}
+
+				m.Storage.
+					On("GetFilter", tc.FilterID).
+					Return(filter, nil).
+					Once()
+
+				minedTxHash := common.HexToHash("0x1")
+				expectedTx := tc.ExpectedResults[0].([]types.Transaction)[0]
+				m.Pool.
+					On("GetPendingTxHashesSince", context.Background(), filter.LastPoll).
+					Return([]common.Hash{minedTxHash, expectedTx.Hash}, nil).
+					Once()
+
+				m.Pool.
+					On("GetTransactionByHash", context.Background(), minedTxHash).
+					Return(&pool.Transaction{Status: pool.TxStatusSelected}, nil).
+					Once()
+
+				tx := ethTypes.NewTransaction(1, common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(1), nil)
+				m.Pool.
+					On("GetTransactionByHash", context.Background(), expectedTx.Hash).
+					Return(&pool.Transaction{Transaction: *tx, Status: pool.TxStatusPending}, nil).
+					Once()
+
+				m.Storage.
+					On("UpdateFilterLastPoll", tc.FilterID).
+					Return(nil).
+					Once()
+			},
+		},
 		{
 			Name: "Get log filter changes multiple times successfully",
 			Prepare: func(t *testing.T, tc *testCase) {
@@ -5103,6 +5580,15 @@ func TestGetFilterChanges(t *testing.T) {
 							require.NoError(t, err)
 							assert.ElementsMatch(t, tc.ExpectedResults[i], hashes)
 						}
+						if expectedTxs, ok := tc.ExpectedResults[i].([]types.Transaction); ok {
+							var txs []types.Transaction
+							err = json.Unmarshal(res.Result, &txs)
+							require.NoError(t, err)
+							require.Len(t, txs, len(expectedTxs))
+							for j := range expectedTxs {
+								assert.Equal(t, expectedTxs[j].Hash, txs[j].Hash)
+							}
+						}
 					}
 				}
 
@@ -5290,6 +5776,303 @@ func TestSubscribeNewLogs(t *testing.T) {
 	}
 }
 
+func TestSubscribeNewPendingTransactions(t *testing.T) {
+	s, m, _ := newSequencerMockedServer(t)
+	defer s.Stop()
+
+	type testCase struct {
+		Name          string
+		Args          []interface{}
+		ExpectedError interface{}
+		SetupMocks    func(m *mocksWrapper, tc testCase)
+	}
+
+	testCases := []testCase{
+		{
+			Name: "Subscribe to new pending transactions successfully",
+			Args: []interface{}{"newPendingTransactions"},
+			SetupMocks: func(m *mocksWrapper, tc testCase) {
+				m.Storage.
+					On("NewPendingTransactionFilter", mock.IsType(&concurrentWsConn{}), PendingTxFilter{FullTx: false}).
+					Return("0x1", nil).
+					Once()
+			},
+		},
+		{
+			Name: "Subscribe to new pending transactions with full txs successfully",
+			Args: []interface{}{"newPendingTransactions", true},
+			SetupMocks: func(m *mocksWrapper, tc testCase) {
+				m.Storage.
+					On("NewPendingTransactionFilter", mock.IsType(&concurrentWsConn{}), PendingTxFilter{FullTx: true}).
+					Return("0x2", nil).
+					Once()
+			},
+		},
+		{
+			Name:          "Subscribe fails to add filter to storage",
+			Args:          []interface{}{"newPendingTransactions"},
+			ExpectedError: types.NewRPCError(types.DefaultErrorCode, "failed to create new pending transaction filter"),
+			SetupMocks: func(m *mocksWrapper, tc testCase) {
+				m.Storage.
+					On("NewPendingTransactionFilter", mock.IsType(&concurrentWsConn{}), PendingTxFilter{FullTx: false}).
+					Return("", fmt.Errorf("failed to add filter to storage")).
+					Once()
+			},
+		},
+		{
+			Name:          "Subscribe fails with invalid full txs flag",
+			Args:          []interface{}{"newPendingTransactions", "invalid"},
+			ExpectedError: types.NewRPCError(types.InvalidParamsErrorCode, "invalid full transaction flag"),
+			SetupMocks:    func(m *mocksWrapper, tc testCase) {},
+		},
+	}
+
+	// the pending txs watcher is started with the first subscription
+	m.Storage.
+		On("GetAllPendingTxFiltersWithWSConn").
+		Return([]*Filter{}).
+		Maybe()
+	m.Pool.
+		On("GetLastTxID", context.Background()).
+		Return(uint64(0), nil).
+		Maybe()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			tc := testCase
+			tc.SetupMocks(m, tc)
+
+			c := s.GetWSClient()
+
+			ctx := context.Background()
+			newPendingTxs := make(chan interface{}, 100)
+			sub, err := c.Client().EthSubscribe(ctx, newPendingTxs, tc.Args...)
+
+			if sub != nil {
+				assert.NotNil(t, sub)
+			}
+
+			if err != nil || tc.ExpectedError != nil {
+				if expectedErr, ok := tc.ExpectedError.(*types.RPCError); ok {
+					rpcErr := err.(rpc.Error)
+					assert.Equal(t, expectedErr.ErrorCode(), rpcErr.ErrorCode())
+					assert.Equal(t, expectedErr.Error(), rpcErr.Error())
+				} else {
+					assert.Equal(t, tc.ExpectedError, err)
+				}
+			}
+		})
+	}
+}
+
+func TestNotifyNewPendingTxs(t *testing.T) {
+	poolMock := mocks.NewPoolMock(t)
+	storage := newStorageMock(t)
+	e := &EthEndpoints{pool: poolMock, storage: storage}
+
+	newFilter := func(id string, fullTx bool) *Filter {
+		return &Filter{
+			ID:            id,
+			Type:          FilterTypePendingTx,
+			Parameters:    PendingTxFilter{FullTx: fullTx},
+			wsQueue:       state.NewQueue[[]byte](),
+			wsQueueSignal: sync.NewCond(&sync.Mutex{}),
+		}
+	}
+	hashesFilter := newFilter("0x1", false)
+	fullTxsFilter := newFilter("0x2", true)
+
+	tx := ethTypes.NewTransaction(1, common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(1), nil)
+
+	// the txs added while there are no filters are skipped
+	storage.On("GetAllPendingTxFiltersWithWSConn").Return([]*Filter{}).Once()
+	poolMock.On("GetLastTxID", context.Background()).Return(uint64(5), nil).Once()
+
+	lastTxID, err := e.notifyNewPendingTxs(3)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), lastTxID)
+
+	storage.On("GetAllPendingTxFiltersWithWSConn").Return([]*Filter{hashesFilter, fullTxsFilter}).Once()
+	poolMock.On("GetPendingTxHashesSinceID", context.Background(), uint64(5)).Return([]common.Hash{tx.Hash()}, uint64(7), nil).Once()
+	poolMock.On("GetTransactionByHash", context.Background(), tx.Hash()).Return(&pool.Transaction{Transaction: *tx, Status: pool.TxStatusPending}, nil).Once()
+
+	lastTxID, err = e.notifyNewPendingTxs(lastTxID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), lastTxID)
+
+	require.Equal(t, 1, hashesFilter.wsQueue.Len())
+	data, err := hashesFilter.wsQueue.Pop()
+	require.NoError(t, err)
+	var hash common.Hash
+	require.NoError(t, json.Unmarshal(data, &hash))
+	assert.Equal(t, tx.Hash(), hash)
+
+	require.Equal(t, 1, fullTxsFilter.wsQueue.Len())
+	data, err = fullTxsFilter.wsQueue.Pop()
+	require.NoError(t, err)
+	var rpcTx types.Transaction
+	require.NoError(t, json.Unmarshal(data, &rpcTx))
+	assert.Equal(t, tx.Hash(), rpcTx.Hash)
+	assert.Equal(t, types.ArgUint64(1), rpcTx.Nonce)
+
+	// the same tx id is kept when there are no new pending txs
+	storage.On("GetAllPendingTxFiltersWithWSConn").Return([]*Filter{hashesFilter, fullTxsFilter}).Once()
+	poolMock.On("GetPendingTxHashesSinceID", context.Background(), uint64(7)).Return([]common.Hash{}, uint64(7), nil).Once()
+
+	lastTxID, err = e.notifyNewPendingTxs(lastTxID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), lastTxID)
+	assert.Equal(t, 0, hashesFilter.wsQueue.Len())
+}
+
+func TestSubscribeSyncing(t *testing.T) {
+	s, m, _ := newSequencerMockedServer(t)
+	defer s.Stop()
+
+	type testCase struct {
+		Name          string
+		ExpectedError interface{}
+		SetupMocks    func(m *mocksWrapper, tc testCase)
+	}
+
+	testCases := []testCase{
+		{
+			Name: "Subscribe to syncing successfully",
+			SetupMocks: func(m *mocksWrapper, tc testCase) {
+				m.Storage.
+					On("NewSyncingFilter", mock.IsType(&concurrentWsConn{})).
+					Return("0x1", nil).
+					Once()
+			},
+		},
+		{
+			Name:          "Subscribe fails to add filter to storage",
+			ExpectedError: types.NewRPCError(types.DefaultErrorCode, "failed to create new syncing filter"),
+			SetupMocks: func(m *mocksWrapper, tc testCase) {
+				m.Storage.
+					On("NewSyncingFilter", mock.IsType(&concurrentWsConn{})).
+					Return("", fmt.Errorf("failed to add filter to storage")).
+					Once()
+			},
+		},
+	}
+
+	// the syncing watcher is started with the first subscription
+	m.Storage.
+		On("GetAllSyncingFiltersWithWSConn").
+		Return([]*Filter{}).
+		Maybe()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			tc := testCase
+			tc.SetupMocks(m, tc)
+
+			c := s.GetWSClient()
+
+			ctx := context.Background()
+			syncing := make(chan interface{}, 100)
+			sub, err := c.Client().EthSubscribe(ctx, syncing, "syncing")
+
+			if sub != nil {
+				assert.NotNil(t, sub)
+			}
+
+			if err != nil || tc.ExpectedError != nil {
+				if expectedErr, ok := tc.ExpectedError.(*types.RPCError); ok {
+					rpcErr := err.(rpc.Error)
+					assert.Equal(t, expectedErr.ErrorCode(), rpcErr.ErrorCode())
+					assert.Equal(t, expectedErr.Error(), rpcErr.Error())
+				} else {
+					assert.Equal(t, tc.ExpectedError, err)
+				}
+			}
+		})
+	}
+}
+
+func TestNotifySyncingStatus(t *testing.T) {
+	stateMock := mocks.NewStateMock(t)
+	storage := newStorageMock(t)
+	e := &EthEndpoints{state: stateMock, storage: storage}
+
+	filter := &Filter{
+		ID:            "0x1",
+		Type:          FilterTypeSyncing,
+		wsQueue:       state.NewQueue[[]byte](),
+		wsQueueSignal: sync.NewCond(&sync.Mutex{}),
+	}
+
+	var nilTx pgx.Tx
+	ctx := context.Background()
+	notified := map[string]bool{}
+	setupMocks := func(syncInfo state.SyncingInfo, lastVirtualBatchNumber, lastVerifiedBatchNumber uint64) {
+		storage.On("GetAllSyncingFiltersWithWSConn").Return([]*Filter{filter}).Once()
+		stateMock.On("GetSyncingInfo", ctx, nilTx).Return(syncInfo, nil).Once()
+		stateMock.On("GetLastVirtualBatchNum", ctx, nilTx).Return(lastVirtualBatchNumber, nil).Once()
+		stateMock.On("GetLastVerifiedBatch", ctx, nilTx).Return(&state.VerifiedBatch{BatchNumber: lastVerifiedBatchNumber}, nil).Once()
+	}
+	popNotification := func() syncingNotification {
+		require.Equal(t, 1, filter.wsQueue.Len())
+		data, err := filter.wsQueue.Pop()
+		require.NoError(t, err)
+		var notification syncingNotification
+		require.NoError(t, json.Unmarshal(data, &notification))
+		return notification
+	}
+
+	// the first status is always sent
+	syncInfo := state.SyncingInfo{
+		InitialSyncingBlock:         1,
+		CurrentBlockNumber:          10,
+		EstimatedHighestBlock:       15,
+		LastBatchNumber:             5,
+		LastBatchNumberSeen:         10,
+		LastBatchNumberConsolidated: 8,
+		IsSynchronizing:             true,
+	}
+	setupMocks(syncInfo, 5, 3)
+	require.NoError(t, e.notifySyncingStatus(notified))
+	assert.Equal(t, syncingNotification{
+		Syncing: true,
+		Status: syncingStatus{
+			StartingBlock:      1,
+			CurrentBlock:       10,
+			HighestBlock:       15,
+			VirtualBatchesLag:  5,
+			VerifiedBatchesLag: 5,
+		},
+	}, popNotification())
+
+	// nothing is sent while the node is still syncing
+	syncInfo.CurrentBlockNumber = 12
+	syncInfo.LastBatchNumber = 7
+	setupMocks(syncInfo, 7, 4)
+	require.NoError(t, e.notifySyncingStatus(notified))
+	assert.Equal(t, 0, filter.wsQueue.Len())
+
+	// the status is sent when the node leaves the syncing status
+	syncInfo.CurrentBlockNumber = 15
+	syncInfo.EstimatedHighestBlock = 15
+	syncInfo.LastBatchNumber = 10
+	syncInfo.IsSynchronizing = false
+	setupMocks(syncInfo, 10, 8)
+	require.NoError(t, e.notifySyncingStatus(notified))
+	assert.Equal(t, syncingNotification{
+		Syncing: false,
+		Status: syncingStatus{
+			StartingBlock: 1,
+			CurrentBlock:  15,
+			HighestBlock:  15,
+		},
+	}, popNotification())
+
+	// the uninstalled filters are forgotten
+	storage.On("GetAllSyncingFiltersWithWSConn").Return([]*Filter{}).Once()
+	require.NoError(t, e.notifySyncingStatus(notified))
+	assert.Empty(t, notified)
+}
+
 func TestFilterLogs(t *testing.T) {
 	logs := []*ethTypes.Log{{
 		Address: common.HexToAddress("0x1"),
@@ -1,18 +1,45 @@
 package jsonrpc
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/types"
+	"github.com/0xPolygonHermez/zkevm-node/pool"
 	"github.com/ethereum/go-ethereum/common"
 )
 
 // TxPoolEndpoints is the txpool jsonrpc endpoint
-type TxPoolEndpoints struct{}
+type TxPoolEndpoints struct {
+	cfg  Config
+	pool types.PoolInterface
+}
+
+// NewTxPoolEndpoints creates an new instance of TxPool
+func NewTxPoolEndpoints(cfg Config, p types.PoolInterface) *TxPoolEndpoints {
+	return &TxPoolEndpoints{cfg: cfg, pool: p}
+}
 
 type contentResponse struct {
 	Pending map[common.Address]map[uint64]*txPoolTransaction `json:"pending"`
 	Queued  map[common.Address]map[uint64]*txPoolTransaction `json:"queued"`
 }
 
+type contentFromResponse struct {
+	Pending map[uint64]*txPoolTransaction `json:"pending"`
+	Queued  map[uint64]*txPoolTransaction `json:"queued"`
+}
+
+type inspectResponse struct {
+	Pending map[common.Address]map[uint64]string `json:"pending"`
+	Queued  map[common.Address]map[uint64]string `json:"queued"`
+}
+
+type statusResponse struct {
+	Pending types.ArgUint64 `json:"pending"`
+	Queued  types.ArgUint64 `json:"queued"`
+}
+
 type txPoolTransaction struct {
 	Nonce       types.ArgUint64 `json:"nonce"`
 	GasPrice    types.ArgBig    `json:"gasPrice"`
@@ -27,13 +54,112 @@ type txPoolTransaction struct {
 	TxIndex     interface{}     `json:"transactionIndex"`
 }
 
+func newTxPoolTransaction(from common.Address, tx pool.Transaction) *txPoolTransaction {
+	return &txPoolTransaction{
+		Nonce:    types.ArgUint64(tx.Nonce()),
+		GasPrice: types.ArgBig(*tx.GasPrice()),
+		Gas:      types.ArgUint64(tx.Gas()),
+		To:       tx.To(),
+		Value:    types.ArgBig(*tx.Value()),
+		Input:    tx.Data(),
+		Hash:     tx.Hash(),
+		From:     from,
+	}
+}
+
+// inspectTx returns a summary of the tx in the same format used by geth
+func inspectTx(tx pool.Transaction) string {
+	if to := tx.To(); to != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
+}
+
 // Content creates a response for txpool_content request.
 // See https://geth.ethereum.org/docs/rpc/ns-txpool#txpool_content.
 func (e *TxPoolEndpoints) Content() (interface{}, types.Error) {
+	content, err := e.pool.GetContent(context.Background(), e.cfg.TxPoolContentLimit)
+	if err != nil {
+		return RPCErrorResponse(types.DefaultErrorCode, "failed to get pool content", err, true)
+	}
+
 	resp := contentResponse{
 		Pending: make(map[common.Address]map[uint64]*txPoolTransaction),
 		Queued:  make(map[common.Address]map[uint64]*txPoolTransaction),
 	}
+	fill := func(dst map[common.Address]map[uint64]*txPoolTransaction, src map[common.Address]map[uint64]pool.Transaction) {
+		for from, txs := range src {
+			dst[from] = make(map[uint64]*txPoolTransaction, len(txs))
+			for nonce, tx := range txs {
+				dst[from][nonce] = newTxPoolTransaction(from, tx)
+			}
+		}
+	}
+	fill(resp.Pending, content.Pending)
+	fill(resp.Queued, content.Queued)
+
+	return resp, nil
+}
+
+// ContentFrom creates a response for txpool_contentFrom request.
+// See https://geth.ethereum.org/docs/interacting-with-geth/rpc/ns-txpool#txpool-contentfrom.
+func (e *TxPoolEndpoints) ContentFrom(address types.ArgAddress) (interface{}, types.Error) {
+	from := address.Address()
+	content, err := e.pool.GetContentFrom(context.Background(), from)
+	if err != nil {
+		return RPCErrorResponse(types.DefaultErrorCode, "failed to get pool content", err, true)
+	}
+
+	resp := contentFromResponse{
+		Pending: make(map[uint64]*txPoolTransaction),
+		Queued:  make(map[uint64]*txPoolTransaction),
+	}
+	for nonce, tx := range content.Pending[from] {
+		resp.Pending[nonce] = newTxPoolTransaction(from, tx)
+	}
+	for nonce, tx := range content.Queued[from] {
+		resp.Queued[nonce] = newTxPoolTransaction(from, tx)
+	}
+
+	return resp, nil
+}
+
+// Inspect creates a response for txpool_inspect request.
+// See https://geth.ethereum.org/docs/interacting-with-geth/rpc/ns-txpool#txpool-inspect.
+func (e *TxPoolEndpoints) Inspect() (interface{}, types.Error) {
+	content, err := e.pool.GetContent(context.Background(), e.cfg.TxPoolContentLimit)
+	if err != nil {
+		return RPCErrorResponse(types.DefaultErrorCode, "failed to get pool content", err, true)
+	}
+
+	resp := inspectResponse{
+		Pending: make(map[common.Address]map[uint64]string),
+		Queued:  make(map[common.Address]map[uint64]string),
+	}
+	fill := func(dst map[common.Address]map[uint64]string, src map[common.Address]map[uint64]pool.Transaction) {
+		for from, txs := range src {
+			dst[from] = make(map[uint64]string, len(txs))
+			for nonce, tx := range txs {
+				dst[from][nonce] = inspectTx(tx)
+			}
+		}
+	}
+	fill(resp.Pending, content.Pending)
+	fill(resp.Queued, content.Queued)
 
 	return resp, nil
 }
+
+// Status creates a response for txpool_status request.
+// See https://geth.ethereum.org/docs/interacting-with-geth/rpc/ns-txpool#txpool-status.
+func (e *TxPoolEndpoints) Status() (interface{}, types.Error) {
+	pending, queued, err := e.pool.GetStatus(context.Background())
+	if err != nil {
+		return RPCErrorResponse(types.DefaultErrorCode, "failed to get pool status", err, true)
+	}
+
+	return statusResponse{
+		Pending: types.ArgUint64(pending),
+		Queued:  types.ArgUint64(queued),
+	}, nil
+}
@@ -0,0 +1,109 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-node/pool"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTxPoolTestContent() (common.Address, pool.Content) {
+	from := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+	content := pool.NewContent()
+	content.Pending[from] = map[uint64]pool.Transaction{
+		0: {Transaction: *ethTypes.NewTransaction(0, to, big.NewInt(1), 21000, big.NewInt(10), nil)},
+		1: {Transaction: *ethTypes.NewTransaction(1, to, big.NewInt(2), 21000, big.NewInt(10), nil)},
+	}
+	content.Queued[from] = map[uint64]pool.Transaction{
+		3: {Transaction: *ethTypes.NewContractCreation(3, big.NewInt(0), 100000, big.NewInt(20), []byte{0x60})},
+	}
+	return from, content
+}
+
+func TestTxPoolContent(t *testing.T) {
+	s, m, _ := newSequencerMockedServer(t)
+	defer s.Stop()
+
+	from, content := newTxPoolTestContent()
+	m.Pool.On("GetContent", context.Background(), uint64(100)).Return(content, nil).Once()
+
+	res, err := s.JSONRPCCall("txpool_content")
+	require.NoError(t, err)
+	require.Nil(t, res.Error)
+
+	var result contentResponse
+	require.NoError(t, json.Unmarshal(res.Result, &result))
+	require.Len(t, result.Pending[from], 2)
+	require.Len(t, result.Queued[from], 1)
+	pendingTx := content.Pending[from][1]
+	assert.Equal(t, pendingTx.Hash(), result.Pending[from][1].Hash)
+	assert.Equal(t, from, result.Pending[from][1].From)
+	assert.Nil(t, result.Queued[from][3].To)
+}
+
+func TestTxPoolContentFrom(t *testing.T) {
+	s, m, _ := newSequencerMockedServer(t)
+	defer s.Stop()
+
+	from, content := newTxPoolTestContent()
+	m.Pool.On("GetContentFrom", context.Background(), from).Return(content, nil).Once()
+
+	res, err := s.JSONRPCCall("txpool_contentFrom", from.String())
+	require.NoError(t, err)
+	require.Nil(t, res.Error)
+
+	var result contentFromResponse
+	require.NoError(t, json.Unmarshal(res.Result, &result))
+	require.Len(t, result.Pending, 2)
+	require.Len(t, result.Queued, 1)
+	queuedTx := content.Queued[from][3]
+	assert.Equal(t, queuedTx.Hash(), result.Queued[3].Hash)
+}
+
+func TestTxPoolInspect(t *testing.T) {
+	s, m, _ := newSequencerMockedServer(t)
+	defer s.Stop()
+
+	from, content := newTxPoolTestContent()
+	m.Pool.On("GetContent", context.Background(), uint64(100)).Return(content, nil).Once()
+
+	res, err := s.JSONRPCCall("txpool_inspect")
+	require.NoError(t, err)
+	require.Nil(t, res.Error)
+
+	var result inspectResponse
+	require.NoError(t, json.Unmarshal(res.Result, &result))
+	assert.Equal(t, "0x0000000000000000000000000000000000000002: 1 wei + 21000 gas × 10 wei", result.Pending[from][0])
+	assert.Equal(t, "contract creation: 0 wei + 100000 gas × 20 wei", result.Queued[from][3])
+}
+
+func TestTxPoolStatus(t *testing.T) {
+	s, m, _ := newSequencerMockedServer(t)
+	defer s.Stop()
+
+	m.Pool.On("GetStatus", context.Background()).Return(uint64(2), uint64(1), nil).Once()
+
+	res, err := s.JSONRPCCall("txpool_status")
+	require.NoError(t, err)
+	require.Nil(t, res.Error)
+
+	var result statusResponse
+	require.NoError(t, json.Unmarshal(res.Result, &result))
+	assert.Equal(t, uint64(2), uint64(result.Pending))
+	assert.Equal(t, uint64(1), uint64(result.Queued))
+
+	m.Pool.On("GetStatus", mock.Anything).Return(uint64(0), uint64(0), errors.New("failure")).Once()
+	res, err = s.JSONRPCCall("txpool_status")
+	require.NoError(t, err)
+	require.NotNil(t, res.Error)
+	assert.Equal(t, "failed to get pool status", res.Error.Message)
+}
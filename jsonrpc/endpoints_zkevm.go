@@ -133,6 +133,30 @@ func (z *ZKEVMEndpoints) VerifiedBatchNumber() (interface{}, types.Error) {
 	})
 }
 
+// GetBatchReceipts returns the receipts of all the transactions of a batch
+func (z *ZKEVMEndpoints) GetBatchReceipts(batchNumber types.BatchNumber) (interface{}, types.Error) {
+	return z.txMan.NewDbTxScope(z.state, func(ctx context.Context, dbTx pgx.Tx) (interface{}, types.Error) {
+		batchNumber, rpcErr := batchNumber.GetNumericBatchNumber(ctx, z.state, z.etherman, dbTx)
+		if rpcErr != nil {
+			return nil, rpcErr
+		}
+
+		_, err := z.state.GetBatchByNumber(ctx, batchNumber, dbTx)
+		if errors.Is(err, state.ErrNotFound) {
+			return nil, nil
+		} else if err != nil {
+			return RPCErrorResponse(types.DefaultErrorCode, fmt.Sprintf("couldn't load batch from state by number %v", batchNumber), err, true)
+		}
+
+		receipts, txs, err := z.state.GetReceiptsByBatchNumber(ctx, batchNumber, dbTx)
+		if err != nil {
+			return RPCErrorResponse(types.DefaultErrorCode, fmt.Sprintf("couldn't load batch receipts from state by number %v", batchNumber), err, true)
+		}
+
+		return newReceipts(receipts, txs)
+	})
+}
+
 // GetBatchByNumber returns information about a batch by batch number
 func (z *ZKEVMEndpoints) GetBatchByNumber(batchNumber types.BatchNumber, fullTx bool) (interface{}, types.Error) {
 	return z.txMan.NewDbTxScope(z.state, func(ctx context.Context, dbTx pgx.Tx) (interface{}, types.Error) {
@@ -484,7 +508,7 @@ func (z *ZKEVMEndpoints) internalEstimateGasPriceAndFee(ctx context.Context, arg
 		return nil, nil, types.NewRPCError(types.DefaultErrorCode, "failed to convert arguments into an unsigned transaction")
 	}
 
-	gasEstimation, returnValue, err := z.state.EstimateGas(tx, sender, blockToProcess, dbTx)
+	gasEstimation, returnValue, err := z.state.EstimateGas(tx, sender, blockToProcess, nil, nil, dbTx)
 	if errors.Is(err, runtime.ErrExecutionReverted) {
 		data := make([]byte, len(returnValue))
 		copy(data, returnValue)
@@ -608,6 +632,35 @@ func (z *ZKEVMEndpoints) EstimateCounters(arg *types.TxArgs, blockArg *types.Blo
 	})
 }
 
+// GetProof returns the values and the proofs of the balance, nonce, code hash,
+// code length and the provided storage keys of an account in the zkEVM state
+// sparse merkle tree for the state root of the provided block. The proofs include
+// the siblings of the path of each leaf, so they can be verified against the state
+// root, which is the state root of the batch when the block is the last one of it
+func (z *ZKEVMEndpoints) GetProof(address types.ArgAddress, storageKeys []types.ArgHash, blockArg *types.BlockNumberOrHash) (interface{}, types.Error) {
+	return z.txMan.NewDbTxScope(z.state, func(ctx context.Context, dbTx pgx.Tx) (interface{}, types.Error) {
+		block, rpcErr := z.getBlockByArg(ctx, blockArg, dbTx)
+		if rpcErr != nil {
+			return nil, rpcErr
+		}
+
+		keys := make([]common.Hash, 0, len(storageKeys))
+		positions := make([]*big.Int, 0, len(storageKeys))
+		for _, storageKey := range storageKeys {
+			key := storageKey.Hash()
+			keys = append(keys, key)
+			positions = append(positions, key.Big())
+		}
+
+		proof, err := z.state.GetProof(ctx, address.Address(), positions, block.Root())
+		if err != nil {
+			return RPCErrorResponse(types.DefaultErrorCode, "failed to get proof", err, true)
+		}
+
+		return types.NewAccountProof(address.Address(), block.Root(), keys, proof), nil
+	})
+}
+
 func (z *ZKEVMEndpoints) getBlockByArg(ctx context.Context, blockArg *types.BlockNumberOrHash, dbTx pgx.Tx) (*state.L2Block, types.Error) {
 	// If no block argument is provided, return the latest block
 	if blockArg == nil {
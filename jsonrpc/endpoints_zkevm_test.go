@@ -13,6 +13,7 @@ import (
 	"github.com/0xPolygonHermez/zkevm-node/hex"
 	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/client"
 	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/types"
+	"github.com/0xPolygonHermez/zkevm-node/merkletree"
 	"github.com/0xPolygonHermez/zkevm-node/pool"
 	"github.com/0xPolygonHermez/zkevm-node/state"
 	"github.com/0xPolygonHermez/zkevm-node/test/operations"
@@ -1201,6 +1202,156 @@ func TestGetBatchByNumber(t *testing.T) {
 	}
 }
 
+func TestGetBatchReceipts(t *testing.T) {
+	s, m, _ := newSequencerMockedServer(t)
+	defer s.Stop()
+
+	type testCase struct {
+		Name           string
+		Number         string
+		ExpectedResult []types.Receipt
+		ExpectedError  types.Error
+		SetupMocks     func(m *mocksWrapper, tc testCase)
+	}
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(1))
+	require.NoError(t, err)
+
+	tx := ethTypes.NewTransaction(1, common.HexToAddress("0x111"), big.NewInt(2), 3, big.NewInt(4), []byte{5, 6, 7, 8})
+	signedTx, err := auth.Signer(auth.From, tx)
+	require.NoError(t, err)
+
+	receipt := &ethTypes.Receipt{
+		Type:              signedTx.Type(),
+		CumulativeGasUsed: 1,
+		BlockNumber:       big.NewInt(2),
+		GasUsed:           1,
+		TxHash:            signedTx.Hash(),
+		Logs:              []*ethTypes.Log{},
+		Status:            ethTypes.ReceiptStatusSuccessful,
+		BlockHash:         common.HexToHash("0x2"),
+	}
+	receipt.Bloom = ethTypes.CreateBloom(ethTypes.Receipts{receipt})
+
+	rpcReceipt := types.Receipt{
+		CumulativeGasUsed: types.ArgUint64(receipt.CumulativeGasUsed),
+		LogsBloom:         receipt.Bloom,
+		Logs:              receipt.Logs,
+		Status:            types.ArgUint64(receipt.Status),
+		TxHash:            receipt.TxHash,
+		TxIndex:           types.ArgUint64(receipt.TransactionIndex),
+		BlockHash:         receipt.BlockHash,
+		BlockNumber:       types.ArgUint64(receipt.BlockNumber.Uint64()),
+		GasUsed:           types.ArgUint64(receipt.GasUsed),
+		FromAddr:          auth.From,
+		ToAddr:            signedTx.To(),
+		Type:              types.ArgUint64(receipt.Type),
+	}
+
+	testCases := []testCase{
+		{
+			Name:           "Batch not found",
+			Number:         "0x123",
+			ExpectedResult: nil,
+			ExpectedError:  nil,
+			SetupMocks: func(m *mocksWrapper, tc testCase) {
+				m.DbTx.
+					On("Commit", context.Background()).
+					Return(nil).
+					Once()
+
+				m.State.
+					On("BeginStateTransaction", context.Background()).
+					Return(m.DbTx, nil).
+					Once()
+
+				m.State.
+					On("GetBatchByNumber", context.Background(), hex.DecodeBig(tc.Number).Uint64(), m.DbTx).
+					Return(nil, state.ErrNotFound).
+					Once()
+			},
+		},
+		{
+			Name:           "Get batch receipts successfully",
+			Number:         "0x123",
+			ExpectedResult: []types.Receipt{rpcReceipt},
+			ExpectedError:  nil,
+			SetupMocks: func(m *mocksWrapper, tc testCase) {
+				m.DbTx.
+					On("Commit", context.Background()).
+					Return(nil).
+					Once()
+
+				m.State.
+					On("BeginStateTransaction", context.Background()).
+					Return(m.DbTx, nil).
+					Once()
+
+				m.State.
+					On("GetBatchByNumber", context.Background(), hex.DecodeBig(tc.Number).Uint64(), m.DbTx).
+					Return(&state.Batch{BatchNumber: hex.DecodeBig(tc.Number).Uint64()}, nil).
+					Once()
+
+				m.State.
+					On("GetReceiptsByBatchNumber", context.Background(), hex.DecodeBig(tc.Number).Uint64(), m.DbTx).
+					Return([]*ethTypes.Receipt{receipt}, []ethTypes.Transaction{*signedTx}, nil).
+					Once()
+			},
+		},
+		{
+			Name:           "Failed to get batch receipts",
+			Number:         "0x123",
+			ExpectedResult: nil,
+			ExpectedError:  types.NewRPCError(types.DefaultErrorCode, "couldn't load batch receipts from state by number 291"),
+			SetupMocks: func(m *mocksWrapper, tc testCase) {
+				m.DbTx.
+					On("Rollback", context.Background()).
+					Return(nil).
+					Once()
+
+				m.State.
+					On("BeginStateTransaction", context.Background()).
+					Return(m.DbTx, nil).
+					Once()
+
+				m.State.
+					On("GetBatchByNumber", context.Background(), hex.DecodeBig(tc.Number).Uint64(), m.DbTx).
+					Return(&state.Batch{BatchNumber: hex.DecodeBig(tc.Number).Uint64()}, nil).
+					Once()
+
+				m.State.
+					On("GetReceiptsByBatchNumber", context.Background(), hex.DecodeBig(tc.Number).Uint64(), m.DbTx).
+					Return(nil, nil, errors.New("failed to get receipts")).
+					Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			tc := testCase
+			tc.SetupMocks(m, tc)
+
+			res, err := s.JSONRPCCall("zkevm_getBatchReceipts", tc.Number)
+			require.NoError(t, err)
+
+			if tc.ExpectedError == nil {
+				require.Nil(t, res.Error)
+				var result []types.Receipt
+				err = json.Unmarshal(res.Result, &result)
+				require.NoError(t, err)
+				assert.Equal(t, tc.ExpectedResult, result)
+			} else {
+				require.NotNil(t, res.Error)
+				assert.Equal(t, tc.ExpectedError.ErrorCode(), res.Error.Code)
+				assert.Equal(t, tc.ExpectedError.Error(), res.Error.Message)
+			}
+		})
+	}
+}
+
 func TestGetL2FullBlockByHash(t *testing.T) {
 	type testCase struct {
 		Name           string
@@ -2705,3 +2856,50 @@ func TestGetLatestGlobalExitRoot(t *testing.T) {
 		})
 	}
 }
+
+func TestGetProof(t *testing.T) {
+	s, m, _ := newSequencerMockedServer(t)
+	defer s.Stop()
+
+	address := common.HexToAddress("0x123")
+	storageKey := common.HexToHash("0x1")
+	stateRoot := common.HexToHash("0xabc")
+	block := state.NewL2BlockWithHeader(state.NewL2Header(&ethTypes.Header{Number: big.NewInt(1), Root: stateRoot}))
+
+	// values are encoded as 8 field elements of 32 bits
+	newProof := func(key, value uint64) *merkletree.Proof {
+		return &merkletree.Proof{
+			Key:      []uint64{key, 0, 0, 0},
+			Value:    []uint64{value, 0, 0, 0, 0, 0, 0, 0},
+			Siblings: [][]uint64{{1, 2, 3, 4}, {5, 6, 7, 8}},
+		}
+	}
+	accountProof := &merkletree.AccountProof{
+		Balance:    newProof(1, 1000),
+		Nonce:      newProof(2, 3),
+		CodeHash:   newProof(3, 0),
+		CodeLength: newProof(4, 0),
+		Storage:    []*merkletree.Proof{newProof(5, 7)},
+	}
+
+	m.DbTx.On("Commit", context.Background()).Return(nil).Once()
+	m.State.On("BeginStateTransaction", context.Background()).Return(m.DbTx, nil).Once()
+	m.State.On("GetL2BlockByNumber", context.Background(), uint64(1), m.DbTx).Return(block, nil).Once()
+	m.State.On("GetProof", context.Background(), address, []*big.Int{storageKey.Big()}, stateRoot).Return(accountProof, nil).Once()
+
+	res, err := s.JSONRPCCall("zkevm_getProof", address.String(), []string{storageKey.String()}, "0x1")
+	require.NoError(t, err)
+	require.Nil(t, res.Error)
+
+	var result types.AccountProof
+	require.NoError(t, json.Unmarshal(res.Result, &result))
+	assert.Equal(t, address, result.Address)
+	assert.Equal(t, stateRoot, result.StateRoot)
+	assert.Equal(t, big.NewInt(1000), (*big.Int)(&result.Balance))
+	assert.Equal(t, uint64(3), uint64(result.Nonce))
+	assert.Equal(t, common.BigToHash(big.NewInt(1)), result.BalanceProof.Key)
+	assert.Equal(t, [][]types.ArgUint64{{1, 2, 3, 4}, {5, 6, 7, 8}}, result.BalanceProof.Siblings)
+	require.Len(t, result.StorageProof, 1)
+	assert.Equal(t, storageKey, result.StorageProof[0].Key)
+	assert.Equal(t, big.NewInt(7), (*big.Int)(&result.StorageProof[0].Value))
+}
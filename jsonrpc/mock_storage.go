@@ -49,6 +49,46 @@ func (_m *storageMock) GetAllLogFiltersWithWSConn() []*Filter {
 	return r0
 }
 
+// GetAllPendingTxFiltersWithWSConn provides a mock function with given fields:
+func (_m *storageMock) GetAllPendingTxFiltersWithWSConn() []*Filter {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPendingTxFiltersWithWSConn")
+	}
+
+	var r0 []*Filter
+	if rf, ok := ret.Get(0).(func() []*Filter); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Filter)
+		}
+	}
+
+	return r0
+}
+
+// GetAllSyncingFiltersWithWSConn provides a mock function with given fields:
+func (_m *storageMock) GetAllSyncingFiltersWithWSConn() []*Filter {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllSyncingFiltersWithWSConn")
+	}
+
+	var r0 []*Filter
+	if rf, ok := ret.Get(0).(func() []*Filter); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Filter)
+		}
+	}
+
+	return r0
+}
+
 // GetFilter provides a mock function with given fields: filterID
 func (_m *storageMock) GetFilter(filterID string) (*Filter, error) {
 	ret := _m.Called(filterID)
@@ -135,14 +175,42 @@ func (_m *storageMock) NewLogFilter(wsConn *concurrentWsConn, filter LogFilter)
 	return r0, r1
 }
 
-// NewPendingTransactionFilter provides a mock function with given fields: wsConn
-func (_m *storageMock) NewPendingTransactionFilter(wsConn *concurrentWsConn) (string, error) {
-	ret := _m.Called(wsConn)
+// NewPendingTransactionFilter provides a mock function with given fields: wsConn, filter
+func (_m *storageMock) NewPendingTransactionFilter(wsConn *concurrentWsConn, filter PendingTxFilter) (string, error) {
+	ret := _m.Called(wsConn, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for NewPendingTransactionFilter")
 	}
 
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*concurrentWsConn, PendingTxFilter) (string, error)); ok {
+		return rf(wsConn, filter)
+	}
+	if rf, ok := ret.Get(0).(func(*concurrentWsConn, PendingTxFilter) string); ok {
+		r0 = rf(wsConn, filter)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*concurrentWsConn, PendingTxFilter) error); ok {
+		r1 = rf(wsConn, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSyncingFilter provides a mock function with given fields: wsConn
+func (_m *storageMock) NewSyncingFilter(wsConn *concurrentWsConn) (string, error) {
+	ret := _m.Called(wsConn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewSyncingFilter")
+	}
+
 	var r0 string
 	var r1 error
 	if rf, ok := ret.Get(0).(func(*concurrentWsConn) (string, error)); ok {
@@ -144,6 +144,62 @@ func (_m *PoolMock) EffectiveGasPriceEnabled() bool {
 	return r0
 }
 
+// GetContent provides a mock function with given fields: ctx, limit
+func (_m *PoolMock) GetContent(ctx context.Context, limit uint64) (pool.Content, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContent")
+	}
+
+	var r0 pool.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (pool.Content, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) pool.Content); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(pool.Content)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetContentFrom provides a mock function with given fields: ctx, from
+func (_m *PoolMock) GetContentFrom(ctx context.Context, from common.Address) (pool.Content, error) {
+	ret := _m.Called(ctx, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentFrom")
+	}
+
+	var r0 pool.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address) (pool.Content, error)); ok {
+		return rf(ctx, from)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address) pool.Content); ok {
+		r0 = rf(ctx, from)
+	} else {
+		r0 = ret.Get(0).(pool.Content)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address) error); ok {
+		r1 = rf(ctx, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetGasPrices provides a mock function with given fields: ctx
 func (_m *PoolMock) GetGasPrices(ctx context.Context) (pool.GasPrices, error) {
 	ret := _m.Called(ctx)
@@ -172,6 +228,34 @@ func (_m *PoolMock) GetGasPrices(ctx context.Context) (pool.GasPrices, error) {
 	return r0, r1
 }
 
+// GetLastTxID provides a mock function with given fields: ctx
+func (_m *PoolMock) GetLastTxID(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastTxID")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (uint64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNonce provides a mock function with given fields: ctx, address
 func (_m *PoolMock) GetNonce(ctx context.Context, address common.Address) (uint64, error) {
 	ret := _m.Called(ctx, address)
@@ -230,6 +314,43 @@ func (_m *PoolMock) GetPendingTxHashesSince(ctx context.Context, since time.Time
 	return r0, r1
 }
 
+// GetPendingTxHashesSinceID provides a mock function with given fields: ctx, id
+func (_m *PoolMock) GetPendingTxHashesSinceID(ctx context.Context, id uint64) ([]common.Hash, uint64, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingTxHashesSinceID")
+	}
+
+	var r0 []common.Hash
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]common.Hash, uint64, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []common.Hash); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.Hash)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) uint64); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64) error); ok {
+		r2 = rf(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetPendingTxs provides a mock function with given fields: ctx, limit
 func (_m *PoolMock) GetPendingTxs(ctx context.Context, limit uint64) ([]pool.Transaction, error) {
 	ret := _m.Called(ctx, limit)
@@ -260,6 +381,41 @@ func (_m *PoolMock) GetPendingTxs(ctx context.Context, limit uint64) ([]pool.Tra
 	return r0, r1
 }
 
+// GetStatus provides a mock function with given fields: ctx
+func (_m *PoolMock) GetStatus(ctx context.Context) (uint64, uint64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatus")
+	}
+
+	var r0 uint64
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context) (uint64, uint64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) uint64); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetTransactionByHash provides a mock function with given fields: ctx, hash
 func (_m *PoolMock) GetTransactionByHash(ctx context.Context, hash common.Hash) (*pool.Transaction, error) {
 	ret := _m.Called(ctx, hash)
@@ -10,6 +10,8 @@ import (
 
 	coretypes "github.com/ethereum/go-ethereum/core/types"
 
+	merkletree "github.com/0xPolygonHermez/zkevm-node/merkletree"
+
 	mock "github.com/stretchr/testify/mock"
 
 	pgx "github.com/jackc/pgx/v4"
@@ -84,6 +86,36 @@ func (_m *StateMock) BeginStateTransaction(ctx context.Context) (pgx.Tx, error)
 	return r0, r1
 }
 
+// DebugBlockTransactions provides a mock function with given fields: ctx, block, traceConfig, dbTx
+func (_m *StateMock) DebugBlockTransactions(ctx context.Context, block *coretypes.Block, traceConfig state.TraceConfig, dbTx pgx.Tx) ([]*runtime.ExecutionResult, error) {
+	ret := _m.Called(ctx, block, traceConfig, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DebugBlockTransactions")
+	}
+
+	var r0 []*runtime.ExecutionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coretypes.Block, state.TraceConfig, pgx.Tx) ([]*runtime.ExecutionResult, error)); ok {
+		return rf(ctx, block, traceConfig, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coretypes.Block, state.TraceConfig, pgx.Tx) []*runtime.ExecutionResult); ok {
+		r0 = rf(ctx, block, traceConfig, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*runtime.ExecutionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coretypes.Block, state.TraceConfig, pgx.Tx) error); ok {
+		r1 = rf(ctx, block, traceConfig, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DebugTransaction provides a mock function with given fields: ctx, transactionHash, traceConfig, dbTx
 func (_m *StateMock) DebugTransaction(ctx context.Context, transactionHash common.Hash, traceConfig state.TraceConfig, dbTx pgx.Tx) (*runtime.ExecutionResult, error) {
 	ret := _m.Called(ctx, transactionHash, traceConfig, dbTx)
@@ -114,9 +146,39 @@ func (_m *StateMock) DebugTransaction(ctx context.Context, transactionHash commo
 	return r0, r1
 }
 
-// EstimateGas provides a mock function with given fields: transaction, senderAddress, l2BlockNumber, dbTx
-func (_m *StateMock) EstimateGas(transaction *coretypes.Transaction, senderAddress common.Address, l2BlockNumber *uint64, dbTx pgx.Tx) (uint64, []byte, error) {
-	ret := _m.Called(transaction, senderAddress, l2BlockNumber, dbTx)
+// DebugUnsignedTransaction provides a mock function with given fields: ctx, tx, senderAddress, l2BlockNumber, traceConfig, dbTx
+func (_m *StateMock) DebugUnsignedTransaction(ctx context.Context, tx *coretypes.Transaction, senderAddress common.Address, l2BlockNumber *uint64, traceConfig state.TraceConfig, dbTx pgx.Tx) (*runtime.ExecutionResult, error) {
+	ret := _m.Called(ctx, tx, senderAddress, l2BlockNumber, traceConfig, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DebugUnsignedTransaction")
+	}
+
+	var r0 *runtime.ExecutionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *coretypes.Transaction, common.Address, *uint64, state.TraceConfig, pgx.Tx) (*runtime.ExecutionResult, error)); ok {
+		return rf(ctx, tx, senderAddress, l2BlockNumber, traceConfig, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *coretypes.Transaction, common.Address, *uint64, state.TraceConfig, pgx.Tx) *runtime.ExecutionResult); ok {
+		r0 = rf(ctx, tx, senderAddress, l2BlockNumber, traceConfig, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*runtime.ExecutionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *coretypes.Transaction, common.Address, *uint64, state.TraceConfig, pgx.Tx) error); ok {
+		r1 = rf(ctx, tx, senderAddress, l2BlockNumber, traceConfig, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EstimateGas provides a mock function with given fields: transaction, senderAddress, l2BlockNumber, stateOverride, blockOverride, dbTx
+func (_m *StateMock) EstimateGas(transaction *coretypes.Transaction, senderAddress common.Address, l2BlockNumber *uint64, stateOverride state.StateOverride, blockOverride *state.BlockOverride, dbTx pgx.Tx) (uint64, []byte, error) {
+	ret := _m.Called(transaction, senderAddress, l2BlockNumber, stateOverride, blockOverride, dbTx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for EstimateGas")
@@ -125,25 +187,25 @@ func (_m *StateMock) EstimateGas(transaction *coretypes.Transaction, senderAddre
 	var r0 uint64
 	var r1 []byte
 	var r2 error
-	if rf, ok := ret.Get(0).(func(*coretypes.Transaction, common.Address, *uint64, pgx.Tx) (uint64, []byte, error)); ok {
-		return rf(transaction, senderAddress, l2BlockNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(*coretypes.Transaction, common.Address, *uint64, state.StateOverride, *state.BlockOverride, pgx.Tx) (uint64, []byte, error)); ok {
+		return rf(transaction, senderAddress, l2BlockNumber, stateOverride, blockOverride, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(*coretypes.Transaction, common.Address, *uint64, pgx.Tx) uint64); ok {
-		r0 = rf(transaction, senderAddress, l2BlockNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(*coretypes.Transaction, common.Address, *uint64, state.StateOverride, *state.BlockOverride, pgx.Tx) uint64); ok {
+		r0 = rf(transaction, senderAddress, l2BlockNumber, stateOverride, blockOverride, dbTx)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
-	if rf, ok := ret.Get(1).(func(*coretypes.Transaction, common.Address, *uint64, pgx.Tx) []byte); ok {
-		r1 = rf(transaction, senderAddress, l2BlockNumber, dbTx)
+	if rf, ok := ret.Get(1).(func(*coretypes.Transaction, common.Address, *uint64, state.StateOverride, *state.BlockOverride, pgx.Tx) []byte); ok {
+		r1 = rf(transaction, senderAddress, l2BlockNumber, stateOverride, blockOverride, dbTx)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]byte)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(*coretypes.Transaction, common.Address, *uint64, pgx.Tx) error); ok {
-		r2 = rf(transaction, senderAddress, l2BlockNumber, dbTx)
+	if rf, ok := ret.Get(2).(func(*coretypes.Transaction, common.Address, *uint64, state.StateOverride, *state.BlockOverride, pgx.Tx) error); ok {
+		r2 = rf(transaction, senderAddress, l2BlockNumber, stateOverride, blockOverride, dbTx)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -507,6 +569,36 @@ func (_m *StateMock) GetL2BlocksByBatchNumber(ctx context.Context, batchNumber u
 	return r0, r1
 }
 
+// GetL2BlocksFeeHistory provides a mock function with given fields: ctx, fromBlockNumber, toBlockNumber, dbTx
+func (_m *StateMock) GetL2BlocksFeeHistory(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx pgx.Tx) ([]state.L2BlockFeeHistory, error) {
+	ret := _m.Called(ctx, fromBlockNumber, toBlockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetL2BlocksFeeHistory")
+	}
+
+	var r0 []state.L2BlockFeeHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) ([]state.L2BlockFeeHistory, error)); ok {
+		return rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) []state.L2BlockFeeHistory); ok {
+		r0 = rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]state.L2BlockFeeHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetL2TxHashByTxHash provides a mock function with given fields: ctx, hash, dbTx
 func (_m *StateMock) GetL2TxHashByTxHash(ctx context.Context, hash common.Hash, dbTx pgx.Tx) (*common.Hash, error) {
 	ret := _m.Called(ctx, hash, dbTx)
@@ -939,6 +1031,114 @@ func (_m *StateMock) GetNonce(ctx context.Context, address common.Address, root
 	return r0, r1
 }
 
+// GetProof provides a mock function with given fields: ctx, address, storagePositions, root
+func (_m *StateMock) GetProof(ctx context.Context, address common.Address, storagePositions []*big.Int, root common.Hash) (*merkletree.AccountProof, error) {
+	ret := _m.Called(ctx, address, storagePositions, root)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProof")
+	}
+
+	var r0 *merkletree.AccountProof
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []*big.Int, common.Hash) (*merkletree.AccountProof, error)); ok {
+		return rf(ctx, address, storagePositions, root)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []*big.Int, common.Hash) *merkletree.AccountProof); ok {
+		r0 = rf(ctx, address, storagePositions, root)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*merkletree.AccountProof)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, []*big.Int, common.Hash) error); ok {
+		r1 = rf(ctx, address, storagePositions, root)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReceiptsByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
+func (_m *StateMock) GetReceiptsByBatchNumber(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) ([]*coretypes.Receipt, []coretypes.Transaction, error) {
+	ret := _m.Called(ctx, batchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReceiptsByBatchNumber")
+	}
+
+	var r0 []*coretypes.Receipt
+	var r1 []coretypes.Transaction
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) ([]*coretypes.Receipt, []coretypes.Transaction, error)); ok {
+		return rf(ctx, batchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) []*coretypes.Receipt); ok {
+		r0 = rf(ctx, batchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*coretypes.Receipt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) []coretypes.Transaction); ok {
+		r1 = rf(ctx, batchNumber, dbTx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]coretypes.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r2 = rf(ctx, batchNumber, dbTx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetReceiptsByL2BlockNumber provides a mock function with given fields: ctx, blockNumber, dbTx
+func (_m *StateMock) GetReceiptsByL2BlockNumber(ctx context.Context, blockNumber uint64, dbTx pgx.Tx) ([]*coretypes.Receipt, []coretypes.Transaction, error) {
+	ret := _m.Called(ctx, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReceiptsByL2BlockNumber")
+	}
+
+	var r0 []*coretypes.Receipt
+	var r1 []coretypes.Transaction
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) ([]*coretypes.Receipt, []coretypes.Transaction, error)); ok {
+		return rf(ctx, blockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) []*coretypes.Receipt); ok {
+		r0 = rf(ctx, blockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*coretypes.Receipt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) []coretypes.Transaction); ok {
+		r1 = rf(ctx, blockNumber, dbTx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]coretypes.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r2 = rf(ctx, blockNumber, dbTx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetStorageAt provides a mock function with given fields: ctx, address, position, root
 func (_m *StateMock) GetStorageAt(ctx context.Context, address common.Address, position *big.Int, root common.Hash) (*big.Int, error) {
 	ret := _m.Called(ctx, address, position, root)
@@ -1332,9 +1532,9 @@ func (_m *StateMock) PreProcessUnsignedTransaction(ctx context.Context, tx *core
 	return r0, r1
 }
 
-// ProcessUnsignedTransaction provides a mock function with given fields: ctx, tx, senderAddress, l2BlockNumber, noZKEVMCounters, dbTx
-func (_m *StateMock) ProcessUnsignedTransaction(ctx context.Context, tx *coretypes.Transaction, senderAddress common.Address, l2BlockNumber *uint64, noZKEVMCounters bool, dbTx pgx.Tx) (*runtime.ExecutionResult, error) {
-	ret := _m.Called(ctx, tx, senderAddress, l2BlockNumber, noZKEVMCounters, dbTx)
+// ProcessUnsignedTransaction provides a mock function with given fields: ctx, tx, senderAddress, l2BlockNumber, noZKEVMCounters, stateOverride, blockOverride, dbTx
+func (_m *StateMock) ProcessUnsignedTransaction(ctx context.Context, tx *coretypes.Transaction, senderAddress common.Address, l2BlockNumber *uint64, noZKEVMCounters bool, stateOverride state.StateOverride, blockOverride *state.BlockOverride, dbTx pgx.Tx) (*runtime.ExecutionResult, error) {
+	ret := _m.Called(ctx, tx, senderAddress, l2BlockNumber, noZKEVMCounters, stateOverride, blockOverride, dbTx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProcessUnsignedTransaction")
@@ -1342,19 +1542,19 @@ func (_m *StateMock) ProcessUnsignedTransaction(ctx context.Context, tx *coretyp
 
 	var r0 *runtime.ExecutionResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *coretypes.Transaction, common.Address, *uint64, bool, pgx.Tx) (*runtime.ExecutionResult, error)); ok {
-		return rf(ctx, tx, senderAddress, l2BlockNumber, noZKEVMCounters, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, *coretypes.Transaction, common.Address, *uint64, bool, state.StateOverride, *state.BlockOverride, pgx.Tx) (*runtime.ExecutionResult, error)); ok {
+		return rf(ctx, tx, senderAddress, l2BlockNumber, noZKEVMCounters, stateOverride, blockOverride, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *coretypes.Transaction, common.Address, *uint64, bool, pgx.Tx) *runtime.ExecutionResult); ok {
-		r0 = rf(ctx, tx, senderAddress, l2BlockNumber, noZKEVMCounters, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, *coretypes.Transaction, common.Address, *uint64, bool, state.StateOverride, *state.BlockOverride, pgx.Tx) *runtime.ExecutionResult); ok {
+		r0 = rf(ctx, tx, senderAddress, l2BlockNumber, noZKEVMCounters, stateOverride, blockOverride, dbTx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*runtime.ExecutionResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *coretypes.Transaction, common.Address, *uint64, bool, pgx.Tx) error); ok {
-		r1 = rf(ctx, tx, senderAddress, l2BlockNumber, noZKEVMCounters, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, *coretypes.Transaction, common.Address, *uint64, bool, state.StateOverride, *state.BlockOverride, pgx.Tx) error); ok {
+		r1 = rf(ctx, tx, senderAddress, l2BlockNumber, noZKEVMCounters, stateOverride, blockOverride, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
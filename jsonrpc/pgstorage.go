@@ -0,0 +1,181 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// PostgresStorage uses postgres to store the polling filters, so they
+// survive restarts and are shared by all the RPC instances using the
+// same database. The filters bound to a web socket connection can't be
+// shared, so they are kept in memory
+type PostgresStorage struct {
+	*Storage
+	db            *pgxpool.Pool
+	filterTimeout time.Duration
+}
+
+// NewPostgresStorage creates a new instance of storage that use
+// postgres to store the polling filters in the state database
+func NewPostgresStorage(db *pgxpool.Pool, filterTimeout time.Duration) *PostgresStorage {
+	return &PostgresStorage{
+		Storage:       NewStorage(),
+		db:            db,
+		filterTimeout: filterTimeout,
+	}
+}
+
+// NewLogFilter persists a new log filter
+func (s *PostgresStorage) NewLogFilter(wsConn *concurrentWsConn, filter LogFilter) (string, error) {
+	if wsConn != nil {
+		return s.Storage.NewLogFilter(wsConn, filter)
+	}
+
+	if err := filter.Validate(); err != nil {
+		return "", err
+	}
+
+	return s.createFilter(FilterTypeLog, &filter)
+}
+
+// NewBlockFilter persists a new block log filter
+func (s *PostgresStorage) NewBlockFilter(wsConn *concurrentWsConn) (string, error) {
+	if wsConn != nil {
+		return s.Storage.NewBlockFilter(wsConn)
+	}
+	return s.createFilter(FilterTypeBlock, nil)
+}
+
+// NewPendingTransactionFilter persists a new pending transaction filter
+func (s *PostgresStorage) NewPendingTransactionFilter(wsConn *concurrentWsConn, filter PendingTxFilter) (string, error) {
+	if wsConn != nil {
+		return s.Storage.NewPendingTransactionFilter(wsConn, filter)
+	}
+	return s.createFilter(FilterTypePendingTx, &filter)
+}
+
+// createFilter persists the filter to the database and provides the filter id
+func (s *PostgresStorage) createFilter(t FilterType, parameters interface{}) (string, error) {
+	ctx := context.Background()
+	id, err := s.generateFilterID()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate filter ID: %w", err)
+	}
+
+	var parametersJSON []byte
+	if parameters != nil {
+		parametersJSON, err = json.Marshal(parameters)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode filter parameters: %w", err)
+		}
+	}
+
+	if err := s.deleteExpiredFilters(ctx); err != nil {
+		return "", fmt.Errorf("failed to delete expired filters: %w", err)
+	}
+
+	const createFilterSQL = "INSERT INTO state.rpc_filter (id, type, parameters, last_poll) VALUES ($1, $2, $3, $4)"
+	if _, err := s.db.Exec(ctx, createFilterSQL, id, string(t), parametersJSON, time.Now().UTC()); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// deleteExpiredFilters deletes the filters that haven't been polled during the filter timeout
+func (s *PostgresStorage) deleteExpiredFilters(ctx context.Context) error {
+	if s.filterTimeout <= 0 {
+		return nil
+	}
+	const deleteExpiredFiltersSQL = "DELETE FROM state.rpc_filter WHERE last_poll < $1"
+	_, err := s.db.Exec(ctx, deleteExpiredFiltersSQL, time.Now().UTC().Add(-s.filterTimeout))
+	return err
+}
+
+// GetFilter gets a filter by its id
+func (s *PostgresStorage) GetFilter(filterID string) (*Filter, error) {
+	filter, err := s.Storage.GetFilter(filterID)
+	if !errors.Is(err, ErrNotFound) {
+		return filter, err
+	}
+
+	var (
+		filterType     string
+		parametersJSON []byte
+		lastPoll       time.Time
+	)
+	const getFilterSQL = "SELECT type, parameters, last_poll FROM state.rpc_filter WHERE id = $1"
+	err = s.db.QueryRow(context.Background(), getFilterSQL, filterID).Scan(&filterType, &parametersJSON, &lastPoll)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	filter = &Filter{
+		ID:       filterID,
+		Type:     FilterType(filterType),
+		LastPoll: lastPoll.UTC(),
+	}
+	switch filter.Type {
+	case FilterTypeLog:
+		var parameters LogFilter
+		if err := json.Unmarshal(parametersJSON, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to decode filter parameters: %w", err)
+		}
+		filter.Parameters = parameters
+	case FilterTypePendingTx:
+		// the parameters are optional, without them only the hashes are returned
+		var parameters PendingTxFilter
+		if len(parametersJSON) > 0 {
+			if err := json.Unmarshal(parametersJSON, &parameters); err != nil {
+				return nil, fmt.Errorf("failed to decode filter parameters: %w", err)
+			}
+		}
+		filter.Parameters = parameters
+	}
+
+	return filter, nil
+}
+
+// UpdateFilterLastPoll updates the last poll to now
+func (s *PostgresStorage) UpdateFilterLastPoll(filterID string) error {
+	err := s.Storage.UpdateFilterLastPoll(filterID)
+	if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	const updateFilterLastPollSQL = "UPDATE state.rpc_filter SET last_poll = $2 WHERE id = $1"
+	commandTag, err := s.db.Exec(context.Background(), updateFilterLastPollSQL, filterID, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// UninstallFilter deletes a filter by its id
+func (s *PostgresStorage) UninstallFilter(filterID string) error {
+	err := s.Storage.UninstallFilter(filterID)
+	if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	const uninstallFilterSQL = "DELETE FROM state.rpc_filter WHERE id = $1"
+	commandTag, err := s.db.Exec(context.Background(), uninstallFilterSQL, filterID)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
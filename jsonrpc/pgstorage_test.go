@@ -0,0 +1,103 @@
+package jsonrpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/db"
+	"github.com/0xPolygonHermez/zkevm-node/test/dbutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPostgresStorage(t *testing.T, filterTimeout time.Duration) *PostgresStorage {
+	dbCfg := dbutils.NewStateConfigFromEnv()
+	require.NoError(t, dbutils.InitOrResetState(dbCfg))
+
+	sqlDB, err := db.NewSQLDB(dbCfg)
+	require.NoError(t, err)
+	t.Cleanup(sqlDB.Close)
+
+	return NewPostgresStorage(sqlDB, filterTimeout)
+}
+
+func TestPostgresStorageCreateAndGetFilter(t *testing.T) {
+	storage := newTestPostgresStorage(t, time.Minute)
+
+	address := common.HexToAddress("0x617b3a3528F9cDd6630fd3301B9c8911F7Bf063D")
+	logFilterID, err := storage.NewLogFilter(nil, LogFilter{Addresses: []common.Address{address}})
+	require.NoError(t, err)
+	blockFilterID, err := storage.NewBlockFilter(nil)
+	require.NoError(t, err)
+	pendingTxFilterID, err := storage.NewPendingTransactionFilter(nil, PendingTxFilter{FullTx: true})
+	require.NoError(t, err)
+
+	filter, err := storage.GetFilter(logFilterID)
+	require.NoError(t, err)
+	assert.Equal(t, FilterTypeLog, filter.Type)
+	assert.Equal(t, []common.Address{address}, filter.Parameters.(LogFilter).Addresses)
+
+	filter, err = storage.GetFilter(blockFilterID)
+	require.NoError(t, err)
+	assert.Equal(t, FilterTypeBlock, filter.Type)
+	assert.Nil(t, filter.Parameters)
+
+	filter, err = storage.GetFilter(pendingTxFilterID)
+	require.NoError(t, err)
+	assert.Equal(t, FilterTypePendingTx, filter.Type)
+	assert.True(t, filter.Parameters.(PendingTxFilter).FullTx)
+
+	// the filters are shared by the instances using the same database
+	otherStorage := NewPostgresStorage(storage.db, time.Minute)
+	filter, err = otherStorage.GetFilter(blockFilterID)
+	require.NoError(t, err)
+	assert.Equal(t, blockFilterID, filter.ID)
+
+	require.NoError(t, storage.UninstallFilter(blockFilterID))
+	_, err = otherStorage.GetFilter(blockFilterID)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, storage.UninstallFilter(blockFilterID), ErrNotFound)
+}
+
+func TestPostgresStorageUpdateFilterLastPoll(t *testing.T) {
+	storage := newTestPostgresStorage(t, time.Minute)
+
+	filterID, err := storage.NewBlockFilter(nil)
+	require.NoError(t, err)
+
+	const setLastPollSQL = "UPDATE state.rpc_filter SET last_poll = $2 WHERE id = $1"
+	oldLastPoll := time.Now().UTC().Add(-30 * time.Second)
+	_, err = storage.db.Exec(context.Background(), setLastPollSQL, filterID, oldLastPoll)
+	require.NoError(t, err)
+
+	require.NoError(t, storage.UpdateFilterLastPoll(filterID))
+	filter, err := storage.GetFilter(filterID)
+	require.NoError(t, err)
+	assert.True(t, filter.LastPoll.After(oldLastPoll))
+
+	assert.ErrorIs(t, storage.UpdateFilterLastPoll("0x1"), ErrNotFound)
+}
+
+func TestPostgresStorageDeleteExpiredFilters(t *testing.T) {
+	storage := newTestPostgresStorage(t, time.Minute)
+
+	expiredFilterID, err := storage.NewBlockFilter(nil)
+	require.NoError(t, err)
+	activeFilterID, err := storage.NewBlockFilter(nil)
+	require.NoError(t, err)
+
+	const setLastPollSQL = "UPDATE state.rpc_filter SET last_poll = $2 WHERE id = $1"
+	_, err = storage.db.Exec(context.Background(), setLastPollSQL, expiredFilterID, time.Now().UTC().Add(-2*time.Minute))
+	require.NoError(t, err)
+
+	// the expired filters are deleted when a new filter is created
+	_, err = storage.NewBlockFilter(nil)
+	require.NoError(t, err)
+
+	_, err = storage.GetFilter(expiredFilterID)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = storage.GetFilter(activeFilterID)
+	assert.NoError(t, err)
+}
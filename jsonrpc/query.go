@@ -7,7 +7,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/0xPolygonHermez/zkevm-node/hex"
 	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/types"
 	"github.com/0xPolygonHermez/zkevm-node/log"
 	"github.com/0xPolygonHermez/zkevm-node/state"
@@ -23,6 +22,8 @@ const (
 	FilterTypeBlock = "block"
 	// FilterTypePendingTx represent a filter of type pending Tx.
 	FilterTypePendingTx = "pendingTx"
+	// FilterTypeSyncing represents a filter of type syncing.
+	FilterTypeSyncing = "syncing"
 )
 
 // Filter represents a filter.
@@ -110,6 +111,13 @@ type LogFilter struct {
 	Since     *time.Time
 }
 
+// PendingTxFilter is the parameter of the pending transaction filters
+type PendingTxFilter struct {
+	// FullTx defines if the filter must return the full pending transactions
+	// instead of only their hashes
+	FullTx bool `json:"fullTx"`
+}
+
 // addTopic adds specific topics to the log filter topics
 func (f *LogFilter) addTopic(topics ...string) error {
 	if f.Topics == nil {
@@ -159,7 +167,7 @@ func (f *LogFilter) MarshalJSON() ([]byte, error) {
 		fromBlock := ""
 		obj.FromBlock = &fromBlock
 	} else if f.FromBlock != nil {
-		fromBlock := hex.EncodeUint64(uint64(*f.FromBlock))
+		fromBlock := f.FromBlock.StringOrHex()
 		obj.FromBlock = &fromBlock
 	}
 
@@ -167,7 +175,7 @@ func (f *LogFilter) MarshalJSON() ([]byte, error) {
 		toBlock := ""
 		obj.ToBlock = &toBlock
 	} else if f.ToBlock != nil {
-		toBlock := hex.EncodeUint64(uint64(*f.ToBlock))
+		toBlock := f.ToBlock.StringOrHex()
 		obj.ToBlock = &toBlock
 	}
 
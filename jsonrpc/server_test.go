@@ -89,7 +89,7 @@ func newMockedServer(t *testing.T, cfg Config) (*mockedServer, *mocksWrapper, *e
 	if _, ok := apis[APITxPool]; ok {
 		services = append(services, Service{
 			Name:    APITxPool,
-			Service: &TxPoolEndpoints{},
+			Service: NewTxPoolEndpoints(cfg, pool),
 		})
 	}
 
@@ -159,6 +159,7 @@ func getSequencerDefaultConfig() Config {
 		MaxLogsCount:                 10000,
 		MaxLogsBlockRange:            10000,
 		MaxNativeBlockHashBlockRange: 60000,
+		TxPoolContentLimit:           100,
 		WebSockets: WebSocketsConfig{
 			Enabled:   true,
 			Host:      "0.0.0.0",
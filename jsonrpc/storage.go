@@ -9,6 +9,7 @@ import (
 	"github.com/0xPolygonHermez/zkevm-node/hex"
 	"github.com/0xPolygonHermez/zkevm-node/state"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 // ErrNotFound represent a not found error.
@@ -25,10 +26,12 @@ type Storage struct {
 	blockFiltersWithWSConn     map[string]*Filter
 	logFiltersWithWSConn       map[string]*Filter
 	pendingTxFiltersWithWSConn map[string]*Filter
+	syncingFiltersWithWSConn   map[string]*Filter
 
 	blockMutex     *sync.Mutex
 	logMutex       *sync.Mutex
 	pendingTxMutex *sync.Mutex
+	syncingMutex   *sync.Mutex
 }
 
 // NewStorage creates and initializes an instance of Storage
@@ -39,9 +42,24 @@ func NewStorage() *Storage {
 		blockFiltersWithWSConn:     make(map[string]*Filter),
 		logFiltersWithWSConn:       make(map[string]*Filter),
 		pendingTxFiltersWithWSConn: make(map[string]*Filter),
+		syncingFiltersWithWSConn:   make(map[string]*Filter),
 		blockMutex:                 &sync.Mutex{},
 		logMutex:                   &sync.Mutex{},
 		pendingTxMutex:             &sync.Mutex{},
+		syncingMutex:               &sync.Mutex{},
+	}
+}
+
+// NewFilterStorage creates the filter storage defined by cfg.FilterStorage,
+// the postgres one uses the state database pool
+func NewFilterStorage(cfg Config, stateDB *pgxpool.Pool) (storageInterface, error) {
+	switch cfg.FilterStorage {
+	case FilterStorageMemory, "":
+		return NewStorage(), nil
+	case FilterStoragePostgres:
+		return NewPostgresStorage(stateDB, cfg.FilterTimeout.Duration), nil
+	default:
+		return nil, fmt.Errorf("unknown filter storage %q", cfg.FilterStorage)
 	}
 }
 
@@ -60,8 +78,13 @@ func (s *Storage) NewBlockFilter(wsConn *concurrentWsConn) (string, error) {
 }
 
 // NewPendingTransactionFilter persists a new pending transaction filter
-func (s *Storage) NewPendingTransactionFilter(wsConn *concurrentWsConn) (string, error) {
-	return s.createFilter(FilterTypePendingTx, nil, wsConn)
+func (s *Storage) NewPendingTransactionFilter(wsConn *concurrentWsConn, filter PendingTxFilter) (string, error) {
+	return s.createFilter(FilterTypePendingTx, filter, wsConn)
+}
+
+// NewSyncingFilter persists a new syncing filter
+func (s *Storage) NewSyncingFilter(wsConn *concurrentWsConn) (string, error) {
+	return s.createFilter(FilterTypeSyncing, nil, wsConn)
 }
 
 // create persists the filter to the memory and provides the filter id
@@ -75,9 +98,11 @@ func (s *Storage) createFilter(t FilterType, parameters interface{}, wsConn *con
 	s.blockMutex.Lock()
 	s.logMutex.Lock()
 	s.pendingTxMutex.Lock()
+	s.syncingMutex.Lock()
 	defer s.blockMutex.Unlock()
 	defer s.logMutex.Unlock()
 	defer s.pendingTxMutex.Unlock()
+	defer s.syncingMutex.Unlock()
 
 	f := &Filter{
 		ID:            id,
@@ -104,6 +129,8 @@ func (s *Storage) createFilter(t FilterType, parameters interface{}, wsConn *con
 			s.logFiltersWithWSConn[id] = f
 		} else if t == FilterTypePendingTx {
 			s.pendingTxFiltersWithWSConn[id] = f
+		} else if t == FilterTypeSyncing {
+			s.syncingFiltersWithWSConn[id] = f
 		}
 	}
 	return id, nil
@@ -152,14 +179,44 @@ func (s *Storage) GetAllLogFiltersWithWSConn() []*Filter {
 	return filters
 }
 
+// GetAllPendingTxFiltersWithWSConn returns an array with all filter that have
+// a web socket connection and are filtering by new pending transactions
+func (s *Storage) GetAllPendingTxFiltersWithWSConn() []*Filter {
+	s.pendingTxMutex.Lock()
+	defer s.pendingTxMutex.Unlock()
+
+	filters := []*Filter{}
+	for _, filter := range s.pendingTxFiltersWithWSConn {
+		f := filter
+		filters = append(filters, f)
+	}
+	return filters
+}
+
+// GetAllSyncingFiltersWithWSConn returns an array with all filter that have
+// a web socket connection and are filtering by syncing status changes
+func (s *Storage) GetAllSyncingFiltersWithWSConn() []*Filter {
+	s.syncingMutex.Lock()
+	defer s.syncingMutex.Unlock()
+
+	filters := []*Filter{}
+	for _, filter := range s.syncingFiltersWithWSConn {
+		f := filter
+		filters = append(filters, f)
+	}
+	return filters
+}
+
 // GetFilter gets a filter by its id
 func (s *Storage) GetFilter(filterID string) (*Filter, error) {
 	s.blockMutex.Lock()
 	s.logMutex.Lock()
 	s.pendingTxMutex.Lock()
+	s.syncingMutex.Lock()
 	defer s.blockMutex.Unlock()
 	defer s.logMutex.Unlock()
 	defer s.pendingTxMutex.Unlock()
+	defer s.syncingMutex.Unlock()
 
 	filter, found := s.allFilters[filterID]
 	if !found {
@@ -174,9 +231,11 @@ func (s *Storage) UpdateFilterLastPoll(filterID string) error {
 	s.blockMutex.Lock()
 	s.logMutex.Lock()
 	s.pendingTxMutex.Lock()
+	s.syncingMutex.Lock()
 	defer s.blockMutex.Unlock()
 	defer s.logMutex.Unlock()
 	defer s.pendingTxMutex.Unlock()
+	defer s.syncingMutex.Unlock()
 
 	filter, found := s.allFilters[filterID]
 	if !found {
@@ -192,9 +251,11 @@ func (s *Storage) UninstallFilter(filterID string) error {
 	s.blockMutex.Lock()
 	s.logMutex.Lock()
 	s.pendingTxMutex.Lock()
+	s.syncingMutex.Lock()
 	defer s.blockMutex.Unlock()
 	defer s.logMutex.Unlock()
 	defer s.pendingTxMutex.Unlock()
+	defer s.syncingMutex.Unlock()
 
 	filter, found := s.allFilters[filterID]
 	if !found {
@@ -210,9 +271,11 @@ func (s *Storage) UninstallFilterByWSConn(wsConn *concurrentWsConn) error {
 	s.blockMutex.Lock()
 	s.logMutex.Lock()
 	s.pendingTxMutex.Lock()
+	s.syncingMutex.Lock()
 	defer s.blockMutex.Unlock()
 	defer s.logMutex.Unlock()
 	defer s.pendingTxMutex.Unlock()
+	defer s.syncingMutex.Unlock()
 
 	filters, found := s.allFiltersWithWSConn[wsConn]
 	if !found {
@@ -234,6 +297,8 @@ func (s *Storage) deleteFilter(filter *Filter) {
 		delete(s.logFiltersWithWSConn, filter.ID)
 	} else if filter.Type == FilterTypePendingTx {
 		delete(s.pendingTxFiltersWithWSConn, filter.ID)
+	} else if filter.Type == FilterTypeSyncing {
+		delete(s.syncingFiltersWithWSConn, filter.ID)
 	}
 
 	if filter.WsConn != nil {
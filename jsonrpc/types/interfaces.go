@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/0xPolygonHermez/zkevm-node/merkletree"
 	"github.com/0xPolygonHermez/zkevm-node/pool"
 	"github.com/0xPolygonHermez/zkevm-node/state"
 	"github.com/0xPolygonHermez/zkevm-node/state/runtime"
@@ -19,6 +20,8 @@ type PoolInterface interface {
 	GetGasPrices(ctx context.Context) (pool.GasPrices, error)
 	GetNonce(ctx context.Context, address common.Address) (uint64, error)
 	GetPendingTxHashesSince(ctx context.Context, since time.Time) ([]common.Hash, error)
+	GetLastTxID(ctx context.Context) (uint64, error)
+	GetPendingTxHashesSinceID(ctx context.Context, id uint64) ([]common.Hash, uint64, error)
 	GetPendingTxs(ctx context.Context, limit uint64) ([]pool.Transaction, error)
 	CountPendingTransactions(ctx context.Context) (uint64, error)
 	GetTransactionByHash(ctx context.Context, hash common.Hash) (*pool.Transaction, error)
@@ -26,6 +29,9 @@ type PoolInterface interface {
 	CalculateEffectiveGasPrice(rawTx []byte, txGasPrice *big.Int, txGasUsed uint64, l1GasPrice uint64, l2GasPrice uint64) (*big.Int, error)
 	CalculateEffectiveGasPricePercentage(gasPrice *big.Int, effectiveGasPrice *big.Int) (uint8, error)
 	EffectiveGasPriceEnabled() bool
+	GetContent(ctx context.Context, limit uint64) (pool.Content, error)
+	GetStatus(ctx context.Context) (uint64, uint64, error)
+	GetContentFrom(ctx context.Context, from common.Address) (pool.Content, error)
 }
 
 // StateInterface gathers the methods required to interact with the state.
@@ -33,7 +39,9 @@ type StateInterface interface {
 	StartToMonitorNewL2Blocks()
 	BeginStateTransaction(ctx context.Context) (pgx.Tx, error)
 	DebugTransaction(ctx context.Context, transactionHash common.Hash, traceConfig state.TraceConfig, dbTx pgx.Tx) (*runtime.ExecutionResult, error)
-	EstimateGas(transaction *types.Transaction, senderAddress common.Address, l2BlockNumber *uint64, dbTx pgx.Tx) (uint64, []byte, error)
+	DebugUnsignedTransaction(ctx context.Context, tx *types.Transaction, senderAddress common.Address, l2BlockNumber *uint64, traceConfig state.TraceConfig, dbTx pgx.Tx) (*runtime.ExecutionResult, error)
+	DebugBlockTransactions(ctx context.Context, block *types.Block, traceConfig state.TraceConfig, dbTx pgx.Tx) ([]*runtime.ExecutionResult, error)
+	EstimateGas(transaction *types.Transaction, senderAddress common.Address, l2BlockNumber *uint64, stateOverride state.StateOverride, blockOverride *state.BlockOverride, dbTx pgx.Tx) (uint64, []byte, error)
 	GetBalance(ctx context.Context, address common.Address, root common.Hash) (*big.Int, error)
 	GetCode(ctx context.Context, address common.Address, root common.Hash) ([]byte, error)
 	GetL2BlockByHash(ctx context.Context, hash common.Hash, dbTx pgx.Tx) (*state.L2Block, error)
@@ -50,15 +58,19 @@ type StateInterface interface {
 	GetLogs(ctx context.Context, fromBlock uint64, toBlock uint64, addresses []common.Address, topics [][]common.Hash, blockHash *common.Hash, since *time.Time, dbTx pgx.Tx) ([]*types.Log, error)
 	GetNonce(ctx context.Context, address common.Address, root common.Hash) (uint64, error)
 	GetStorageAt(ctx context.Context, address common.Address, position *big.Int, root common.Hash) (*big.Int, error)
+	GetProof(ctx context.Context, address common.Address, storagePositions []*big.Int, root common.Hash) (*merkletree.AccountProof, error)
 	GetSyncingInfo(ctx context.Context, dbTx pgx.Tx) (state.SyncingInfo, error)
 	GetTransactionByHash(ctx context.Context, transactionHash common.Hash, dbTx pgx.Tx) (*types.Transaction, error)
 	GetTransactionByL2Hash(ctx context.Context, transactionHash common.Hash, dbTx pgx.Tx) (*types.Transaction, error)
 	GetTransactionByL2BlockHashAndIndex(ctx context.Context, blockHash common.Hash, index uint64, dbTx pgx.Tx) (*types.Transaction, error)
 	GetTransactionByL2BlockNumberAndIndex(ctx context.Context, blockNumber uint64, index uint64, dbTx pgx.Tx) (*types.Transaction, error)
 	GetTransactionReceipt(ctx context.Context, transactionHash common.Hash, dbTx pgx.Tx) (*types.Receipt, error)
+	GetReceiptsByL2BlockNumber(ctx context.Context, blockNumber uint64, dbTx pgx.Tx) ([]*types.Receipt, []types.Transaction, error)
+	GetReceiptsByBatchNumber(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) ([]*types.Receipt, []types.Transaction, error)
+	GetL2BlocksFeeHistory(ctx context.Context, fromBlockNumber, toBlockNumber uint64, dbTx pgx.Tx) ([]state.L2BlockFeeHistory, error)
 	IsL2BlockConsolidated(ctx context.Context, blockNumber uint64, dbTx pgx.Tx) (bool, error)
 	IsL2BlockVirtualized(ctx context.Context, blockNumber uint64, dbTx pgx.Tx) (bool, error)
-	ProcessUnsignedTransaction(ctx context.Context, tx *types.Transaction, senderAddress common.Address, l2BlockNumber *uint64, noZKEVMCounters bool, dbTx pgx.Tx) (*runtime.ExecutionResult, error)
+	ProcessUnsignedTransaction(ctx context.Context, tx *types.Transaction, senderAddress common.Address, l2BlockNumber *uint64, noZKEVMCounters bool, stateOverride state.StateOverride, blockOverride *state.BlockOverride, dbTx pgx.Tx) (*runtime.ExecutionResult, error)
 	RegisterNewL2BlockEventHandler(h state.NewL2BlockEventHandler)
 	GetLastVirtualBatchNum(ctx context.Context, dbTx pgx.Tx) (uint64, error)
 	GetLastVerifiedBatch(ctx context.Context, dbTx pgx.Tx) (*state.VerifiedBatch, error)
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/0xPolygonHermez/zkevm-node/hex"
+	"github.com/0xPolygonHermez/zkevm-node/merkletree"
 	"github.com/0xPolygonHermez/zkevm-node/state"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -237,6 +238,77 @@ func (args *TxArgs) ToTransaction(ctx context.Context, st StateInterface, maxCum
 	return sender, tx, nil
 }
 
+// OverrideAccount indicates the overriding fields of an account during the
+// execution of a message call.
+// See https://geth.ethereum.org/docs/interacting-with-geth/rpc/ns-eth#eth-call
+type OverrideAccount struct {
+	Nonce     *ArgUint64                   `json:"nonce"`
+	Code      *ArgBytes                    `json:"code"`
+	Balance   *ArgBig                      `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// StateOverride is the collection of accounts to be overridden during the
+// execution of a message call, by address
+type StateOverride map[common.Address]OverrideAccount
+
+// ToStateOverride converts the state override to the state format
+func (o *StateOverride) ToStateOverride() state.StateOverride {
+	if o == nil {
+		return nil
+	}
+
+	result := make(state.StateOverride, len(*o))
+	for address, account := range *o {
+		overrideAccount := state.OverrideAccount{}
+		if account.Nonce != nil {
+			nonce := uint64(*account.Nonce)
+			overrideAccount.Nonce = &nonce
+		}
+		if account.Code != nil {
+			overrideAccount.Code = append([]byte{}, *account.Code...)
+		}
+		if account.Balance != nil {
+			overrideAccount.Balance = (*big.Int)(account.Balance)
+		}
+		if account.State != nil {
+			overrideAccount.State = *account.State
+		}
+		if account.StateDiff != nil {
+			overrideAccount.StateDiff = *account.StateDiff
+		}
+		result[address] = overrideAccount
+	}
+	return result
+}
+
+// BlockOverride indicates the overriding fields of the block context in
+// which a message call is executed
+type BlockOverride struct {
+	Number   *ArgUint64      `json:"number"`
+	Time     *ArgUint64      `json:"time"`
+	Coinbase *common.Address `json:"coinbase"`
+}
+
+// ToBlockOverride converts the block override to the state format
+func (o *BlockOverride) ToBlockOverride() *state.BlockOverride {
+	if o == nil {
+		return nil
+	}
+
+	result := &state.BlockOverride{Coinbase: o.Coinbase}
+	if o.Number != nil {
+		number := uint64(*o.Number)
+		result.Number = &number
+	}
+	if o.Time != nil {
+		time := uint64(*o.Time)
+		result.Time = &time
+	}
+	return result
+}
+
 // Block structure
 type Block struct {
 	ParentHash      common.Hash         `json:"parentHash"`
@@ -520,39 +592,80 @@ func (bh *BlockOrHash) UnmarshalJSON(input []byte) error {
 
 // Transaction structure
 type Transaction struct {
-	Nonce       ArgUint64       `json:"nonce"`
-	GasPrice    ArgBig          `json:"gasPrice"`
-	Gas         ArgUint64       `json:"gas"`
-	To          *common.Address `json:"to"`
-	Value       ArgBig          `json:"value"`
-	Input       ArgBytes        `json:"input"`
-	V           ArgBig          `json:"v"`
-	R           ArgBig          `json:"r"`
-	S           ArgBig          `json:"s"`
-	Hash        common.Hash     `json:"hash"`
-	From        common.Address  `json:"from"`
-	BlockHash   *common.Hash    `json:"blockHash"`
-	BlockNumber *ArgUint64      `json:"blockNumber"`
-	TxIndex     *ArgUint64      `json:"transactionIndex"`
-	ChainID     ArgBig          `json:"chainId"`
-	Type        ArgUint64       `json:"type"`
-	Receipt     *Receipt        `json:"receipt,omitempty"`
-	L2Hash      *common.Hash    `json:"l2Hash,omitempty"`
+	Nonce                ArgUint64         `json:"nonce"`
+	GasPrice             ArgBig            `json:"gasPrice"`
+	MaxFeePerGas         *ArgBig           `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *ArgBig           `json:"maxPriorityFeePerGas,omitempty"`
+	Gas                  ArgUint64         `json:"gas"`
+	To                   *common.Address   `json:"to"`
+	Value                ArgBig            `json:"value"`
+	Input                ArgBytes          `json:"input"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+	V                    ArgBig            `json:"v"`
+	R                    ArgBig            `json:"r"`
+	S                    ArgBig            `json:"s"`
+	YParity              *ArgUint64        `json:"yParity,omitempty"`
+	Hash                 common.Hash       `json:"hash"`
+	From                 common.Address    `json:"from"`
+	BlockHash            *common.Hash      `json:"blockHash"`
+	BlockNumber          *ArgUint64        `json:"blockNumber"`
+	TxIndex              *ArgUint64        `json:"transactionIndex"`
+	ChainID              ArgBig            `json:"chainId"`
+	Type                 ArgUint64         `json:"type"`
+	Receipt              *Receipt          `json:"receipt,omitempty"`
+	L2Hash               *common.Hash      `json:"l2Hash,omitempty"`
 }
 
 // CoreTx returns a geth core type Transaction
 func (t Transaction) CoreTx() *types.Transaction {
-	return types.NewTx(&types.LegacyTx{
-		Nonce:    uint64(t.Nonce),
-		GasPrice: (*big.Int)(&t.GasPrice),
-		Gas:      uint64(t.Gas),
-		To:       t.To,
-		Value:    (*big.Int)(&t.Value),
-		Data:     t.Input,
-		V:        (*big.Int)(&t.V),
-		R:        (*big.Int)(&t.R),
-		S:        (*big.Int)(&t.S),
-	})
+	var accessList types.AccessList
+	if t.AccessList != nil {
+		accessList = *t.AccessList
+	}
+
+	switch uint8(t.Type) {
+	case types.AccessListTxType:
+		return types.NewTx(&types.AccessListTx{
+			ChainID:    (*big.Int)(&t.ChainID),
+			Nonce:      uint64(t.Nonce),
+			GasPrice:   (*big.Int)(&t.GasPrice),
+			Gas:        uint64(t.Gas),
+			To:         t.To,
+			Value:      (*big.Int)(&t.Value),
+			Data:       t.Input,
+			AccessList: accessList,
+			V:          (*big.Int)(&t.V),
+			R:          (*big.Int)(&t.R),
+			S:          (*big.Int)(&t.S),
+		})
+	case types.DynamicFeeTxType:
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    (*big.Int)(&t.ChainID),
+			Nonce:      uint64(t.Nonce),
+			GasTipCap:  (*big.Int)(t.MaxPriorityFeePerGas),
+			GasFeeCap:  (*big.Int)(t.MaxFeePerGas),
+			Gas:        uint64(t.Gas),
+			To:         t.To,
+			Value:      (*big.Int)(&t.Value),
+			Data:       t.Input,
+			AccessList: accessList,
+			V:          (*big.Int)(&t.V),
+			R:          (*big.Int)(&t.R),
+			S:          (*big.Int)(&t.S),
+		})
+	default:
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    uint64(t.Nonce),
+			GasPrice: (*big.Int)(&t.GasPrice),
+			Gas:      uint64(t.Gas),
+			To:       t.To,
+			Value:    (*big.Int)(&t.Value),
+			Data:     t.Input,
+			V:        (*big.Int)(&t.V),
+			R:        (*big.Int)(&t.R),
+			S:        (*big.Int)(&t.S),
+		})
+	}
 }
 
 // NewTransaction creates a transaction instance
@@ -581,6 +694,28 @@ func NewTransaction(
 		L2Hash:   l2Hash,
 	}
 
+	// typed txs (EIP-2718) include the access list and the y parity of the signature
+	if tx.Type() != types.LegacyTxType {
+		accessList := tx.AccessList()
+		if accessList == nil {
+			accessList = types.AccessList{}
+		}
+		res.AccessList = &accessList
+		yParity := ArgUint64(v.Uint64())
+		res.YParity = &yParity
+	}
+
+	if tx.Type() == types.DynamicFeeTxType {
+		maxFeePerGas := ArgBig(*tx.GasFeeCap())
+		res.MaxFeePerGas = &maxFeePerGas
+		maxPriorityFeePerGas := ArgBig(*tx.GasTipCap())
+		res.MaxPriorityFeePerGas = &maxPriorityFeePerGas
+		// gasPrice is the price paid once the tx is mined, otherwise the fee cap
+		if receipt != nil && receipt.EffectiveGasPrice != nil {
+			res.GasPrice = ArgBig(*receipt.EffectiveGasPrice)
+		}
+	}
+
 	if receipt != nil {
 		bn := ArgUint64(receipt.BlockNumber.Uint64())
 		res.BlockNumber = &bn
@@ -599,6 +734,106 @@ func NewTransaction(
 	return res, nil
 }
 
+// FeeHistory is the response of eth_feeHistory, EffectiveGasPricePercentage
+// contains the effective gas price percentage applied by the sequencer to the
+// txs used to compute each reward percentile
+type FeeHistory struct {
+	OldestBlock                 ArgUint64     `json:"oldestBlock"`
+	BaseFeePerGas               []ArgBig      `json:"baseFeePerGas"`
+	GasUsedRatio                []float64     `json:"gasUsedRatio"`
+	Reward                      [][]ArgBig    `json:"reward,omitempty"`
+	EffectiveGasPricePercentage [][]ArgUint64 `json:"effectiveGasPricePercentage,omitempty"`
+}
+
+// SMTProof is the proof of a leaf of the zkEVM state sparse merkle tree.
+// Siblings contains the siblings of the nodes in the path from the root to
+// the leaf, sorted by level. InsKey and InsValue are the key and value of the
+// leaf found in the path when the key is not in the tree
+type SMTProof struct {
+	Key      common.Hash   `json:"key"`
+	Value    ArgBig        `json:"value"`
+	Siblings [][]ArgUint64 `json:"siblings"`
+	InsKey   *common.Hash  `json:"insKey,omitempty"`
+	InsValue *ArgBig       `json:"insValue,omitempty"`
+	IsOld0   bool          `json:"isOld0"`
+}
+
+// NewSMTProof creates a SMTProof instance from a merkletree proof
+func NewSMTProof(proof *merkletree.Proof) SMTProof {
+	res := SMTProof{
+		Key:      proof.KeyHash(),
+		Value:    ArgBig(*proof.ValueScalar()),
+		Siblings: make([][]ArgUint64, 0, len(proof.Siblings)),
+		IsOld0:   proof.IsOld0,
+	}
+	for _, siblings := range proof.Siblings {
+		levelSiblings := make([]ArgUint64, 0, len(siblings))
+		for _, sibling := range siblings {
+			levelSiblings = append(levelSiblings, ArgUint64(sibling))
+		}
+		res.Siblings = append(res.Siblings, levelSiblings)
+	}
+	if len(proof.InsKey) > 0 {
+		insKey := proof.InsKeyHash()
+		res.InsKey = &insKey
+	}
+	if len(proof.InsValue) > 0 {
+		insValue := ArgBig(*proof.InsValueScalar())
+		res.InsValue = &insValue
+	}
+	return res
+}
+
+// StorageProof is the proof of a storage slot of an account
+type StorageProof struct {
+	Key   common.Hash `json:"key"`
+	Value ArgBig      `json:"value"`
+	Proof SMTProof    `json:"proof"`
+}
+
+// AccountProof is the response of zkevm_getProof, it contains the values and
+// the proofs of the leaves of an account in the zkEVM state sparse merkle
+// tree for the state root
+type AccountProof struct {
+	Address         common.Address `json:"address"`
+	StateRoot       common.Hash    `json:"stateRoot"`
+	Balance         ArgBig         `json:"balance"`
+	Nonce           ArgUint64      `json:"nonce"`
+	CodeHash        common.Hash    `json:"codeHash"`
+	CodeLength      ArgUint64      `json:"codeLength"`
+	BalanceProof    SMTProof       `json:"balanceProof"`
+	NonceProof      SMTProof       `json:"nonceProof"`
+	CodeHashProof   SMTProof       `json:"codeHashProof"`
+	CodeLengthProof SMTProof       `json:"codeLengthProof"`
+	StorageProof    []StorageProof `json:"storageProof"`
+}
+
+// NewAccountProof creates an AccountProof instance from the merkletree account
+// proof, storageKeys must be in the same order as the storage proofs
+func NewAccountProof(address common.Address, stateRoot common.Hash, storageKeys []common.Hash, proof *merkletree.AccountProof) AccountProof {
+	res := AccountProof{
+		Address:         address,
+		StateRoot:       stateRoot,
+		Balance:         ArgBig(*proof.Balance.ValueScalar()),
+		Nonce:           ArgUint64(proof.Nonce.ValueScalar().Uint64()),
+		CodeHash:        common.BigToHash(proof.CodeHash.ValueScalar()),
+		CodeLength:      ArgUint64(proof.CodeLength.ValueScalar().Uint64()),
+		BalanceProof:    NewSMTProof(proof.Balance),
+		NonceProof:      NewSMTProof(proof.Nonce),
+		CodeHashProof:   NewSMTProof(proof.CodeHash),
+		CodeLengthProof: NewSMTProof(proof.CodeLength),
+		StorageProof:    make([]StorageProof, 0, len(proof.Storage)),
+	}
+	for i, storageProof := range proof.Storage {
+		res.StorageProof = append(res.StorageProof, StorageProof{
+			Key:   storageKeys[i],
+			Value: ArgBig(*storageProof.ValueScalar()),
+			Proof: NewSMTProof(storageProof),
+		})
+	}
+	return res
+}
+
 // Receipt structure
 type Receipt struct {
 	Root              *common.Hash    `json:"root,omitempty"`
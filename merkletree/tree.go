@@ -243,10 +243,57 @@ func (tree *StateTree) SetStorageAt(ctx context.Context, address common.Address,
 	return h4ToFilledByteSlice(updateProof.NewRoot), updateProof, nil
 }
 
+// GetAccountProof returns the proofs of the balance, nonce, code hash, code length
+// and the provided storage positions of an account, including the siblings of the
+// path of each leaf, so they can be verified against the root.
+func (tree *StateTree) GetAccountProof(ctx context.Context, address common.Address, storagePositions []*big.Int, root []byte) (*AccountProof, error) {
+	r := scalarToh4(new(big.Int).SetBytes(root))
+
+	getLeafProof := func(key []byte, err error) (*Proof, error) {
+		if err != nil {
+			return nil, err
+		}
+		return tree.getWithDetails(ctx, r, scalarToh4(new(big.Int).SetBytes(key)), true)
+	}
+
+	var (
+		accountProof AccountProof
+		err          error
+	)
+	if accountProof.Balance, err = getLeafProof(KeyEthAddrBalance(address)); err != nil {
+		return nil, err
+	}
+	if accountProof.Nonce, err = getLeafProof(KeyEthAddrNonce(address)); err != nil {
+		return nil, err
+	}
+	if accountProof.CodeHash, err = getLeafProof(KeyContractCode(address)); err != nil {
+		return nil, err
+	}
+	if accountProof.CodeLength, err = getLeafProof(KeyCodeLength(address)); err != nil {
+		return nil, err
+	}
+
+	accountProof.Storage = make([]*Proof, 0, len(storagePositions))
+	for _, position := range storagePositions {
+		storageProof, err := getLeafProof(KeyContractStorage(address, position.Bytes()))
+		if err != nil {
+			return nil, err
+		}
+		accountProof.Storage = append(accountProof.Storage, storageProof)
+	}
+
+	return &accountProof, nil
+}
+
 func (tree *StateTree) get(ctx context.Context, root, key []uint64) (*Proof, error) {
+	return tree.getWithDetails(ctx, root, key, false)
+}
+
+func (tree *StateTree) getWithDetails(ctx context.Context, root, key []uint64, details bool) (*Proof, error) {
 	result, err := tree.grpcClient.Get(ctx, &hashdb.GetRequest{
-		Root: &hashdb.Fea{Fe0: root[0], Fe1: root[1], Fe2: root[2], Fe3: root[3]},
-		Key:  &hashdb.Fea{Fe0: key[0], Fe1: key[1], Fe2: key[2], Fe3: key[3]},
+		Root:    &hashdb.Fea{Fe0: root[0], Fe1: root[1], Fe2: root[2], Fe3: root[3]},
+		Key:     &hashdb.Fea{Fe0: key[0], Fe1: key[1], Fe2: key[2], Fe3: key[3]},
+		Details: details,
 	})
 	if err != nil {
 		return nil, err
@@ -256,11 +303,34 @@ func (tree *StateTree) get(ctx context.Context, root, key []uint64) (*Proof, err
 	if err != nil {
 		return nil, err
 	}
-	return &Proof{
+	proof := &Proof{
 		Root:  []uint64{root[0], root[1], root[2], root[3]},
 		Key:   key,
 		Value: value,
-	}, nil
+	}
+	if !details {
+		return proof, nil
+	}
+
+	proof.Siblings = make([][]uint64, len(result.Siblings))
+	for level := range proof.Siblings {
+		siblingList, found := result.Siblings[uint64(level)]
+		if !found {
+			return nil, fmt.Errorf("missing siblings for level %d", level)
+		}
+		proof.Siblings[level] = siblingList.Sibling
+	}
+	if result.InsKey != nil {
+		proof.InsKey = []uint64{result.InsKey.Fe0, result.InsKey.Fe1, result.InsKey.Fe2, result.InsKey.Fe3}
+	}
+	if result.InsValue != "" {
+		if proof.InsValue, err = string2fea(result.InsValue); err != nil {
+			return nil, err
+		}
+	}
+	proof.IsOld0 = result.IsOld0
+
+	return proof, nil
 }
 
 func (tree *StateTree) getProgram(ctx context.Context, key []uint64) (*ProgramProof, error) {
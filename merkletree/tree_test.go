@@ -3,6 +3,7 @@ package merkletree
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/0xPolygonHermez/zkevm-node/hex"
@@ -84,3 +85,44 @@ func TestGetCode(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAccountProof(t *testing.T) {
+	ctx := context.Background()
+	zkProverURI := testutils.GetEnv("ZKPROVER_URI", "localhost")
+
+	cfg := Config{URI: fmt.Sprintf("%s:50061", zkProverURI)}
+	c, _, _ := NewMTDBServiceClient(ctx, cfg)
+	sTree := NewStateTree(c)
+
+	addr := common.HexToAddress("0x3")
+	balance := big.NewInt(1000)
+	storagePosition := big.NewInt(1)
+	storageValue := big.NewInt(2000)
+	root := common.HexToHash("0x0").Bytes()
+	txID := uuid.NewString()
+
+	err := sTree.StartBlock(ctx, common.Hash(root), txID)
+	require.NoError(t, err)
+	root, _, err = sTree.SetBalance(ctx, addr, balance, root, txID)
+	require.NoError(t, err)
+	root, _, err = sTree.SetStorageAt(ctx, addr, storagePosition, storageValue, root, txID)
+	require.NoError(t, err)
+	err = sTree.FinishBlock(ctx, common.Hash(root), txID)
+	require.NoError(t, err)
+	err = sTree.Flush(ctx, common.Hash(root), txID)
+	require.NoError(t, err)
+
+	proof, err := sTree.GetAccountProof(ctx, addr, []*big.Int{storagePosition, big.NewInt(2)}, root)
+	require.NoError(t, err)
+
+	require.Equal(t, balance, proof.Balance.ValueScalar())
+	require.NotEmpty(t, proof.Balance.Siblings)
+	require.Equal(t, big.NewInt(0), proof.Nonce.ValueScalar())
+	require.Len(t, proof.Storage, 2)
+	require.Equal(t, storageValue, proof.Storage[0].ValueScalar())
+	require.Equal(t, big.NewInt(0), proof.Storage[1].ValueScalar())
+
+	key, err := KeyEthAddrBalance(addr)
+	require.NoError(t, err)
+	require.Equal(t, common.BytesToHash(key), proof.Balance.KeyHash())
+}
@@ -1,5 +1,11 @@
 package merkletree
 
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
 // ResultCode represents the result code.
 type ResultCode int64
 
@@ -24,6 +30,52 @@ type Proof struct {
 	Key []uint64
 	// Value is the proof value.
 	Value []uint64
+	// Siblings are the siblings of the nodes in the path from the root to
+	// the leaf, sorted by level. Only set when the proof is requested with details.
+	Siblings [][]uint64
+	// InsKey is the key of the leaf found in the path of the proof key
+	// when the proof key is not in the tree.
+	InsKey []uint64
+	// InsValue is the value of the leaf found in the path of the proof key
+	// when the proof key is not in the tree.
+	InsValue []uint64
+	// IsOld0 is true when the path of the proof key ends in an empty node.
+	IsOld0 bool
+}
+
+// KeyHash returns the proof key as a hash.
+func (p *Proof) KeyHash() common.Hash {
+	return common.BytesToHash(h4ToFilledByteSlice(p.Key))
+}
+
+// ValueScalar returns the proof value as a scalar.
+func (p *Proof) ValueScalar() *big.Int {
+	return fea2scalar(p.Value)
+}
+
+// InsKeyHash returns the proof ins key as a hash.
+func (p *Proof) InsKeyHash() common.Hash {
+	return common.BytesToHash(h4ToFilledByteSlice(p.InsKey))
+}
+
+// InsValueScalar returns the proof ins value as a scalar.
+func (p *Proof) InsValueScalar() *big.Int {
+	return fea2scalar(p.InsValue)
+}
+
+// AccountProof contains the proofs of the leaves of an account.
+type AccountProof struct {
+	// Balance is the proof of the balance leaf.
+	Balance *Proof
+	// Nonce is the proof of the nonce leaf.
+	Nonce *Proof
+	// CodeHash is the proof of the code hash leaf.
+	CodeHash *Proof
+	// CodeLength is the proof of the code length leaf.
+	CodeLength *Proof
+	// Storage are the proofs of the storage leaves, in the same order
+	// as the requested storage positions.
+	Storage []*Proof
 }
 
 // UpdateProof is a proof generated on Set operation.
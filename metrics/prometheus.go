@@ -13,6 +13,7 @@ var (
 	storageMutex  sync.RWMutex
 	registerer    prometheus.Registerer
 	gauges        map[string]prometheus.Gauge
+	gaugeVecs     map[string]*prometheus.GaugeVec
 	counters      map[string]prometheus.Counter
 	counterVecs   map[string]*prometheus.CounterVec
 	histograms    map[string]prometheus.Histogram
@@ -22,6 +23,12 @@ var (
 	initOnce      sync.Once
 )
 
+// GaugeVecOpts holds options for the GaugeVec type.
+type GaugeVecOpts struct {
+	prometheus.GaugeOpts
+	Labels []string
+}
+
 // CounterVecOpts holds options for the CounterVec type.
 type CounterVecOpts struct {
 	prometheus.CounterOpts
@@ -40,6 +47,7 @@ func Init() {
 		storageMutex = sync.RWMutex{}
 		registerer = prometheus.DefaultRegisterer
 		gauges = make(map[string]prometheus.Gauge)
+		gaugeVecs = make(map[string]*prometheus.GaugeVec)
 		counters = make(map[string]prometheus.Counter)
 		counterVecs = make(map[string]*prometheus.CounterVec)
 		histograms = make(map[string]prometheus.Histogram)
@@ -133,6 +141,73 @@ func GaugeDec(name string) {
 	}
 }
 
+// RegisterGaugeVecs registers the provided gauge vec metrics to the
+// Prometheus registerer.
+func RegisterGaugeVecs(opts ...GaugeVecOpts) {
+	if !initialized {
+		return
+	}
+
+	storageMutex.Lock()
+	defer storageMutex.Unlock()
+
+	for _, options := range opts {
+		registerGaugeVecIfNotExists(options)
+	}
+}
+
+// GaugeVec retrieves gauge vec metric by name
+func GaugeVec(name string) (gaugeVec *prometheus.GaugeVec, exist bool) {
+	if !initialized {
+		return
+	}
+
+	storageMutex.RLock()
+	defer storageMutex.RUnlock()
+
+	gaugeVec, exist = gaugeVecs[name]
+
+	return gaugeVec, exist
+}
+
+// GaugeVecSet sets the value for the gauge vec with the given name and label.
+func GaugeVecSet(name string, label string, value float64) {
+	if !initialized {
+		return
+	}
+
+	if gv, ok := GaugeVec(name); ok {
+		gv.WithLabelValues(label).Set(value)
+	}
+}
+
+// GaugeVecDelete deletes the gauge of the gauge vec with the given name and
+// label, so it is no longer exported.
+func GaugeVecDelete(name string, label string) {
+	if !initialized {
+		return
+	}
+
+	if gv, ok := GaugeVec(name); ok {
+		gv.DeleteLabelValues(label)
+	}
+}
+
+// UnregisterGaugeVecs unregisters the provided gauge vec metrics from the
+// Prometheus registerer.
+func UnregisterGaugeVecs(names ...string) {
+	if !initialized {
+		return
+	}
+
+	storageMutex.Lock()
+	defer storageMutex.Unlock()
+
+	for _, name := range names {
+		unregisterGaugeVecIfExists(name)
+	}
+}
+
 // RegisterCounters registers the provided counter metrics to the Prometheus
 // registerer.
 func RegisterCounters(opts ...prometheus.CounterOpts) {
@@ -468,6 +543,48 @@ func unregisterGaugeIfExists(name string) {
 	log.Debug("Gauge Metric successfully unregistered!")
 }
 
+// registerGaugeVecIfNotExists registers single gauge vec metric if not exists
+func registerGaugeVecIfNotExists(opts GaugeVecOpts) {
+	log := log.WithFields("metricName", opts.Name)
+	if _, exist := gaugeVecs[opts.Name]; exist {
+		log.Warn("Gauge vec metric already exists.")
+		return
+	}
+
+	log.Debug("Creating Gauge Vec Metric...")
+	gaugeVec := prometheus.NewGaugeVec(opts.GaugeOpts, opts.Labels)
+	log.Debugf("Gauge Vec Metric successfully created! Labels: %p", opts.ConstLabels)
+
+	log.Debug("Registering Gauge Vec Metric...")
+	registerer.MustRegister(gaugeVec)
+	log.Debug("Gauge Vec Metric successfully registered!")
+
+	gaugeVecs[opts.Name] = gaugeVec
+}
+
+// unregisterGaugeVecIfExists unregisters single gauge vec metric if exists
+func unregisterGaugeVecIfExists(name string) {
+	var (
+		gaugeVec *prometheus.GaugeVec
+		ok       bool
+	)
+
+	log := log.WithFields("metricName", name)
+	if gaugeVec, ok = gaugeVecs[name]; !ok {
+		log.Warn("Trying to delete non-existing Gauge Vec metrics.")
+		return
+	}
+
+	log.Debug("Unregistering Gauge Vec Metric...")
+	ok = registerer.Unregister(gaugeVec)
+	if !ok {
+		log.Error("Failed to unregister Gauge Vec Metric.")
+		return
+	}
+	delete(gaugeVecs, name)
+	log.Debug("Gauge Vec Metric successfully unregistered!")
+}
+
 // registerCounterIfNotExists registers single counter metric if not exists
 func registerCounterIfNotExists(opts prometheus.CounterOpts) {
 	log := log.WithFields("metricName", opts.Name)
@@ -38,6 +38,9 @@ type Config struct {
 	// GlobalQueue represents the maximum number of non-executable transaction slots for all accounts
 	GlobalQueue uint64 `mapstructure:"GlobalQueue"`
 
+	// Quotas are the limits of the pending transactions per sender and per IP
+	Quotas QuotasCfg `mapstructure:"Quotas"`
+
 	// EffectiveGasPrice is the config for the effective gas price calculation
 	EffectiveGasPrice EffectiveGasPriceCfg `mapstructure:"EffectiveGasPrice"`
 
@@ -80,3 +83,36 @@ type EffectiveGasPriceCfg struct {
 	// calculations when the effective gas price is disabled (testing/metrics purposes)
 	L2GasPriceSuggesterFactor float64 `mapstructure:"L2GasPriceSuggesterFactor"`
 }
+
+// QuotasCfg contains the admission quotas of the pool. The senders and IPs stored in the
+// pool.whitelisted table are exempted from the quotas
+type QuotasCfg struct {
+	// PerSender is the quota of the pending transactions sent by the same address
+	PerSender QuotaCfg `mapstructure:"PerSender"`
+
+	// PerIP is the quota of the pending transactions received from the same IP
+	PerIP QuotaCfg `mapstructure:"PerIP"`
+}
+
+// IsEnabled returns true if the quota per sender or the quota per IP is enabled
+func (c QuotasCfg) IsEnabled() bool {
+	return c.PerSender.IsEnabled() || c.PerIP.IsEnabled()
+}
+
+// QuotaCfg contains the limits of a quota, a limit set to 0 is disabled
+type QuotaCfg struct {
+	// MaxTxs is the max number of pending transactions
+	MaxTxs uint64 `mapstructure:"MaxTxs"`
+
+	// MaxBytes is the max size in bytes of the pending transactions
+	MaxBytes uint64 `mapstructure:"MaxBytes"`
+
+	// MaxReservedBatches is the max number of batches that can be filled with the ZK counters reserved
+	// by the pending transactions, i.e. 0.5 allows to reserve up to half of any ZK counter of a batch
+	MaxReservedBatches float64 `mapstructure:"MaxReservedBatches"`
+}
+
+// IsEnabled returns true if any of the limits of the quota is set
+func (c QuotaCfg) IsEnabled() bool {
+	return c.MaxTxs > 0 || c.MaxBytes > 0 || c.MaxReservedBatches > 0
+}
@@ -0,0 +1,184 @@
+package pool
+
+import (
+	"context"
+	"sort"
+
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Content contains the pending txs of the pool grouped by sender and nonce.
+// Pending contains the txs that can be executed right away, starting at the
+// current nonce of the sender, Queued contains the txs waiting for a nonce gap
+// to be filled
+type Content struct {
+	Pending map[common.Address]map[uint64]Transaction
+	Queued  map[common.Address]map[uint64]Transaction
+}
+
+// NewContent creates an empty pool content
+func NewContent() Content {
+	return Content{
+		Pending: make(map[common.Address]map[uint64]Transaction),
+		Queued:  make(map[common.Address]map[uint64]Transaction),
+	}
+}
+
+// GetContent returns the pending txs of the pool grouped by sender and nonce.
+// If limit is not zero at most limit txs are loaded, sorted by sender and nonce,
+// so the txs left out are the ones with the highest nonces of the last senders
+func (p *Pool) GetContent(ctx context.Context, limit uint64) (Content, error) {
+	txs, err := p.storage.GetTxsByStatusSortedByFromAndNonce(ctx, TxStatusPending, limit)
+	if err != nil {
+		return Content{}, err
+	}
+	return p.buildContent(ctx, txs)
+}
+
+// GetContentFrom returns the pending txs of the pool sent by the provided address
+// grouped by nonce
+func (p *Pool) GetContentFrom(ctx context.Context, from common.Address) (Content, error) {
+	txs, err := p.storage.GetTxsByFromAndStatus(ctx, from, TxStatusPending)
+	if err != nil {
+		return Content{}, err
+	}
+	return p.buildContent(ctx, txs)
+}
+
+// GetStatus returns the number of pending and queued txs of the pool, counted
+// from the nonces of the pending txs of each sender without loading the txs
+func (p *Pool) GetStatus(ctx context.Context) (uint64, uint64, error) {
+	noncesBySender, err := p.storage.GetNoncesByStatus(ctx, TxStatusPending)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(noncesBySender) == 0 {
+		return 0, 0, nil
+	}
+
+	lastL2Block, err := p.state.GetLastL2Block(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var pending, queued uint64
+	for sender, nonces := range noncesBySender {
+		currentNonce, err := p.state.GetNonce(ctx, sender, lastL2Block.Root())
+		if err != nil {
+			return 0, 0, err
+		}
+
+		// the nonces are sorted and without duplicates
+		nextNonce := currentNonce
+		for _, nonce := range nonces {
+			if nonce < currentNonce {
+				continue
+			}
+			if nonce == nextNonce {
+				pending++
+				nextNonce++
+				continue
+			}
+			if p.fitsAccountQueue(nonce, currentNonce) {
+				queued++
+			}
+		}
+	}
+	if p.cfg.GlobalQueue > 0 && queued > p.cfg.GlobalQueue {
+		queued = p.cfg.GlobalQueue
+	}
+
+	return pending, queued, nil
+}
+
+// fitsAccountQueue returns if a tx waiting for a nonce gap to be filled is within
+// the AccountQueue of its sender
+func (p *Pool) fitsAccountQueue(nonce, currentNonce uint64) bool {
+	return p.cfg.AccountQueue == 0 || nonce <= currentNonce+p.cfg.AccountQueue-1
+}
+
+// buildContent splits the provided txs in pending and queued accordingly to the
+// current nonce of each sender. The queued txs of each sender are limited by the
+// AccountQueue and the queued txs of the whole pool are limited by the GlobalQueue,
+// keeping the oldest ones
+func (p *Pool) buildContent(ctx context.Context, txs []Transaction) (Content, error) {
+	content := NewContent()
+	if len(txs) == 0 {
+		return content, nil
+	}
+
+	txsBySender := make(map[common.Address][]Transaction)
+	for _, tx := range txs {
+		from, err := state.GetSender(tx.Transaction)
+		if err != nil {
+			log.Warnf("failed to get sender of pool tx %v: %v", tx.Hash().String(), err)
+			continue
+		}
+		txsBySender[from] = append(txsBySender[from], tx)
+	}
+
+	lastL2Block, err := p.state.GetLastL2Block(ctx, nil)
+	if err != nil {
+		return Content{}, err
+	}
+
+	type queuedTx struct {
+		sender common.Address
+		tx     Transaction
+	}
+	queuedTxs := []queuedTx{}
+	for sender, senderTxs := range txsBySender {
+		// for the same nonce the tx with the highest gas price is the one to be executed
+		sort.SliceStable(senderTxs, func(i, j int) bool {
+			if senderTxs[i].Nonce() != senderTxs[j].Nonce() {
+				return senderTxs[i].Nonce() < senderTxs[j].Nonce()
+			}
+			return senderTxs[i].GasPrice().Cmp(senderTxs[j].GasPrice()) > 0
+		})
+
+		currentNonce, err := p.state.GetNonce(ctx, sender, lastL2Block.Root())
+		if err != nil {
+			return Content{}, err
+		}
+
+		nextNonce := currentNonce
+		for i, tx := range senderTxs {
+			nonce := tx.Nonce()
+			// skip the txs already executed and the ones with the same nonce
+			// as the previous one, which has a higher gas price
+			if nonce < currentNonce || (i > 0 && nonce == senderTxs[i-1].Nonce()) {
+				continue
+			}
+
+			if nonce == nextNonce {
+				if content.Pending[sender] == nil {
+					content.Pending[sender] = make(map[uint64]Transaction)
+				}
+				content.Pending[sender][nonce] = tx
+				nextNonce++
+				continue
+			}
+
+			if p.fitsAccountQueue(nonce, currentNonce) {
+				queuedTxs = append(queuedTxs, queuedTx{sender: sender, tx: tx})
+			}
+		}
+	}
+
+	sort.SliceStable(queuedTxs, func(i, j int) bool {
+		return queuedTxs[i].tx.ReceivedAt.Before(queuedTxs[j].tx.ReceivedAt)
+	})
+	if p.cfg.GlobalQueue > 0 && uint64(len(queuedTxs)) > p.cfg.GlobalQueue {
+		queuedTxs = queuedTxs[:p.cfg.GlobalQueue]
+	}
+	for _, queued := range queuedTxs {
+		if content.Queued[queued.sender] == nil {
+			content.Queued[queued.sender] = make(map[uint64]Transaction)
+		}
+		content.Queued[queued.sender][queued.tx.Nonce()] = queued.tx
+	}
+
+	return content, nil
+}
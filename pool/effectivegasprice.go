@@ -7,6 +7,7 @@ import (
 
 	"github.com/0xPolygonHermez/zkevm-node/log"
 	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 var (
@@ -54,6 +55,20 @@ func (e *EffectiveGasPrice) GetTxAndL2GasPrice(txGasPrice *big.Int, l1GasPrice u
 	}
 }
 
+// GetTxGasPrice returns the gas price a tx is willing to pay for the given l2 gas price.
+// For dynamic fee txs (EIP-1559) the l2 gas price acts as the base fee, so the tx pays
+// min(gasFeeCap, l2GasPrice + gasTipCap). For the rest of txs it's the tx gas price
+func GetTxGasPrice(txType uint8, gasPrice *big.Int, gasTipCap *big.Int, l2GasPrice uint64) *big.Int {
+	if txType != types.DynamicFeeTxType || gasTipCap == nil {
+		return gasPrice
+	}
+	txGasPrice := new(big.Int).Add(new(big.Int).SetUint64(l2GasPrice), gasTipCap)
+	if txGasPrice.Cmp(gasPrice) > 0 {
+		return gasPrice
+	}
+	return txGasPrice
+}
+
 // CalculateBreakEvenGasPrice calculates the break even gas price for a transaction
 func (e *EffectiveGasPrice) CalculateBreakEvenGasPrice(rawTx []byte, txGasPrice *big.Int, txGasUsed uint64, l1GasPrice uint64) (*big.Int, error) {
 	const ethTransferGas = 21000
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,6 +23,49 @@ var (
 	}
 )
 
+func TestGetTxGasPrice(t *testing.T) {
+	testCases := []struct {
+		name          string
+		txType        uint8
+		gasPrice      *big.Int
+		gasTipCap     *big.Int
+		l2GasPrice    uint64
+		expectedValue *big.Int
+	}{
+		{
+			name:          "Legacy tx uses the gas price",
+			txType:        types.LegacyTxType,
+			gasPrice:      big.NewInt(1000),
+			gasTipCap:     big.NewInt(1000),
+			l2GasPrice:    100,
+			expectedValue: big.NewInt(1000),
+		},
+		{
+			name:          "Dynamic fee tx pays the l2 gas price plus the tip",
+			txType:        types.DynamicFeeTxType,
+			gasPrice:      big.NewInt(1000),
+			gasTipCap:     big.NewInt(10),
+			l2GasPrice:    100,
+			expectedValue: big.NewInt(110),
+		},
+		{
+			name:          "Dynamic fee tx is capped by the fee cap",
+			txType:        types.DynamicFeeTxType,
+			gasPrice:      big.NewInt(1000),
+			gasTipCap:     big.NewInt(500),
+			l2GasPrice:    900,
+			expectedValue: big.NewInt(1000),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := GetTxGasPrice(tc.txType, tc.gasPrice, tc.gasTipCap, tc.l2GasPrice)
+			assert.Equal(t, tc.expectedValue, actual)
+		})
+	}
+}
+
 func TestCalculateEffectiveGasPricePercentage(t *testing.T) {
 	egp := NewEffectiveGasPrice(egpCfg)
 
@@ -39,6 +39,14 @@ var (
 	// AccountQueue and can't accept another remote transaction.
 	ErrTxPoolAccountOverflow = errors.New("account has reached the tx limit in the txpool")
 
+	// ErrTxPoolSenderQuotaExceeded is returned if the pending transactions of the sender
+	// have reached any of the limits of the per sender quota.
+	ErrTxPoolSenderQuotaExceeded = errors.New("sender has reached the quota of the txpool")
+
+	// ErrTxPoolIPQuotaExceeded is returned if the pending transactions received from the
+	// IP have reached any of the limits of the per IP quota.
+	ErrTxPoolIPQuotaExceeded = errors.New("IP has reached the quota of the txpool")
+
 	// ErrTxPoolOverflow is returned if the transaction pool is full and can't accept
 	// another remote transaction.
 	ErrTxPoolOverflow = errors.New("txpool is full")
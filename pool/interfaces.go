@@ -13,14 +13,20 @@ import (
 
 type storage interface {
 	AddTx(ctx context.Context, tx Transaction) error
+	AddTxWithinQuotas(ctx context.Context, tx Transaction, checkQuotas func(ctx context.Context, usage TxsUsageReader) error) error
 	CountTransactionsByStatus(ctx context.Context, status ...TxStatus) (uint64, error)
 	CountTransactionsByFromAndStatus(ctx context.Context, from common.Address, status ...TxStatus) (uint64, error)
 	DeleteTransactionsByHashes(ctx context.Context, hashes []common.Hash) error
 	GetGasPrices(ctx context.Context) (uint64, uint64, error)
 	GetNonce(ctx context.Context, address common.Address) (uint64, error)
 	GetPendingTxHashesSince(ctx context.Context, since time.Time) ([]common.Hash, error)
+	GetLastTxID(ctx context.Context) (uint64, error)
+	GetPendingTxHashesSinceID(ctx context.Context, id uint64) ([]common.Hash, uint64, error)
 	GetTxsByFromAndNonce(ctx context.Context, from common.Address, nonce uint64) ([]Transaction, error)
 	GetTxsByStatus(ctx context.Context, state TxStatus, limit uint64) ([]Transaction, error)
+	GetTxsByFromAndStatus(ctx context.Context, from common.Address, status ...TxStatus) ([]Transaction, error)
+	GetTxsByStatusSortedByFromAndNonce(ctx context.Context, status TxStatus, limit uint64) ([]Transaction, error)
+	GetNoncesByStatus(ctx context.Context, status TxStatus) (map[common.Address][]uint64, error)
 	GetNonWIPPendingTxs(ctx context.Context) ([]Transaction, error)
 	IsTxPending(ctx context.Context, hash common.Hash) (bool, error)
 	SetGasPrices(ctx context.Context, l2GasPrice uint64, l1GasPrice uint64) error
@@ -37,6 +43,9 @@ type storage interface {
 	DeleteTransactionByHash(ctx context.Context, hash common.Hash) error
 	MarkWIPTxsAsPending(ctx context.Context) error
 	GetAllAddressesBlocked(ctx context.Context) ([]common.Address, error)
+	IsWhitelisted(ctx context.Context, addr string) (bool, error)
+	GetPendingTxsUsageByFrom(ctx context.Context, from common.Address) (TxsUsage, error)
+	GetPendingTxsUsageByIP(ctx context.Context, ip string) (TxsUsage, error)
 	MinL2GasPriceSince(ctx context.Context, timestamp time.Time) (uint64, error)
 	GetEarliestProcessedTx(ctx context.Context) (common.Hash, error)
 }
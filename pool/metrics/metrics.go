@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"github.com/0xPolygonHermez/zkevm-node/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	prefix               = "pool_"
+	quotaLimitName       = prefix + "quota_limit"
+	quotaRejectedTxsName = prefix + "quota_rejected_txs"
+	quotaExemptedTxsName = prefix + "quota_exempted_txs"
+
+	quotaLabelName = "quota"
+)
+
+// Register the metrics for the pool package.
+func Register() {
+	counterVecs := []metrics.CounterVecOpts{
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: quotaRejectedTxsName,
+				Help: "[POOL] number of txs rejected because the sender or the IP reached a limit of its quota",
+			},
+			Labels: []string{quotaLabelName},
+		},
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: quotaExemptedTxsName,
+				Help: "[POOL] number of txs over a quota accepted because the sender or the IP is whitelisted",
+			},
+			Labels: []string{quotaLabelName},
+		},
+	}
+
+	gaugeVecs := []metrics.GaugeVecOpts{
+		{
+			GaugeOpts: prometheus.GaugeOpts{
+				Name: quotaLimitName,
+				Help: "[POOL] configured limit of each quota, 0 means disabled",
+			},
+			Labels: []string{quotaLabelName},
+		},
+	}
+
+	metrics.RegisterCounterVecs(counterVecs...)
+	metrics.RegisterGaugeVecs(gaugeVecs...)
+}
+
+// QuotaLimit sets the gauge for the configured limit of the quota.
+func QuotaLimit(quota string, limit float64) {
+	metrics.GaugeVecSet(quotaLimitName, quota, limit)
+}
+
+// QuotaRejectedTx increments the counter of txs rejected by the quota.
+func QuotaRejectedTx(quota string) {
+	metrics.CounterVecInc(quotaRejectedTxsName, quota)
+}
+
+// QuotaExemptedTx increments the counter of whitelisted txs that exceeded the quota.
+func QuotaExemptedTx(quota string) {
+	metrics.CounterVecInc(quotaExemptedTxsName, quota)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/0xPolygonHermez/zkevm-node/db"
@@ -12,6 +13,7 @@ import (
 	"github.com/0xPolygonHermez/zkevm-node/state"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
@@ -22,6 +24,12 @@ type PostgresPoolStorage struct {
 	db *pgxpool.Pool
 }
 
+// execQuerier is implemented by the db pool and the db transactions
+type execQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
 // NewPostgresPoolStorage creates and initializes an instance of PostgresPoolStorage
 func NewPostgresPoolStorage(cfg db.Config) (*PostgresPoolStorage, error) {
 	poolDB, err := db.NewSQLDB(cfg)
@@ -36,6 +44,47 @@ func NewPostgresPoolStorage(cfg db.Config) (*PostgresPoolStorage, error) {
 
 // AddTx adds a transaction to the pool table with the provided status
 func (p *PostgresPoolStorage) AddTx(ctx context.Context, tx pool.Transaction) error {
+	return addTx(ctx, p.db, tx)
+}
+
+// AddTxWithinQuotas adds a transaction to the pool table once checkQuotas accepts the usage of the
+// pending txs of its sender and IP. The check and the insert run in a db transaction holding an
+// advisory lock of the sender and the IP, so the txs of the same sender or IP are added one by one
+func (p *PostgresPoolStorage) AddTxWithinQuotas(ctx context.Context, tx pool.Transaction, checkQuotas func(ctx context.Context, usage pool.TxsUsageReader) error) error {
+	const lockSQL = "SELECT pg_advisory_xact_lock(hashtext($1))"
+
+	from, err := state.GetSender(tx.Transaction)
+	if err != nil {
+		return err
+	}
+
+	dbTx, err := p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = dbTx.Rollback(ctx) }()
+
+	// the sender lock is always taken before the IP lock to avoid deadlocks
+	lockKeys := []string{"pool_quota_sender_" + from.String()}
+	if tx.IP != "" {
+		lockKeys = append(lockKeys, "pool_quota_ip_"+tx.IP)
+	}
+	for _, key := range lockKeys {
+		if _, err := dbTx.Exec(ctx, lockSQL, key); err != nil {
+			return err
+		}
+	}
+
+	if err := checkQuotas(ctx, pendingTxsUsageReader{db: dbTx}); err != nil {
+		return err
+	}
+	if err := addTx(ctx, dbTx, tx); err != nil {
+		return err
+	}
+	return dbTx.Commit(ctx)
+}
+
+func addTx(ctx context.Context, db execQuerier, tx pool.Transaction) error {
 	hash := tx.Hash().Hex()
 
 	b, err := tx.MarshalBinary()
@@ -97,6 +146,7 @@ func (p *PostgresPoolStorage) AddTx(ctx context.Context, tx pool.Transaction) er
 			used_sha256_hashes = $15,
 			received_at = $16,
 			from_address = $17,
+			id = DEFAULT,
 			is_wip = $18,
 			ip = $19,
 			failed_reason = NULL,
@@ -110,7 +160,7 @@ func (p *PostgresPoolStorage) AddTx(ctx context.Context, tx pool.Transaction) er
 	}
 	fromAddress := data.String()
 
-	if _, err := p.db.Exec(ctx, sql,
+	if _, err := db.Exec(ctx, sql,
 		hash,
 		encoded,
 		decoded,
@@ -171,6 +221,86 @@ func (p *PostgresPoolStorage) GetTxsByStatus(ctx context.Context, status pool.Tx
 	return txs, nil
 }
 
+// GetTxsByFromAndStatus returns the txs sent by the provided address with
+// any of the provided statuses, ordered by nonce
+func (p *PostgresPoolStorage) GetTxsByFromAndStatus(ctx context.Context, from common.Address, status ...pool.TxStatus) ([]pool.Transaction, error) {
+	sql := `SELECT encoded, status, received_at, is_wip, ip, cumulative_gas_used, used_keccak_hashes, used_poseidon_hashes, used_poseidon_paddings, used_mem_aligns,
+			used_arithmetics, used_binaries, used_steps, used_sha256_hashes, failed_reason, reserved_zkcounters
+			FROM pool.transaction WHERE from_address = $1 AND status = ANY ($2) ORDER BY nonce ASC, gas_price DESC`
+	rows, err := p.db.Query(ctx, sql, from.String(), status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	txs := make([]pool.Transaction, 0, len(rows.RawValues()))
+	for rows.Next() {
+		tx, err := scanTx(rows)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, *tx)
+	}
+
+	return txs, nil
+}
+
+// GetTxsByStatusSortedByFromAndNonce returns an array of transactions filtered by status
+// sorted by sender, nonce and gas price, limit = 0 means unlimited
+func (p *PostgresPoolStorage) GetTxsByStatusSortedByFromAndNonce(ctx context.Context, status pool.TxStatus, limit uint64) ([]pool.Transaction, error) {
+	sql := `SELECT encoded, status, received_at, is_wip, ip, cumulative_gas_used, used_keccak_hashes, used_poseidon_hashes, used_poseidon_paddings, used_mem_aligns,
+			used_arithmetics, used_binaries, used_steps, used_sha256_hashes, failed_reason, reserved_zkcounters
+			FROM pool.transaction WHERE status = $1 ORDER BY from_address ASC, nonce ASC, gas_price DESC`
+	args := []interface{}{status.String()}
+	if limit > 0 {
+		sql += " LIMIT $2"
+		args = append(args, limit)
+	}
+	rows, err := p.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	txs := make([]pool.Transaction, 0, len(rows.RawValues()))
+	for rows.Next() {
+		tx, err := scanTx(rows)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, *tx)
+	}
+
+	return txs, nil
+}
+
+// GetNoncesByStatus returns the nonces of the transactions filtered by status grouped
+// by sender, the nonces of each sender are sorted and without duplicates
+func (p *PostgresPoolStorage) GetNoncesByStatus(ctx context.Context, status pool.TxStatus) (map[common.Address][]uint64, error) {
+	sql := `SELECT from_address, nonce FROM pool.transaction WHERE status = $1
+			GROUP BY from_address, nonce ORDER BY from_address ASC, nonce ASC`
+	rows, err := p.db.Query(ctx, sql, status.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	noncesBySender := make(map[common.Address][]uint64)
+	for rows.Next() {
+		var (
+			from  string
+			nonce uint64
+		)
+		if err := rows.Scan(&from, &nonce); err != nil {
+			return nil, err
+		}
+		sender := common.HexToAddress(from)
+		noncesBySender[sender] = append(noncesBySender[sender], nonce)
+	}
+
+	return noncesBySender, nil
+}
+
 // GetNonWIPPendingTxs returns an array of transactions
 func (p *PostgresPoolStorage) GetNonWIPPendingTxs(ctx context.Context) ([]pool.Transaction, error) {
 	var (
@@ -221,6 +351,40 @@ func (p *PostgresPoolStorage) GetPendingTxHashesSince(ctx context.Context, since
 	return hashes, nil
 }
 
+// GetLastTxID returns the id of the last tx added to the pool, 0 if the pool is empty.
+func (p *PostgresPoolStorage) GetLastTxID(ctx context.Context) (uint64, error) {
+	sql := "SELECT COALESCE(MAX(id), 0) FROM pool.transaction"
+	var lastID uint64
+	if err := p.db.QueryRow(ctx, sql).Scan(&lastID); err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// GetPendingTxHashesSinceID returns the hashes of the pending txs added to the pool
+// after the tx with the given id, sorted by id, and the id of the last of them. The
+// given id is returned when there are no new pending txs.
+func (p *PostgresPoolStorage) GetPendingTxHashesSinceID(ctx context.Context, id uint64) ([]common.Hash, uint64, error) {
+	sql := "SELECT hash, id FROM pool.transaction WHERE status = $1 AND id > $2 ORDER BY id"
+	rows, err := p.db.Query(ctx, sql, pool.TxStatusPending, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	lastID := id
+	hashes := make([]common.Hash, 0, len(rows.RawValues()))
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash, &lastID); err != nil {
+			return nil, 0, err
+		}
+		hashes = append(hashes, common.HexToHash(hash))
+	}
+
+	return hashes, lastID, nil
+}
+
 // GetTxs gets txs with the lowest nonce
 func (p *PostgresPoolStorage) GetTxs(ctx context.Context, filterStatus pool.TxStatus, minGasPrice, limit uint64) ([]*pool.Transaction, error) {
 	query := `
@@ -802,6 +966,72 @@ func (p *PostgresPoolStorage) GetAllAddressesBlocked(ctx context.Context) ([]com
 	return addrs, nil
 }
 
+// IsWhitelisted checks if the provided address or IP is stored in the whitelisted table
+func (p *PostgresPoolStorage) IsWhitelisted(ctx context.Context, addr string) (bool, error) {
+	const isWhitelistedSQL = `SELECT EXISTS (SELECT 1 FROM pool.whitelisted WHERE LOWER(addr) = LOWER($1))`
+
+	var exists bool
+	if err := p.db.QueryRow(ctx, isWhitelistedSQL, addr).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// GetPendingTxsUsageByFrom returns the resources used by the pending txs sent by the provided address
+func (p *PostgresPoolStorage) GetPendingTxsUsageByFrom(ctx context.Context, from common.Address) (pool.TxsUsage, error) {
+	return pendingTxsUsageReader{db: p.db}.GetPendingTxsUsageByFrom(ctx, from)
+}
+
+// GetPendingTxsUsageByIP returns the resources used by the pending txs received from the provided IP
+func (p *PostgresPoolStorage) GetPendingTxsUsageByIP(ctx context.Context, ip string) (pool.TxsUsage, error) {
+	return pendingTxsUsageReader{db: p.db}.GetPendingTxsUsageByIP(ctx, ip)
+}
+
+// pendingTxsUsageReader reads the usage of the pending txs from the db pool or a db transaction
+type pendingTxsUsageReader struct {
+	db execQuerier
+}
+
+// GetPendingTxsUsageByFrom returns the resources used by the pending txs sent by the provided address
+func (r pendingTxsUsageReader) GetPendingTxsUsageByFrom(ctx context.Context, from common.Address) (pool.TxsUsage, error) {
+	return r.getPendingTxsUsage(ctx, "from_address", from.String())
+}
+
+// GetPendingTxsUsageByIP returns the resources used by the pending txs received from the provided IP
+func (r pendingTxsUsageReader) GetPendingTxsUsageByIP(ctx context.Context, ip string) (pool.TxsUsage, error) {
+	return r.getPendingTxsUsage(ctx, "ip", ip)
+}
+
+// getPendingTxsUsage sums the size and the reserved zk counters of the pending txs filtered by the provided column
+func (r pendingTxsUsageReader) getPendingTxsUsage(ctx context.Context, column string, value string) (pool.TxsUsage, error) {
+	const getPendingTxsUsageSQL = `SELECT COUNT(*),
+			COALESCE(SUM((LENGTH(encoded) - 2) / 2), 0)::BIGINT,
+			COALESCE(SUM((reserved_zkcounters->>'GasUsed')::BIGINT), 0)::BIGINT,
+			COALESCE(SUM((reserved_zkcounters->>'KeccakHashes')::BIGINT), 0)::BIGINT,
+			COALESCE(SUM((reserved_zkcounters->>'PoseidonHashes')::BIGINT), 0)::BIGINT,
+			COALESCE(SUM((reserved_zkcounters->>'PoseidonPaddings')::BIGINT), 0)::BIGINT,
+			COALESCE(SUM((reserved_zkcounters->>'MemAligns')::BIGINT), 0)::BIGINT,
+			COALESCE(SUM((reserved_zkcounters->>'Arithmetics')::BIGINT), 0)::BIGINT,
+			COALESCE(SUM((reserved_zkcounters->>'Binaries')::BIGINT), 0)::BIGINT,
+			COALESCE(SUM((reserved_zkcounters->>'Steps')::BIGINT), 0)::BIGINT,
+			COALESCE(SUM((reserved_zkcounters->>'Sha256Hashes_V2')::BIGINT), 0)::BIGINT
+		FROM pool.transaction WHERE %s = $1 AND status = $2`
+
+	var (
+		usage pool.TxsUsage
+		zk    = &usage.ReservedZKCounters
+	)
+	err := r.db.QueryRow(ctx, fmt.Sprintf(getPendingTxsUsageSQL, column), value, pool.TxStatusPending).
+		Scan(&usage.Txs, &usage.Bytes, &zk.GasUsed, &zk.KeccakHashes, &zk.PoseidonHashes, &zk.PoseidonPaddings,
+			&zk.MemAligns, &zk.Arithmetics, &zk.Binaries, &zk.Steps, &zk.Sha256Hashes_V2)
+	if err != nil {
+		return pool.TxsUsage{}, err
+	}
+
+	return usage, nil
+}
+
 // GetEarliestProcessedTx gets the earliest processed tx from the pool. Mainly used for cleanup
 func (p *PostgresPoolStorage) GetEarliestProcessedTx(ctx context.Context) (common.Hash, error) {
 	const getEarliestProcessedTxnFromTxnPool = `SELECT hash
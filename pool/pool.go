@@ -10,6 +10,7 @@ import (
 
 	"github.com/0xPolygonHermez/zkevm-node/event"
 	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/pool/metrics"
 	"github.com/0xPolygonHermez/zkevm-node/state"
 	"github.com/0xPolygonHermez/zkevm-node/state/runtime"
 	"github.com/0xPolygonHermez/zkevm-node/state/runtime/executor"
@@ -86,6 +87,9 @@ func NewPool(cfg Config, batchConstraintsCfg state.BatchConstraintsCfg, s storag
 		gasPricesMux:            new(sync.RWMutex),
 		effectiveGasPrice:       NewEffectiveGasPrice(cfg.EffectiveGasPrice),
 	}
+	metrics.Register()
+	p.exportQuotaLimits()
+
 	p.refreshGasPrices()
 	go func(cfg *Config, p *Pool) {
 		for {
@@ -177,11 +181,17 @@ func (p *Pool) AddTx(ctx context.Context, tx types.Transaction, ip string) error
 		return err
 	}
 
-	return p.StoreTx(ctx, tx, ip, false)
+	return p.storeTx(ctx, tx, ip, false, true)
 }
 
 // StoreTx adds a transaction to the pool with the pending state
 func (p *Pool) StoreTx(ctx context.Context, tx types.Transaction, ip string, isWIP bool) error {
+	return p.storeTx(ctx, tx, ip, isWIP, false)
+}
+
+// storeTx pre-executes the transaction and adds it to the pool with the pending state,
+// checking the quotas of its sender and IP if withQuotas is set
+func (p *Pool) storeTx(ctx context.Context, tx types.Transaction, ip string, isWIP bool, withQuotas bool) error {
 	// Execute transaction to calculate its zkCounters
 	preExecutionResponse, err := p.preExecuteTx(ctx, tx)
 	if errors.Is(err, runtime.ErrIntrinsicInvalidBatchGasLimit) {
@@ -243,13 +253,16 @@ func (p *Pool) StoreTx(ctx context.Context, tx types.Transaction, ip string, isW
 	poolTx.ZKCounters = preExecutionResponse.usedZKCounters
 	poolTx.ReservedZKCounters = preExecutionResponse.reservedZKCounters
 
+	if withQuotas && p.cfg.Quotas.IsEnabled() {
+		return p.addTxWithinQuotas(ctx, *poolTx)
+	}
 	return p.storage.AddTx(ctx, *poolTx)
 }
 
 // ValidateBreakEvenGasPrice validates the effective gas price
 func (p *Pool) ValidateBreakEvenGasPrice(ctx context.Context, tx types.Transaction, preExecutionGasUsed uint64, gasPrices GasPrices) error {
 	// Get the tx gas price we will use in the egp calculation. If egp is disabled we will use a "simulated" tx gas price and l2 gas price
-	txGasPrice, l2GasPrice := p.effectiveGasPrice.GetTxAndL2GasPrice(tx.GasPrice(), gasPrices.L1GasPrice, gasPrices.L2GasPrice)
+	txGasPrice, l2GasPrice := p.effectiveGasPrice.GetTxAndL2GasPrice(GetTxGasPrice(tx.Type(), tx.GasPrice(), tx.GasTipCap(), gasPrices.L2GasPrice), gasPrices.L1GasPrice, gasPrices.L2GasPrice)
 
 	breakEvenGasPrice, err := p.effectiveGasPrice.CalculateBreakEvenGasPrice(tx.Data(), txGasPrice, preExecutionGasUsed, gasPrices.L1GasPrice)
 	if err != nil {
@@ -369,6 +382,17 @@ func (p *Pool) GetPendingTxHashesSince(ctx context.Context, since time.Time) ([]
 	return p.storage.GetPendingTxHashesSince(ctx, since)
 }
 
+// GetLastTxID returns the id of the last tx added to the pool.
+func (p *Pool) GetLastTxID(ctx context.Context) (uint64, error) {
+	return p.storage.GetLastTxID(ctx)
+}
+
+// GetPendingTxHashesSinceID returns the hashes of the pending txs added to the pool
+// after the tx with the given id and the id of the last of them.
+func (p *Pool) GetPendingTxHashesSinceID(ctx context.Context, id uint64) ([]common.Hash, uint64, error) {
+	return p.storage.GetPendingTxHashesSinceID(ctx, id)
+}
+
 // UpdateTxStatus updates a transaction state accordingly to the
 // provided state and hash
 func (p *Pool) UpdateTxStatus(ctx context.Context, hash common.Hash, newStatus TxStatus, isWIP bool, failedReason *string) error {
@@ -424,7 +448,8 @@ func (p *Pool) validateTx(ctx context.Context, poolTx Transaction) error {
 		return ErrInvalidChainID
 	}
 
-	// Accept only legacy transactions until EIP-2718/2930 activates.
+	// Accept only legacy transactions, the executor doesn't decode the typed
+	// transactions (EIP-2718) in the batch L2 data of any fork yet.
 	if poolTx.Type() != types.LegacyTxType {
 		return ErrTxTypeNotSupported
 	}
@@ -552,6 +577,7 @@ func (p *Pool) validateTx(ctx context.Context, poolTx Transaction) error {
 	// check if the new transaction has more gas than all the other txs in the pool
 	// with the same from and nonce to be able to replace the current txs by the new
 	// when being selected
+	isReplacement := false
 	for _, oldTx := range oldTxs {
 		// discard invalid txs
 		if oldTx.Status == TxStatusInvalid || oldTx.Status == TxStatusFailed {
@@ -569,6 +595,18 @@ func (p *Pool) validateTx(ctx context.Context, poolTx Transaction) error {
 		if oldTxPrice.Cmp(txPrice) > 0 {
 			return ErrReplaceUnderpriced
 		}
+
+		if oldTx.Status == TxStatusPending {
+			isReplacement = true
+		}
+	}
+
+	// check the quotas of the sender and the IP before pre-executing the tx, a replacement
+	// doesn't add a new pending tx so it's allowed even if the quotas are exhausted
+	if !isReplacement {
+		if err := p.checkQuotas(ctx, p.storage, poolTx, from); err != nil {
+			return err
+		}
 	}
 
 	// Executor field size requirements check
@@ -678,6 +716,9 @@ const (
 	txGasContractCreation uint64 = 53000
 	txGas                 uint64 = 21000
 	txDataZeroGas         uint64 = 4
+
+	txAccessListAddressGas    uint64 = 2400
+	txAccessListStorageKeyGas uint64 = 1900
 )
 
 // CalculateEffectiveGasPrice calculates the final effective gas price for a tx
@@ -727,5 +768,11 @@ func IntrinsicGas(tx types.Transaction) (uint64, error) {
 		}
 		gas += z * txDataZeroGas
 	}
+	// Access list txs (EIP-2930) pay for the addresses and storage keys they warm up
+	accessList := tx.AccessList()
+	if len(accessList) > 0 {
+		gas += uint64(len(accessList)) * txAccessListAddressGas
+		gas += uint64(accessList.StorageKeys()) * txAccessListStorageKeyGas
+	}
 	return gas, nil
 }
@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -470,6 +471,83 @@ func Test_GetPendingTxs(t *testing.T) {
 	}
 }
 
+func Test_GetContent(t *testing.T) {
+	initOrResetDB(t)
+
+	stateSqlDB, err := db.NewSQLDB(stateDBCfg)
+	require.NoError(t, err)
+	defer stateSqlDB.Close() //nolint:gosec,errcheck
+
+	eventStorage, err := nileventstorage.NewNilEventStorage()
+	if err != nil {
+		log.Fatal(err)
+	}
+	eventLog := event.NewEventLog(event.Config{}, eventStorage)
+
+	st := newState(stateSqlDB, eventLog)
+
+	genesisBlock := state.Block{
+		BlockNumber: 0,
+		BlockHash:   state.ZeroHash,
+		ParentHash:  state.ZeroHash,
+		ReceivedAt:  time.Now(),
+	}
+	ctx := context.Background()
+	dbTx, err := st.BeginStateTransaction(ctx)
+	require.NoError(t, err)
+	_, err = st.SetGenesis(ctx, genesisBlock, genesis, metrics.SynchronizerCallerLabel, dbTx)
+	require.NoError(t, err)
+	require.NoError(t, dbTx.Commit(ctx))
+
+	s, err := pgpoolstorage.NewPostgresPoolStorage(poolDBCfg)
+	require.NoError(t, err)
+	p := setupPool(t, cfg, bc, s, st, chainID.Uint64(), ctx, eventLog)
+
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(senderPrivateKey, "0x"))
+	require.NoError(t, err)
+
+	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
+	require.NoError(t, err)
+
+	// nonces 0 and 1 are executable, nonce 3 waits for nonce 2
+	for _, nonce := range []uint64{0, 1, 3} {
+		tx := ethTypes.NewTransaction(nonce, common.Address{}, big.NewInt(10), gasLimit, gasPrice, []byte{})
+		signedTx, err := auth.Signer(auth.From, tx)
+		require.NoError(t, err)
+		err = p.AddTx(ctx, *signedTx, ip)
+		require.NoError(t, err)
+	}
+
+	content, err := p.GetContent(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, content.Pending[auth.From], 2)
+	require.Len(t, content.Queued[auth.From], 1)
+	queuedTx := content.Queued[auth.From][3]
+	assert.Equal(t, uint64(3), queuedTx.Nonce())
+
+	pending, queued, err := p.GetStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), pending)
+	assert.Equal(t, uint64(1), queued)
+
+	// the limit leaves out the txs with the highest nonces
+	content, err = p.GetContent(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, content.Pending[auth.From], 2)
+	assert.Empty(t, content.Queued)
+	content, err = p.GetContent(ctx, 0)
+	require.NoError(t, err)
+
+	contentFrom, err := p.GetContentFrom(ctx, auth.From)
+	require.NoError(t, err)
+	assert.Equal(t, content, contentFrom)
+
+	contentFrom, err = p.GetContentFrom(ctx, common.HexToAddress("0x1"))
+	require.NoError(t, err)
+	assert.Empty(t, contentFrom.Pending)
+	assert.Empty(t, contentFrom.Queued)
+}
+
 func Test_GetPendingTxsZeroPassed(t *testing.T) {
 	initOrResetDB(t)
 
@@ -862,6 +940,8 @@ func TestGetPendingTxSince(t *testing.T) {
 	txsAddedTime := []time.Time{}
 
 	timeBeforeTxs := time.Now()
+	lastTxIDBeforeTxs, err := p.GetLastTxID(ctx)
+	require.NoError(t, err)
 	// insert pending transactions
 	for i := 0; i < txsCount; i++ {
 		tx := ethTypes.NewTransaction(uint64(i), common.Address{}, big.NewInt(10), gasLimit, gasPrice, []byte{})
@@ -904,6 +984,21 @@ func TestGetPendingTxSince(t *testing.T) {
 	txHashes, err = p.GetPendingTxHashesSince(ctx, txsAddedTime[9].Add(1*time.Second))
 	require.NoError(t, err)
 	assert.Equal(t, 0, len(txHashes))
+
+	txHashes, lastTxID, err := p.GetPendingTxHashesSinceID(ctx, lastTxIDBeforeTxs)
+	require.NoError(t, err)
+	assert.Equal(t, txsCount, len(txHashes))
+	for i, txHash := range txHashes {
+		assert.Equal(t, txHash.Hex(), txsAddedHashes[i].Hex())
+	}
+	expectedLastTxID, err := p.GetLastTxID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, expectedLastTxID, lastTxID)
+
+	txHashes, lastTxID, err = p.GetPendingTxHashesSinceID(ctx, lastTxID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(txHashes))
+	assert.Equal(t, expectedLastTxID, lastTxID)
 }
 
 func Test_DeleteTransactionsByHashes(t *testing.T) {
@@ -1727,6 +1822,115 @@ func Test_AddTx_AccountQueueLimit(t *testing.T) {
 	require.Error(t, err, pool.ErrNonceTooHigh)
 }
 
+func Test_AddTx_SenderQuota(t *testing.T) {
+	eventStorage, err := nileventstorage.NewNilEventStorage()
+	if err != nil {
+		log.Fatal(err)
+	}
+	eventLog := event.NewEventLog(event.Config{}, eventStorage)
+
+	initOrResetDB(t)
+
+	stateSqlDB, err := db.NewSQLDB(stateDBCfg)
+	if err != nil {
+		panic(err)
+	}
+	defer stateSqlDB.Close() //nolint:gosec,errcheck
+
+	poolSqlDB, err := db.NewSQLDB(poolDBCfg)
+	require.NoError(t, err)
+	defer poolSqlDB.Close() //nolint:gosec,errcheck
+
+	st := newState(stateSqlDB, eventLog)
+
+	genesisBlock := state.Block{
+		BlockNumber: 0,
+		BlockHash:   state.ZeroHash,
+		ParentHash:  state.ZeroHash,
+		ReceivedAt:  time.Now(),
+	}
+	genesis := state.Genesis{
+		Actions: []*state.GenesisAction{
+			{
+				Address: senderAddress,
+				Type:    int(merkletree.LeafTypeBalance),
+				Value:   "1000000000000000000000",
+			},
+		},
+	}
+	ctx := context.Background()
+	dbTx, err := st.BeginStateTransaction(ctx)
+	require.NoError(t, err)
+	_, err = st.SetGenesis(ctx, genesisBlock, genesis, metrics.SynchronizerCallerLabel, dbTx)
+	require.NoError(t, err)
+	require.NoError(t, dbTx.Commit(ctx))
+
+	s, err := pgpoolstorage.NewPostgresPoolStorage(poolDBCfg)
+	require.NoError(t, err)
+
+	const maxTxs = 3
+	quotaCfg := cfg
+	quotaCfg.Quotas.PerSender.MaxTxs = maxTxs
+	p := setupPool(t, quotaCfg, bc, s, st, chainID.Uint64(), ctx, eventLog)
+
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(senderPrivateKey, "0x"))
+	require.NoError(t, err)
+
+	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
+	require.NoError(t, err)
+
+	newTx := func(nonce uint64, gasPrice *big.Int) ethTypes.Transaction {
+		tx := ethTypes.NewTx(&ethTypes.LegacyTx{
+			Nonce:    nonce,
+			Value:    big.NewInt(0),
+			Gas:      uint64(1000000),
+			GasPrice: gasPrice,
+		})
+		signedTx, err := auth.Signer(auth.From, tx)
+		require.NoError(t, err)
+		return *signedTx
+	}
+
+	// the txs added concurrently can't exceed the quota
+	const concurrentTxs = maxTxs + 2
+	errs := make([]error, concurrentTxs)
+	var wg sync.WaitGroup
+	for nonce := uint64(0); nonce < concurrentTxs; nonce++ {
+		wg.Add(1)
+		go func(nonce uint64) {
+			defer wg.Done()
+			errs[nonce] = p.AddTx(ctx, newTx(nonce, gasPrice), ip)
+		}(nonce)
+	}
+	wg.Wait()
+
+	var added, rejected []uint64
+	for nonce, err := range errs {
+		if err == nil {
+			added = append(added, uint64(nonce))
+		} else {
+			require.ErrorIs(t, err, pool.ErrTxPoolSenderQuotaExceeded)
+			rejected = append(rejected, uint64(nonce))
+		}
+	}
+	require.Len(t, added, maxTxs)
+	require.Len(t, rejected, concurrentTxs-maxTxs)
+
+	err = p.AddTx(ctx, newTx(rejected[0], gasPrice), ip)
+	require.ErrorIs(t, err, pool.ErrTxPoolSenderQuotaExceeded)
+
+	// a replacement doesn't add a new pending tx
+	err = p.AddTx(ctx, newTx(added[0], new(big.Int).Mul(gasPrice, big.NewInt(2))), ip)
+	require.NoError(t, err)
+
+	// whitelisted senders are exempted from the quota
+	_, err = poolSqlDB.Exec(ctx, "INSERT INTO pool.whitelisted(addr) VALUES($1)", auth.From.String())
+	require.NoError(t, err)
+
+	err = p.AddTx(ctx, newTx(rejected[0], gasPrice), ip)
+	require.NoError(t, err)
+}
+
 func Test_AddTx_GlobalQueueLimit(t *testing.T) {
 	eventStorage, err := nileventstorage.NewNilEventStorage()
 	if err != nil {
@@ -0,0 +1,134 @@
+package pool
+
+import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/pool/metrics"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	senderQuota = "sender"
+	ipQuota     = "ip"
+
+	txsLimit             = "txs"
+	bytesLimit           = "bytes"
+	reservedBatchesLimit = "reserved_batches"
+)
+
+// TxsUsage contains the resources used by a set of pending txs
+type TxsUsage struct {
+	Txs                uint64
+	Bytes              uint64
+	ReservedZKCounters state.ZKCounters
+}
+
+// TxsUsageReader gets the resources used by the pending txs of a sender or an IP
+type TxsUsageReader interface {
+	GetPendingTxsUsageByFrom(ctx context.Context, from common.Address) (TxsUsage, error)
+	GetPendingTxsUsageByIP(ctx context.Context, ip string) (TxsUsage, error)
+}
+
+// reservedBatches returns the number of batches that are filled with the reserved ZK counters,
+// computed with the ZK counter that uses the highest share of its batch constraint. The bytes
+// aren't included as they have their own limit
+func (u TxsUsage) reservedBatches(constraints state.BatchConstraintsCfg) float64 {
+	return constraints.BatchShare(u.ReservedZKCounters, 0)
+}
+
+// exportQuotaLimits sets the metrics of the configured quota limits
+func (p *Pool) exportQuotaLimits() {
+	for quota, cfg := range map[string]QuotaCfg{senderQuota: p.cfg.Quotas.PerSender, ipQuota: p.cfg.Quotas.PerIP} {
+		metrics.QuotaLimit(quota+"_"+txsLimit, float64(cfg.MaxTxs))
+		metrics.QuotaLimit(quota+"_"+bytesLimit, float64(cfg.MaxBytes))
+		metrics.QuotaLimit(quota+"_"+reservedBatchesLimit, cfg.MaxReservedBatches)
+	}
+}
+
+// checkQuotas checks that the pending txs of the sender and the IP of the tx don't exceed their quotas.
+// The reserved ZK counters of the new tx are unknown until it's pre-executed, so the tx is rejected
+// once the reserved ZK counters of the pending txs have reached the quota
+func (p *Pool) checkQuotas(ctx context.Context, usageReader TxsUsageReader, poolTx Transaction, from common.Address) error {
+	if p.cfg.Quotas.PerSender.IsEnabled() {
+		usage, err := usageReader.GetPendingTxsUsageByFrom(ctx, from)
+		if err != nil {
+			log.Errorf("failed to get the pending txs usage of sender %s while adding tx to the pool, error: %v", from.String(), err)
+			return err
+		}
+		err = p.checkQuota(ctx, senderQuota, from.String(), p.cfg.Quotas.PerSender, usage, poolTx.Size(), ErrTxPoolSenderQuotaExceeded)
+		if err != nil {
+			return err
+		}
+	}
+
+	if poolTx.IP != "" && p.cfg.Quotas.PerIP.IsEnabled() {
+		usage, err := usageReader.GetPendingTxsUsageByIP(ctx, poolTx.IP)
+		if err != nil {
+			log.Errorf("failed to get the pending txs usage of IP %s while adding tx to the pool, error: %v", poolTx.IP, err)
+			return err
+		}
+		err = p.checkQuota(ctx, ipQuota, poolTx.IP, p.cfg.Quotas.PerIP, usage, poolTx.Size(), ErrTxPoolIPQuotaExceeded)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkQuota returns errExceeded if adding a tx of txSize bytes to the usage exceeds any limit
+// of the quota and the key (sender or IP) isn't whitelisted
+func (p *Pool) checkQuota(ctx context.Context, quota string, key string, cfg QuotaCfg, usage TxsUsage, txSize uint64, errExceeded error) error {
+	var limit string
+	switch {
+	case cfg.MaxTxs > 0 && usage.Txs >= cfg.MaxTxs:
+		limit = txsLimit
+	case cfg.MaxBytes > 0 && usage.Bytes+txSize > cfg.MaxBytes:
+		limit = bytesLimit
+	case cfg.MaxReservedBatches > 0 && usage.reservedBatches(p.batchConstraintsCfg) >= cfg.MaxReservedBatches:
+		limit = reservedBatchesLimit
+	default:
+		return nil
+	}
+
+	whitelisted, err := p.storage.IsWhitelisted(ctx, key)
+	if err != nil {
+		log.Errorf("failed to check if %s is whitelisted while adding tx to the pool, error: %v", key, err)
+		return err
+	}
+	if whitelisted {
+		metrics.QuotaExemptedTx(quota)
+		return nil
+	}
+
+	log.Infof("%s %s has reached the %s limit of its pool quota", quota, key, limit)
+	metrics.QuotaRejectedTx(quota + "_" + limit)
+	return errExceeded
+}
+
+// addTxWithinQuotas adds the tx to the pool checking again the quotas of its sender and IP in the same
+// db transaction, so concurrent txs can't exceed the quotas checked before pre-executing them. A
+// replacement doesn't add a new pending tx so it's allowed even if the quotas are exhausted
+func (p *Pool) addTxWithinQuotas(ctx context.Context, poolTx Transaction) error {
+	from, err := state.GetSender(poolTx.Transaction)
+	if err != nil {
+		return ErrInvalidSender
+	}
+
+	oldTxs, err := p.storage.GetTxsByFromAndNonce(ctx, from, poolTx.Nonce())
+	if err != nil {
+		log.Errorf("failed to get txs for the same account and nonce while adding tx to the pool, error: %v", err)
+		return err
+	}
+	for _, oldTx := range oldTxs {
+		if oldTx.Status == TxStatusPending {
+			return p.storage.AddTx(ctx, poolTx)
+		}
+	}
+
+	return p.storage.AddTxWithinQuotas(ctx, poolTx, func(ctx context.Context, usageReader TxsUsageReader) error {
+		return p.checkQuotas(ctx, usageReader, poolTx, from)
+	})
+}
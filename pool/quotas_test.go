@@ -0,0 +1,174 @@
+package pool
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type whitelistStorage struct {
+	storage
+	whitelisted map[string]bool
+}
+
+func (s *whitelistStorage) IsWhitelisted(ctx context.Context, addr string) (bool, error) {
+	return s.whitelisted[addr], nil
+}
+
+func TestReservedBatches(t *testing.T) {
+	constraints := state.BatchConstraintsCfg{
+		MaxCumulativeGasUsed: 1000,
+		MaxKeccakHashes:      100,
+		MaxSteps:             4000,
+	}
+
+	usage := TxsUsage{ReservedZKCounters: state.ZKCounters{GasUsed: 100, KeccakHashes: 50, Steps: 1000}}
+	assert.Equal(t, 0.5, usage.reservedBatches(constraints))
+
+	// the counters without constraint are ignored
+	usage.ReservedZKCounters.Binaries = 1000
+	assert.Equal(t, 0.5, usage.reservedBatches(constraints))
+
+	usage.ReservedZKCounters.Steps = 6000
+	assert.Equal(t, 1.5, usage.reservedBatches(constraints))
+}
+
+func TestCheckQuota(t *testing.T) {
+	p := &Pool{
+		storage:             &whitelistStorage{whitelisted: map[string]bool{"1.1.1.1": true}},
+		batchConstraintsCfg: state.BatchConstraintsCfg{MaxSteps: 1000},
+	}
+	cfg := QuotaCfg{MaxTxs: 2, MaxBytes: 300, MaxReservedBatches: 0.5}
+
+	testCases := []struct {
+		name     string
+		key      string
+		usage    TxsUsage
+		txSize   uint64
+		expected error
+	}{
+		{
+			name:   "within quota",
+			key:    "2.2.2.2",
+			usage:  TxsUsage{Txs: 1, Bytes: 100, ReservedZKCounters: state.ZKCounters{Steps: 400}},
+			txSize: 200,
+		},
+		{
+			name:     "txs limit reached",
+			key:      "2.2.2.2",
+			usage:    TxsUsage{Txs: 2, Bytes: 100},
+			txSize:   100,
+			expected: ErrTxPoolIPQuotaExceeded,
+		},
+		{
+			name:     "bytes limit exceeded",
+			key:      "2.2.2.2",
+			usage:    TxsUsage{Txs: 1, Bytes: 100},
+			txSize:   201,
+			expected: ErrTxPoolIPQuotaExceeded,
+		},
+		{
+			name:     "reserved batches limit reached",
+			key:      "2.2.2.2",
+			usage:    TxsUsage{Txs: 1, Bytes: 100, ReservedZKCounters: state.ZKCounters{Steps: 500}},
+			txSize:   100,
+			expected: ErrTxPoolIPQuotaExceeded,
+		},
+		{
+			name:   "whitelisted",
+			key:    "1.1.1.1",
+			usage:  TxsUsage{Txs: 10, Bytes: 1000, ReservedZKCounters: state.ZKCounters{Steps: 1000}},
+			txSize: 100,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := p.checkQuota(context.Background(), ipQuota, tc.key, cfg, tc.usage, tc.txSize, ErrTxPoolIPQuotaExceeded)
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+// quotaStorage adds the txs checking the quotas against a fixed usage
+type quotaStorage struct {
+	storage
+	usage        TxsUsage
+	pendingTxs   []Transaction
+	addedTxs     int
+	checkedAdded int
+}
+
+func (s *quotaStorage) GetTxsByFromAndNonce(ctx context.Context, from common.Address, nonce uint64) ([]Transaction, error) {
+	var txs []Transaction
+	for _, tx := range s.pendingTxs {
+		if tx.Nonce() == nonce {
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
+
+func (s *quotaStorage) AddTx(ctx context.Context, tx Transaction) error {
+	s.addedTxs++
+	return nil
+}
+
+func (s *quotaStorage) AddTxWithinQuotas(ctx context.Context, tx Transaction, checkQuotas func(ctx context.Context, usage TxsUsageReader) error) error {
+	if err := checkQuotas(ctx, s); err != nil {
+		return err
+	}
+	s.checkedAdded++
+	return nil
+}
+
+func (s *quotaStorage) GetPendingTxsUsageByFrom(ctx context.Context, from common.Address) (TxsUsage, error) {
+	return s.usage, nil
+}
+
+func (s *quotaStorage) GetPendingTxsUsageByIP(ctx context.Context, ip string) (TxsUsage, error) {
+	return s.usage, nil
+}
+
+func (s *quotaStorage) IsWhitelisted(ctx context.Context, addr string) (bool, error) {
+	return false, nil
+}
+
+func TestAddTxWithinQuotas(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := types.NewEIP155Signer(big.NewInt(1000))
+	newTx := func(nonce uint64) Transaction {
+		tx, err := types.SignTx(types.NewTransaction(nonce, common.Address{}, big.NewInt(0), 21000, big.NewInt(1), nil), signer, privateKey)
+		require.NoError(t, err)
+		return *NewTransaction(*tx, "1.1.1.1", false)
+	}
+
+	s := &quotaStorage{usage: TxsUsage{Txs: 2}}
+	p := &Pool{storage: s, cfg: Config{Quotas: QuotasCfg{PerSender: QuotaCfg{MaxTxs: 2}}}}
+
+	// the quotas are checked by the storage while adding the tx
+	err = p.addTxWithinQuotas(context.Background(), newTx(2))
+	assert.ErrorIs(t, err, ErrTxPoolSenderQuotaExceeded)
+	assert.Equal(t, 0, s.checkedAdded)
+
+	s.usage.Txs = 1
+	require.NoError(t, p.addTxWithinQuotas(context.Background(), newTx(2)))
+	assert.Equal(t, 1, s.checkedAdded)
+
+	// a replacement of a pending tx is added without checking the quotas
+	s.usage.Txs = 2
+	pendingTx := newTx(1)
+	pendingTx.Status = TxStatusPending
+	s.pendingTxs = []Transaction{pendingTx}
+	require.NoError(t, p.addTxWithinQuotas(context.Background(), newTx(1)))
+	assert.Equal(t, 1, s.addedTxs)
+	assert.Equal(t, 1, s.checkedAdded)
+}
@@ -0,0 +1,262 @@
+package sequencer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/adminapi"
+	"github.com/0xPolygonHermez/zkevm-node/event"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	adminStatusEndpoint       = "/status"
+	adminPauseEndpoint        = "/pause"
+	adminResumeEndpoint       = "/resume"
+	adminCloseL2BlockEndpoint = "/close/l2block"
+	adminCloseBatchEndpoint   = "/close/batch"
+	adminHaltEndpoint         = "/halt"
+)
+
+var (
+	// ErrAdminAuthTokenRequired is returned when the admin API is enabled without an auth token
+	ErrAdminAuthTokenRequired = errors.New("the auth token is required to enable the sequencer admin API")
+	// ErrInvalidHaltBatchNumber is returned when the requested halt batch number isn't after the wip batch
+	ErrInvalidHaltBatchNumber = errors.New("invalid halt batch number, it must be greater than the wip batch number or 0 to cancel the halt")
+	// ErrFinalizerNotStarted is returned when the admin API is called before the finalizer opens the wip batch
+	ErrFinalizerNotStarted = errors.New("finalizer not started")
+)
+
+// wipStatus is the status of the wip batch and L2 block of the finalizer
+type wipStatus struct {
+	Batch   wipBatchStatus   `json:"batch"`
+	L2Block wipL2BlockStatus `json:"l2Block"`
+}
+
+type wipBatchStatus struct {
+	BatchNumber        uint64               `json:"batchNumber"`
+	Coinbase           common.Address       `json:"coinbase"`
+	Timestamp          time.Time            `json:"timestamp"`
+	CountOfTxs         int                  `json:"countOfTxs"`
+	CountOfL2Blocks    int                  `json:"countOfL2Blocks"`
+	RemainingResources state.BatchResources `json:"remainingResources"`
+}
+
+type wipL2BlockStatus struct {
+	TrackingNum        uint64           `json:"trackingNum"`
+	Timestamp          uint64           `json:"timestamp"`
+	CountOfTxs         int              `json:"countOfTxs"`
+	Bytes              uint64           `json:"bytes"`
+	UsedZKCounters     state.ZKCounters `json:"usedZKCounters"`
+	ReservedZKCounters state.ZKCounters `json:"reservedZKCounters"`
+}
+
+// finalizerStatus is the response of the admin API status endpoint
+type finalizerStatus struct {
+	Paused            bool       `json:"paused"`
+	Halted            bool       `json:"halted"`
+	HaltOnBatchNumber uint64     `json:"haltOnBatchNumber"`
+	WIP               *wipStatus `json:"wip"`
+}
+
+type haltRequest struct {
+	BatchNumber uint64 `json:"batchNumber"`
+}
+
+// updateWIPStatus stores a copy of the status of the wip batch and L2 block to be read by the admin API
+func (f *finalizer) updateWIPStatus() {
+	if f.wipBatch == nil || f.wipL2Block == nil {
+		return
+	}
+
+	status := &wipStatus{
+		Batch: wipBatchStatus{
+			BatchNumber:        f.wipBatch.batchNumber,
+			Coinbase:           f.wipBatch.coinbase,
+			Timestamp:          f.wipBatch.timestamp,
+			CountOfTxs:         f.wipBatch.countOfTxs,
+			CountOfL2Blocks:    f.wipBatch.countOfL2Blocks,
+			RemainingResources: f.wipBatch.imRemainingResources,
+		},
+		L2Block: wipL2BlockStatus{
+			TrackingNum:        f.wipL2Block.trackingNum,
+			Timestamp:          f.wipL2Block.timestamp,
+			CountOfTxs:         len(f.wipL2Block.transactions),
+			Bytes:              f.wipL2Block.bytes,
+			UsedZKCounters:     f.wipL2Block.usedZKCounters,
+			ReservedZKCounters: f.wipL2Block.reservedZKCounters,
+		},
+	}
+
+	f.wipStatusMux.Lock()
+	f.wipStatus = status
+	f.wipStatusMux.Unlock()
+}
+
+// status returns the current status of the finalizer
+func (f *finalizer) status() finalizerStatus {
+	f.wipStatusMux.RLock()
+	defer f.wipStatusMux.RUnlock()
+
+	return finalizerStatus{
+		Paused:            f.paused.Load(),
+		Halted:            f.haltFinalizer.Load(),
+		HaltOnBatchNumber: f.haltOnBatchNumber.Load(),
+		WIP:               f.wipStatus,
+	}
+}
+
+// checkAdminCloseRequests closes the wip batch or the wip L2 block if it has been requested through the admin API
+func (f *finalizer) checkAdminCloseRequests(ctx context.Context) {
+	if f.closeBatchRequested.Swap(false) {
+		// Closing the wip batch also closes the wip L2 block
+		f.closeL2BlockRequested.Store(false)
+
+		if f.wipBatch.isEmpty() && f.wipL2Block.isEmpty() {
+			log.Infof("skipping admin request to close batch %d, the batch is empty", f.wipBatch.batchNumber)
+		} else {
+			log.Infof("closing batch %d, because of admin request", f.wipBatch.batchNumber)
+			f.finalizeWIPBatch(ctx, state.AdminClosingReason)
+		}
+	}
+
+	if f.closeL2BlockRequested.Swap(false) {
+		log.Infof("closing L2 block [%d], because of admin request", f.wipL2Block.trackingNum)
+		f.finalizeWIPL2Block(ctx)
+	}
+}
+
+// scheduleHalt sets the batch number where the finalizer will halt, 0 cancels the scheduled halt
+func (f *finalizer) scheduleHalt(batchNumber uint64) error {
+	status := f.status()
+	if status.WIP == nil {
+		return ErrFinalizerNotStarted
+	}
+	if batchNumber != 0 && batchNumber <= status.WIP.Batch.BatchNumber {
+		return ErrInvalidHaltBatchNumber
+	}
+	f.haltOnBatchNumber.Store(batchNumber)
+	return nil
+}
+
+func (s *Sequencer) startAdminServer() {
+	adminSrv, err := adminapi.Start("sequencer", s.cfg.Admin.Host, s.cfg.Admin.Port, s.adminHandler())
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.adminSrv = adminSrv
+}
+
+func (s *Sequencer) adminHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(adminStatusEndpoint, s.handleStatus)
+	mux.HandleFunc(adminPauseEndpoint, s.handlePause)
+	mux.HandleFunc(adminResumeEndpoint, s.handleResume)
+	mux.HandleFunc(adminCloseL2BlockEndpoint, s.handleCloseL2Block)
+	mux.HandleFunc(adminCloseBatchEndpoint, s.handleCloseBatch)
+	mux.HandleFunc(adminHaltEndpoint, s.handleHalt)
+	return adminapi.BearerAuth(s.cfg.Admin.AuthToken, mux)
+}
+
+// handleStatus returns the status of the finalizer and the resources of its wip batch and L2 block
+func (s *Sequencer) handleStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	status := s.finalizer.status()
+	if status.WIP == nil {
+		http.Error(w, ErrFinalizerNotStarted.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	adminapi.WriteResponse(w, status)
+}
+
+// handlePause pauses the selection of txs from the worker
+func (s *Sequencer) handlePause(w http.ResponseWriter, r *http.Request) {
+	if !checkAdminPost(w, r) {
+		return
+	}
+	s.finalizer.paused.Store(true)
+	s.logAdminAction(r, "tx selection paused")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// handleResume resumes the selection of txs from the worker
+func (s *Sequencer) handleResume(w http.ResponseWriter, r *http.Request) {
+	if !checkAdminPost(w, r) {
+		return
+	}
+	s.finalizer.paused.Store(false)
+	s.logAdminAction(r, "tx selection resumed")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// handleCloseL2Block requests the finalizer to close the wip L2 block
+func (s *Sequencer) handleCloseL2Block(w http.ResponseWriter, r *http.Request) {
+	if !checkAdminPost(w, r) {
+		return
+	}
+	s.finalizer.closeL2BlockRequested.Store(true)
+	s.logAdminAction(r, "close of the wip L2 block requested")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// handleCloseBatch requests the finalizer to close the wip batch
+func (s *Sequencer) handleCloseBatch(w http.ResponseWriter, r *http.Request) {
+	if !checkAdminPost(w, r) {
+		return
+	}
+	s.finalizer.closeBatchRequested.Store(true)
+	s.logAdminAction(r, "close of the wip batch requested")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// handleHalt schedules the halt of the finalizer when the batch before the requested one is closed
+func (s *Sequencer) handleHalt(w http.ResponseWriter, r *http.Request) {
+	if !checkAdminPost(w, r) {
+		return
+	}
+	var req haltRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	err := s.finalizer.scheduleHalt(req.BatchNumber)
+	if errors.Is(err, ErrFinalizerNotStarted) {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if req.BatchNumber == 0 {
+		s.logAdminAction(r, "scheduled halt cancelled")
+	} else {
+		s.logAdminAction(r, fmt.Sprintf("halt scheduled on batch number %d", req.BatchNumber))
+	}
+	adminapi.WriteResponse(w, s.finalizer.status())
+}
+
+// logAdminAction records the action run through the admin API in the event log
+func (s *Sequencer) logAdminAction(r *http.Request, action string) {
+	description := fmt.Sprintf("admin API: %s, remote address: %s", action, r.RemoteAddr)
+	log.Info(description)
+	s.finalizer.LogEvent(r.Context(), event.Level_Info, event.EventID_SequencerAdminAction, description, nil)
+}
+
+func checkAdminPost(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return false
+	}
+	return true
+}
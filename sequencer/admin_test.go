@@ -0,0 +1,154 @@
+package sequencer
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-node/event"
+	"github.com/0xPolygonHermez/zkevm-node/event/nileventstorage"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const adminTestToken = "token"
+
+func newAdminTestSequencer(t *testing.T) *Sequencer {
+	eventStorage, err := nileventstorage.NewNilEventStorage()
+	require.NoError(t, err)
+
+	return &Sequencer{
+		cfg: Config{Admin: AdminCfg{Enabled: true, AuthToken: adminTestToken}},
+		finalizer: &finalizer{
+			eventLog: event.NewEventLog(event.Config{}, eventStorage),
+			wipBatch: &Batch{
+				batchNumber:          10,
+				countOfTxs:           3,
+				countOfL2Blocks:      2,
+				imRemainingResources: state.BatchResources{Bytes: 100, ZKCounters: state.ZKCounters{Steps: 1000}},
+			},
+			wipL2Block: &L2Block{
+				trackingNum:    5,
+				transactions:   []*TxTracker{{}},
+				bytes:          20,
+				usedZKCounters: state.ZKCounters{Steps: 50},
+			},
+		},
+	}
+}
+
+func adminRequest(t *testing.T, s *Sequencer, method, endpoint, token string, body interface{}) *httptest.ResponseRecorder {
+	var reqBody bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&reqBody).Encode(body))
+	}
+	req := httptest.NewRequest(method, endpoint, &reqBody)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	res := httptest.NewRecorder()
+	s.adminHandler().ServeHTTP(res, req)
+	return res
+}
+
+func TestAdminAuth(t *testing.T) {
+	s := newAdminTestSequencer(t)
+	s.finalizer.updateWIPStatus()
+
+	res := adminRequest(t, s, http.MethodGet, adminStatusEndpoint, "", nil)
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	res = adminRequest(t, s, http.MethodGet, adminStatusEndpoint, "wrong", nil)
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	res = adminRequest(t, s, http.MethodPost, adminPauseEndpoint, "wrong", nil)
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	assert.False(t, s.finalizer.paused.Load())
+
+	res = adminRequest(t, s, http.MethodGet, adminStatusEndpoint, adminTestToken, nil)
+	assert.Equal(t, http.StatusOK, res.Code)
+}
+
+func TestAdminStatus(t *testing.T) {
+	s := newAdminTestSequencer(t)
+
+	// the finalizer hasn't published the wip status yet
+	res := adminRequest(t, s, http.MethodGet, adminStatusEndpoint, adminTestToken, nil)
+	assert.Equal(t, http.StatusServiceUnavailable, res.Code)
+
+	s.finalizer.updateWIPStatus()
+	res = adminRequest(t, s, http.MethodGet, adminStatusEndpoint, adminTestToken, nil)
+	require.Equal(t, http.StatusOK, res.Code)
+
+	var status finalizerStatus
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&status))
+	assert.False(t, status.Paused)
+	assert.False(t, status.Halted)
+	require.NotNil(t, status.WIP)
+	assert.Equal(t, uint64(10), status.WIP.Batch.BatchNumber)
+	assert.Equal(t, 3, status.WIP.Batch.CountOfTxs)
+	assert.Equal(t, 2, status.WIP.Batch.CountOfL2Blocks)
+	assert.Equal(t, uint64(100), status.WIP.Batch.RemainingResources.Bytes)
+	assert.Equal(t, uint32(1000), status.WIP.Batch.RemainingResources.ZKCounters.Steps)
+	assert.Equal(t, uint64(5), status.WIP.L2Block.TrackingNum)
+	assert.Equal(t, 1, status.WIP.L2Block.CountOfTxs)
+	assert.Equal(t, uint64(20), status.WIP.L2Block.Bytes)
+	assert.Equal(t, uint32(50), status.WIP.L2Block.UsedZKCounters.Steps)
+
+	res = adminRequest(t, s, http.MethodPost, adminStatusEndpoint, adminTestToken, nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, res.Code)
+}
+
+func TestAdminPauseResume(t *testing.T) {
+	s := newAdminTestSequencer(t)
+
+	res := adminRequest(t, s, http.MethodPost, adminPauseEndpoint, adminTestToken, nil)
+	assert.Equal(t, http.StatusAccepted, res.Code)
+	assert.True(t, s.finalizer.paused.Load())
+
+	res = adminRequest(t, s, http.MethodPost, adminResumeEndpoint, adminTestToken, nil)
+	assert.Equal(t, http.StatusAccepted, res.Code)
+	assert.False(t, s.finalizer.paused.Load())
+
+	res = adminRequest(t, s, http.MethodGet, adminPauseEndpoint, adminTestToken, nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, res.Code)
+}
+
+func TestAdminCloseRequests(t *testing.T) {
+	s := newAdminTestSequencer(t)
+
+	res := adminRequest(t, s, http.MethodPost, adminCloseL2BlockEndpoint, adminTestToken, nil)
+	assert.Equal(t, http.StatusAccepted, res.Code)
+	assert.True(t, s.finalizer.closeL2BlockRequested.Load())
+	assert.False(t, s.finalizer.closeBatchRequested.Load())
+
+	res = adminRequest(t, s, http.MethodPost, adminCloseBatchEndpoint, adminTestToken, nil)
+	assert.Equal(t, http.StatusAccepted, res.Code)
+	assert.True(t, s.finalizer.closeBatchRequested.Load())
+}
+
+func TestAdminHalt(t *testing.T) {
+	s := newAdminTestSequencer(t)
+
+	res := adminRequest(t, s, http.MethodPost, adminHaltEndpoint, adminTestToken, haltRequest{BatchNumber: 20})
+	assert.Equal(t, http.StatusServiceUnavailable, res.Code)
+
+	s.finalizer.updateWIPStatus()
+
+	// the halt batch number must be after the wip batch
+	res = adminRequest(t, s, http.MethodPost, adminHaltEndpoint, adminTestToken, haltRequest{BatchNumber: 10})
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	assert.Equal(t, uint64(0), s.finalizer.haltOnBatchNumber.Load())
+
+	res = adminRequest(t, s, http.MethodPost, adminHaltEndpoint, adminTestToken, haltRequest{BatchNumber: 11})
+	require.Equal(t, http.StatusOK, res.Code)
+	var status finalizerStatus
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&status))
+	assert.Equal(t, uint64(11), status.HaltOnBatchNumber)
+	assert.Equal(t, uint64(11), s.finalizer.haltOnBatchNumber.Load())
+
+	res = adminRequest(t, s, http.MethodPost, adminHaltEndpoint, adminTestToken, haltRequest{BatchNumber: 0})
+	require.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, uint64(0), s.finalizer.haltOnBatchNumber.Load())
+}
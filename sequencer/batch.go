@@ -123,8 +123,8 @@ func (f *finalizer) initWIPBatch(ctx context.Context) {
 	log.Infof("batch %d isClosed: %v", lastBatchNum, isClosed)
 
 	if isClosed { //if the last batch is close then open a new wip batch
-		if lastStateBatch.BatchNumber+1 == f.cfg.HaltOnBatchNumber {
-			f.Halt(ctx, fmt.Errorf("finalizer reached stop sequencer on batch number: %d", f.cfg.HaltOnBatchNumber), false)
+		if haltOnBatchNumber := f.haltOnBatchNumber.Load(); lastStateBatch.BatchNumber+1 == haltOnBatchNumber {
+			f.Halt(ctx, fmt.Errorf("finalizer reached stop sequencer on batch number: %d", haltOnBatchNumber), false)
 		}
 
 		f.wipBatch, err = f.openNewWIPBatch(ctx, lastStateBatch.BatchNumber+1, lastStateBatch.StateRoot)
@@ -208,8 +208,8 @@ func (f *finalizer) closeAndOpenNewWIPBatch(ctx context.Context, closeReason sta
 		}()
 	}
 
-	if f.wipBatch.batchNumber+1 == f.cfg.HaltOnBatchNumber {
-		f.Halt(ctx, fmt.Errorf("finalizer reached stop sequencer on batch number: %d", f.cfg.HaltOnBatchNumber), false)
+	if haltOnBatchNumber := f.haltOnBatchNumber.Load(); f.wipBatch.batchNumber+1 == haltOnBatchNumber {
+		f.Halt(ctx, fmt.Errorf("finalizer reached stop sequencer on batch number: %d", haltOnBatchNumber), false)
 	}
 
 	// Metadata for the next batch
@@ -3,6 +3,7 @@ package sequencer
 import (
 	"github.com/0xPolygonHermez/zkevm-data-streamer/log"
 	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // Config represents the configuration of a sequencer
@@ -30,6 +31,23 @@ type Config struct {
 
 	// StreamServerCfg is the config for the stream server
 	StreamServer StreamServerCfg `mapstructure:"StreamServer"`
+
+	// Admin is the configuration of the admin API of the sequencer
+	Admin AdminCfg `mapstructure:"Admin"`
+}
+
+// AdminCfg contains the sequencer admin API configuration properties
+type AdminCfg struct {
+	// Enabled enables the admin API, it allows to pause the tx selection, close the wip L2 block
+	// or batch and halt the sequencer, so it must only be reachable by the operators
+	Enabled bool `mapstructure:"Enabled"`
+	// Host for the admin http server
+	Host string `mapstructure:"Host"`
+	// Port for the admin http server
+	Port int `mapstructure:"Port"`
+	// AuthToken is the bearer token the requests to the admin API must provide
+	// in the Authorization header, it's required when the admin API is enabled
+	AuthToken string `mapstructure:"AuthToken"`
 }
 
 // StreamServerCfg contains the data streamer's configuration properties
@@ -91,10 +109,21 @@ type FinalizerCfg struct {
 	// in the processPendingL2Blocks go func
 	SequentialProcessL2Block bool `mapstructure:"SequentialProcessL2Block"`
 
+	// TxOrderingPolicy is the policy used by the worker to sort the ready txs that are offered to the finalizer
+	// possible values: gasprice/fifo/priority/zkcounters
+	TxOrderingPolicy TxOrderingPolicyType `mapstructure:"TxOrderingPolicy"`
+
+	// TxOrderingPriorityAddresses are the senders or contracts whose txs are processed before the rest of txs,
+	// this parameter is used for the priority tx ordering policy
+	TxOrderingPriorityAddresses []common.Address `mapstructure:"TxOrderingPriorityAddresses"`
+
 	// Metrics is the config for the sequencer metrics
 	Metrics MetricsCfg `mapstructure:"Metrics"`
 }
 
+// TxOrderingPolicyType is the policy used by the worker to sort the ready txs
+type TxOrderingPolicyType string
+
 // MetricsCfg contains the sequencer metrics configuration properties
 type MetricsCfg struct {
 	// Interval is the interval of time to calculate sequencer metrics
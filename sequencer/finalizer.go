@@ -44,6 +44,13 @@ type finalizer struct {
 	wipL2Block       *L2Block
 	batchConstraints state.BatchConstraintsCfg
 	haltFinalizer    atomic.Bool
+	// admin API requests
+	paused                atomic.Bool
+	closeL2BlockRequested atomic.Bool
+	closeBatchRequested   atomic.Bool
+	haltOnBatchNumber     atomic.Uint64
+	wipStatus             *wipStatus
+	wipStatusMux          sync.RWMutex
 	// forced batches
 	nextForcedBatches       []state.ForcedBatch
 	nextForcedBatchDeadline int64
@@ -140,6 +147,7 @@ func newFinalizer(
 	}
 
 	f.haltFinalizer.Store(false)
+	f.haltOnBatchNumber.Store(cfg.HaltOnBatchNumber)
 
 	return &f
 }
@@ -375,12 +383,25 @@ func (f *finalizer) finalizeBatches(ctx context.Context) {
 	log.Debug("finalizer init loop")
 	showNotFoundTxLog := true // used to log debug only the first message when there is no txs to process
 	for {
+		// Close the wip L2 block or the wip batch if it has been requested through the admin API
+		f.checkAdminCloseRequests(ctx)
+
+		// Update the status of the wip batch and L2 block returned by the admin API
+		f.updateWIPStatus()
+
 		// We have reached the L2 block time, we need to close the current L2 block and open a new one
 		if f.wipL2Block.timestamp+uint64(f.cfg.L2BlockMaxDeltaTimestamp.Seconds()) <= uint64(time.Now().Unix()) {
 			f.finalizeWIPL2Block(ctx)
 		}
 
-		tx, err := f.workerIntf.GetBestFittingTx(f.wipBatch.imRemainingResources)
+		var (
+			tx  *TxTracker
+			err error
+		)
+		// If the tx selection is paused (admin API) we keep closing L2 blocks and batches but we don't process new txs
+		if !f.paused.Load() {
+			tx, err = f.workerIntf.GetBestFittingTx(f.wipBatch.imRemainingResources)
+		}
 
 		// If we have txs pending to process but none of them fits into the wip batch, we close the wip batch and open a new one
 		if err == ErrNoFittingTransaction {
@@ -475,7 +496,7 @@ func (f *finalizer) processTransaction(ctx context.Context, tx *TxTracker, first
 		// Get L1 gas price and store in txTracker to make it consistent during the lifespan of the transaction
 		tx.L1GasPrice, tx.L2GasPrice = f.poolIntf.GetL1AndL2GasPrice()
 		// Get the tx and l2 gas price we will use in the egp calculation. If egp is disabled we will use a "simulated" tx gas price
-		txGasPrice, txL2GasPrice := f.effectiveGasPrice.GetTxAndL2GasPrice(tx.GasPrice, tx.L1GasPrice, tx.L2GasPrice)
+		txGasPrice, txL2GasPrice := f.effectiveGasPrice.GetTxAndL2GasPrice(pool.GetTxGasPrice(tx.Type, tx.GasPrice, tx.GasTipCap, tx.L2GasPrice), tx.L1GasPrice, tx.L2GasPrice)
 
 		// Save values for later logging
 		tx.EGPLog.L1GasPrice = tx.L1GasPrice
@@ -605,7 +626,7 @@ func (f *finalizer) handleProcessTransactionResponse(ctx context.Context, tx *Tx
 		tx.IsLastExecution = true
 
 		// Get the tx gas price we will use in the egp calculation. If egp is disabled we will use a "simulated" tx gas price
-		txGasPrice, txL2GasPrice := f.effectiveGasPrice.GetTxAndL2GasPrice(tx.GasPrice, tx.L1GasPrice, tx.L2GasPrice)
+		txGasPrice, txL2GasPrice := f.effectiveGasPrice.GetTxAndL2GasPrice(pool.GetTxGasPrice(tx.Type, tx.GasPrice, tx.GasTipCap, tx.L2GasPrice), tx.L1GasPrice, tx.L2GasPrice)
 
 		newEffectiveGasPrice, err := f.effectiveGasPrice.CalculateEffectiveGasPrice(tx.RawTx, txGasPrice, txResponse.GasUsed, tx.L1GasPrice, txL2GasPrice)
 		if err != nil {
@@ -712,7 +733,7 @@ func (f *finalizer) handleProcessTransactionResponse(ctx context.Context, tx *Tx
 // the tx.EffectiveGasPrice updated, otherwise it returns nil
 func (f *finalizer) compareTxEffectiveGasPrice(ctx context.Context, tx *TxTracker, newEffectiveGasPrice *big.Int, hasGasPriceOC bool, hasBalanceOC bool) error {
 	// Get the tx gas price we will use in the egp calculation. If egp is disabled we will use a "simulated" tx gas price
-	txGasPrice, _ := f.effectiveGasPrice.GetTxAndL2GasPrice(tx.GasPrice, tx.L1GasPrice, tx.L2GasPrice)
+	txGasPrice, _ := f.effectiveGasPrice.GetTxAndL2GasPrice(pool.GetTxGasPrice(tx.Type, tx.GasPrice, tx.GasTipCap, tx.L2GasPrice), tx.L1GasPrice, tx.L2GasPrice)
 
 	// Compute the absolute difference between tx.EffectiveGasPrice - newEffectiveGasPrice
 	diff := new(big.Int).Abs(new(big.Int).Sub(tx.EffectiveGasPrice, newEffectiveGasPrice))
@@ -3,6 +3,7 @@ package sequencer
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
@@ -31,6 +32,10 @@ type Sequencer struct {
 	worker    *Worker
 	finalizer *finalizer
 
+	txOrdering txOrdering
+
+	adminSrv *http.Server
+
 	workerReadyTxsCond *timeoutCond
 
 	streamServer *datastreamer.StreamServer
@@ -48,6 +53,15 @@ func New(cfg Config, batchCfg state.BatchConfig, poolCfg pool.Config, txPool txP
 		return nil, fmt.Errorf("failed to get trusted sequencer address, error: %v", err)
 	}
 
+	txOrdering, err := newTxOrdering(cfg.Finalizer, batchCfg.Constraints)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Admin.Enabled && cfg.Admin.AuthToken == "" {
+		return nil, ErrAdminAuthTokenRequired
+	}
+
 	sequencer := &Sequencer{
 		cfg:       cfg,
 		batchCfg:  batchCfg,
@@ -57,6 +71,8 @@ func New(cfg Config, batchCfg state.BatchConfig, poolCfg pool.Config, txPool txP
 		etherman:  etherman,
 		address:   addr,
 		eventLog:  eventLog,
+
+		txOrdering: txOrdering,
 	}
 
 	// TODO: Make configurable
@@ -100,10 +116,14 @@ func (s *Sequencer) Start(ctx context.Context) {
 	}
 
 	s.workerReadyTxsCond = newTimeoutCond(&sync.Mutex{})
-	s.worker = NewWorker(s.stateIntf, s.batchCfg.Constraints, s.workerReadyTxsCond)
+	s.worker = NewWorker(s.stateIntf, s.batchCfg.Constraints, s.txOrdering, s.workerReadyTxsCond)
 	s.finalizer = newFinalizer(s.cfg.Finalizer, s.poolCfg, s.worker, s.pool, s.stateIntf, s.etherman, s.address, s.isSynced, s.batchCfg.Constraints, s.eventLog, s.streamServer, s.workerReadyTxsCond, s.dataToStream)
 	go s.finalizer.Start(ctx)
 
+	if s.cfg.Admin.Enabled {
+		s.startAdminServer()
+	}
+
 	go s.deleteOldPoolTxs(ctx)
 
 	go s.expireOldWorkerTxs(ctx)
@@ -112,6 +132,12 @@ func (s *Sequencer) Start(ctx context.Context) {
 
 	// Wait until context is done
 	<-ctx.Done()
+
+	if s.adminSrv != nil {
+		if err := s.adminSrv.Close(); err != nil {
+			log.Errorf("failed to close the admin API, error: %v", err)
+		}
+	}
 }
 
 // checkStateInconsistency checks if state inconsistency happened
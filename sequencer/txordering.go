@@ -0,0 +1,112 @@
+package sequencer
+
+import (
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// TxOrderingGasPrice sorts the ready txs by gas price, the highest first
+	TxOrderingGasPrice TxOrderingPolicyType = "gasprice"
+	// TxOrderingFIFO sorts the ready txs by the time they were received, the oldest first
+	TxOrderingFIFO TxOrderingPolicyType = "fifo"
+	// TxOrderingPriority sorts first the ready txs sent by or to the priority addresses, then by gas price
+	TxOrderingPriority TxOrderingPolicyType = "priority"
+	// TxOrderingZKCounters sorts the ready txs by the share of the batch resources they reserve, the smallest
+	// first, to maximize the number of txs that fit in a batch
+	TxOrderingZKCounters TxOrderingPolicyType = "zkcounters"
+)
+
+// txOrdering is the policy used by the txSortedList to sort the ready txs. The fields used to compare
+// the txs must not change while the txs are in the txSortedList
+type txOrdering interface {
+	// isBefore returns true if tx1 must be offered to the finalizer before tx2
+	isBefore(tx1 *TxTracker, tx2 *TxTracker) bool
+}
+
+// newTxOrdering creates the txOrdering for the policy set in the finalizer config
+func newTxOrdering(cfg FinalizerCfg, constraints state.BatchConstraintsCfg) (txOrdering, error) {
+	switch cfg.TxOrderingPolicy {
+	case TxOrderingGasPrice:
+		return &gasPriceOrdering{}, nil
+	case TxOrderingFIFO:
+		return &fifoOrdering{}, nil
+	case TxOrderingPriority:
+		return newPriorityOrdering(cfg.TxOrderingPriorityAddresses), nil
+	case TxOrderingZKCounters:
+		return &zkCountersOrdering{constraints: constraints}, nil
+	default:
+		return nil, fmt.Errorf("unknown tx ordering policy %q", cfg.TxOrderingPolicy)
+	}
+}
+
+// gasPriceOrdering sorts the txs by gas price, the highest first
+type gasPriceOrdering struct{}
+
+func (o *gasPriceOrdering) isBefore(tx1 *TxTracker, tx2 *TxTracker) bool {
+	return tx1.GasPrice.Cmp(tx2.GasPrice) == 1
+}
+
+// fifoOrdering sorts the txs by the time they were received, the oldest first
+type fifoOrdering struct{}
+
+func (o *fifoOrdering) isBefore(tx1 *TxTracker, tx2 *TxTracker) bool {
+	return tx1.ReceivedAt.Before(tx2.ReceivedAt)
+}
+
+// priorityOrdering sorts first the txs sent by or to the priority addresses, txs in the same lane are sorted by gas price
+type priorityOrdering struct {
+	addresses map[common.Address]struct{}
+	gasPriceOrdering
+}
+
+func newPriorityOrdering(addresses []common.Address) *priorityOrdering {
+	o := &priorityOrdering{addresses: make(map[common.Address]struct{}, len(addresses))}
+	for _, addr := range addresses {
+		o.addresses[addr] = struct{}{}
+	}
+	return o
+}
+
+func (o *priorityOrdering) isBefore(tx1 *TxTracker, tx2 *TxTracker) bool {
+	priority1, priority2 := o.isPriority(tx1), o.isPriority(tx2)
+	if priority1 != priority2 {
+		return priority1
+	}
+	return o.gasPriceOrdering.isBefore(tx1, tx2)
+}
+
+// isPriority returns true if the tx is sent by or to one of the priority addresses
+func (o *priorityOrdering) isPriority(tx *TxTracker) bool {
+	if _, found := o.addresses[tx.From]; found {
+		return true
+	}
+	if tx.To != nil {
+		if _, found := o.addresses[*tx.To]; found {
+			return true
+		}
+	}
+	return false
+}
+
+// zkCountersOrdering sorts the txs by the share of the batch resources they reserve, the smallest first,
+// txs with the same share are sorted by gas price
+type zkCountersOrdering struct {
+	constraints state.BatchConstraintsCfg
+	gasPriceOrdering
+}
+
+func (o *zkCountersOrdering) isBefore(tx1 *TxTracker, tx2 *TxTracker) bool {
+	usage1, usage2 := o.usage(tx1), o.usage(tx2)
+	if usage1 != usage2 {
+		return usage1 < usage2
+	}
+	return o.gasPriceOrdering.isBefore(tx1, tx2)
+}
+
+// usage returns the highest share of a batch resource (ZKCounter or bytes) reserved by the tx
+func (o *zkCountersOrdering) usage(tx *TxTracker) float64 {
+	return o.constraints.BatchShare(tx.ReservedZKCounters, tx.Bytes)
+}
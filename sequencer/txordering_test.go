@@ -0,0 +1,74 @@
+package sequencer
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sortedHashes(el *txSortedList) []string {
+	hashes := make([]string, 0, el.len())
+	for _, tx := range el.GetSorted() {
+		hashes = append(hashes, tx.HashStr)
+	}
+	return hashes
+}
+
+func TestNewTxOrdering(t *testing.T) {
+	for _, policy := range []TxOrderingPolicyType{TxOrderingGasPrice, TxOrderingFIFO, TxOrderingPriority, TxOrderingZKCounters} {
+		ordering, err := newTxOrdering(FinalizerCfg{TxOrderingPolicy: policy}, rcMax)
+		require.NoError(t, err)
+		assert.NotNil(t, ordering)
+	}
+
+	_, err := newTxOrdering(FinalizerCfg{TxOrderingPolicy: "unknown"}, rcMax)
+	assert.Error(t, err)
+}
+
+func TestTxSortedListFIFO(t *testing.T) {
+	el := newTxSortedList(&fifoOrdering{})
+	now := time.Now()
+
+	el.add(&TxTracker{HashStr: "0x01", GasPrice: big.NewInt(10), ReceivedAt: now.Add(2 * time.Second)})
+	el.add(&TxTracker{HashStr: "0x02", GasPrice: big.NewInt(40), ReceivedAt: now})
+	el.add(&TxTracker{HashStr: "0x03", GasPrice: big.NewInt(20), ReceivedAt: now.Add(time.Second)})
+	el.add(&TxTracker{HashStr: "0x04", GasPrice: big.NewInt(30), ReceivedAt: now})
+	assert.Equal(t, []string{"0x02", "0x04", "0x03", "0x01"}, sortedHashes(el))
+
+	assert.True(t, el.delete(&TxTracker{HashStr: "0x04"}))
+	assert.True(t, el.delete(&TxTracker{HashStr: "0x01"}))
+	assert.Equal(t, []string{"0x02", "0x03"}, sortedHashes(el))
+}
+
+func TestTxSortedListPriority(t *testing.T) {
+	sender := common.HexToAddress("0x617b3a3528F9cDd6630fd3301B9c8911F7Bf063D")
+	contract := common.HexToAddress("0x4d5Cf5032B2a844602278b01199ED191A86c93ff")
+	other := common.HexToAddress("0x1275fbb540c8efc58b812ba83b0d0b8b9917ae98")
+
+	el := newTxSortedList(newPriorityOrdering([]common.Address{sender, contract}))
+	el.add(&TxTracker{HashStr: "0x01", From: other, To: &other, GasPrice: big.NewInt(100)})
+	el.add(&TxTracker{HashStr: "0x02", From: other, To: &contract, GasPrice: big.NewInt(10)})
+	el.add(&TxTracker{HashStr: "0x03", From: sender, GasPrice: big.NewInt(20)})
+	el.add(&TxTracker{HashStr: "0x04", From: other, To: &other, GasPrice: big.NewInt(50)})
+	assert.Equal(t, []string{"0x03", "0x02", "0x01", "0x04"}, sortedHashes(el))
+
+	assert.True(t, el.delete(&TxTracker{HashStr: "0x02"}))
+	assert.Equal(t, []string{"0x03", "0x01", "0x04"}, sortedHashes(el))
+}
+
+func TestTxSortedListZKCounters(t *testing.T) {
+	el := newTxSortedList(&zkCountersOrdering{constraints: rcMax})
+	el.add(&TxTracker{HashStr: "0x01", GasPrice: big.NewInt(100), Bytes: 1, ReservedZKCounters: state.ZKCounters{Steps: 8}})
+	el.add(&TxTracker{HashStr: "0x02", GasPrice: big.NewInt(10), Bytes: 1, ReservedZKCounters: state.ZKCounters{Arithmetics: 2}})
+	el.add(&TxTracker{HashStr: "0x03", GasPrice: big.NewInt(20), Bytes: 5, ReservedZKCounters: state.ZKCounters{GasUsed: 1}})
+	el.add(&TxTracker{HashStr: "0x04", GasPrice: big.NewInt(50), Bytes: 2, ReservedZKCounters: state.ZKCounters{Binaries: 1}})
+	assert.Equal(t, []string{"0x04", "0x02", "0x03", "0x01"}, sortedHashes(el))
+
+	assert.True(t, el.delete(&TxTracker{HashStr: "0x02"}))
+	assert.Equal(t, []string{"0x04", "0x03", "0x01"}, sortedHashes(el))
+}
@@ -8,18 +8,20 @@ import (
 	"github.com/0xPolygonHermez/zkevm-node/log"
 )
 
-// txSortedList represents a list of tx sorted by gasPrice
+// txSortedList represents a list of tx sorted by the txOrdering policy
 type txSortedList struct {
-	list   map[string]*TxTracker
-	sorted []*TxTracker
-	mutex  sync.Mutex
+	list     map[string]*TxTracker
+	sorted   []*TxTracker
+	ordering txOrdering
+	mutex    sync.Mutex
 }
 
 // newTxSortedList creates and init an txSortedList
-func newTxSortedList() *txSortedList {
+func newTxSortedList(ordering txOrdering) *txSortedList {
 	return &txSortedList{
-		list:   make(map[string]*TxTracker),
-		sorted: []*TxTracker{},
+		list:     make(map[string]*TxTracker),
+		sorted:   []*TxTracker{},
+		ordering: ordering,
 	}
 }
 
@@ -44,21 +46,21 @@ func (e *txSortedList) delete(tx *TxTracker) bool {
 	if tx, found := e.list[tx.HashStr]; found {
 		sLen := len(e.sorted)
 		i := sort.Search(sLen, func(i int) bool {
-			return e.isGreaterOrEqualThan(tx, e.list[e.sorted[i].HashStr])
+			return !e.ordering.isBefore(e.sorted[i], tx)
 		})
 
-		// i is the index of the first tx that has equal (or lower) gasPrice than the tx. From here we need to go down in the list
+		// i is the index of the first tx that isn't sorted before the tx. From here we need to go down in the list
 		// looking for the sorted[i].HashStr equal to tx.HashStr to get the index of tx in the sorted slice.
-		// We need to go down until we find the tx or we have a tx with different (lower) gasPrice or we reach the end of the list
+		// We need to go down until we find the tx or we have a tx sorted after the tx or we reach the end of the list
 		for {
 			if i == sLen {
 				log.Warnf("error deleting tx %s from txSortedList, we reach the end of the list", tx.HashStr)
 				return false
 			}
 
-			if (e.sorted[i].GasPrice.Cmp(tx.GasPrice)) != 0 {
-				// we have a tx with different (lower) GasPrice than the tx we are looking for, therefore we haven't found the tx
-				log.Warnf("error deleting tx %s from txSortedList, not found in the list of txs with same gasPrice: %s", tx.HashStr)
+			if e.ordering.isBefore(tx, e.sorted[i]) {
+				// we have a tx sorted after the tx we are looking for, therefore we haven't found the tx
+				log.Warnf("error deleting tx %s from txSortedList, not found in the list of txs with same order", tx.HashStr)
 				return false
 			}
 
@@ -114,7 +116,7 @@ func (e *txSortedList) Print() {
 // addSort adds the tx to the txSortedList in a sorted way
 func (e *txSortedList) addSort(tx *TxTracker) {
 	i := sort.Search(len(e.sorted), func(i int) bool {
-		return e.isGreaterThan(tx, e.list[e.sorted[i].HashStr])
+		return e.ordering.isBefore(tx, e.sorted[i])
 	})
 
 	e.sorted = append(e.sorted, nil)
@@ -123,26 +125,6 @@ func (e *txSortedList) addSort(tx *TxTracker) {
 	log.Debugf("added tx %s with  gasPrice %d to txSortedList at index %d from total %d", tx.HashStr, tx.GasPrice, i, len(e.sorted))
 }
 
-// isGreaterThan returns true if the tx1 has greater gasPrice than tx2
-func (e *txSortedList) isGreaterThan(tx1 *TxTracker, tx2 *TxTracker) bool {
-	cmp := tx1.GasPrice.Cmp(tx2.GasPrice)
-	if cmp == 1 {
-		return true
-	} else {
-		return false
-	}
-}
-
-// isGreaterOrEqualThan returns true if the tx1 has greater or equal gasPrice than tx2
-func (e *txSortedList) isGreaterOrEqualThan(tx1 *TxTracker, tx2 *TxTracker) bool {
-	cmp := tx1.GasPrice.Cmp(tx2.GasPrice)
-	if cmp >= 0 {
-		return true
-	} else {
-		return false
-	}
-}
-
 // GetSorted returns the sorted list of tx
 func (e *txSortedList) GetSorted() []*TxTracker {
 	e.mutex.Lock()
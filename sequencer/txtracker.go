@@ -15,9 +15,12 @@ type TxTracker struct {
 	HashStr            string
 	From               common.Address
 	FromStr            string
+	To                 *common.Address
 	Nonce              uint64
-	Gas                uint64 // To check if it fits into a batch
-	GasPrice           *big.Int
+	Type               uint8
+	Gas                uint64   // To check if it fits into a batch
+	GasPrice           *big.Int // For dynamic fee txs (EIP-1559) it's the gas fee cap
+	GasTipCap          *big.Int
 	Cost               *big.Int // Cost = Amount + Benefit
 	Bytes              uint64
 	UsedZKCounters     state.ZKCounters
@@ -51,9 +54,12 @@ func newTxTracker(tx types.Transaction, usedZKCounters state.ZKCounters, reserve
 		HashStr:            tx.Hash().String(),
 		From:               addr,
 		FromStr:            addr.String(),
+		To:                 tx.To(),
 		Nonce:              tx.Nonce(),
+		Type:               tx.Type(),
 		Gas:                tx.Gas(),
 		GasPrice:           tx.GasPrice(),
+		GasTipCap:          tx.GasTipCap(),
 		Cost:               tx.Cost(),
 		Bytes:              uint64(len(rawTx)) + state.EfficiencyPercentageByteLength,
 		UsedZKCounters:     usedZKCounters,
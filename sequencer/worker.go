@@ -27,10 +27,10 @@ type Worker struct {
 }
 
 // NewWorker creates an init a worker
-func NewWorker(state stateInterface, constraints state.BatchConstraintsCfg, readyTxsCond *timeoutCond) *Worker {
+func NewWorker(state stateInterface, constraints state.BatchConstraintsCfg, ordering txOrdering, readyTxsCond *timeoutCond) *Worker {
 	w := Worker{
 		pool:             make(map[string]*addrQueue),
-		txSortedList:     newTxSortedList(),
+		txSortedList:     newTxSortedList(ordering),
 		state:            state,
 		batchConstraints: constraints,
 		readyTxsCond:     readyTxsCond,
@@ -251,7 +251,14 @@ func (w *Worker) UpdateTxZKCounters(txHash common.Hash, addr common.Address, use
 	addrQueue, found := w.pool[addr.String()]
 
 	if found {
+		// The position of the readyTx in the txSortedList can depend on its ZKCounters (zkcounters ordering policy),
+		// therefore we remove it from the txSortedList while we update them
+		readyTx := addrQueue.readyTx
+		resort := readyTx != nil && readyTx.Hash == txHash && w.txSortedList.delete(readyTx)
 		addrQueue.UpdateTxZKCounters(txHash, usedZKCounters, reservedZKCounters)
+		if resort {
+			w.txSortedList.add(readyTx)
+		}
 	} else {
 		log.Warnf("addrQueue %s not found", addr.String())
 	}
@@ -0,0 +1,328 @@
+package sequencesender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	ethman "github.com/0xPolygonHermez/zkevm-node/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/etherman/types"
+	"github.com/0xPolygonHermez/zkevm-node/ethtxmanager"
+	"github.com/0xPolygonHermez/zkevm-node/event"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/sequencesender/metrics"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	// SequenceModeCalldata sends the batches in the calldata of a sequenceBatches call
+	SequenceModeCalldata SequenceModeType = "calldata"
+	// SequenceModeBlob encodes the batches into EIP-4844 blobs of a sequenceBlobs call
+	SequenceModeBlob SequenceModeType = "blob"
+)
+
+// blobSequence is a sequence of blobs to be sent in a single sequenceBlobs call
+type blobSequence struct {
+	blobs            []types.BlobData
+	firstBatchNumber uint64
+	lastBatchNumber  uint64
+	// blobsSize is the size of the blob data of each blob
+	blobsSize []int
+}
+
+func (b *blobSequence) batchesCount() int {
+	return int(b.lastBatchNumber - b.firstBatchNumber + 1)
+}
+
+// maxSequenceTimestamp returns the timestamp of the last L2 block of the sequence
+func (b *blobSequence) maxSequenceTimestamp() uint64 {
+	return b.blobs[len(b.blobs)-1].MaxSequenceTimestamp
+}
+
+// blobSequenceChoice is the data mode chosen to send a blob sequence to L1
+type blobSequenceChoice struct {
+	Mode             SequenceModeType `json:"mode"`
+	FirstBatchNumber uint64           `json:"firstBatchNumber"`
+	LastBatchNumber  uint64           `json:"lastBatchNumber"`
+	Blobs            int              `json:"blobs"`
+	BlobCost         *big.Int         `json:"blobCost"`
+	CalldataCost     *big.Int         `json:"calldataCost"`
+	Reason           string           `json:"reason"`
+}
+
+// tryToSendBlobs sends the closed and checked batches to L1 encoded in blobs
+func (s *SequenceSender) tryToSendBlobs(ctx context.Context) {
+	log.Infof("getting blobs to send")
+	seq, err := s.getBlobsToSend(ctx)
+	if err != nil || seq == nil {
+		if err != nil {
+			log.Errorf("error getting blobs: %v", err)
+		} else {
+			log.Info("waiting for blobs to be worth sending to L1")
+		}
+		time.Sleep(s.cfg.WaitPeriodSendSequence.Duration)
+		return
+	}
+
+	choice, err := s.chooseBlobType(ctx, seq)
+	if err != nil {
+		log.Errorf("error choosing the data mode of the blobs: %v", err)
+		time.Sleep(s.cfg.WaitPeriodSendSequence.Duration)
+		return
+	}
+
+	log.Infof("sending blobs to L1. From batch %d to batch %d in %d blobs (%s)", seq.firstBatchNumber, seq.lastBatchNumber, len(seq.blobs), choice.Mode)
+	if !s.waitL1BlockTimestampMargin(ctx, seq.lastBatchNumber, seq.maxSequenceTimestamp()) {
+		return
+	}
+
+	blobType := ethman.TypeBlobTransaction
+	if choice.Mode == SequenceModeCalldata {
+		blobType = ethman.TypeCallData
+	}
+	to, data, sidecar, err := s.etherman.BuildSequenceBlobsTxData(seq.blobs, blobType, s.cfg.L2Coinbase)
+	if err != nil {
+		log.Error("error building sequenceBlobs to add to eth tx manager: ", err)
+		return
+	}
+
+	monitoredTxID := fmt.Sprintf(monitoredIDFormat, seq.firstBatchNumber, seq.lastBatchNumber)
+	if sidecar != nil {
+		err = s.ethTxManager.AddBlobTx(ctx, ethTxManagerOwner, monitoredTxID, s.cfg.SenderAddress, to, nil, data, s.cfg.GasOffset, sidecar, nil)
+	} else {
+		err = s.ethTxManager.Add(ctx, ethTxManagerOwner, monitoredTxID, s.cfg.SenderAddress, to, nil, data, s.cfg.GasOffset, nil)
+	}
+	if err != nil {
+		mTxLogger := ethtxmanager.CreateLogger(ethTxManagerOwner, monitoredTxID, s.cfg.SenderAddress, to)
+		mTxLogger.Errorf("error to add blobs tx to eth tx manager: ", err)
+		return
+	}
+
+	metrics.SequenceSent(string(choice.Mode), seq.batchesCount(), len(seq.blobs))
+	s.logBlobSequenceChoice(ctx, choice)
+}
+
+// getBlobsToSend packs the closed and checked batches into blobs. The blobs are returned once
+// MaxBlobsPerTx blobs are full, or when LastBatchVirtualizationTimeMaxWaitPeriod has elapsed since
+// the last batch was virtualized, otherwise it returns nil to wait for more batches
+func (s *SequenceSender) getBlobsToSend(ctx context.Context) (*blobSequence, error) {
+	lastVirtualBatchNum, err := s.state.GetLastVirtualBatchNum(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last virtual batch num, err: %w", err)
+	}
+
+	seq := &blobSequence{firstBatchNumber: lastVirtualBatchNum + 1}
+	var current *types.BlobData
+	currentSize := 0
+
+	closeBlob := func() {
+		if current != nil {
+			seq.blobs = append(seq.blobs, *current)
+			seq.blobsSize = append(seq.blobsSize, currentSize)
+			current = nil
+		}
+	}
+
+	for batchNumber := seq.firstBatchNumber; ; batchNumber++ {
+		if (s.cfg.ForkUpgradeBatchNumber != 0) && (batchNumber == (s.cfg.ForkUpgradeBatchNumber + 1)) {
+			return nil, fmt.Errorf("aborting sequencing process as we reached the batch %d where a new forkid is applied (upgrade)", s.cfg.ForkUpgradeBatchNumber+1)
+		}
+
+		batch, err := s.state.GetBatchByNumber(ctx, batchNumber, nil)
+		if errors.Is(err, state.ErrNotFound) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get batch by number %d, err: %w", batchNumber, err)
+		}
+
+		isChecked, err := s.state.IsBatchChecked(ctx, batchNumber, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if batch %d is closed and checked, err: %w", batchNumber, err)
+		}
+		if !isChecked {
+			break
+		}
+
+		if batch.ForcedBatchNum != nil {
+			return nil, fmt.Errorf("forced batch %d can't be sequenced in blobs, it must be sequenced with the calldata SequenceMode", batchNumber)
+		}
+
+		batchSize := len(batch.BatchL2Data)
+		if ethman.BlobDataSize(batchSize) > ethman.MaxBlobDataSize {
+			return nil, fmt.Errorf("batch %d of %d bytes doesn't fit in a blob", batchNumber, batchSize)
+		}
+
+		// Start a new blob when the batch doesn't fit in the current one
+		if current != nil && currentSize+ethman.BlobBatchHeaderSize+batchSize > ethman.MaxBlobDataSize {
+			closeBlob()
+			if uint64(len(seq.blobs)) >= s.cfg.MaxBlobsPerTx {
+				log.Infof("blobs are full, selected batches %d to %d", seq.firstBatchNumber, seq.lastBatchNumber)
+				return seq, nil
+			}
+		}
+		if current == nil {
+			current = &types.BlobData{}
+			currentSize = ethman.BlobDataHeaderSize
+		}
+
+		if err := s.addBatchToBlob(ctx, current, batch); err != nil {
+			return nil, err
+		}
+		currentSize += ethman.BlobBatchHeaderSize + batchSize
+		seq.lastBatchNumber = batchNumber
+
+		if (s.cfg.ForkUpgradeBatchNumber != 0) && (batchNumber == (s.cfg.ForkUpgradeBatchNumber)) {
+			log.Infof("blobs should be sent to L1, as we have reached the batch %d from which a new forkid is applied (upgrade)", s.cfg.ForkUpgradeBatchNumber)
+			closeBlob()
+			return seq, nil
+		}
+	}
+	closeBlob()
+
+	if len(seq.blobs) == 0 {
+		log.Info("no batches to be sequenced")
+		return nil, nil
+	}
+
+	lastBatchVirtualizationTime, err := s.state.GetTimeForLatestBatchVirtualization(ctx, nil)
+	if err != nil && !errors.Is(err, state.ErrNotFound) {
+		log.Warnf("failed to get last l1 interaction time, err: %v. Sending blobs as a conservative approach", err)
+		return seq, nil
+	}
+	if lastBatchVirtualizationTime.Before(time.Now().Add(-s.cfg.LastBatchVirtualizationTimeMaxWaitPeriod.Duration)) {
+		log.Info("blobs should be sent to L1, because too long since didn't send anything to L1")
+		return seq, nil
+	}
+
+	log.Info("not enough time has passed since last batch was virtualized, and the blobs aren't full")
+	return nil, nil
+}
+
+// addBatchToBlob adds the batch to the blob and updates the params of the blob: the timestamp of the last
+// L2 block, the zkGasLimit with the gas used by the L2 blocks and the highest L1InfoTree index used
+func (s *SequenceSender) addBatchToBlob(ctx context.Context, blob *types.BlobData, batch *state.Batch) error {
+	l2Blocks, err := s.state.GetL2BlocksByBatchNumber(ctx, batch.BatchNumber, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get L2 blocks of batch %d, err: %w", batch.BatchNumber, err)
+	}
+	if len(l2Blocks) == 0 {
+		return fmt.Errorf("no L2 blocks returned from the state for batch %d", batch.BatchNumber)
+	}
+	for _, l2Block := range l2Blocks {
+		blob.ZkGasLimit += l2Block.GasUsed()
+		if timestamp := uint64(l2Block.ReceivedAt.Unix()); timestamp > blob.MaxSequenceTimestamp {
+			blob.MaxSequenceTimestamp = timestamp
+		}
+	}
+
+	l1InfoTreeData, _, _, err := s.state.GetL1InfoTreeDataFromBatchL2Data(ctx, batch.BatchL2Data, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get L1InfoTree data of batch %d, err: %w", batch.BatchNumber, err)
+	}
+	for index := range l1InfoTreeData {
+		if index > blob.L1InfoLeafIndex {
+			blob.L1InfoLeafIndex = index
+		}
+	}
+
+	blob.BatchesL2Data = append(blob.BatchesL2Data, batch.BatchL2Data)
+	return nil
+}
+
+// chooseBlobType compares the cost of sending the blobs in a blob tx, its gas plus the blob fees, with the
+// gas cost of sending them in the calldata. When the calldata is cheaper the sequence is trimmed to the
+// blobs that fit in MaxTxSizeForL1
+func (s *SequenceSender) chooseBlobType(ctx context.Context, seq *blobSequence) (*blobSequenceChoice, error) {
+	choice := &blobSequenceChoice{
+		Mode:             SequenceModeBlob,
+		FirstBatchNumber: seq.firstBatchNumber,
+		LastBatchNumber:  seq.lastBatchNumber,
+		Blobs:            len(seq.blobs),
+	}
+
+	header, err := s.etherman.GetLatestBlockHeader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last L1 block header, err: %w", err)
+	}
+	if header.ExcessBlobGas == nil {
+		choice.Mode = SequenceModeCalldata
+		choice.Reason = "blob txs are not supported by the L1 network"
+		return choice, s.trimToCalldata(ctx, seq, choice)
+	}
+
+	l1GasPrice := s.etherman.GetL1GasPrice(ctx)
+	blobFee := eip4844.CalcBlobFee(*header.ExcessBlobGas)
+
+	to, data, sidecar, err := s.etherman.BuildSequenceBlobsTxData(seq.blobs, ethman.TypeBlobTransaction, s.cfg.L2Coinbase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the blob tx data, err: %w", err)
+	}
+	blobTxGas, err := s.etherman.EstimateGasBlobTx(ctx, s.cfg.SenderAddress, to, nil, nil, blobFee, nil, data, sidecar.BlobHashes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate the gas of the blob tx, err: %w", err)
+	}
+	blobGas := new(big.Int).SetUint64(uint64(len(seq.blobs)) * params.BlobTxBlobGasPerBlob)
+	choice.BlobCost = new(big.Int).Mul(blobFee, blobGas)
+	choice.BlobCost.Add(choice.BlobCost, new(big.Int).Mul(l1GasPrice, new(big.Int).SetUint64(blobTxGas)))
+
+	to, data, _, err = s.etherman.BuildSequenceBlobsTxData(seq.blobs, ethman.TypeCallData, s.cfg.L2Coinbase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the calldata tx data, err: %w", err)
+	}
+	calldataTxGas, err := s.etherman.EstimateGas(ctx, s.cfg.SenderAddress, to, nil, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate the gas of the calldata tx, err: %w", err)
+	}
+	choice.CalldataCost = new(big.Int).Mul(l1GasPrice, new(big.Int).SetUint64(calldataTxGas))
+
+	blobCost, _ := new(big.Float).SetInt(choice.BlobCost).Float64()
+	calldataCost, _ := new(big.Float).SetInt(choice.CalldataCost).Float64()
+	metrics.SequenceCost(string(SequenceModeBlob), blobCost)
+	metrics.SequenceCost(string(SequenceModeCalldata), calldataCost)
+
+	if choice.BlobCost.Cmp(choice.CalldataCost) <= 0 {
+		choice.Reason = "the blob fees don't exceed the calldata cost"
+		return choice, nil
+	}
+
+	if seq.blobsSize[0] > int(s.cfg.MaxTxSizeForL1) {
+		choice.Reason = "the blob fees exceed the calldata cost, but the first blob doesn't fit in the calldata"
+		return choice, nil
+	}
+	choice.Mode = SequenceModeCalldata
+	choice.Reason = "the blob fees exceed the calldata cost"
+	return choice, s.trimToCalldata(ctx, seq, choice)
+}
+
+// trimToCalldata removes the last blobs of the sequence until the blob data fits in MaxTxSizeForL1
+func (s *SequenceSender) trimToCalldata(ctx context.Context, seq *blobSequence, choice *blobSequenceChoice) error {
+	size, blobs := 0, 0
+	for blobs < len(seq.blobs) && size+seq.blobsSize[blobs] <= int(s.cfg.MaxTxSizeForL1) {
+		size += seq.blobsSize[blobs]
+		blobs++
+	}
+	if blobs == 0 {
+		return fmt.Errorf("blob data of %d bytes doesn't fit in the calldata, MaxTxSizeForL1 is %d", seq.blobsSize[0], s.cfg.MaxTxSizeForL1)
+	}
+
+	for _, blob := range seq.blobs[blobs:] {
+		seq.lastBatchNumber -= uint64(len(blob.BatchesL2Data))
+	}
+	seq.blobs = seq.blobs[:blobs]
+	seq.blobsSize = seq.blobsSize[:blobs]
+
+	choice.LastBatchNumber = seq.lastBatchNumber
+	choice.Blobs = blobs
+	return nil
+}
+
+// logBlobSequenceChoice stores the data mode chosen for the blob sequence in the event log
+func (s *SequenceSender) logBlobSequenceChoice(ctx context.Context, choice *blobSequenceChoice) {
+	description := fmt.Sprintf("Batches %d-%d sent in %d blobs using %s, %s. Blob cost: %v, calldata cost: %v",
+		choice.FirstBatchNumber, choice.LastBatchNumber, choice.Blobs, choice.Mode, choice.Reason, choice.BlobCost, choice.CalldataCost)
+	s.eventLog.LogInfoEvent(ctx, event.Component_Sequence_Sender, event.EventID_SequenceSenderBlobSequence, description, choice)
+}
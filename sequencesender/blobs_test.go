@@ -0,0 +1,216 @@
+package sequencesender
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	cfgTypes "github.com/0xPolygonHermez/zkevm-node/config/types"
+	ethman "github.com/0xPolygonHermez/zkevm-node/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testBatchSize = 50000
+
+// mockClosedBatches mocks the closed and checked batches from firstBatch to lastBatch, and the batch
+// lastBatch+1 as not checked
+func mockClosedBatches(stateMock *StateMock, firstBatch, lastBatch uint64) {
+	for batchNumber := firstBatch; batchNumber <= lastBatch; batchNumber++ {
+		batchL2Data := bytes.Repeat([]byte{byte(batchNumber)}, testBatchSize)
+		stateMock.On("GetBatchByNumber", mock.Anything, batchNumber, nil).Return(&state.Batch{BatchNumber: batchNumber, BatchL2Data: batchL2Data}, nil).Maybe()
+		stateMock.On("IsBatchChecked", mock.Anything, batchNumber, nil).Return(true, nil).Maybe()
+
+		header := &types.Header{Number: new(big.Int).SetUint64(batchNumber), GasUsed: 1000}
+		l2Block := state.NewL2BlockWithHeader(state.NewL2Header(header))
+		l2Block.ReceivedAt = time.Unix(int64(1700000000+batchNumber), 0)
+		stateMock.On("GetL2BlocksByBatchNumber", mock.Anything, batchNumber, nil).Return([]state.L2Block{*l2Block}, nil).Maybe()
+		l1InfoTreeData := map[uint32]state.L1DataV2{uint32(batchNumber): {}}
+		stateMock.On("GetL1InfoTreeDataFromBatchL2Data", mock.Anything, batchL2Data, nil).Return(l1InfoTreeData, common.Hash{}, common.Hash{}, nil).Maybe()
+	}
+	stateMock.On("GetBatchByNumber", mock.Anything, lastBatch+1, nil).Return(&state.Batch{BatchNumber: lastBatch + 1}, nil).Maybe()
+	stateMock.On("IsBatchChecked", mock.Anything, lastBatch+1, nil).Return(false, nil).Maybe()
+}
+
+func TestGetBlobsToSend(t *testing.T) {
+	ctx := context.Background()
+	cfg := Config{SequenceMode: SequenceModeBlob, MaxBlobsPerTx: 2}
+	cfg.LastBatchVirtualizationTimeMaxWaitPeriod.Duration = time.Minute
+
+	t.Run("blobs are full", func(t *testing.T) {
+		stateMock := new(StateMock)
+		ssender, err := New(cfg, stateMock, new(EthermanMock), new(EthTxManagerMock), nil, nil)
+		require.NoError(t, err)
+
+		stateMock.On("GetLastVirtualBatchNum", ctx, nil).Return(uint64(10), nil).Once()
+		mockClosedBatches(stateMock, 11, 15)
+
+		seq, err := ssender.getBlobsToSend(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, seq)
+		assert.Equal(t, uint64(11), seq.firstBatchNumber)
+		assert.Equal(t, uint64(14), seq.lastBatchNumber)
+		require.Len(t, seq.blobs, 2)
+		for i, blob := range seq.blobs {
+			assert.Len(t, blob.BatchesL2Data, 2)
+			assert.Equal(t, uint64(2000), blob.ZkGasLimit)
+			assert.Equal(t, uint64(1700000012+2*i), blob.MaxSequenceTimestamp)
+			assert.Equal(t, uint32(12+2*i), blob.L1InfoLeafIndex)
+			assert.Equal(t, ethman.BlobDataSize(testBatchSize, testBatchSize), seq.blobsSize[i])
+		}
+		stateMock.AssertNotCalled(t, "GetL2BlocksByBatchNumber", mock.Anything, uint64(15), nil)
+	})
+
+	t.Run("wait for more batches", func(t *testing.T) {
+		stateMock := new(StateMock)
+		ssender, err := New(cfg, stateMock, new(EthermanMock), new(EthTxManagerMock), nil, nil)
+		require.NoError(t, err)
+
+		stateMock.On("GetLastVirtualBatchNum", ctx, nil).Return(uint64(10), nil).Once()
+		stateMock.On("GetTimeForLatestBatchVirtualization", ctx, nil).Return(time.Now(), nil).Once()
+		mockClosedBatches(stateMock, 11, 13)
+
+		seq, err := ssender.getBlobsToSend(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, seq)
+	})
+
+	t.Run("too long since last virtualization", func(t *testing.T) {
+		stateMock := new(StateMock)
+		ssender, err := New(cfg, stateMock, new(EthermanMock), new(EthTxManagerMock), nil, nil)
+		require.NoError(t, err)
+
+		stateMock.On("GetLastVirtualBatchNum", ctx, nil).Return(uint64(10), nil).Once()
+		stateMock.On("GetTimeForLatestBatchVirtualization", ctx, nil).Return(time.Now().Add(-time.Hour), nil).Once()
+		mockClosedBatches(stateMock, 11, 13)
+
+		seq, err := ssender.getBlobsToSend(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, seq)
+		assert.Equal(t, uint64(13), seq.lastBatchNumber)
+		require.Len(t, seq.blobs, 2)
+		assert.Len(t, seq.blobs[1].BatchesL2Data, 1)
+	})
+
+	t.Run("forced batch", func(t *testing.T) {
+		stateMock := new(StateMock)
+		ssender, err := New(cfg, stateMock, new(EthermanMock), new(EthTxManagerMock), nil, nil)
+		require.NoError(t, err)
+
+		forcedBatchNum := uint64(1)
+		stateMock.On("GetLastVirtualBatchNum", ctx, nil).Return(uint64(10), nil).Once()
+		stateMock.On("GetBatchByNumber", ctx, uint64(11), nil).Return(&state.Batch{BatchNumber: 11, ForcedBatchNum: &forcedBatchNum}, nil).Once()
+		stateMock.On("IsBatchChecked", ctx, uint64(11), nil).Return(true, nil).Once()
+
+		_, err = ssender.getBlobsToSend(ctx)
+		require.Error(t, err)
+	})
+}
+
+func TestChooseBlobType(t *testing.T) {
+	ctx := context.Background()
+	cfg := Config{SequenceMode: SequenceModeBlob, MaxBlobsPerTx: 2, MaxTxSizeForL1: 131072}
+	newSequence := func() *blobSequence {
+		stateMock := new(StateMock)
+		ssender, err := New(cfg, stateMock, nil, nil, nil, nil)
+		require.NoError(t, err)
+		stateMock.On("GetLastVirtualBatchNum", ctx, nil).Return(uint64(10), nil).Once()
+		mockClosedBatches(stateMock, 11, 15)
+		seq, err := ssender.getBlobsToSend(ctx)
+		require.NoError(t, err)
+		return seq
+	}
+	l1GasPrice := big.NewInt(1000000000)
+	blobTxGas := uint64(100000)
+	calldataTxGas := uint64(2000000)
+
+	testCases := []struct {
+		name              string
+		excessBlobGas     *uint64
+		expectedMode      SequenceModeType
+		expectedBlobs     int
+		expectedLastBatch uint64
+	}{
+		{
+			name:              "blobs are cheaper",
+			excessBlobGas:     new(uint64),
+			expectedMode:      SequenceModeBlob,
+			expectedBlobs:     2,
+			expectedLastBatch: 14,
+		},
+		{
+			name: "calldata is cheaper",
+			excessBlobGas: func() *uint64 {
+				excess := uint64(30 * params.BlobTxBlobGaspriceUpdateFraction)
+				return &excess
+			}(),
+			expectedMode:      SequenceModeCalldata,
+			expectedBlobs:     1,
+			expectedLastBatch: 12,
+		},
+		{
+			name:              "blob txs not supported",
+			expectedMode:      SequenceModeCalldata,
+			expectedBlobs:     1,
+			expectedLastBatch: 12,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ethermanMock := new(EthermanMock)
+			ssender, err := New(cfg, new(StateMock), ethermanMock, new(EthTxManagerMock), nil, nil)
+			require.NoError(t, err)
+
+			ethermanMock.On("GetLatestBlockHeader", ctx).Return(&types.Header{ExcessBlobGas: tc.excessBlobGas}, nil).Once()
+			ethermanMock.On("GetL1GasPrice", ctx).Return(l1GasPrice).Maybe()
+			to := common.HexToAddress("0x1")
+			ethermanMock.On("BuildSequenceBlobsTxData", mock.Anything, ethman.TypeBlobTransaction, cfg.L2Coinbase).Return(&to, []byte{1}, &types.BlobTxSidecar{}, nil).Maybe()
+			ethermanMock.On("BuildSequenceBlobsTxData", mock.Anything, ethman.TypeCallData, cfg.L2Coinbase).Return(&to, []byte{2}, nil, nil).Maybe()
+			ethermanMock.On("EstimateGasBlobTx", ctx, cfg.SenderAddress, &to, mock.Anything, mock.Anything, mock.Anything, mock.Anything, []byte{1}, mock.Anything).Return(blobTxGas, nil).Maybe()
+			ethermanMock.On("EstimateGas", ctx, cfg.SenderAddress, &to, mock.Anything, []byte{2}).Return(calldataTxGas, nil).Maybe()
+
+			seq := newSequence()
+			choice, err := ssender.chooseBlobType(ctx, seq)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMode, choice.Mode)
+			assert.Equal(t, tc.expectedBlobs, choice.Blobs)
+			assert.Len(t, seq.blobs, tc.expectedBlobs)
+			assert.Equal(t, tc.expectedLastBatch, seq.lastBatchNumber)
+			assert.Equal(t, tc.expectedLastBatch, choice.LastBatchNumber)
+		})
+	}
+}
+
+func TestTryToSendBlobsChooseBlobTypeError(t *testing.T) {
+	ctx := context.Background()
+	cfg := Config{
+		SequenceMode:           SequenceModeBlob,
+		MaxBlobsPerTx:          2,
+		MaxTxSizeForL1:         131072,
+		WaitPeriodSendSequence: cfgTypes.NewDuration(100 * time.Millisecond),
+	}
+	stateMock := new(StateMock)
+	ethermanMock := new(EthermanMock)
+	ethTxManagerMock := new(EthTxManagerMock)
+	ssender, err := New(cfg, stateMock, ethermanMock, ethTxManagerMock, nil, nil)
+	require.NoError(t, err)
+
+	stateMock.On("GetLastVirtualBatchNum", ctx, nil).Return(uint64(10), nil).Once()
+	mockClosedBatches(stateMock, 11, 15)
+	ethermanMock.On("GetLatestBlockHeader", ctx).Return(nil, errors.New("error")).Once()
+
+	start := time.Now()
+	ssender.tryToSendBlobs(ctx)
+	assert.GreaterOrEqual(t, time.Since(start), cfg.WaitPeriodSendSequence.Duration)
+	ethermanMock.AssertExpectations(t)
+	ethTxManagerMock.AssertExpectations(t)
+}
@@ -41,4 +41,17 @@ type Config struct {
 	// gas offset: 100
 	// final gas: 1100
 	GasOffset uint64 `mapstructure:"GasOffset"`
+	// SequenceMode defines how the batches are sent to L1:
+	//   - calldata: the batches are sent in the calldata of a sequenceBatches call
+	//   - blob: the batches are encoded into EIP-4844 blobs of a sequenceBlobs call (feijoa), the
+	//     blobs are sent in the calldata instead when the blob fees exceed the calldata cost
+	//   - validium: the batches are posted to the data availability committee and only their hashes
+	//     and the signatures of the committee are sent to L1, it requires DataAvailability to be enabled
+	SequenceMode SequenceModeType `mapstructure:"SequenceMode"`
+	// MaxBlobsPerTx is the max number of blobs sent in a single L1 tx when the SequenceMode is blob,
+	// the sequence is sent once all the blobs are full or LastBatchVirtualizationTimeMaxWaitPeriod has elapsed
+	MaxBlobsPerTx uint64 `mapstructure:"MaxBlobsPerTx"`
 }
+
+// SequenceModeType is the way the sequences are sent to L1
+type SequenceModeType string
@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"github.com/0xPolygonHermez/zkevm-node/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	prefix            = "sequencesender_"
+	sequencesSentName = prefix + "sequences_sent"
+	batchesSentName   = prefix + "batches_sent"
+	blobsSentName     = prefix + "blobs_sent"
+	sequenceCostName  = prefix + "sequence_cost"
+
+	modeLabelName = "mode"
+)
+
+// Register the metrics for the sequencesender package.
+func Register() {
+	counterVecs := []metrics.CounterVecOpts{
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: sequencesSentName,
+				Help: "[SEQUENCESENDER] number of sequences sent to L1 by data mode",
+			},
+			Labels: []string{modeLabelName},
+		},
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: batchesSentName,
+				Help: "[SEQUENCESENDER] number of batches sent to L1 by data mode",
+			},
+			Labels: []string{modeLabelName},
+		},
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: blobsSentName,
+				Help: "[SEQUENCESENDER] number of blobs of the sequenceBlobs calls sent to L1 by data mode",
+			},
+			Labels: []string{modeLabelName},
+		},
+	}
+
+	gaugeVecs := []metrics.GaugeVecOpts{
+		{
+			GaugeOpts: prometheus.GaugeOpts{
+				Name: sequenceCostName,
+				Help: "[SEQUENCESENDER] estimated L1 data cost in wei of the last blob sequence for each data mode",
+			},
+			Labels: []string{modeLabelName},
+		},
+	}
+
+	metrics.RegisterCounterVecs(counterVecs...)
+	metrics.RegisterGaugeVecs(gaugeVecs...)
+}
+
+// SequenceSent increments the counters of the sequences, batches and blobs sent with the data mode.
+func SequenceSent(mode string, batches int, blobs int) {
+	metrics.CounterVecInc(sequencesSentName, mode)
+	metrics.CounterVecAdd(batchesSentName, mode, float64(batches))
+	metrics.CounterVecAdd(blobsSentName, mode, float64(blobs))
+}
+
+// SequenceCost sets the gauge for the estimated L1 data cost of the last blob sequence with the data mode.
+func SequenceCost(mode string, cost float64) {
+	metrics.GaugeVecSet(sequenceCostName, mode, cost)
+}
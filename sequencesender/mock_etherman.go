@@ -5,10 +5,14 @@ package sequencesender
 import (
 	context "context"
 
+	big "math/big"
+
 	common "github.com/ethereum/go-ethereum/common"
 
 	coretypes "github.com/ethereum/go-ethereum/core/types"
 
+	ethman "github.com/0xPolygonHermez/zkevm-node/etherman"
+
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/0xPolygonHermez/zkevm-node/etherman/types"
@@ -58,6 +62,149 @@ func (_m *EthermanMock) BuildSequenceBatchesTxData(sender common.Address, sequen
 	return r0, r1, r2
 }
 
+// BuildSequenceBatchesValidiumTxData provides a mock function with given fields: sequences, maxSequenceTimestamp, lastSequencedBatchNumber, l2Coinbase, dataAvailabilityMessage
+func (_m *EthermanMock) BuildSequenceBatchesValidiumTxData(sequences []types.Sequence, maxSequenceTimestamp uint64, lastSequencedBatchNumber uint64, l2Coinbase common.Address, dataAvailabilityMessage []byte) (*common.Address, []byte, error) {
+	ret := _m.Called(sequences, maxSequenceTimestamp, lastSequencedBatchNumber, l2Coinbase, dataAvailabilityMessage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildSequenceBatchesValidiumTxData")
+	}
+
+	var r0 *common.Address
+	var r1 []byte
+	var r2 error
+	if rf, ok := ret.Get(0).(func([]types.Sequence, uint64, uint64, common.Address, []byte) (*common.Address, []byte, error)); ok {
+		return rf(sequences, maxSequenceTimestamp, lastSequencedBatchNumber, l2Coinbase, dataAvailabilityMessage)
+	}
+	if rf, ok := ret.Get(0).(func([]types.Sequence, uint64, uint64, common.Address, []byte) *common.Address); ok {
+		r0 = rf(sequences, maxSequenceTimestamp, lastSequencedBatchNumber, l2Coinbase, dataAvailabilityMessage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]types.Sequence, uint64, uint64, common.Address, []byte) []byte); ok {
+		r1 = rf(sequences, maxSequenceTimestamp, lastSequencedBatchNumber, l2Coinbase, dataAvailabilityMessage)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func([]types.Sequence, uint64, uint64, common.Address, []byte) error); ok {
+		r2 = rf(sequences, maxSequenceTimestamp, lastSequencedBatchNumber, l2Coinbase, dataAvailabilityMessage)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// BuildSequenceBlobsTxData provides a mock function with given fields: blobs, blobType, l2Coinbase
+func (_m *EthermanMock) BuildSequenceBlobsTxData(blobs []types.BlobData, blobType ethman.BlobType, l2Coinbase common.Address) (*common.Address, []byte, *coretypes.BlobTxSidecar, error) {
+	ret := _m.Called(blobs, blobType, l2Coinbase)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildSequenceBlobsTxData")
+	}
+
+	var r0 *common.Address
+	var r1 []byte
+	var r2 *coretypes.BlobTxSidecar
+	var r3 error
+	if rf, ok := ret.Get(0).(func([]types.BlobData, ethman.BlobType, common.Address) (*common.Address, []byte, *coretypes.BlobTxSidecar, error)); ok {
+		return rf(blobs, blobType, l2Coinbase)
+	}
+	if rf, ok := ret.Get(0).(func([]types.BlobData, ethman.BlobType, common.Address) *common.Address); ok {
+		r0 = rf(blobs, blobType, l2Coinbase)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]types.BlobData, ethman.BlobType, common.Address) []byte); ok {
+		r1 = rf(blobs, blobType, l2Coinbase)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func([]types.BlobData, ethman.BlobType, common.Address) *coretypes.BlobTxSidecar); ok {
+		r2 = rf(blobs, blobType, l2Coinbase)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*coretypes.BlobTxSidecar)
+		}
+	}
+
+	if rf, ok := ret.Get(3).(func([]types.BlobData, ethman.BlobType, common.Address) error); ok {
+		r3 = rf(blobs, blobType, l2Coinbase)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// EstimateGas provides a mock function with given fields: ctx, from, to, value, data
+func (_m *EthermanMock) EstimateGas(ctx context.Context, from common.Address, to *common.Address, value *big.Int, data []byte) (uint64, error) {
+	ret := _m.Called(ctx, from, to, value, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EstimateGas")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *common.Address, *big.Int, []byte) (uint64, error)); ok {
+		return rf(ctx, from, to, value, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *common.Address, *big.Int, []byte) uint64); ok {
+		r0 = rf(ctx, from, to, value, data)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *common.Address, *big.Int, []byte) error); ok {
+		r1 = rf(ctx, from, to, value, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EstimateGasBlobTx provides a mock function with given fields: ctx, from, to, gasFeeCap, gasTipCap, blobGasPrice, value, data, blobHashes
+func (_m *EthermanMock) EstimateGasBlobTx(ctx context.Context, from common.Address, to *common.Address, gasFeeCap *big.Int, gasTipCap *big.Int, blobGasPrice *big.Int, value *big.Int, data []byte, blobHashes []common.Hash) (uint64, error) {
+	ret := _m.Called(ctx, from, to, gasFeeCap, gasTipCap, blobGasPrice, value, data, blobHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EstimateGasBlobTx")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *common.Address, *big.Int, *big.Int, *big.Int, *big.Int, []byte, []common.Hash) (uint64, error)); ok {
+		return rf(ctx, from, to, gasFeeCap, gasTipCap, blobGasPrice, value, data, blobHashes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *common.Address, *big.Int, *big.Int, *big.Int, *big.Int, []byte, []common.Hash) uint64); ok {
+		r0 = rf(ctx, from, to, gasFeeCap, gasTipCap, blobGasPrice, value, data, blobHashes)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *common.Address, *big.Int, *big.Int, *big.Int, *big.Int, []byte, []common.Hash) error); ok {
+		r1 = rf(ctx, from, to, gasFeeCap, gasTipCap, blobGasPrice, value, data, blobHashes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EstimateGasSequenceBatches provides a mock function with given fields: sender, sequences, maxSequenceTimestamp, initSequenceBatchNumber, l2Coinbase
 func (_m *EthermanMock) EstimateGasSequenceBatches(sender common.Address, sequences []types.Sequence, maxSequenceTimestamp uint64, initSequenceBatchNumber uint64, l2Coinbase common.Address) (*coretypes.Transaction, error) {
 	ret := _m.Called(sender, sequences, maxSequenceTimestamp, initSequenceBatchNumber, l2Coinbase)
@@ -88,6 +235,26 @@ func (_m *EthermanMock) EstimateGasSequenceBatches(sender common.Address, sequen
 	return r0, r1
 }
 
+// GetL1GasPrice provides a mock function with given fields: ctx
+func (_m *EthermanMock) GetL1GasPrice(ctx context.Context) *big.Int {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetL1GasPrice")
+	}
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(context.Context) *big.Int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
 // GetLatestBatchNumber provides a mock function with given fields:
 func (_m *EthermanMock) GetLatestBatchNumber() (uint64, error) {
 	ret := _m.Called()
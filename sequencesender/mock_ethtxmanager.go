@@ -4,6 +4,7 @@ package sequencesender
 
 import (
 	context "context"
+
 	big "math/big"
 
 	common "github.com/ethereum/go-ethereum/common"
@@ -13,6 +14,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	pgx "github.com/jackc/pgx/v4"
+
+	types "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EthTxManagerMock is an autogenerated mock type for the ethTxManager type
@@ -38,6 +41,24 @@ func (_m *EthTxManagerMock) Add(ctx context.Context, owner string, id string, fr
 	return r0
 }
 
+// AddBlobTx provides a mock function with given fields: ctx, owner, id, from, to, value, data, gasOffset, sidecar, dbTx
+func (_m *EthTxManagerMock) AddBlobTx(ctx context.Context, owner string, id string, from common.Address, to *common.Address, value *big.Int, data []byte, gasOffset uint64, sidecar *types.BlobTxSidecar, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, owner, id, from, to, value, data, gasOffset, sidecar, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBlobTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, common.Address, *common.Address, *big.Int, []byte, uint64, *types.BlobTxSidecar, pgx.Tx) error); ok {
+		r0 = rf(ctx, owner, id, from, to, value, data, gasOffset, sidecar, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ProcessPendingMonitoredTxs provides a mock function with given fields: ctx, owner, failedResultHandler, dbTx
 func (_m *EthTxManagerMock) ProcessPendingMonitoredTxs(ctx context.Context, owner string, failedResultHandler ethtxmanager.ResultHandler, dbTx pgx.Tx) {
 	_m.Called(ctx, owner, failedResultHandler, dbTx)
@@ -5,9 +5,12 @@ package sequencesender
 import (
 	context "context"
 
-	pgx "github.com/jackc/pgx/v4"
+	common "github.com/ethereum/go-ethereum/common"
+
 	mock "github.com/stretchr/testify/mock"
 
+	pgx "github.com/jackc/pgx/v4"
+
 	state "github.com/0xPolygonHermez/zkevm-node/state"
 
 	time "time"
@@ -108,6 +111,84 @@ func (_m *StateMock) GetForcedBatch(ctx context.Context, forcedBatchNumber uint6
 	return r0, r1
 }
 
+// GetL1InfoTreeDataFromBatchL2Data provides a mock function with given fields: ctx, batchL2Data, dbTx
+func (_m *StateMock) GetL1InfoTreeDataFromBatchL2Data(ctx context.Context, batchL2Data []byte, dbTx pgx.Tx) (map[uint32]state.L1DataV2, common.Hash, common.Hash, error) {
+	ret := _m.Called(ctx, batchL2Data, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetL1InfoTreeDataFromBatchL2Data")
+	}
+
+	var r0 map[uint32]state.L1DataV2
+	var r1 common.Hash
+	var r2 common.Hash
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, pgx.Tx) (map[uint32]state.L1DataV2, common.Hash, common.Hash, error)); ok {
+		return rf(ctx, batchL2Data, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, pgx.Tx) map[uint32]state.L1DataV2); ok {
+		r0 = rf(ctx, batchL2Data, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint32]state.L1DataV2)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, pgx.Tx) common.Hash); ok {
+		r1 = rf(ctx, batchL2Data, dbTx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(common.Hash)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []byte, pgx.Tx) common.Hash); ok {
+		r2 = rf(ctx, batchL2Data, dbTx)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(common.Hash)
+		}
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, []byte, pgx.Tx) error); ok {
+		r3 = rf(ctx, batchL2Data, dbTx)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// GetL2BlocksByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
+func (_m *StateMock) GetL2BlocksByBatchNumber(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) ([]state.L2Block, error) {
+	ret := _m.Called(ctx, batchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetL2BlocksByBatchNumber")
+	}
+
+	var r0 []state.L2Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) ([]state.L2Block, error)); ok {
+		return rf(ctx, batchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) []state.L2Block); ok {
+		r0 = rf(ctx, batchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]state.L2Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, batchNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLastBatchNumber provides a mock function with given fields: ctx, dbTx
 func (_m *StateMock) GetLastBatchNumber(ctx context.Context, dbTx pgx.Tx) (uint64, error) {
 	ret := _m.Called(ctx, dbTx)
@@ -11,8 +11,9 @@ import (
 	"github.com/0xPolygonHermez/zkevm-node/ethtxmanager"
 	"github.com/0xPolygonHermez/zkevm-node/event"
 	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/sequencesender/metrics"
 	"github.com/0xPolygonHermez/zkevm-node/state"
-	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/jackc/pgx/v4"
 )
 
@@ -41,16 +42,28 @@ type SequenceSender struct {
 	ethTxManager ethTxManager
 	etherman     etherman
 	eventLog     *event.EventLog
+	da           dataAvailability
 }
 
-// New inits sequence sender
-func New(cfg Config, state stateInterface, etherman etherman, manager ethTxManager, eventLog *event.EventLog) (*SequenceSender, error) {
+// New inits sequence sender, da is only required when the SequenceMode is validium
+func New(cfg Config, state stateInterface, etherman etherman, manager ethTxManager, eventLog *event.EventLog, da dataAvailability) (*SequenceSender, error) {
+	if cfg.SequenceMode == "" {
+		cfg.SequenceMode = SequenceModeCalldata
+	} else if cfg.SequenceMode != SequenceModeCalldata && cfg.SequenceMode != SequenceModeBlob && cfg.SequenceMode != SequenceModeValidium {
+		return nil, fmt.Errorf("invalid SequenceMode %q, valid values are %q, %q and %q", cfg.SequenceMode, SequenceModeCalldata, SequenceModeBlob, SequenceModeValidium)
+	}
+	if cfg.SequenceMode == SequenceModeValidium && da == nil {
+		return nil, fmt.Errorf("the data availability committee is required by the SequenceMode %q", SequenceModeValidium)
+	}
+	metrics.Register()
+
 	return &SequenceSender{
 		cfg:          cfg,
 		state:        state,
 		etherman:     etherman,
 		ethTxManager: manager,
 		eventLog:     eventLog,
+		da:           da,
 	}, nil
 }
 
@@ -113,6 +126,11 @@ func (s *SequenceSender) tryToSendSequence(ctx context.Context) {
 		return
 	}
 
+	if s.cfg.SequenceMode == SequenceModeBlob {
+		s.tryToSendBlobs(ctx)
+		return
+	}
+
 	// Check if should send sequence to L1
 	log.Infof("getting sequences to send")
 	sequences, err := s.getSequencesToSend(ctx)
@@ -136,12 +154,41 @@ func (s *SequenceSender) tryToSendSequence(ctx context.Context) {
 	sequenceCount := len(sequences)
 	log.Infof("sending sequences to L1. From batch %d to batch %d", lastVirtualBatchNum+1, lastVirtualBatchNum+uint64(sequenceCount))
 
-	// Check if we need to wait until last L1 block timestamp is L1BlockTimestampMargin seconds above the timestamp of the last L2 block in the sequence
 	// Get last sequence
 	lastSequence := sequences[sequenceCount-1]
-	// Get timestamp of the last L2 block in the sequence
-	lastL2BlockTimestamp := uint64(lastSequence.LastL2BLockTimestamp)
+	if !s.waitL1BlockTimestampMargin(ctx, lastSequence.BatchNumber, uint64(lastSequence.LastL2BLockTimestamp)) {
+		return
+	}
+
+	// add sequence to be monitored
+	firstSequence := sequences[0]
 
+	var to *common.Address
+	var data []byte
+	if s.cfg.SequenceMode == SequenceModeValidium {
+		to, data, err = s.buildSequenceBatchesValidiumTxData(ctx, sequences)
+	} else {
+		to, data, err = s.etherman.BuildSequenceBatchesTxData(s.cfg.SenderAddress, sequences, uint64(lastSequence.LastL2BLockTimestamp), firstSequence.BatchNumber-1, s.cfg.L2Coinbase)
+	}
+	if err != nil {
+		log.Error("error estimating new sequenceBatches to add to eth tx manager: ", err)
+		return
+	}
+
+	monitoredTxID := fmt.Sprintf(monitoredIDFormat, firstSequence.BatchNumber, lastSequence.BatchNumber)
+	err = s.ethTxManager.Add(ctx, ethTxManagerOwner, monitoredTxID, s.cfg.SenderAddress, to, nil, data, s.cfg.GasOffset, nil)
+	if err != nil {
+		mTxLogger := ethtxmanager.CreateLogger(ethTxManagerOwner, monitoredTxID, s.cfg.SenderAddress, to)
+		mTxLogger.Errorf("error to add sequences tx to eth tx manager: ", err)
+		return
+	}
+	metrics.SequenceSent(string(s.cfg.SequenceMode), sequenceCount, 0)
+}
+
+// waitL1BlockTimestampMargin waits until the timestamps of the last L1 block and the current time are L1BlockTimestampMargin
+// seconds above the timestamp of the last L2 block in the sequence. It returns false if the last L1 block can't be read
+func (s *SequenceSender) waitL1BlockTimestampMargin(ctx context.Context, lastBatchNumber uint64, lastL2BlockTimestamp uint64) bool {
+	// Check if we need to wait until last L1 block timestamp is L1BlockTimestampMargin seconds above the timestamp of the last L2 block in the sequence
 	timeMargin := int64(s.cfg.L1BlockTimestampMargin.Seconds())
 
 	// Wait until last L1 block timestamp is timeMargin (L1BlockTimestampMargin) seconds above the timestamp of the last L2 block in the sequence
@@ -150,18 +197,18 @@ func (s *SequenceSender) tryToSendSequence(ctx context.Context) {
 		lastL1BlockHeader, err := s.etherman.GetLatestBlockHeader(ctx)
 		if err != nil {
 			log.Errorf("failed to get last L1 block timestamp, err: %v", err)
-			return
+			return false
 		}
 
 		elapsed, waitTime := s.marginTimeElapsed(ctx, lastL2BlockTimestamp, lastL1BlockHeader.Time, timeMargin)
 
 		if !elapsed {
 			log.Infof("waiting at least %d seconds to send sequences, time difference between last L1 block %d (ts: %d) and last L2 block %d (ts: %d) in the sequence is lower than %d seconds",
-				waitTime, lastL1BlockHeader.Number, lastL1BlockHeader.Time, lastSequence.BatchNumber, lastL2BlockTimestamp, timeMargin)
+				waitTime, lastL1BlockHeader.Number, lastL1BlockHeader.Time, lastBatchNumber, lastL2BlockTimestamp, timeMargin)
 			time.Sleep(time.Duration(waitTime) * time.Second)
 		} else {
 			log.Infof("continuing, time difference between last L1 block %d (ts: %d) and last L2 block %d (ts: %d) in the sequence is greater than %d seconds",
-				lastL1BlockHeader.Number, lastL1BlockHeader.Time, lastSequence.BatchNumber, lastL2BlockTimestamp, timeMargin)
+				lastL1BlockHeader.Number, lastL1BlockHeader.Time, lastBatchNumber, lastL2BlockTimestamp, timeMargin)
 			break
 		}
 	}
@@ -175,31 +222,16 @@ func (s *SequenceSender) tryToSendSequence(ctx context.Context) {
 		// Wait if the time difference is less than timeMargin (L1BlockTimestampMargin)
 		if !elapsed {
 			log.Infof("waiting at least %d seconds to send sequences, time difference between now (ts: %d) and last L2 block %d (ts: %d) in the sequence is lower than %d seconds",
-				waitTime, currentTime, lastSequence.BatchNumber, lastL2BlockTimestamp, timeMargin)
+				waitTime, currentTime, lastBatchNumber, lastL2BlockTimestamp, timeMargin)
 			time.Sleep(time.Duration(waitTime) * time.Second)
 		} else {
 			log.Infof("sending sequences now, time difference between now (ts: %d) and last L2 block %d (ts: %d) in the sequence is also greater than %d seconds",
-				currentTime, lastSequence.BatchNumber, lastL2BlockTimestamp, timeMargin)
+				currentTime, lastBatchNumber, lastL2BlockTimestamp, timeMargin)
 			break
 		}
 	}
 
-	// add sequence to be monitored
-	firstSequence := sequences[0]
-
-	to, data, err := s.etherman.BuildSequenceBatchesTxData(s.cfg.SenderAddress, sequences, uint64(lastSequence.LastL2BLockTimestamp), firstSequence.BatchNumber-1, s.cfg.L2Coinbase)
-	if err != nil {
-		log.Error("error estimating new sequenceBatches to add to eth tx manager: ", err)
-		return
-	}
-
-	monitoredTxID := fmt.Sprintf(monitoredIDFormat, firstSequence.BatchNumber, lastSequence.BatchNumber)
-	err = s.ethTxManager.Add(ctx, ethTxManagerOwner, monitoredTxID, s.cfg.SenderAddress, to, nil, data, s.cfg.GasOffset, nil)
-	if err != nil {
-		mTxLogger := ethtxmanager.CreateLogger(ethTxManagerOwner, monitoredTxID, s.cfg.SenderAddress, to)
-		mTxLogger.Errorf("error to add sequences tx to eth tx manager: ", err)
-		return
-	}
+	return true
 }
 
 // getSequencesToSend generates an array of sequences to be send to L1.
@@ -218,8 +250,6 @@ func (s *SequenceSender) getSequencesToSend(ctx context.Context) ([]types.Sequen
 	sequences := []types.Sequence{}
 	// var estimatedGas uint64
 
-	var tx *ethTypes.Transaction
-
 	// Add sequences until too big for a single L1 tx or last batch is reached
 	for {
 		//Check if the next batch belongs to a new forkid, in this case we need to stop sequencing as we need to
@@ -288,11 +318,9 @@ func (s *SequenceSender) getSequencesToSend(ctx context.Context) ([]types.Sequen
 
 		sequences = append(sequences, seq)
 		// Check if can be send
-		firstSequence := sequences[0]
-		lastSequence := sequences[len(sequences)-1]
-		tx, err = s.etherman.EstimateGasSequenceBatches(s.cfg.SenderAddress, sequences, uint64(lastSequence.LastL2BLockTimestamp), firstSequence.BatchNumber-1, s.cfg.L2Coinbase)
-		if err == nil && tx.Size() > s.cfg.MaxTxSizeForL1 {
-			log.Infof("oversized Data on TX oldHash %s (txSize %d > %d)", tx.Hash(), tx.Size(), s.cfg.MaxTxSizeForL1)
+		txSize, err := s.sequenceTxSize(sequences)
+		if err == nil && txSize > s.cfg.MaxTxSizeForL1 {
+			log.Infof("oversized Data on TX (txSize %d > %d)", txSize, s.cfg.MaxTxSizeForL1)
 			err = ErrOversizedData
 		}
 		if err != nil {
@@ -301,8 +329,7 @@ func (s *SequenceSender) getSequencesToSend(ctx context.Context) ([]types.Sequen
 			if sequences != nil {
 				if len(sequences) > 0 {
 					// Handling the error gracefully, re-processing the sequence as a sanity check
-					lastSequence = sequences[len(sequences)-1]
-					_, err = s.etherman.EstimateGasSequenceBatches(s.cfg.SenderAddress, sequences, uint64(lastSequence.LastL2BLockTimestamp), firstSequence.BatchNumber-1, s.cfg.L2Coinbase)
+					_, err = s.sequenceTxSize(sequences)
 					return sequences, err
 				}
 			}
@@ -0,0 +1,58 @@
+package sequencesender
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-node/etherman/types"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SequenceModeValidium posts the batches data to the data availability committee and only the hashes
+// of the batches and the signatures of the committee are sent to L1
+const SequenceModeValidium SequenceModeType = "validium"
+
+// sequenceTxSize returns the size of the L1 tx that sends the sequences. In validium mode the gas can't be
+// estimated until the committee has signed the sequence, so the size is computed from the tx data without
+// the data availability message
+func (s *SequenceSender) sequenceTxSize(sequences []types.Sequence) (uint64, error) {
+	firstSequence := sequences[0]
+	lastSequence := sequences[len(sequences)-1]
+	if s.cfg.SequenceMode == SequenceModeValidium {
+		_, data, err := s.etherman.BuildSequenceBatchesValidiumTxData(sequences, uint64(lastSequence.LastL2BLockTimestamp), firstSequence.BatchNumber-1, s.cfg.L2Coinbase, nil)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(len(data)), nil
+	}
+
+	tx, err := s.etherman.EstimateGasSequenceBatches(s.cfg.SenderAddress, sequences, uint64(lastSequence.LastL2BLockTimestamp), firstSequence.BatchNumber-1, s.cfg.L2Coinbase)
+	if err != nil {
+		return 0, err
+	}
+	return tx.Size(), nil
+}
+
+// buildSequenceBatchesValidiumTxData posts the batches data to the data availability committee and builds the
+// data of the sequenceBatchesValidium call with the hashes of the batches and the signatures of the committee.
+// The forced batches data is already on L1, so only the non-forced batches are posted and signed
+func (s *SequenceSender) buildSequenceBatchesValidiumTxData(ctx context.Context, sequences []types.Sequence) (*common.Address, []byte, error) {
+	batchesData := make([][]byte, 0, len(sequences))
+	for _, seq := range sequences {
+		if seq.ForcedBatchTimestamp > 0 {
+			continue
+		}
+		batchesData = append(batchesData, seq.BatchL2Data)
+	}
+
+	firstSequence := sequences[0]
+	lastSequence := sequences[len(sequences)-1]
+	dataAvailabilityMessage, err := s.da.PostSequence(ctx, batchesData)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error posting batches %d to %d to the data availability committee: %w", firstSequence.BatchNumber, lastSequence.BatchNumber, err)
+	}
+	log.Infof("batches %d to %d posted to the data availability committee", firstSequence.BatchNumber, lastSequence.BatchNumber)
+
+	return s.etherman.BuildSequenceBatchesValidiumTxData(sequences, uint64(lastSequence.LastL2BLockTimestamp), firstSequence.BatchNumber-1, s.cfg.L2Coinbase, dataAvailabilityMessage)
+}
@@ -0,0 +1,61 @@
+package sequencesender
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-node/dataavailability"
+	"github.com/0xPolygonHermez/zkevm-node/etherman/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSequenceBatchesValidiumTxData(t *testing.T) {
+	ctx := context.Background()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	committee, err := dataavailability.NewLocalCommittee([]*ecdsa.PrivateKey{key}, 1)
+	require.NoError(t, err)
+	da := dataavailability.New(committee, new(StateMock), nil)
+
+	cfg := Config{SequenceMode: SequenceModeValidium, L2Coinbase: common.HexToAddress("0x1")}
+	_, err = New(cfg, new(StateMock), new(EthermanMock), new(EthTxManagerMock), nil, nil)
+	require.Error(t, err)
+
+	ethermanMock := new(EthermanMock)
+	ssender, err := New(cfg, new(StateMock), ethermanMock, new(EthTxManagerMock), nil, da)
+	require.NoError(t, err)
+
+	sequences := []types.Sequence{
+		{BatchNumber: 11, BatchL2Data: []byte{0x01}, LastL2BLockTimestamp: 1700000000},
+		{BatchNumber: 12, BatchL2Data: []byte{0x03}, LastL2BLockTimestamp: 1700000005, ForcedBatchTimestamp: 1700000001},
+		{BatchNumber: 13, BatchL2Data: []byte{0x02}, LastL2BLockTimestamp: 1700000010},
+	}
+	to := common.HexToAddress("0x2")
+	isCommitteeMessage := func(message []byte) bool {
+		if len(message) != crypto.SignatureLength+common.AddressLength {
+			return false
+		}
+		// the forced batches aren't signed by the committee
+		signer, err := dataavailability.RecoverSigner(dataavailability.SequenceHash([][]byte{{0x01}, {0x02}}), message[:crypto.SignatureLength])
+		return err == nil && signer == crypto.PubkeyToAddress(key.PublicKey)
+	}
+	ethermanMock.On("BuildSequenceBatchesValidiumTxData", sequences, uint64(1700000010), uint64(10), cfg.L2Coinbase, mock.MatchedBy(isCommitteeMessage)).Return(&to, []byte{0xaa}, nil).Once()
+
+	resTo, data, err := ssender.buildSequenceBatchesValidiumTxData(ctx, sequences)
+	require.NoError(t, err)
+	assert.Equal(t, &to, resTo)
+	assert.Equal(t, []byte{0xaa}, data)
+	ethermanMock.AssertExpectations(t)
+
+	// the posted batches are available in the committee
+	batchData, err := committee.GetOffChainData(ctx, crypto.Keccak256Hash([]byte{0x02}))
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x02}, batchData)
+	_, err = committee.GetOffChainData(ctx, crypto.Keccak256Hash([]byte{0x03}))
+	assert.ErrorIs(t, err, dataavailability.ErrOffChainDataNotFound)
+}
@@ -83,6 +83,8 @@ const (
 	MaxDeltaTimestampClosingReason ClosingReason = "Max delta timestamp"
 	// NoTxFitsClosingReason is the closing reason used when any of the txs in the pool (worker) fits in the remaining resources of the batch
 	NoTxFitsClosingReason ClosingReason = "No transaction fits"
+	// AdminClosingReason is the closing reason used when a batch is closed by an operator through the sequencer admin API
+	AdminClosingReason ClosingReason = "Admin request"
 
 	// Reason due Synchronizer
 	// ------------------------------------------------------------------------------------------
@@ -135,6 +137,9 @@ type VirtualBatch struct {
 	// TimestampBatchEtrog etrog: Batch timestamp comes from L1 block timestamp
 	//  for previous batches is NULL because the batch timestamp is in batch table
 	TimestampBatchEtrog *time.Time
+	// BlobInnerNum feijoa: blob inner that contains the batch
+	//  for previous forks is NULL because the batches are not sequenced in blobs
+	BlobInnerNum *uint64
 }
 
 // Sequence represents the sequence interval
@@ -0,0 +1,38 @@
+package state
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BlobType is the type of a blob inner
+type BlobType uint8
+
+const (
+	// BlobTypeCallData the blob data is sent as calldata
+	BlobTypeCallData BlobType = 0
+	// BlobTypeBlobTransaction the blob data is sent as an EIP-4844 blob
+	BlobTypeBlobTransaction BlobType = 1
+	// BlobTypeForced the blob is a forced blob
+	BlobTypeForced BlobType = 2
+)
+
+// BlobInner represents a blob inner stored in the state
+type BlobInner struct {
+	BlobInnerNum         uint64
+	BlobSequenceIndex    uint64
+	Type                 BlobType
+	MaxSequenceTimestamp time.Time
+	ZkGasLimit           uint64
+	L1InfoLeafIndex      uint32
+	// Coinbase is the L2 coinbase of the blob sequence that includes the blob
+	Coinbase common.Address
+	PointZ   []byte
+	PointY   []byte
+	Data     []byte
+	// BlobStateRoot and BlobAccInputHash are the values after processing the blob
+	BlobStateRoot    common.Hash
+	BlobAccInputHash common.Hash
+	BlockNumber      uint64 // L1 block number where the blob was sequenced
+}
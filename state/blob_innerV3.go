@@ -0,0 +1,135 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/hex"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/state/metrics"
+	"github.com/0xPolygonHermez/zkevm-node/state/runtime/executor"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+)
+
+// ProcessBlobInnerRequest represents the request to process a blob inner
+type ProcessBlobInnerRequest struct {
+	OldBlobStateRoot    common.Hash
+	OldBlobAccInputHash common.Hash
+	OldNumBlob          uint64
+	OldStateRoot        common.Hash
+	ForkID              uint64
+	LastL1InfoTreeIndex uint32
+	LastL1InfoTreeRoot  common.Hash
+	TimestampLimit      uint64
+	Coinbase            common.Address
+	ZkGasLimit          uint64
+	BlobType            BlobType
+	PointZ              []byte
+	PointY              []byte
+	BlobData            []byte
+	ForcedHashData      common.Hash
+	Caller              metrics.CallerLabel
+}
+
+// ProcessBlobInnerResponse represents the response of a blob inner process
+type ProcessBlobInnerResponse struct {
+	NewBlobStateRoot      common.Hash
+	NewBlobAccInputHash   common.Hash
+	NewNumBlob            uint64
+	FinalAccBatchHashData common.Hash
+	LocalExitRootFromBlob common.Hash
+	// IsInvalid is true when the blob can't be decoded, in that case BatchData is empty
+	IsInvalid bool
+	// BatchData contains the BatchL2Data of each batch included in the blob
+	BatchData    [][]byte
+	RomBlobError error
+}
+
+// ProcessBlobInner processes a blob inner for forkID >= FEIJOA and splits it into its batches
+func (s *State) ProcessBlobInner(ctx context.Context, request ProcessBlobInnerRequest) (*ProcessBlobInnerResponse, error) {
+	var processBlobInnerRequest = &executor.ProcessBlobInnerRequestV3{
+		OldBlobStateRoot:    request.OldBlobStateRoot.Bytes(),
+		OldBlobAccInputHash: request.OldBlobAccInputHash.Bytes(),
+		OldNumBlob:          request.OldNumBlob,
+		OldStateRoot:        request.OldStateRoot.Bytes(),
+		ForkId:              request.ForkID,
+		LastL1InfoTreeIndex: request.LastL1InfoTreeIndex,
+		LastL1InfoTreeRoot:  request.LastL1InfoTreeRoot.Bytes(),
+		TimestampLimit:      request.TimestampLimit,
+		Coinbase:            request.Coinbase.String(),
+		ZkGasLimit:          request.ZkGasLimit,
+		BlobType:            uint32(request.BlobType),
+		PointZ:              request.PointZ,
+		PointY:              request.PointY,
+		BlobData:            request.BlobData,
+		ForcedHashData:      request.ForcedHashData.Bytes(),
+		ContextId:           uuid.NewString(),
+	}
+
+	res, err := s.sendBlobInnerRequestToExecutorV3(ctx, processBlobInnerRequest, request.Caller)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProcessBlobInnerResponse{
+		NewBlobStateRoot:      common.BytesToHash(res.NewBlobStateRoot),
+		NewBlobAccInputHash:   common.BytesToHash(res.NewBlobAccInputHash),
+		NewNumBlob:            res.NewNumBlob,
+		FinalAccBatchHashData: common.BytesToHash(res.FinalAccBatchHashData),
+		LocalExitRootFromBlob: common.BytesToHash(res.LocalExitRootFromBlob),
+		IsInvalid:             res.IsInvalid == cTrue,
+		BatchData:             res.BatchData,
+		RomBlobError:          executor.RomBlobErr(res.ErrorRomBlob),
+	}, nil
+}
+
+func (s *State) sendBlobInnerRequestToExecutorV3(ctx context.Context, blobRequest *executor.ProcessBlobInnerRequestV3, caller metrics.CallerLabel) (*executor.ProcessBlobInnerResponseV3, error) {
+	if s.executorClient == nil {
+		return nil, ErrExecutorNil
+	}
+
+	// Log the blob inner request
+	blobRequestLog := "OldBlobStateRoot: %v, OldBlobAccInputHash: %v, OldNumBlob: %v, OldStateRoot: %v, ForkId: %v, LastL1InfoTreeIndex: %v, LastL1InfoTreeRoot: %v, TimestampLimit: %v, Coinbase: %v, ZkGasLimit: %v, BlobType: %v, PointZ: %v, PointY: %v, BlobData: %v, ContextId: %v"
+	blobRequestLog = fmt.Sprintf(blobRequestLog, hex.EncodeToHex(blobRequest.OldBlobStateRoot), hex.EncodeToHex(blobRequest.OldBlobAccInputHash), blobRequest.OldNumBlob, hex.EncodeToHex(blobRequest.OldStateRoot), blobRequest.ForkId,
+		blobRequest.LastL1InfoTreeIndex, hex.EncodeToHex(blobRequest.LastL1InfoTreeRoot), blobRequest.TimestampLimit, blobRequest.Coinbase, blobRequest.ZkGasLimit, blobRequest.BlobType,
+		hex.EncodeToHex(blobRequest.PointZ), hex.EncodeToHex(blobRequest.PointY), len(blobRequest.BlobData), blobRequest.ContextId)
+
+	log.Debugf("executor blob inner request, %s", blobRequestLog)
+
+	now := time.Now()
+	blobResponse, err := s.executorClient.ProcessBlobInnerV3(ctx, blobRequest)
+	elapsed := time.Since(now)
+
+	if caller != metrics.DiscardCallerLabel {
+		metrics.ExecutorProcessingTime(string(caller), elapsed)
+	}
+
+	if err != nil {
+		log.Errorf("error executor ProcessBlobInnerV3: %v", err)
+		log.Errorf("error executor ProcessBlobInnerV3 response: %v", blobResponse)
+	} else {
+		blobResponseToString := processBlobInnerResponseV3ToString(blobResponse, elapsed)
+		if blobResponse.Error != executor.ExecutorError_EXECUTOR_ERROR_NO_ERROR {
+			err = executor.ExecutorErr(blobResponse.Error)
+			log.Warnf("executor blob inner response, executor error: %v", err)
+			log.Warn(blobResponseToString)
+			s.eventLog.LogExecutorError(ctx, blobResponse.Error, blobRequest)
+		} else if blobResponse.ErrorRomBlob != executor.RomBlobError_ROM_BLOB_ERROR_NO_ERROR {
+			// A ROM blob error means that the blob is invalid, it's not an error processing it
+			log.Warnf("executor blob inner response, ROM blob error: %v", executor.RomBlobErr(blobResponse.ErrorRomBlob))
+			log.Warn(blobResponseToString)
+		} else {
+			log.Debug(blobResponseToString)
+		}
+	}
+
+	return blobResponse, err
+}
+
+func processBlobInnerResponseV3ToString(blobResponse *executor.ProcessBlobInnerResponseV3, executionTime time.Duration) string {
+	blobResponseLog := "executor blob inner response, Time: %v, NewBlobStateRoot: %v, NewBlobAccInputHash: %v, NewNumBlob: %v, FinalAccBatchHashData: %v, LocalExitRootFromBlob: %v, IsInvalid: %v, Batches: %v, Error: %v, ErrorRomBlob: %v\n"
+	return fmt.Sprintf(blobResponseLog, executionTime, hex.EncodeToHex(blobResponse.NewBlobStateRoot), hex.EncodeToHex(blobResponse.NewBlobAccInputHash), blobResponse.NewNumBlob,
+		hex.EncodeToHex(blobResponse.FinalAccBatchHashData), hex.EncodeToHex(blobResponse.LocalExitRootFromBlob), blobResponse.IsInvalid, len(blobResponse.BatchData), blobResponse.Error, blobResponse.ErrorRomBlob)
+}
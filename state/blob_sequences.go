@@ -19,12 +19,13 @@ var (
 
 // BlobSequence represents a blob sequence.
 type BlobSequence struct {
-	Index             uint64
-	L2Coinbase        common.Address
-	FinalAccInputHash common.Hash
-	LastBlobSequenced uint64    // That comes from the event
-	CreateAt          time.Time // time of the L1block
-	BlockNumber       uint64    // L1BlockNumber where appears this event
+	Index              uint64
+	L2Coinbase         common.Address
+	FinalAccInputHash  common.Hash
+	FirstBlobSequenced uint64    // First blob inner number of the sequence
+	LastBlobSequenced  uint64    // That comes from the event
+	CreateAt           time.Time // time of the L1block
+	BlockNumber        uint64    // L1BlockNumber where appears this event
 }
 
 // AddBlobSequence adds a new blob sequence to the state.
@@ -42,6 +43,10 @@ func (s *State) sanityCheckAddBlobSequence(ctx context.Context, blobSequence *Bl
 	if err != nil {
 		return err
 	}
+	if previousBlobSequence == nil {
+		// First blob sequence, nothing to compare with
+		return nil
+	}
 	// The index must be the previous index + 1
 	if previousBlobSequence.Index+1 != blobSequence.Index {
 		return fmt.Errorf("last_index_on_db:%d try_to_insert:%d. Err: %w",
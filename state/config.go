@@ -83,3 +83,29 @@ func (c BatchConstraintsCfg) IsWithinConstraints(counters ZKCounters) bool {
 		counters.Steps <= c.MaxSteps &&
 		counters.Sha256Hashes_V2 <= c.MaxSHA256Hashes
 }
+
+// BatchShare returns the highest share of its batch constraint used by the ZK counters or the
+// bytes, 1 means a full batch. The resources without constraint are ignored
+func (c BatchConstraintsCfg) BatchShare(counters ZKCounters, bytes uint64) float64 {
+	share := 0.0
+	for _, r := range [][2]uint64{
+		{bytes, c.MaxBatchBytesSize},
+		{counters.GasUsed, c.MaxCumulativeGasUsed},
+		{uint64(counters.KeccakHashes), uint64(c.MaxKeccakHashes)},
+		{uint64(counters.PoseidonHashes), uint64(c.MaxPoseidonHashes)},
+		{uint64(counters.PoseidonPaddings), uint64(c.MaxPoseidonPaddings)},
+		{uint64(counters.MemAligns), uint64(c.MaxMemAligns)},
+		{uint64(counters.Arithmetics), uint64(c.MaxArithmetics)},
+		{uint64(counters.Binaries), uint64(c.MaxBinaries)},
+		{uint64(counters.Steps), uint64(c.MaxSteps)},
+		{uint64(counters.Sha256Hashes_V2), uint64(c.MaxSHA256Hashes)},
+	} {
+		if r[1] == 0 {
+			continue
+		}
+		if s := float64(r[0]) / float64(r[1]); s > share {
+			share = s
+		}
+	}
+	return share
+}
@@ -0,0 +1,27 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchShare(t *testing.T) {
+	constraints := BatchConstraintsCfg{
+		MaxBatchBytesSize:    200,
+		MaxCumulativeGasUsed: 1000,
+		MaxKeccakHashes:      100,
+		MaxSteps:             4000,
+	}
+
+	counters := ZKCounters{GasUsed: 100, KeccakHashes: 50, Steps: 1000}
+	assert.Equal(t, 0.5, constraints.BatchShare(counters, 0))
+	assert.Equal(t, 0.75, constraints.BatchShare(counters, 150))
+
+	// the resources without constraint are ignored
+	counters.Binaries = 1000
+	assert.Equal(t, 0.5, constraints.BatchShare(counters, 0))
+
+	counters.Steps = 6000
+	assert.Equal(t, 1.5, constraints.BatchShare(counters, 0))
+}
@@ -1,10 +1,14 @@
 package state
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/0xPolygonHermez/zkevm-node/hex"
 	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -277,3 +281,35 @@ func TestEncodeBatchV2WithTxInBinary(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedBatchData, batchData)
 }
+
+func TestEncodeBatchV2TypedTxsNotSupported(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	chainID := big.NewInt(1001)
+	to := common.HexToAddress("0x4d5Cf5032B2a844602278b01199ED191A86c93ff")
+	dynamicFeeTx, err := types.SignNewTx(privateKey, types.NewLondonSigner(chainID), &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(100),
+		GasFeeCap: big.NewInt(2000000000),
+		Gas:       100000,
+		To:        &to,
+		Value:     big.NewInt(1000),
+	})
+	require.NoError(t, err)
+
+	// typed txs can't be included in the batches until the executor supports them
+	_, err = EncodeTransactions([]types.Transaction{*dynamicFeeTx}, []uint8{255}, FORKID_FEIJOA)
+	require.ErrorIs(t, err, types.ErrTxTypeNotSupported)
+
+	batch := &BatchRawV2{
+		Blocks: []L2BlockRaw{
+			{
+				ChangeL2BlockHeader: ChangeL2BlockHeader{DeltaTimestamp: 1, IndexL1InfoTree: 2},
+				Transactions:        []L2TxRaw{{Tx: *dynamicFeeTx, EfficiencyPercentage: 255}},
+			},
+		},
+	}
+	_, err = EncodeBatchV2(batch)
+	require.ErrorIs(t, err, types.ErrTxTypeNotSupported)
+}
@@ -50,6 +50,9 @@ var (
 	ErrExecutorNil = errors.New("the method requires an executor that is not nil")
 	// ErrStateTreeNil indicates that the method requires a state tree that is not nil
 	ErrStateTreeNil = errors.New("the method requires a state tree that is not nil")
+	// ErrTraceNotSupportedForForkID indicates that the trace of txs that are not
+	// stored in the state is not supported for forks previous to ETROG
+	ErrTraceNotSupportedForForkID = errors.New("trace not supported for the fork id")
 	// ErrUnsupportedDuration is returned if the provided unit for a time
 	// interval is not supported by our conversion mechanism.
 	ErrUnsupportedDuration = errors.New("unsupported time duration")
@@ -67,6 +70,17 @@ var (
 	// ErrMaxNativeBlockHashBlockRangeLimitExceeded returned when the range between block number range
 	// to filter native block hashes is bigger than the configured limit
 	ErrMaxNativeBlockHashBlockRangeLimitExceeded = errors.New("native block hashes are limited to a %v block range")
+	// ErrStateAndStateDiffOverride returned when the state and the state diff of
+	// the same account are overridden at the same time
+	ErrStateAndStateDiffOverride = errors.New("state and stateDiff can't be overridden at the same time")
+	// ErrInvalidTimestampOverride returned when the overridden timestamp is lower than
+	// the timestamp of the block the unsigned transaction is executed on top of
+	ErrInvalidTimestampOverride = errors.New("timestamp override must be greater or equal than the parent block timestamp")
+	// ErrInvalidBlockNumberOverride returned when the overridden block number is 0
+	ErrInvalidBlockNumberOverride = errors.New("block number override must be greater than 0")
+	// ErrBlockNumberOverrideNotSupported indicates that the block number override
+	// is not supported for forks previous to ETROG
+	ErrBlockNumberOverrideNotSupported = errors.New("block number override not supported for the fork id")
 )
 
 // ConstructErrorFromRevert extracts the reverted reason from the provided returnValue
@@ -58,6 +58,12 @@ func EncodeTransactions(txs []types.Transaction, effectivePercentages []uint8, f
 }
 
 func prepareRLPTxData(tx types.Transaction) ([]byte, error) {
+	// typed txs (EIP-2718) can't be encoded in the batch L2 data until the
+	// executor supports them
+	if tx.Type() != types.LegacyTxType {
+		return nil, types.ErrTxTypeNotSupported
+	}
+
 	v, r, s := tx.RawSignatureValues()
 	sign := 1 - (v.Uint64() & 1)
 
@@ -2,6 +2,7 @@ package state
 
 import (
 	"context"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -67,11 +68,15 @@ type storage interface {
 	GetTransactionByHash(ctx context.Context, transactionHash common.Hash, dbTx pgx.Tx) (*types.Transaction, error)
 	GetTransactionByL2Hash(ctx context.Context, transactionHash common.Hash, dbTx pgx.Tx) (*types.Transaction, error)
 	GetTransactionReceipt(ctx context.Context, transactionHash common.Hash, dbTx pgx.Tx) (*types.Receipt, error)
+	GetReceiptsByL2BlockNumber(ctx context.Context, blockNumber uint64, dbTx pgx.Tx) ([]*types.Receipt, []types.Transaction, error)
+	GetReceiptsByBatchNumber(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) ([]*types.Receipt, []types.Transaction, error)
+	GetBatchesFees(ctx context.Context, fromBatchNumber, toBatchNumber uint64, dbTx pgx.Tx) (*big.Int, error)
 	GetTransactionByL2BlockHashAndIndex(ctx context.Context, blockHash common.Hash, index uint64, dbTx pgx.Tx) (*types.Transaction, error)
 	GetTransactionByL2BlockNumberAndIndex(ctx context.Context, blockNumber uint64, index uint64, dbTx pgx.Tx) (*types.Transaction, error)
 	GetL2BlockTransactionCountByHash(ctx context.Context, blockHash common.Hash, dbTx pgx.Tx) (uint64, error)
 	GetL2BlockTransactionCountByNumber(ctx context.Context, blockNumber uint64, dbTx pgx.Tx) (uint64, error)
 	GetTransactionEGPLogByHash(ctx context.Context, transactionHash common.Hash, dbTx pgx.Tx) (*EffectiveGasPriceLog, error)
+	GetL2BlocksFeeHistory(ctx context.Context, fromBlockNumber, toBlockNumber uint64, dbTx pgx.Tx) ([]L2BlockFeeHistory, error)
 	AddL2Block(ctx context.Context, batchNumber uint64, l2Block *L2Block, receipts []*types.Receipt, txsL2Hash []common.Hash, txsEGPData []StoreTxEGPData, imStateRoots []common.Hash, dbTx pgx.Tx) error
 	GetLastVirtualizedL2BlockNumber(ctx context.Context, dbTx pgx.Tx) (uint64, error)
 	GetLastConsolidatedL2BlockNumber(ctx context.Context, dbTx pgx.Tx) (uint64, error)
@@ -108,6 +113,8 @@ type storage interface {
 	CleanupBatchProofs(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) error
 	CleanupLockedBatchProofs(ctx context.Context, duration string, dbTx pgx.Tx) (int64, error)
 	DeleteUngeneratedBatchProofs(ctx context.Context, dbTx pgx.Tx) error
+	GetBatchProofs(ctx context.Context, dbTx pgx.Tx) ([]*Proof, error)
+	UnlockBatchProofs(ctx context.Context, batchNumber uint64, batchNumberFinal uint64, dbTx pgx.Tx) (int64, error)
 	GetLastClosedBatch(ctx context.Context, dbTx pgx.Tx) (*Batch, error)
 	GetLastClosedBatchNumber(ctx context.Context, dbTx pgx.Tx) (uint64, error)
 	UpdateBatchL2Data(ctx context.Context, batchNumber uint64, batchL2Data []byte, dbTx pgx.Tx) error
@@ -162,9 +169,30 @@ type storage interface {
 	GetAllL1InfoTreeRecursiveRootEntries(ctx context.Context, dbTx pgx.Tx) ([]L1InfoTreeRecursiveExitRootStorageEntry, error)
 	GetLatestL1InfoTreeRecursiveRoot(ctx context.Context, maxBlockNumber uint64, dbTx pgx.Tx) (L1InfoTreeRecursiveExitRootStorageEntry, error)
 	storeblobsequences
+	storeblobinners
 }
 
 type storeblobsequences interface {
 	AddBlobSequence(ctx context.Context, blobSequence *BlobSequence, dbTx pgx.Tx) error
 	GetLastBlobSequence(ctx context.Context, dbTx pgx.Tx) (*BlobSequence, error)
 }
+
+type storeblobinners interface {
+	AddBlobInner(ctx context.Context, blobInner *BlobInner, dbTx pgx.Tx) error
+	GetBlobInner(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx) (*BlobInner, error)
+	GetBlobInnerToProve(ctx context.Context, lastVerfiedBatchNumber uint64, maxL1Block uint64, dbTx pgx.Tx) (*BlobInner, error)
+	GetBlobInnerBatchRange(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx) (uint64, uint64, error)
+	AddBlobInnerProof(ctx context.Context, proof *BlobInnerProof, dbTx pgx.Tx) error
+	UpdateBlobInnerProof(ctx context.Context, proof *BlobInnerProof, dbTx pgx.Tx) error
+	DeleteBlobInnerProof(ctx context.Context, blobInnerNumber uint64, dbTx pgx.Tx) error
+	GetProofsToBlobOuter(ctx context.Context, dbTx pgx.Tx) (*Proof, *BlobInnerProof, error)
+	AddBlobOuterProof(ctx context.Context, proof *BlobOuterProof, dbTx pgx.Tx) error
+	UpdateBlobOuterProof(ctx context.Context, proof *BlobOuterProof, dbTx pgx.Tx) error
+	DeleteBlobOuterProofs(ctx context.Context, blobOuterNumber uint64, blobOuterNumberFinal uint64, dbTx pgx.Tx) error
+	GetBlobOuterProofsToAggregate(ctx context.Context, dbTx pgx.Tx) (*BlobOuterProof, *BlobOuterProof, error)
+	GetBlobOuterProofReadyForFinal(ctx context.Context, lastVerfiedBatchNumber uint64, dbTx pgx.Tx) (*BlobOuterProof, error)
+	CheckBlobOuterProofContainsCompleteSequences(ctx context.Context, proof *BlobOuterProof, dbTx pgx.Tx) (bool, error)
+	CleanupBlobProofs(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) error
+	CleanupLockedBlobProofs(ctx context.Context, duration string, dbTx pgx.Tx) (int64, error)
+	DeleteUngeneratedBlobProofs(ctx context.Context, dbTx pgx.Tx) error
+}
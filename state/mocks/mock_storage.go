@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	big "math/big"
+
 	common "github.com/ethereum/go-ethereum/common"
 
 	mock "github.com/stretchr/testify/mock"
@@ -130,6 +132,150 @@ func (_c *StorageMock_AddBatchProof_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// AddBlobInner provides a mock function with given fields: ctx, blobInner, dbTx
+func (_m *StorageMock) AddBlobInner(ctx context.Context, blobInner *state.BlobInner, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, blobInner, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBlobInner")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *state.BlobInner, pgx.Tx) error); ok {
+		r0 = rf(ctx, blobInner, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageMock_AddBlobInner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBlobInner'
+type StorageMock_AddBlobInner_Call struct {
+	*mock.Call
+}
+
+// AddBlobInner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blobInner *state.BlobInner
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) AddBlobInner(ctx interface{}, blobInner interface{}, dbTx interface{}) *StorageMock_AddBlobInner_Call {
+	return &StorageMock_AddBlobInner_Call{Call: _e.mock.On("AddBlobInner", ctx, blobInner, dbTx)}
+}
+
+func (_c *StorageMock_AddBlobInner_Call) Run(run func(ctx context.Context, blobInner *state.BlobInner, dbTx pgx.Tx)) *StorageMock_AddBlobInner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*state.BlobInner), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_AddBlobInner_Call) Return(_a0 error) *StorageMock_AddBlobInner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageMock_AddBlobInner_Call) RunAndReturn(run func(context.Context, *state.BlobInner, pgx.Tx) error) *StorageMock_AddBlobInner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddBlobInnerProof provides a mock function with given fields: ctx, proof, dbTx
+func (_m *StorageMock) AddBlobInnerProof(ctx context.Context, proof *state.BlobInnerProof, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, proof, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBlobInnerProof")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *state.BlobInnerProof, pgx.Tx) error); ok {
+		r0 = rf(ctx, proof, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageMock_AddBlobInnerProof_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBlobInnerProof'
+type StorageMock_AddBlobInnerProof_Call struct {
+	*mock.Call
+}
+
+// AddBlobInnerProof is a helper method to define mock.On call
+//   - ctx context.Context
+//   - proof *state.BlobInnerProof
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) AddBlobInnerProof(ctx interface{}, proof interface{}, dbTx interface{}) *StorageMock_AddBlobInnerProof_Call {
+	return &StorageMock_AddBlobInnerProof_Call{Call: _e.mock.On("AddBlobInnerProof", ctx, proof, dbTx)}
+}
+
+func (_c *StorageMock_AddBlobInnerProof_Call) Run(run func(ctx context.Context, proof *state.BlobInnerProof, dbTx pgx.Tx)) *StorageMock_AddBlobInnerProof_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*state.BlobInnerProof), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_AddBlobInnerProof_Call) Return(_a0 error) *StorageMock_AddBlobInnerProof_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageMock_AddBlobInnerProof_Call) RunAndReturn(run func(context.Context, *state.BlobInnerProof, pgx.Tx) error) *StorageMock_AddBlobInnerProof_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddBlobOuterProof provides a mock function with given fields: ctx, proof, dbTx
+func (_m *StorageMock) AddBlobOuterProof(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, proof, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBlobOuterProof")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *state.BlobOuterProof, pgx.Tx) error); ok {
+		r0 = rf(ctx, proof, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageMock_AddBlobOuterProof_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBlobOuterProof'
+type StorageMock_AddBlobOuterProof_Call struct {
+	*mock.Call
+}
+
+// AddBlobOuterProof is a helper method to define mock.On call
+//   - ctx context.Context
+//   - proof *state.BlobOuterProof
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) AddBlobOuterProof(ctx interface{}, proof interface{}, dbTx interface{}) *StorageMock_AddBlobOuterProof_Call {
+	return &StorageMock_AddBlobOuterProof_Call{Call: _e.mock.On("AddBlobOuterProof", ctx, proof, dbTx)}
+}
+
+func (_c *StorageMock_AddBlobOuterProof_Call) Run(run func(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx)) *StorageMock_AddBlobOuterProof_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*state.BlobOuterProof), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_AddBlobOuterProof_Call) Return(_a0 error) *StorageMock_AddBlobOuterProof_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageMock_AddBlobOuterProof_Call) RunAndReturn(run func(context.Context, *state.BlobOuterProof, pgx.Tx) error) *StorageMock_AddBlobOuterProof_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddBlobSequence provides a mock function with given fields: ctx, blobSequence, dbTx
 func (_m *StorageMock) AddBlobSequence(ctx context.Context, blobSequence *state.BlobSequence, dbTx pgx.Tx) error {
 	ret := _m.Called(ctx, blobSequence, dbTx)
@@ -972,6 +1118,64 @@ func (_c *StorageMock_Begin_Call) RunAndReturn(run func(context.Context) (pgx.Tx
 	return _c
 }
 
+// CheckBlobOuterProofContainsCompleteSequences provides a mock function with given fields: ctx, proof, dbTx
+func (_m *StorageMock) CheckBlobOuterProofContainsCompleteSequences(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx) (bool, error) {
+	ret := _m.Called(ctx, proof, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBlobOuterProofContainsCompleteSequences")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *state.BlobOuterProof, pgx.Tx) (bool, error)); ok {
+		return rf(ctx, proof, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *state.BlobOuterProof, pgx.Tx) bool); ok {
+		r0 = rf(ctx, proof, dbTx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *state.BlobOuterProof, pgx.Tx) error); ok {
+		r1 = rf(ctx, proof, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageMock_CheckBlobOuterProofContainsCompleteSequences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckBlobOuterProofContainsCompleteSequences'
+type StorageMock_CheckBlobOuterProofContainsCompleteSequences_Call struct {
+	*mock.Call
+}
+
+// CheckBlobOuterProofContainsCompleteSequences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - proof *state.BlobOuterProof
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) CheckBlobOuterProofContainsCompleteSequences(ctx interface{}, proof interface{}, dbTx interface{}) *StorageMock_CheckBlobOuterProofContainsCompleteSequences_Call {
+	return &StorageMock_CheckBlobOuterProofContainsCompleteSequences_Call{Call: _e.mock.On("CheckBlobOuterProofContainsCompleteSequences", ctx, proof, dbTx)}
+}
+
+func (_c *StorageMock_CheckBlobOuterProofContainsCompleteSequences_Call) Run(run func(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx)) *StorageMock_CheckBlobOuterProofContainsCompleteSequences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*state.BlobOuterProof), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_CheckBlobOuterProofContainsCompleteSequences_Call) Return(_a0 bool, _a1 error) *StorageMock_CheckBlobOuterProofContainsCompleteSequences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageMock_CheckBlobOuterProofContainsCompleteSequences_Call) RunAndReturn(run func(context.Context, *state.BlobOuterProof, pgx.Tx) (bool, error)) *StorageMock_CheckBlobOuterProofContainsCompleteSequences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckProofContainsCompleteSequences provides a mock function with given fields: ctx, proof, dbTx
 func (_m *StorageMock) CheckProofContainsCompleteSequences(ctx context.Context, proof *state.Proof, dbTx pgx.Tx) (bool, error) {
 	ret := _m.Called(ctx, proof, dbTx)
@@ -1078,6 +1282,54 @@ func (_c *StorageMock_CleanupBatchProofs_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// CleanupBlobProofs provides a mock function with given fields: ctx, batchNumber, dbTx
+func (_m *StorageMock) CleanupBlobProofs(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, batchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupBlobProofs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r0 = rf(ctx, batchNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageMock_CleanupBlobProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CleanupBlobProofs'
+type StorageMock_CleanupBlobProofs_Call struct {
+	*mock.Call
+}
+
+// CleanupBlobProofs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batchNumber uint64
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) CleanupBlobProofs(ctx interface{}, batchNumber interface{}, dbTx interface{}) *StorageMock_CleanupBlobProofs_Call {
+	return &StorageMock_CleanupBlobProofs_Call{Call: _e.mock.On("CleanupBlobProofs", ctx, batchNumber, dbTx)}
+}
+
+func (_c *StorageMock_CleanupBlobProofs_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx pgx.Tx)) *StorageMock_CleanupBlobProofs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_CleanupBlobProofs_Call) Return(_a0 error) *StorageMock_CleanupBlobProofs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageMock_CleanupBlobProofs_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) error) *StorageMock_CleanupBlobProofs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CleanupLockedBatchProofs provides a mock function with given fields: ctx, duration, dbTx
 func (_m *StorageMock) CleanupLockedBatchProofs(ctx context.Context, duration string, dbTx pgx.Tx) (int64, error) {
 	ret := _m.Called(ctx, duration, dbTx)
@@ -1136,6 +1388,64 @@ func (_c *StorageMock_CleanupLockedBatchProofs_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// CleanupLockedBlobProofs provides a mock function with given fields: ctx, duration, dbTx
+func (_m *StorageMock) CleanupLockedBlobProofs(ctx context.Context, duration string, dbTx pgx.Tx) (int64, error) {
+	ret := _m.Called(ctx, duration, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupLockedBlobProofs")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, pgx.Tx) (int64, error)); ok {
+		return rf(ctx, duration, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, pgx.Tx) int64); ok {
+		r0 = rf(ctx, duration, dbTx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, pgx.Tx) error); ok {
+		r1 = rf(ctx, duration, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageMock_CleanupLockedBlobProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CleanupLockedBlobProofs'
+type StorageMock_CleanupLockedBlobProofs_Call struct {
+	*mock.Call
+}
+
+// CleanupLockedBlobProofs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - duration string
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) CleanupLockedBlobProofs(ctx interface{}, duration interface{}, dbTx interface{}) *StorageMock_CleanupLockedBlobProofs_Call {
+	return &StorageMock_CleanupLockedBlobProofs_Call{Call: _e.mock.On("CleanupLockedBlobProofs", ctx, duration, dbTx)}
+}
+
+func (_c *StorageMock_CleanupLockedBlobProofs_Call) Run(run func(ctx context.Context, duration string, dbTx pgx.Tx)) *StorageMock_CleanupLockedBlobProofs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_CleanupLockedBlobProofs_Call) Return(_a0 int64, _a1 error) *StorageMock_CleanupLockedBlobProofs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageMock_CleanupLockedBlobProofs_Call) RunAndReturn(run func(context.Context, string, pgx.Tx) (int64, error)) *StorageMock_CleanupLockedBlobProofs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CloseBatchInStorage provides a mock function with given fields: ctx, receipt, dbTx
 func (_m *StorageMock) CloseBatchInStorage(ctx context.Context, receipt state.ProcessingReceipt, dbTx pgx.Tx) error {
 	ret := _m.Called(ctx, receipt, dbTx)
@@ -1338,17 +1648,17 @@ func (_c *StorageMock_DeleteBatchProofs_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// DeleteUngeneratedBatchProofs provides a mock function with given fields: ctx, dbTx
-func (_m *StorageMock) DeleteUngeneratedBatchProofs(ctx context.Context, dbTx pgx.Tx) error {
-	ret := _m.Called(ctx, dbTx)
+// DeleteBlobInnerProof provides a mock function with given fields: ctx, blobInnerNumber, dbTx
+func (_m *StorageMock) DeleteBlobInnerProof(ctx context.Context, blobInnerNumber uint64, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, blobInnerNumber, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteUngeneratedBatchProofs")
+		panic("no return value specified for DeleteBlobInnerProof")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) error); ok {
-		r0 = rf(ctx, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r0 = rf(ctx, blobInnerNumber, dbTx)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1356,51 +1666,195 @@ func (_m *StorageMock) DeleteUngeneratedBatchProofs(ctx context.Context, dbTx pg
 	return r0
 }
 
-// StorageMock_DeleteUngeneratedBatchProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUngeneratedBatchProofs'
-type StorageMock_DeleteUngeneratedBatchProofs_Call struct {
+// StorageMock_DeleteBlobInnerProof_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBlobInnerProof'
+type StorageMock_DeleteBlobInnerProof_Call struct {
 	*mock.Call
 }
 
-// DeleteUngeneratedBatchProofs is a helper method to define mock.On call
+// DeleteBlobInnerProof is a helper method to define mock.On call
 //   - ctx context.Context
+//   - blobInnerNumber uint64
 //   - dbTx pgx.Tx
-func (_e *StorageMock_Expecter) DeleteUngeneratedBatchProofs(ctx interface{}, dbTx interface{}) *StorageMock_DeleteUngeneratedBatchProofs_Call {
-	return &StorageMock_DeleteUngeneratedBatchProofs_Call{Call: _e.mock.On("DeleteUngeneratedBatchProofs", ctx, dbTx)}
+func (_e *StorageMock_Expecter) DeleteBlobInnerProof(ctx interface{}, blobInnerNumber interface{}, dbTx interface{}) *StorageMock_DeleteBlobInnerProof_Call {
+	return &StorageMock_DeleteBlobInnerProof_Call{Call: _e.mock.On("DeleteBlobInnerProof", ctx, blobInnerNumber, dbTx)}
 }
 
-func (_c *StorageMock_DeleteUngeneratedBatchProofs_Call) Run(run func(ctx context.Context, dbTx pgx.Tx)) *StorageMock_DeleteUngeneratedBatchProofs_Call {
+func (_c *StorageMock_DeleteBlobInnerProof_Call) Run(run func(ctx context.Context, blobInnerNumber uint64, dbTx pgx.Tx)) *StorageMock_DeleteBlobInnerProof_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pgx.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(pgx.Tx))
 	})
 	return _c
 }
 
-func (_c *StorageMock_DeleteUngeneratedBatchProofs_Call) Return(_a0 error) *StorageMock_DeleteUngeneratedBatchProofs_Call {
+func (_c *StorageMock_DeleteBlobInnerProof_Call) Return(_a0 error) *StorageMock_DeleteBlobInnerProof_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *StorageMock_DeleteUngeneratedBatchProofs_Call) RunAndReturn(run func(context.Context, pgx.Tx) error) *StorageMock_DeleteUngeneratedBatchProofs_Call {
+func (_c *StorageMock_DeleteBlobInnerProof_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) error) *StorageMock_DeleteBlobInnerProof_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Exec provides a mock function with given fields: ctx, sql, arguments
-func (_m *StorageMock) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
-	var _ca []interface{}
-	_ca = append(_ca, ctx, sql)
-	_ca = append(_ca, arguments...)
-	ret := _m.Called(_ca...)
+// DeleteBlobOuterProofs provides a mock function with given fields: ctx, blobOuterNumber, blobOuterNumberFinal, dbTx
+func (_m *StorageMock) DeleteBlobOuterProofs(ctx context.Context, blobOuterNumber uint64, blobOuterNumberFinal uint64, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, blobOuterNumber, blobOuterNumberFinal, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Exec")
+		panic("no return value specified for DeleteBlobOuterProofs")
 	}
 
-	var r0 pgconn.CommandTag
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) (pgconn.CommandTag, error)); ok {
-		return rf(ctx, sql, arguments...)
-	}
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) error); ok {
+		r0 = rf(ctx, blobOuterNumber, blobOuterNumberFinal, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageMock_DeleteBlobOuterProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBlobOuterProofs'
+type StorageMock_DeleteBlobOuterProofs_Call struct {
+	*mock.Call
+}
+
+// DeleteBlobOuterProofs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blobOuterNumber uint64
+//   - blobOuterNumberFinal uint64
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) DeleteBlobOuterProofs(ctx interface{}, blobOuterNumber interface{}, blobOuterNumberFinal interface{}, dbTx interface{}) *StorageMock_DeleteBlobOuterProofs_Call {
+	return &StorageMock_DeleteBlobOuterProofs_Call{Call: _e.mock.On("DeleteBlobOuterProofs", ctx, blobOuterNumber, blobOuterNumberFinal, dbTx)}
+}
+
+func (_c *StorageMock_DeleteBlobOuterProofs_Call) Run(run func(ctx context.Context, blobOuterNumber uint64, blobOuterNumberFinal uint64, dbTx pgx.Tx)) *StorageMock_DeleteBlobOuterProofs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_DeleteBlobOuterProofs_Call) Return(_a0 error) *StorageMock_DeleteBlobOuterProofs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageMock_DeleteBlobOuterProofs_Call) RunAndReturn(run func(context.Context, uint64, uint64, pgx.Tx) error) *StorageMock_DeleteBlobOuterProofs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUngeneratedBatchProofs provides a mock function with given fields: ctx, dbTx
+func (_m *StorageMock) DeleteUngeneratedBatchProofs(ctx context.Context, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUngeneratedBatchProofs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) error); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageMock_DeleteUngeneratedBatchProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUngeneratedBatchProofs'
+type StorageMock_DeleteUngeneratedBatchProofs_Call struct {
+	*mock.Call
+}
+
+// DeleteUngeneratedBatchProofs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) DeleteUngeneratedBatchProofs(ctx interface{}, dbTx interface{}) *StorageMock_DeleteUngeneratedBatchProofs_Call {
+	return &StorageMock_DeleteUngeneratedBatchProofs_Call{Call: _e.mock.On("DeleteUngeneratedBatchProofs", ctx, dbTx)}
+}
+
+func (_c *StorageMock_DeleteUngeneratedBatchProofs_Call) Run(run func(ctx context.Context, dbTx pgx.Tx)) *StorageMock_DeleteUngeneratedBatchProofs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_DeleteUngeneratedBatchProofs_Call) Return(_a0 error) *StorageMock_DeleteUngeneratedBatchProofs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageMock_DeleteUngeneratedBatchProofs_Call) RunAndReturn(run func(context.Context, pgx.Tx) error) *StorageMock_DeleteUngeneratedBatchProofs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUngeneratedBlobProofs provides a mock function with given fields: ctx, dbTx
+func (_m *StorageMock) DeleteUngeneratedBlobProofs(ctx context.Context, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUngeneratedBlobProofs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) error); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageMock_DeleteUngeneratedBlobProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUngeneratedBlobProofs'
+type StorageMock_DeleteUngeneratedBlobProofs_Call struct {
+	*mock.Call
+}
+
+// DeleteUngeneratedBlobProofs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) DeleteUngeneratedBlobProofs(ctx interface{}, dbTx interface{}) *StorageMock_DeleteUngeneratedBlobProofs_Call {
+	return &StorageMock_DeleteUngeneratedBlobProofs_Call{Call: _e.mock.On("DeleteUngeneratedBlobProofs", ctx, dbTx)}
+}
+
+func (_c *StorageMock_DeleteUngeneratedBlobProofs_Call) Run(run func(ctx context.Context, dbTx pgx.Tx)) *StorageMock_DeleteUngeneratedBlobProofs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_DeleteUngeneratedBlobProofs_Call) Return(_a0 error) *StorageMock_DeleteUngeneratedBlobProofs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageMock_DeleteUngeneratedBlobProofs_Call) RunAndReturn(run func(context.Context, pgx.Tx) error) *StorageMock_DeleteUngeneratedBlobProofs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exec provides a mock function with given fields: ctx, sql, arguments
+func (_m *StorageMock) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, sql)
+	_ca = append(_ca, arguments...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 pgconn.CommandTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) (pgconn.CommandTag, error)); ok {
+		return rf(ctx, sql, arguments...)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) pgconn.CommandTag); ok {
 		r0 = rf(ctx, sql, arguments...)
 	} else {
@@ -1829,11 +2283,445 @@ func (_m *StorageMock) GetBatchNumberOfL2Block(ctx context.Context, blockNumber
 	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) uint64); ok {
 		r0 = rf(ctx, blockNumber, dbTx)
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, blockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageMock_GetBatchNumberOfL2Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBatchNumberOfL2Block'
+type StorageMock_GetBatchNumberOfL2Block_Call struct {
+	*mock.Call
+}
+
+// GetBatchNumberOfL2Block is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blockNumber uint64
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) GetBatchNumberOfL2Block(ctx interface{}, blockNumber interface{}, dbTx interface{}) *StorageMock_GetBatchNumberOfL2Block_Call {
+	return &StorageMock_GetBatchNumberOfL2Block_Call{Call: _e.mock.On("GetBatchNumberOfL2Block", ctx, blockNumber, dbTx)}
+}
+
+func (_c *StorageMock_GetBatchNumberOfL2Block_Call) Run(run func(ctx context.Context, blockNumber uint64, dbTx pgx.Tx)) *StorageMock_GetBatchNumberOfL2Block_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_GetBatchNumberOfL2Block_Call) Return(_a0 uint64, _a1 error) *StorageMock_GetBatchNumberOfL2Block_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageMock_GetBatchNumberOfL2Block_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) (uint64, error)) *StorageMock_GetBatchNumberOfL2Block_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBatchProofs provides a mock function with given fields: ctx, dbTx
+func (_m *StorageMock) GetBatchProofs(ctx context.Context, dbTx pgx.Tx) ([]*state.Proof, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBatchProofs")
+	}
+
+	var r0 []*state.Proof
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) ([]*state.Proof, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) []*state.Proof); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*state.Proof)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageMock_GetBatchProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBatchProofs'
+type StorageMock_GetBatchProofs_Call struct {
+	*mock.Call
+}
+
+// GetBatchProofs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) GetBatchProofs(ctx interface{}, dbTx interface{}) *StorageMock_GetBatchProofs_Call {
+	return &StorageMock_GetBatchProofs_Call{Call: _e.mock.On("GetBatchProofs", ctx, dbTx)}
+}
+
+func (_c *StorageMock_GetBatchProofs_Call) Run(run func(ctx context.Context, dbTx pgx.Tx)) *StorageMock_GetBatchProofs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_GetBatchProofs_Call) Return(_a0 []*state.Proof, _a1 error) *StorageMock_GetBatchProofs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageMock_GetBatchProofs_Call) RunAndReturn(run func(context.Context, pgx.Tx) ([]*state.Proof, error)) *StorageMock_GetBatchProofs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBatchProofsToAggregate provides a mock function with given fields: ctx, dbTx
+func (_m *StorageMock) GetBatchProofsToAggregate(ctx context.Context, dbTx pgx.Tx) (*state.Proof, *state.Proof, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBatchProofsToAggregate")
+	}
+
+	var r0 *state.Proof
+	var r1 *state.Proof
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) (*state.Proof, *state.Proof, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) *state.Proof); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.Proof)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) *state.Proof); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*state.Proof)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pgx.Tx) error); ok {
+		r2 = rf(ctx, dbTx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// StorageMock_GetBatchProofsToAggregate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBatchProofsToAggregate'
+type StorageMock_GetBatchProofsToAggregate_Call struct {
+	*mock.Call
+}
+
+// GetBatchProofsToAggregate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) GetBatchProofsToAggregate(ctx interface{}, dbTx interface{}) *StorageMock_GetBatchProofsToAggregate_Call {
+	return &StorageMock_GetBatchProofsToAggregate_Call{Call: _e.mock.On("GetBatchProofsToAggregate", ctx, dbTx)}
+}
+
+func (_c *StorageMock_GetBatchProofsToAggregate_Call) Run(run func(ctx context.Context, dbTx pgx.Tx)) *StorageMock_GetBatchProofsToAggregate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_GetBatchProofsToAggregate_Call) Return(_a0 *state.Proof, _a1 *state.Proof, _a2 error) *StorageMock_GetBatchProofsToAggregate_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *StorageMock_GetBatchProofsToAggregate_Call) RunAndReturn(run func(context.Context, pgx.Tx) (*state.Proof, *state.Proof, error)) *StorageMock_GetBatchProofsToAggregate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBatchesFees provides a mock function with given fields: ctx, fromBatchNumber, toBatchNumber, dbTx
+func (_m *StorageMock) GetBatchesFees(ctx context.Context, fromBatchNumber uint64, toBatchNumber uint64, dbTx pgx.Tx) (*big.Int, error) {
+	ret := _m.Called(ctx, fromBatchNumber, toBatchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBatchesFees")
+	}
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) (*big.Int, error)); ok {
+		return rf(ctx, fromBatchNumber, toBatchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) *big.Int); ok {
+		r0 = rf(ctx, fromBatchNumber, toBatchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, fromBatchNumber, toBatchNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageMock_GetBatchesFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBatchesFees'
+type StorageMock_GetBatchesFees_Call struct {
+	*mock.Call
+}
+
+// GetBatchesFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromBatchNumber uint64
+//   - toBatchNumber uint64
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) GetBatchesFees(ctx interface{}, fromBatchNumber interface{}, toBatchNumber interface{}, dbTx interface{}) *StorageMock_GetBatchesFees_Call {
+	return &StorageMock_GetBatchesFees_Call{Call: _e.mock.On("GetBatchesFees", ctx, fromBatchNumber, toBatchNumber, dbTx)}
+}
+
+func (_c *StorageMock_GetBatchesFees_Call) Run(run func(ctx context.Context, fromBatchNumber uint64, toBatchNumber uint64, dbTx pgx.Tx)) *StorageMock_GetBatchesFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_GetBatchesFees_Call) Return(_a0 *big.Int, _a1 error) *StorageMock_GetBatchesFees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageMock_GetBatchesFees_Call) RunAndReturn(run func(context.Context, uint64, uint64, pgx.Tx) (*big.Int, error)) *StorageMock_GetBatchesFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlobInner provides a mock function with given fields: ctx, blobInnerNum, dbTx
+func (_m *StorageMock) GetBlobInner(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx) (*state.BlobInner, error) {
+	ret := _m.Called(ctx, blobInnerNum, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobInner")
+	}
+
+	var r0 *state.BlobInner
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) (*state.BlobInner, error)); ok {
+		return rf(ctx, blobInnerNum, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) *state.BlobInner); ok {
+		r0 = rf(ctx, blobInnerNum, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.BlobInner)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, blobInnerNum, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageMock_GetBlobInner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobInner'
+type StorageMock_GetBlobInner_Call struct {
+	*mock.Call
+}
+
+// GetBlobInner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blobInnerNum uint64
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) GetBlobInner(ctx interface{}, blobInnerNum interface{}, dbTx interface{}) *StorageMock_GetBlobInner_Call {
+	return &StorageMock_GetBlobInner_Call{Call: _e.mock.On("GetBlobInner", ctx, blobInnerNum, dbTx)}
+}
+
+func (_c *StorageMock_GetBlobInner_Call) Run(run func(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx)) *StorageMock_GetBlobInner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_GetBlobInner_Call) Return(_a0 *state.BlobInner, _a1 error) *StorageMock_GetBlobInner_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageMock_GetBlobInner_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) (*state.BlobInner, error)) *StorageMock_GetBlobInner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlobInnerBatchRange provides a mock function with given fields: ctx, blobInnerNum, dbTx
+func (_m *StorageMock) GetBlobInnerBatchRange(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx) (uint64, uint64, error) {
+	ret := _m.Called(ctx, blobInnerNum, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobInnerBatchRange")
+	}
+
+	var r0 uint64
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) (uint64, uint64, error)); ok {
+		return rf(ctx, blobInnerNum, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) uint64); ok {
+		r0 = rf(ctx, blobInnerNum, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) uint64); ok {
+		r1 = rf(ctx, blobInnerNum, dbTx)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r2 = rf(ctx, blobInnerNum, dbTx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// StorageMock_GetBlobInnerBatchRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobInnerBatchRange'
+type StorageMock_GetBlobInnerBatchRange_Call struct {
+	*mock.Call
+}
+
+// GetBlobInnerBatchRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blobInnerNum uint64
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) GetBlobInnerBatchRange(ctx interface{}, blobInnerNum interface{}, dbTx interface{}) *StorageMock_GetBlobInnerBatchRange_Call {
+	return &StorageMock_GetBlobInnerBatchRange_Call{Call: _e.mock.On("GetBlobInnerBatchRange", ctx, blobInnerNum, dbTx)}
+}
+
+func (_c *StorageMock_GetBlobInnerBatchRange_Call) Run(run func(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx)) *StorageMock_GetBlobInnerBatchRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_GetBlobInnerBatchRange_Call) Return(_a0 uint64, _a1 uint64, _a2 error) *StorageMock_GetBlobInnerBatchRange_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *StorageMock_GetBlobInnerBatchRange_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) (uint64, uint64, error)) *StorageMock_GetBlobInnerBatchRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlobInnerToProve provides a mock function with given fields: ctx, lastVerfiedBatchNumber, maxL1Block, dbTx
+func (_m *StorageMock) GetBlobInnerToProve(ctx context.Context, lastVerfiedBatchNumber uint64, maxL1Block uint64, dbTx pgx.Tx) (*state.BlobInner, error) {
+	ret := _m.Called(ctx, lastVerfiedBatchNumber, maxL1Block, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobInnerToProve")
+	}
+
+	var r0 *state.BlobInner
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) (*state.BlobInner, error)); ok {
+		return rf(ctx, lastVerfiedBatchNumber, maxL1Block, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) *state.BlobInner); ok {
+		r0 = rf(ctx, lastVerfiedBatchNumber, maxL1Block, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.BlobInner)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, lastVerfiedBatchNumber, maxL1Block, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageMock_GetBlobInnerToProve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobInnerToProve'
+type StorageMock_GetBlobInnerToProve_Call struct {
+	*mock.Call
+}
+
+// GetBlobInnerToProve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lastVerfiedBatchNumber uint64
+//   - maxL1Block uint64
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) GetBlobInnerToProve(ctx interface{}, lastVerfiedBatchNumber interface{}, maxL1Block interface{}, dbTx interface{}) *StorageMock_GetBlobInnerToProve_Call {
+	return &StorageMock_GetBlobInnerToProve_Call{Call: _e.mock.On("GetBlobInnerToProve", ctx, lastVerfiedBatchNumber, maxL1Block, dbTx)}
+}
+
+func (_c *StorageMock_GetBlobInnerToProve_Call) Run(run func(ctx context.Context, lastVerfiedBatchNumber uint64, maxL1Block uint64, dbTx pgx.Tx)) *StorageMock_GetBlobInnerToProve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_GetBlobInnerToProve_Call) Return(_a0 *state.BlobInner, _a1 error) *StorageMock_GetBlobInnerToProve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageMock_GetBlobInnerToProve_Call) RunAndReturn(run func(context.Context, uint64, uint64, pgx.Tx) (*state.BlobInner, error)) *StorageMock_GetBlobInnerToProve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlobOuterProofReadyForFinal provides a mock function with given fields: ctx, lastVerfiedBatchNumber, dbTx
+func (_m *StorageMock) GetBlobOuterProofReadyForFinal(ctx context.Context, lastVerfiedBatchNumber uint64, dbTx pgx.Tx) (*state.BlobOuterProof, error) {
+	ret := _m.Called(ctx, lastVerfiedBatchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobOuterProofReadyForFinal")
+	}
+
+	var r0 *state.BlobOuterProof
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) (*state.BlobOuterProof, error)); ok {
+		return rf(ctx, lastVerfiedBatchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) *state.BlobOuterProof); ok {
+		r0 = rf(ctx, lastVerfiedBatchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.BlobOuterProof)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) error); ok {
-		r1 = rf(ctx, blockNumber, dbTx)
+		r1 = rf(ctx, lastVerfiedBatchNumber, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1841,63 +2729,63 @@ func (_m *StorageMock) GetBatchNumberOfL2Block(ctx context.Context, blockNumber
 	return r0, r1
 }
 
-// StorageMock_GetBatchNumberOfL2Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBatchNumberOfL2Block'
-type StorageMock_GetBatchNumberOfL2Block_Call struct {
+// StorageMock_GetBlobOuterProofReadyForFinal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobOuterProofReadyForFinal'
+type StorageMock_GetBlobOuterProofReadyForFinal_Call struct {
 	*mock.Call
 }
 
-// GetBatchNumberOfL2Block is a helper method to define mock.On call
+// GetBlobOuterProofReadyForFinal is a helper method to define mock.On call
 //   - ctx context.Context
-//   - blockNumber uint64
+//   - lastVerfiedBatchNumber uint64
 //   - dbTx pgx.Tx
-func (_e *StorageMock_Expecter) GetBatchNumberOfL2Block(ctx interface{}, blockNumber interface{}, dbTx interface{}) *StorageMock_GetBatchNumberOfL2Block_Call {
-	return &StorageMock_GetBatchNumberOfL2Block_Call{Call: _e.mock.On("GetBatchNumberOfL2Block", ctx, blockNumber, dbTx)}
+func (_e *StorageMock_Expecter) GetBlobOuterProofReadyForFinal(ctx interface{}, lastVerfiedBatchNumber interface{}, dbTx interface{}) *StorageMock_GetBlobOuterProofReadyForFinal_Call {
+	return &StorageMock_GetBlobOuterProofReadyForFinal_Call{Call: _e.mock.On("GetBlobOuterProofReadyForFinal", ctx, lastVerfiedBatchNumber, dbTx)}
 }
 
-func (_c *StorageMock_GetBatchNumberOfL2Block_Call) Run(run func(ctx context.Context, blockNumber uint64, dbTx pgx.Tx)) *StorageMock_GetBatchNumberOfL2Block_Call {
+func (_c *StorageMock_GetBlobOuterProofReadyForFinal_Call) Run(run func(ctx context.Context, lastVerfiedBatchNumber uint64, dbTx pgx.Tx)) *StorageMock_GetBlobOuterProofReadyForFinal_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint64), args[2].(pgx.Tx))
 	})
 	return _c
 }
 
-func (_c *StorageMock_GetBatchNumberOfL2Block_Call) Return(_a0 uint64, _a1 error) *StorageMock_GetBatchNumberOfL2Block_Call {
+func (_c *StorageMock_GetBlobOuterProofReadyForFinal_Call) Return(_a0 *state.BlobOuterProof, _a1 error) *StorageMock_GetBlobOuterProofReadyForFinal_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *StorageMock_GetBatchNumberOfL2Block_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) (uint64, error)) *StorageMock_GetBatchNumberOfL2Block_Call {
+func (_c *StorageMock_GetBlobOuterProofReadyForFinal_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) (*state.BlobOuterProof, error)) *StorageMock_GetBlobOuterProofReadyForFinal_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetBatchProofsToAggregate provides a mock function with given fields: ctx, dbTx
-func (_m *StorageMock) GetBatchProofsToAggregate(ctx context.Context, dbTx pgx.Tx) (*state.Proof, *state.Proof, error) {
+// GetBlobOuterProofsToAggregate provides a mock function with given fields: ctx, dbTx
+func (_m *StorageMock) GetBlobOuterProofsToAggregate(ctx context.Context, dbTx pgx.Tx) (*state.BlobOuterProof, *state.BlobOuterProof, error) {
 	ret := _m.Called(ctx, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetBatchProofsToAggregate")
+		panic("no return value specified for GetBlobOuterProofsToAggregate")
 	}
 
-	var r0 *state.Proof
-	var r1 *state.Proof
+	var r0 *state.BlobOuterProof
+	var r1 *state.BlobOuterProof
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) (*state.Proof, *state.Proof, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) (*state.BlobOuterProof, *state.BlobOuterProof, error)); ok {
 		return rf(ctx, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) *state.Proof); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) *state.BlobOuterProof); ok {
 		r0 = rf(ctx, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*state.Proof)
+			r0 = ret.Get(0).(*state.BlobOuterProof)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) *state.Proof); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) *state.BlobOuterProof); ok {
 		r1 = rf(ctx, dbTx)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*state.Proof)
+			r1 = ret.Get(1).(*state.BlobOuterProof)
 		}
 	}
 
@@ -1910,31 +2798,31 @@ func (_m *StorageMock) GetBatchProofsToAggregate(ctx context.Context, dbTx pgx.T
 	return r0, r1, r2
 }
 
-// StorageMock_GetBatchProofsToAggregate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBatchProofsToAggregate'
-type StorageMock_GetBatchProofsToAggregate_Call struct {
+// StorageMock_GetBlobOuterProofsToAggregate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobOuterProofsToAggregate'
+type StorageMock_GetBlobOuterProofsToAggregate_Call struct {
 	*mock.Call
 }
 
-// GetBatchProofsToAggregate is a helper method to define mock.On call
+// GetBlobOuterProofsToAggregate is a helper method to define mock.On call
 //   - ctx context.Context
 //   - dbTx pgx.Tx
-func (_e *StorageMock_Expecter) GetBatchProofsToAggregate(ctx interface{}, dbTx interface{}) *StorageMock_GetBatchProofsToAggregate_Call {
-	return &StorageMock_GetBatchProofsToAggregate_Call{Call: _e.mock.On("GetBatchProofsToAggregate", ctx, dbTx)}
+func (_e *StorageMock_Expecter) GetBlobOuterProofsToAggregate(ctx interface{}, dbTx interface{}) *StorageMock_GetBlobOuterProofsToAggregate_Call {
+	return &StorageMock_GetBlobOuterProofsToAggregate_Call{Call: _e.mock.On("GetBlobOuterProofsToAggregate", ctx, dbTx)}
 }
 
-func (_c *StorageMock_GetBatchProofsToAggregate_Call) Run(run func(ctx context.Context, dbTx pgx.Tx)) *StorageMock_GetBatchProofsToAggregate_Call {
+func (_c *StorageMock_GetBlobOuterProofsToAggregate_Call) Run(run func(ctx context.Context, dbTx pgx.Tx)) *StorageMock_GetBlobOuterProofsToAggregate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(pgx.Tx))
 	})
 	return _c
 }
 
-func (_c *StorageMock_GetBatchProofsToAggregate_Call) Return(_a0 *state.Proof, _a1 *state.Proof, _a2 error) *StorageMock_GetBatchProofsToAggregate_Call {
+func (_c *StorageMock_GetBlobOuterProofsToAggregate_Call) Return(_a0 *state.BlobOuterProof, _a1 *state.BlobOuterProof, _a2 error) *StorageMock_GetBlobOuterProofsToAggregate_Call {
 	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *StorageMock_GetBatchProofsToAggregate_Call) RunAndReturn(run func(context.Context, pgx.Tx) (*state.Proof, *state.Proof, error)) *StorageMock_GetBatchProofsToAggregate_Call {
+func (_c *StorageMock_GetBlobOuterProofsToAggregate_Call) RunAndReturn(run func(context.Context, pgx.Tx) (*state.BlobOuterProof, *state.BlobOuterProof, error)) *StorageMock_GetBlobOuterProofsToAggregate_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3586,6 +4474,67 @@ func (_c *StorageMock_GetL2BlocksByBatchNumber_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// GetL2BlocksFeeHistory provides a mock function with given fields: ctx, fromBlockNumber, toBlockNumber, dbTx
+func (_m *StorageMock) GetL2BlocksFeeHistory(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx pgx.Tx) ([]state.L2BlockFeeHistory, error) {
+	ret := _m.Called(ctx, fromBlockNumber, toBlockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetL2BlocksFeeHistory")
+	}
+
+	var r0 []state.L2BlockFeeHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) ([]state.L2BlockFeeHistory, error)); ok {
+		return rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) []state.L2BlockFeeHistory); ok {
+		r0 = rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]state.L2BlockFeeHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageMock_GetL2BlocksFeeHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetL2BlocksFeeHistory'
+type StorageMock_GetL2BlocksFeeHistory_Call struct {
+	*mock.Call
+}
+
+// GetL2BlocksFeeHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromBlockNumber uint64
+//   - toBlockNumber uint64
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) GetL2BlocksFeeHistory(ctx interface{}, fromBlockNumber interface{}, toBlockNumber interface{}, dbTx interface{}) *StorageMock_GetL2BlocksFeeHistory_Call {
+	return &StorageMock_GetL2BlocksFeeHistory_Call{Call: _e.mock.On("GetL2BlocksFeeHistory", ctx, fromBlockNumber, toBlockNumber, dbTx)}
+}
+
+func (_c *StorageMock_GetL2BlocksFeeHistory_Call) Run(run func(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx pgx.Tx)) *StorageMock_GetL2BlocksFeeHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_GetL2BlocksFeeHistory_Call) Return(_a0 []state.L2BlockFeeHistory, _a1 error) *StorageMock_GetL2BlocksFeeHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageMock_GetL2BlocksFeeHistory_Call) RunAndReturn(run func(context.Context, uint64, uint64, pgx.Tx) ([]state.L2BlockFeeHistory, error)) *StorageMock_GetL2BlocksFeeHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetL2TxHashByTxHash provides a mock function with given fields: ctx, hash, dbTx
 func (_m *StorageMock) GetL2TxHashByTxHash(ctx context.Context, hash common.Hash, dbTx pgx.Tx) (*common.Hash, error) {
 	ret := _m.Called(ctx, hash, dbTx)
@@ -5736,202 +6685,408 @@ func (_m *StorageMock) GetPreviousBlock(ctx context.Context, offset uint64, dbTx
 	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) *state.Block); ok {
 		r0 = rf(ctx, offset, dbTx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*state.Block)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, offset, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageMock_GetPreviousBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviousBlock'
+type StorageMock_GetPreviousBlock_Call struct {
+	*mock.Call
+}
+
+// GetPreviousBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - offset uint64
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) GetPreviousBlock(ctx interface{}, offset interface{}, dbTx interface{}) *StorageMock_GetPreviousBlock_Call {
+	return &StorageMock_GetPreviousBlock_Call{Call: _e.mock.On("GetPreviousBlock", ctx, offset, dbTx)}
+}
+
+func (_c *StorageMock_GetPreviousBlock_Call) Run(run func(ctx context.Context, offset uint64, dbTx pgx.Tx)) *StorageMock_GetPreviousBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_GetPreviousBlock_Call) Return(_a0 *state.Block, _a1 error) *StorageMock_GetPreviousBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageMock_GetPreviousBlock_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) (*state.Block, error)) *StorageMock_GetPreviousBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProcessingContext provides a mock function with given fields: ctx, batchNumber, dbTx
+func (_m *StorageMock) GetProcessingContext(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) (*state.ProcessingContext, error) {
+	ret := _m.Called(ctx, batchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProcessingContext")
+	}
+
+	var r0 *state.ProcessingContext
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) (*state.ProcessingContext, error)); ok {
+		return rf(ctx, batchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) *state.ProcessingContext); ok {
+		r0 = rf(ctx, batchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.ProcessingContext)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, batchNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageMock_GetProcessingContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProcessingContext'
+type StorageMock_GetProcessingContext_Call struct {
+	*mock.Call
+}
+
+// GetProcessingContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batchNumber uint64
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) GetProcessingContext(ctx interface{}, batchNumber interface{}, dbTx interface{}) *StorageMock_GetProcessingContext_Call {
+	return &StorageMock_GetProcessingContext_Call{Call: _e.mock.On("GetProcessingContext", ctx, batchNumber, dbTx)}
+}
+
+func (_c *StorageMock_GetProcessingContext_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx pgx.Tx)) *StorageMock_GetProcessingContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_GetProcessingContext_Call) Return(_a0 *state.ProcessingContext, _a1 error) *StorageMock_GetProcessingContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageMock_GetProcessingContext_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) (*state.ProcessingContext, error)) *StorageMock_GetProcessingContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProofReadyForFinal provides a mock function with given fields: ctx, lastVerfiedBatchNumber, dbTx
+func (_m *StorageMock) GetProofReadyForFinal(ctx context.Context, lastVerfiedBatchNumber uint64, dbTx pgx.Tx) (*state.Proof, error) {
+	ret := _m.Called(ctx, lastVerfiedBatchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProofReadyForFinal")
+	}
+
+	var r0 *state.Proof
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) (*state.Proof, error)); ok {
+		return rf(ctx, lastVerfiedBatchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) *state.Proof); ok {
+		r0 = rf(ctx, lastVerfiedBatchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.Proof)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, lastVerfiedBatchNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageMock_GetProofReadyForFinal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProofReadyForFinal'
+type StorageMock_GetProofReadyForFinal_Call struct {
+	*mock.Call
+}
+
+// GetProofReadyForFinal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lastVerfiedBatchNumber uint64
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) GetProofReadyForFinal(ctx interface{}, lastVerfiedBatchNumber interface{}, dbTx interface{}) *StorageMock_GetProofReadyForFinal_Call {
+	return &StorageMock_GetProofReadyForFinal_Call{Call: _e.mock.On("GetProofReadyForFinal", ctx, lastVerfiedBatchNumber, dbTx)}
+}
+
+func (_c *StorageMock_GetProofReadyForFinal_Call) Run(run func(ctx context.Context, lastVerfiedBatchNumber uint64, dbTx pgx.Tx)) *StorageMock_GetProofReadyForFinal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_GetProofReadyForFinal_Call) Return(_a0 *state.Proof, _a1 error) *StorageMock_GetProofReadyForFinal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageMock_GetProofReadyForFinal_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) (*state.Proof, error)) *StorageMock_GetProofReadyForFinal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProofsToBlobOuter provides a mock function with given fields: ctx, dbTx
+func (_m *StorageMock) GetProofsToBlobOuter(ctx context.Context, dbTx pgx.Tx) (*state.Proof, *state.BlobInnerProof, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProofsToBlobOuter")
+	}
+
+	var r0 *state.Proof
+	var r1 *state.BlobInnerProof
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) (*state.Proof, *state.BlobInnerProof, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) *state.Proof); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.Proof)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) *state.BlobInnerProof); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*state.BlobInnerProof)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) error); ok {
-		r1 = rf(ctx, offset, dbTx)
+	if rf, ok := ret.Get(2).(func(context.Context, pgx.Tx) error); ok {
+		r2 = rf(ctx, dbTx)
 	} else {
-		r1 = ret.Error(1)
+		r2 = ret.Error(2)
 	}
 
-	return r0, r1
+	return r0, r1, r2
 }
 
-// StorageMock_GetPreviousBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviousBlock'
-type StorageMock_GetPreviousBlock_Call struct {
+// StorageMock_GetProofsToBlobOuter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProofsToBlobOuter'
+type StorageMock_GetProofsToBlobOuter_Call struct {
 	*mock.Call
 }
 
-// GetPreviousBlock is a helper method to define mock.On call
+// GetProofsToBlobOuter is a helper method to define mock.On call
 //   - ctx context.Context
-//   - offset uint64
 //   - dbTx pgx.Tx
-func (_e *StorageMock_Expecter) GetPreviousBlock(ctx interface{}, offset interface{}, dbTx interface{}) *StorageMock_GetPreviousBlock_Call {
-	return &StorageMock_GetPreviousBlock_Call{Call: _e.mock.On("GetPreviousBlock", ctx, offset, dbTx)}
+func (_e *StorageMock_Expecter) GetProofsToBlobOuter(ctx interface{}, dbTx interface{}) *StorageMock_GetProofsToBlobOuter_Call {
+	return &StorageMock_GetProofsToBlobOuter_Call{Call: _e.mock.On("GetProofsToBlobOuter", ctx, dbTx)}
 }
 
-func (_c *StorageMock_GetPreviousBlock_Call) Run(run func(ctx context.Context, offset uint64, dbTx pgx.Tx)) *StorageMock_GetPreviousBlock_Call {
+func (_c *StorageMock_GetProofsToBlobOuter_Call) Run(run func(ctx context.Context, dbTx pgx.Tx)) *StorageMock_GetProofsToBlobOuter_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(pgx.Tx))
+		run(args[0].(context.Context), args[1].(pgx.Tx))
 	})
 	return _c
 }
 
-func (_c *StorageMock_GetPreviousBlock_Call) Return(_a0 *state.Block, _a1 error) *StorageMock_GetPreviousBlock_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *StorageMock_GetProofsToBlobOuter_Call) Return(_a0 *state.Proof, _a1 *state.BlobInnerProof, _a2 error) *StorageMock_GetProofsToBlobOuter_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *StorageMock_GetPreviousBlock_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) (*state.Block, error)) *StorageMock_GetPreviousBlock_Call {
+func (_c *StorageMock_GetProofsToBlobOuter_Call) RunAndReturn(run func(context.Context, pgx.Tx) (*state.Proof, *state.BlobInnerProof, error)) *StorageMock_GetProofsToBlobOuter_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProcessingContext provides a mock function with given fields: ctx, batchNumber, dbTx
-func (_m *StorageMock) GetProcessingContext(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) (*state.ProcessingContext, error) {
+// GetRawBatchTimestamps provides a mock function with given fields: ctx, batchNumber, dbTx
+func (_m *StorageMock) GetRawBatchTimestamps(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) (*time.Time, *time.Time, error) {
 	ret := _m.Called(ctx, batchNumber, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProcessingContext")
+		panic("no return value specified for GetRawBatchTimestamps")
 	}
 
-	var r0 *state.ProcessingContext
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) (*state.ProcessingContext, error)); ok {
+	var r0 *time.Time
+	var r1 *time.Time
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) (*time.Time, *time.Time, error)); ok {
 		return rf(ctx, batchNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) *state.ProcessingContext); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) *time.Time); ok {
 		r0 = rf(ctx, batchNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*state.ProcessingContext)
+			r0 = ret.Get(0).(*time.Time)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) *time.Time); ok {
 		r1 = rf(ctx, batchNumber, dbTx)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*time.Time)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r2 = rf(ctx, batchNumber, dbTx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// StorageMock_GetProcessingContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProcessingContext'
-type StorageMock_GetProcessingContext_Call struct {
+// StorageMock_GetRawBatchTimestamps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRawBatchTimestamps'
+type StorageMock_GetRawBatchTimestamps_Call struct {
 	*mock.Call
 }
 
-// GetProcessingContext is a helper method to define mock.On call
+// GetRawBatchTimestamps is a helper method to define mock.On call
 //   - ctx context.Context
 //   - batchNumber uint64
 //   - dbTx pgx.Tx
-func (_e *StorageMock_Expecter) GetProcessingContext(ctx interface{}, batchNumber interface{}, dbTx interface{}) *StorageMock_GetProcessingContext_Call {
-	return &StorageMock_GetProcessingContext_Call{Call: _e.mock.On("GetProcessingContext", ctx, batchNumber, dbTx)}
+func (_e *StorageMock_Expecter) GetRawBatchTimestamps(ctx interface{}, batchNumber interface{}, dbTx interface{}) *StorageMock_GetRawBatchTimestamps_Call {
+	return &StorageMock_GetRawBatchTimestamps_Call{Call: _e.mock.On("GetRawBatchTimestamps", ctx, batchNumber, dbTx)}
 }
 
-func (_c *StorageMock_GetProcessingContext_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx pgx.Tx)) *StorageMock_GetProcessingContext_Call {
+func (_c *StorageMock_GetRawBatchTimestamps_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx pgx.Tx)) *StorageMock_GetRawBatchTimestamps_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint64), args[2].(pgx.Tx))
 	})
 	return _c
 }
 
-func (_c *StorageMock_GetProcessingContext_Call) Return(_a0 *state.ProcessingContext, _a1 error) *StorageMock_GetProcessingContext_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *StorageMock_GetRawBatchTimestamps_Call) Return(_a0 *time.Time, _a1 *time.Time, _a2 error) *StorageMock_GetRawBatchTimestamps_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *StorageMock_GetProcessingContext_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) (*state.ProcessingContext, error)) *StorageMock_GetProcessingContext_Call {
+func (_c *StorageMock_GetRawBatchTimestamps_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) (*time.Time, *time.Time, error)) *StorageMock_GetRawBatchTimestamps_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProofReadyForFinal provides a mock function with given fields: ctx, lastVerfiedBatchNumber, dbTx
-func (_m *StorageMock) GetProofReadyForFinal(ctx context.Context, lastVerfiedBatchNumber uint64, dbTx pgx.Tx) (*state.Proof, error) {
-	ret := _m.Called(ctx, lastVerfiedBatchNumber, dbTx)
+// GetReceiptsByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
+func (_m *StorageMock) GetReceiptsByBatchNumber(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) ([]*types.Receipt, []types.Transaction, error) {
+	ret := _m.Called(ctx, batchNumber, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProofReadyForFinal")
+		panic("no return value specified for GetReceiptsByBatchNumber")
 	}
 
-	var r0 *state.Proof
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) (*state.Proof, error)); ok {
-		return rf(ctx, lastVerfiedBatchNumber, dbTx)
+	var r0 []*types.Receipt
+	var r1 []types.Transaction
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) ([]*types.Receipt, []types.Transaction, error)); ok {
+		return rf(ctx, batchNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) *state.Proof); ok {
-		r0 = rf(ctx, lastVerfiedBatchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) []*types.Receipt); ok {
+		r0 = rf(ctx, batchNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*state.Proof)
+			r0 = ret.Get(0).([]*types.Receipt)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) error); ok {
-		r1 = rf(ctx, lastVerfiedBatchNumber, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) []types.Transaction); ok {
+		r1 = rf(ctx, batchNumber, dbTx)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]types.Transaction)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r2 = rf(ctx, batchNumber, dbTx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// StorageMock_GetProofReadyForFinal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProofReadyForFinal'
-type StorageMock_GetProofReadyForFinal_Call struct {
+// StorageMock_GetReceiptsByBatchNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReceiptsByBatchNumber'
+type StorageMock_GetReceiptsByBatchNumber_Call struct {
 	*mock.Call
 }
 
-// GetProofReadyForFinal is a helper method to define mock.On call
+// GetReceiptsByBatchNumber is a helper method to define mock.On call
 //   - ctx context.Context
-//   - lastVerfiedBatchNumber uint64
+//   - batchNumber uint64
 //   - dbTx pgx.Tx
-func (_e *StorageMock_Expecter) GetProofReadyForFinal(ctx interface{}, lastVerfiedBatchNumber interface{}, dbTx interface{}) *StorageMock_GetProofReadyForFinal_Call {
-	return &StorageMock_GetProofReadyForFinal_Call{Call: _e.mock.On("GetProofReadyForFinal", ctx, lastVerfiedBatchNumber, dbTx)}
+func (_e *StorageMock_Expecter) GetReceiptsByBatchNumber(ctx interface{}, batchNumber interface{}, dbTx interface{}) *StorageMock_GetReceiptsByBatchNumber_Call {
+	return &StorageMock_GetReceiptsByBatchNumber_Call{Call: _e.mock.On("GetReceiptsByBatchNumber", ctx, batchNumber, dbTx)}
 }
 
-func (_c *StorageMock_GetProofReadyForFinal_Call) Run(run func(ctx context.Context, lastVerfiedBatchNumber uint64, dbTx pgx.Tx)) *StorageMock_GetProofReadyForFinal_Call {
+func (_c *StorageMock_GetReceiptsByBatchNumber_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx pgx.Tx)) *StorageMock_GetReceiptsByBatchNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint64), args[2].(pgx.Tx))
 	})
 	return _c
 }
 
-func (_c *StorageMock_GetProofReadyForFinal_Call) Return(_a0 *state.Proof, _a1 error) *StorageMock_GetProofReadyForFinal_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *StorageMock_GetReceiptsByBatchNumber_Call) Return(_a0 []*types.Receipt, _a1 []types.Transaction, _a2 error) *StorageMock_GetReceiptsByBatchNumber_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *StorageMock_GetProofReadyForFinal_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) (*state.Proof, error)) *StorageMock_GetProofReadyForFinal_Call {
+func (_c *StorageMock_GetReceiptsByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) ([]*types.Receipt, []types.Transaction, error)) *StorageMock_GetReceiptsByBatchNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRawBatchTimestamps provides a mock function with given fields: ctx, batchNumber, dbTx
-func (_m *StorageMock) GetRawBatchTimestamps(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) (*time.Time, *time.Time, error) {
-	ret := _m.Called(ctx, batchNumber, dbTx)
+// GetReceiptsByL2BlockNumber provides a mock function with given fields: ctx, blockNumber, dbTx
+func (_m *StorageMock) GetReceiptsByL2BlockNumber(ctx context.Context, blockNumber uint64, dbTx pgx.Tx) ([]*types.Receipt, []types.Transaction, error) {
+	ret := _m.Called(ctx, blockNumber, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRawBatchTimestamps")
+		panic("no return value specified for GetReceiptsByL2BlockNumber")
 	}
 
-	var r0 *time.Time
-	var r1 *time.Time
+	var r0 []*types.Receipt
+	var r1 []types.Transaction
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) (*time.Time, *time.Time, error)); ok {
-		return rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) ([]*types.Receipt, []types.Transaction, error)); ok {
+		return rf(ctx, blockNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) *time.Time); ok {
-		r0 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) []*types.Receipt); ok {
+		r0 = rf(ctx, blockNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*time.Time)
+			r0 = ret.Get(0).([]*types.Receipt)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) *time.Time); ok {
-		r1 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) []types.Transaction); ok {
+		r1 = rf(ctx, blockNumber, dbTx)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*time.Time)
+			r1 = ret.Get(1).([]types.Transaction)
 		}
 	}
 
 	if rf, ok := ret.Get(2).(func(context.Context, uint64, pgx.Tx) error); ok {
-		r2 = rf(ctx, batchNumber, dbTx)
+		r2 = rf(ctx, blockNumber, dbTx)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -5939,32 +7094,32 @@ func (_m *StorageMock) GetRawBatchTimestamps(ctx context.Context, batchNumber ui
 	return r0, r1, r2
 }
 
-// StorageMock_GetRawBatchTimestamps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRawBatchTimestamps'
-type StorageMock_GetRawBatchTimestamps_Call struct {
+// StorageMock_GetReceiptsByL2BlockNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReceiptsByL2BlockNumber'
+type StorageMock_GetReceiptsByL2BlockNumber_Call struct {
 	*mock.Call
 }
 
-// GetRawBatchTimestamps is a helper method to define mock.On call
+// GetReceiptsByL2BlockNumber is a helper method to define mock.On call
 //   - ctx context.Context
-//   - batchNumber uint64
+//   - blockNumber uint64
 //   - dbTx pgx.Tx
-func (_e *StorageMock_Expecter) GetRawBatchTimestamps(ctx interface{}, batchNumber interface{}, dbTx interface{}) *StorageMock_GetRawBatchTimestamps_Call {
-	return &StorageMock_GetRawBatchTimestamps_Call{Call: _e.mock.On("GetRawBatchTimestamps", ctx, batchNumber, dbTx)}
+func (_e *StorageMock_Expecter) GetReceiptsByL2BlockNumber(ctx interface{}, blockNumber interface{}, dbTx interface{}) *StorageMock_GetReceiptsByL2BlockNumber_Call {
+	return &StorageMock_GetReceiptsByL2BlockNumber_Call{Call: _e.mock.On("GetReceiptsByL2BlockNumber", ctx, blockNumber, dbTx)}
 }
 
-func (_c *StorageMock_GetRawBatchTimestamps_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx pgx.Tx)) *StorageMock_GetRawBatchTimestamps_Call {
+func (_c *StorageMock_GetReceiptsByL2BlockNumber_Call) Run(run func(ctx context.Context, blockNumber uint64, dbTx pgx.Tx)) *StorageMock_GetReceiptsByL2BlockNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint64), args[2].(pgx.Tx))
 	})
 	return _c
 }
 
-func (_c *StorageMock_GetRawBatchTimestamps_Call) Return(_a0 *time.Time, _a1 *time.Time, _a2 error) *StorageMock_GetRawBatchTimestamps_Call {
+func (_c *StorageMock_GetReceiptsByL2BlockNumber_Call) Return(_a0 []*types.Receipt, _a1 []types.Transaction, _a2 error) *StorageMock_GetReceiptsByL2BlockNumber_Call {
 	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *StorageMock_GetRawBatchTimestamps_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) (*time.Time, *time.Time, error)) *StorageMock_GetRawBatchTimestamps_Call {
+func (_c *StorageMock_GetReceiptsByL2BlockNumber_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) ([]*types.Receipt, []types.Transaction, error)) *StorageMock_GetReceiptsByL2BlockNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -8278,6 +9433,65 @@ func (_c *StorageMock_StoreGenesisBatch_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// UnlockBatchProofs provides a mock function with given fields: ctx, batchNumber, batchNumberFinal, dbTx
+func (_m *StorageMock) UnlockBatchProofs(ctx context.Context, batchNumber uint64, batchNumberFinal uint64, dbTx pgx.Tx) (int64, error) {
+	ret := _m.Called(ctx, batchNumber, batchNumberFinal, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockBatchProofs")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) (int64, error)); ok {
+		return rf(ctx, batchNumber, batchNumberFinal, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, pgx.Tx) int64); ok {
+		r0 = rf(ctx, batchNumber, batchNumberFinal, dbTx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, batchNumber, batchNumberFinal, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageMock_UnlockBatchProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockBatchProofs'
+type StorageMock_UnlockBatchProofs_Call struct {
+	*mock.Call
+}
+
+// UnlockBatchProofs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batchNumber uint64
+//   - batchNumberFinal uint64
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) UnlockBatchProofs(ctx interface{}, batchNumber interface{}, batchNumberFinal interface{}, dbTx interface{}) *StorageMock_UnlockBatchProofs_Call {
+	return &StorageMock_UnlockBatchProofs_Call{Call: _e.mock.On("UnlockBatchProofs", ctx, batchNumber, batchNumberFinal, dbTx)}
+}
+
+func (_c *StorageMock_UnlockBatchProofs_Call) Run(run func(ctx context.Context, batchNumber uint64, batchNumberFinal uint64, dbTx pgx.Tx)) *StorageMock_UnlockBatchProofs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_UnlockBatchProofs_Call) Return(_a0 int64, _a1 error) *StorageMock_UnlockBatchProofs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageMock_UnlockBatchProofs_Call) RunAndReturn(run func(context.Context, uint64, uint64, pgx.Tx) (int64, error)) *StorageMock_UnlockBatchProofs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateBatchAsChecked provides a mock function with given fields: ctx, batchNumber, dbTx
 func (_m *StorageMock) UpdateBatchAsChecked(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) error {
 	ret := _m.Called(ctx, batchNumber, dbTx)
@@ -8423,6 +9637,102 @@ func (_c *StorageMock_UpdateBatchProof_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// UpdateBlobInnerProof provides a mock function with given fields: ctx, proof, dbTx
+func (_m *StorageMock) UpdateBlobInnerProof(ctx context.Context, proof *state.BlobInnerProof, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, proof, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBlobInnerProof")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *state.BlobInnerProof, pgx.Tx) error); ok {
+		r0 = rf(ctx, proof, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageMock_UpdateBlobInnerProof_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBlobInnerProof'
+type StorageMock_UpdateBlobInnerProof_Call struct {
+	*mock.Call
+}
+
+// UpdateBlobInnerProof is a helper method to define mock.On call
+//   - ctx context.Context
+//   - proof *state.BlobInnerProof
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) UpdateBlobInnerProof(ctx interface{}, proof interface{}, dbTx interface{}) *StorageMock_UpdateBlobInnerProof_Call {
+	return &StorageMock_UpdateBlobInnerProof_Call{Call: _e.mock.On("UpdateBlobInnerProof", ctx, proof, dbTx)}
+}
+
+func (_c *StorageMock_UpdateBlobInnerProof_Call) Run(run func(ctx context.Context, proof *state.BlobInnerProof, dbTx pgx.Tx)) *StorageMock_UpdateBlobInnerProof_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*state.BlobInnerProof), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_UpdateBlobInnerProof_Call) Return(_a0 error) *StorageMock_UpdateBlobInnerProof_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageMock_UpdateBlobInnerProof_Call) RunAndReturn(run func(context.Context, *state.BlobInnerProof, pgx.Tx) error) *StorageMock_UpdateBlobInnerProof_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBlobOuterProof provides a mock function with given fields: ctx, proof, dbTx
+func (_m *StorageMock) UpdateBlobOuterProof(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, proof, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBlobOuterProof")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *state.BlobOuterProof, pgx.Tx) error); ok {
+		r0 = rf(ctx, proof, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageMock_UpdateBlobOuterProof_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBlobOuterProof'
+type StorageMock_UpdateBlobOuterProof_Call struct {
+	*mock.Call
+}
+
+// UpdateBlobOuterProof is a helper method to define mock.On call
+//   - ctx context.Context
+//   - proof *state.BlobOuterProof
+//   - dbTx pgx.Tx
+func (_e *StorageMock_Expecter) UpdateBlobOuterProof(ctx interface{}, proof interface{}, dbTx interface{}) *StorageMock_UpdateBlobOuterProof_Call {
+	return &StorageMock_UpdateBlobOuterProof_Call{Call: _e.mock.On("UpdateBlobOuterProof", ctx, proof, dbTx)}
+}
+
+func (_c *StorageMock_UpdateBlobOuterProof_Call) Run(run func(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx)) *StorageMock_UpdateBlobOuterProof_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*state.BlobOuterProof), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageMock_UpdateBlobOuterProof_Call) Return(_a0 error) *StorageMock_UpdateBlobOuterProof_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageMock_UpdateBlobOuterProof_Call) RunAndReturn(run func(context.Context, *state.BlobOuterProof, pgx.Tx) error) *StorageMock_UpdateBlobOuterProof_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateForkIDBlockNumber provides a mock function with given fields: ctx, forkdID, newBlockNumber, updateMemCache, dbTx
 func (_m *StorageMock) UpdateForkIDBlockNumber(ctx context.Context, forkdID uint64, newBlockNumber uint64, updateMemCache bool, dbTx pgx.Tx) error {
 	ret := _m.Called(ctx, forkdID, newBlockNumber, updateMemCache, dbTx)
@@ -0,0 +1,210 @@
+package state
+
+import (
+	"math/big"
+
+	"github.com/0xPolygonHermez/zkevm-node/state/runtime/executor"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// lastL2BlockNumberStoragePos is the storage position of the system smart
+// contract where the ROM keeps the number of the last L2 block, it is increased
+// every time a change L2 block tx is processed
+var lastL2BlockNumberStoragePos = common.Hash{}
+
+// OverrideAccount indicates the overriding fields of an account during the
+// execution of an unsigned transaction.
+// Note, State and StateDiff can't be specified at the same time. If State is
+// set, the execution will only use the data in the given storage. Otherwise
+// if StateDiff is set, all the diff will be applied over the current storage
+type OverrideAccount struct {
+	Balance   *big.Int
+	Nonce     *uint64
+	Code      []byte
+	State     map[common.Hash]common.Hash
+	StateDiff map[common.Hash]common.Hash
+}
+
+// StateOverride is the collection of accounts to be overridden during the
+// execution of an unsigned transaction, by address
+type StateOverride map[common.Address]OverrideAccount
+
+// BlockOverride indicates the overriding fields of the block context in which
+// an unsigned transaction is executed
+type BlockOverride struct {
+	Number   *uint64
+	Time     *uint64
+	Coinbase *common.Address
+}
+
+// validate checks the block override can be applied to a block opened on top
+// of the provided parent block for the provided fork id
+func (o *BlockOverride) validate(parent *L2Block, forkID uint64) error {
+	if o == nil {
+		return nil
+	}
+	if o.Time != nil && *o.Time < parent.Time() {
+		return ErrInvalidTimestampOverride
+	}
+	if o.Number != nil {
+		if forkID < FORKID_ETROG {
+			return ErrBlockNumberOverrideNotSupported
+		}
+		if *o.Number == 0 {
+			return ErrInvalidBlockNumberOverride
+		}
+	}
+	return nil
+}
+
+// timestamp returns the overridden timestamp, if any, otherwise the provided one
+func (o *BlockOverride) timestamp(timestamp uint64) uint64 {
+	if o == nil || o.Time == nil {
+		return timestamp
+	}
+	return *o.Time
+}
+
+// coinbase returns the overridden coinbase, if any, otherwise the provided one
+func (o *BlockOverride) coinbase(coinbase common.Address) common.Address {
+	if o == nil || o.Coinbase == nil {
+		return coinbase
+	}
+	return *o.Coinbase
+}
+
+// overridesL2Block indicates if the executor needs to open a new L2 block
+// for the overridden timestamp or number to take effect
+func (o *BlockOverride) overridesL2Block() bool {
+	return o != nil && (o.Time != nil || o.Number != nil)
+}
+
+// validate checks none of the accounts overrides both the state and the state diff
+func (o StateOverride) validate() error {
+	for _, account := range o {
+		if account.State != nil && account.StateDiff != nil {
+			return ErrStateAndStateDiffOverride
+		}
+	}
+	return nil
+}
+
+// nonce returns the overridden nonce of the provided address, if any
+func (o StateOverride) nonce(address common.Address) *uint64 {
+	if account, found := o[address]; found {
+		return account.Nonce
+	}
+	return nil
+}
+
+// balance returns the overridden balance of the provided address, if any
+func (o StateOverride) balance(address common.Address) *big.Int {
+	if account, found := o[address]; found {
+		return account.Balance
+	}
+	return nil
+}
+
+// code returns the overridden code of the provided address, if any
+func (o StateOverride) code(address common.Address) []byte {
+	if account, found := o[address]; found {
+		return account.Code
+	}
+	return nil
+}
+
+// withBlockOverride returns a copy of the state override that sets the number
+// of the last L2 block in the system smart contract when the block number is
+// overridden, so the next L2 block opened by the executor gets the overridden number
+func (o StateOverride) withBlockOverride(blockOverride *BlockOverride) StateOverride {
+	if blockOverride == nil || blockOverride.Number == nil {
+		return o
+	}
+	number := *blockOverride.Number
+
+	result := make(StateOverride, len(o)+1)
+	for address, account := range o {
+		result[address] = account
+	}
+
+	systemSC := common.HexToAddress(SystemSC)
+	account := result[systemSC]
+	value := common.BigToHash(new(big.Int).SetUint64(number - 1))
+	if account.State != nil {
+		storage := make(map[common.Hash]common.Hash, len(account.State)+1)
+		for k, v := range account.State {
+			storage[k] = v
+		}
+		storage[lastL2BlockNumberStoragePos] = value
+		account.State = storage
+	} else {
+		storage := make(map[common.Hash]common.Hash, len(account.StateDiff)+1)
+		for k, v := range account.StateDiff {
+			storage[k] = v
+		}
+		storage[lastL2BlockNumberStoragePos] = value
+		account.StateDiff = storage
+	}
+	result[systemSC] = account
+
+	return result
+}
+
+// toExecutorV1 converts the state override to the format expected by the
+// executor for forks previous to ETROG
+func (o StateOverride) toExecutorV1() map[string]*executor.OverrideAccount {
+	if len(o) == 0 {
+		return nil
+	}
+	result := make(map[string]*executor.OverrideAccount, len(o))
+	for address, account := range o {
+		overrideAccount := &executor.OverrideAccount{
+			Code:      account.Code,
+			State:     convertToExecutorStorage(account.State),
+			StateDiff: convertToExecutorStorage(account.StateDiff),
+		}
+		if account.Balance != nil {
+			overrideAccount.Balance = account.Balance.Bytes()
+		}
+		if account.Nonce != nil {
+			overrideAccount.Nonce = *account.Nonce
+		}
+		result[address.String()] = overrideAccount
+	}
+	return result
+}
+
+// toExecutorV2 converts the state override to the format expected by the
+// executor for ETROG and later forks
+func (o StateOverride) toExecutorV2() map[string]*executor.OverrideAccountV2 {
+	if len(o) == 0 {
+		return nil
+	}
+	result := make(map[string]*executor.OverrideAccountV2, len(o))
+	for address, account := range o {
+		overrideAccount := &executor.OverrideAccountV2{
+			Code:      account.Code,
+			State:     convertToExecutorStorage(account.State),
+			StateDiff: convertToExecutorStorage(account.StateDiff),
+		}
+		if account.Balance != nil {
+			overrideAccount.Balance = account.Balance.Bytes()
+		}
+		if account.Nonce != nil {
+			overrideAccount.Nonce = *account.Nonce
+		}
+		result[address.String()] = overrideAccount
+	}
+	return result
+}
+
+func convertToExecutorStorage(storage map[common.Hash]common.Hash) map[string]string {
+	if storage == nil {
+		return nil
+	}
+	result := make(map[string]string, len(storage))
+	for k, v := range storage {
+		result[k.String()] = v.String()
+	}
+	return result
+}
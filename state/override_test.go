@@ -0,0 +1,88 @@
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateOverrideToExecutorV2(t *testing.T) {
+	nonce := uint64(5)
+	address := common.HexToAddress("0x1")
+	stateOverride := StateOverride{
+		address: OverrideAccount{
+			Balance:   big.NewInt(1000),
+			Nonce:     &nonce,
+			Code:      []byte{0x60, 0x01},
+			StateDiff: map[common.Hash]common.Hash{common.HexToHash("0x1"): common.HexToHash("0x2")},
+		},
+	}
+	require.NoError(t, stateOverride.validate())
+
+	result := stateOverride.toExecutorV2()
+	require.Len(t, result, 1)
+	account := result[address.String()]
+	require.NotNil(t, account)
+	assert.Equal(t, big.NewInt(1000).Bytes(), account.Balance)
+	assert.Equal(t, nonce, account.Nonce)
+	assert.Equal(t, []byte{0x60, 0x01}, account.Code)
+	assert.Nil(t, account.State)
+	assert.Equal(t, map[string]string{common.HexToHash("0x1").String(): common.HexToHash("0x2").String()}, account.StateDiff)
+
+	assert.Nil(t, StateOverride(nil).toExecutorV2())
+}
+
+func TestStateOverrideValidate(t *testing.T) {
+	stateOverride := StateOverride{
+		common.HexToAddress("0x1"): OverrideAccount{
+			State:     map[common.Hash]common.Hash{},
+			StateDiff: map[common.Hash]common.Hash{},
+		},
+	}
+	assert.ErrorIs(t, stateOverride.validate(), ErrStateAndStateDiffOverride)
+}
+
+func TestStateOverrideWithBlockOverride(t *testing.T) {
+	systemSC := common.HexToAddress(SystemSC)
+	number := uint64(10)
+	blockOverride := &BlockOverride{Number: &number}
+
+	// without overridden number the state override is kept as it is
+	stateOverride := StateOverride{}
+	assert.Equal(t, stateOverride, stateOverride.withBlockOverride(nil))
+	assert.Equal(t, stateOverride, stateOverride.withBlockOverride(&BlockOverride{}))
+
+	// the number of the last block is set in the storage diff of the system SC
+	result := stateOverride.withBlockOverride(blockOverride)
+	require.Contains(t, result, systemSC)
+	assert.Equal(t, common.BigToHash(big.NewInt(9)), result[systemSC].StateDiff[lastL2BlockNumberStoragePos])
+	assert.Empty(t, stateOverride)
+
+	// when the whole storage of the system SC is overridden the number is set there
+	stateOverride = StateOverride{systemSC: OverrideAccount{State: map[common.Hash]common.Hash{common.HexToHash("0x1"): common.HexToHash("0x2")}}}
+	result = stateOverride.withBlockOverride(blockOverride)
+	assert.Nil(t, result[systemSC].StateDiff)
+	assert.Equal(t, common.BigToHash(big.NewInt(9)), result[systemSC].State[lastL2BlockNumberStoragePos])
+	assert.Equal(t, common.HexToHash("0x2"), result[systemSC].State[common.HexToHash("0x1")])
+	assert.Len(t, stateOverride[systemSC].State, 1)
+}
+
+func TestBlockOverrideValidate(t *testing.T) {
+	parent := NewL2BlockWithHeader(NewL2Header(&types.Header{Number: big.NewInt(1), Time: 100}))
+
+	var nilOverride *BlockOverride
+	assert.NoError(t, nilOverride.validate(parent, FORKID_ETROG))
+	assert.Equal(t, uint64(50), nilOverride.timestamp(50))
+
+	past, future, zero, one := uint64(99), uint64(101), uint64(0), uint64(1)
+	assert.ErrorIs(t, (&BlockOverride{Time: &past}).validate(parent, FORKID_ETROG), ErrInvalidTimestampOverride)
+	assert.NoError(t, (&BlockOverride{Time: &future}).validate(parent, FORKID_ETROG))
+	assert.ErrorIs(t, (&BlockOverride{Number: &zero}).validate(parent, FORKID_ETROG), ErrInvalidBlockNumberOverride)
+	assert.ErrorIs(t, (&BlockOverride{Number: &one}).validate(parent, FORKID_DRAGONFRUIT), ErrBlockNumberOverrideNotSupported)
+	assert.NoError(t, (&BlockOverride{Number: &one}).validate(parent, FORKID_ETROG))
+	assert.Equal(t, future, (&BlockOverride{Time: &future}).timestamp(50))
+}
@@ -517,10 +517,10 @@ func (p *PostgresStorage) AddVirtualBatch(ctx context.Context, virtualBatch *sta
 			l1IR := virtualBatch.L1InfoRoot.String()
 			l1InfoRoot = &l1IR
 		}
-		const addVirtualBatchSQL = "INSERT INTO state.virtual_batch (batch_num, tx_hash, coinbase, block_num, sequencer_addr, timestamp_batch_etrog, l1_info_root) VALUES ($1, $2, $3, $4, $5, $6, $7)"
+		const addVirtualBatchSQL = "INSERT INTO state.virtual_batch (batch_num, tx_hash, coinbase, block_num, sequencer_addr, timestamp_batch_etrog, l1_info_root, blob_inner_num) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
 		e := p.getExecQuerier(dbTx)
 		_, err := e.Exec(ctx, addVirtualBatchSQL, virtualBatch.BatchNumber, virtualBatch.TxHash.String(), virtualBatch.Coinbase.String(), virtualBatch.BlockNumber, virtualBatch.SequencerAddr.String(),
-			virtualBatch.TimestampBatchEtrog.UTC(), l1InfoRoot)
+			virtualBatch.TimestampBatchEtrog.UTC(), l1InfoRoot, virtualBatch.BlobInnerNum)
 		return err
 	}
 }
@@ -536,12 +536,12 @@ func (p *PostgresStorage) GetVirtualBatch(ctx context.Context, batchNumber uint6
 	)
 
 	const getVirtualBatchSQL = `
-    SELECT block_num, batch_num, tx_hash, coinbase, sequencer_addr, timestamp_batch_etrog, l1_info_root
+    SELECT block_num, batch_num, tx_hash, coinbase, sequencer_addr, timestamp_batch_etrog, l1_info_root, blob_inner_num
       FROM state.virtual_batch
      WHERE batch_num = $1`
 
 	e := p.getExecQuerier(dbTx)
-	err := e.QueryRow(ctx, getVirtualBatchSQL, batchNumber).Scan(&virtualBatch.BlockNumber, &virtualBatch.BatchNumber, &txHash, &coinbase, &sequencerAddr, &virtualBatch.TimestampBatchEtrog, &l1InfoRoot)
+	err := e.QueryRow(ctx, getVirtualBatchSQL, batchNumber).Scan(&virtualBatch.BlockNumber, &virtualBatch.BatchNumber, &txHash, &coinbase, &sequencerAddr, &virtualBatch.TimestampBatchEtrog, &l1InfoRoot, &virtualBatch.BlobInnerNum)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, state.ErrNotFound
 	} else if err != nil {
@@ -787,6 +787,10 @@ func (p *PostgresStorage) GetVirtualBatchToProve(ctx context.Context, lastVerfie
 			NOT EXISTS (
 				SELECT p.batch_num FROM state.batch_proof p 
 				WHERE v.batch_num >= p.batch_num AND v.batch_num <= p.batch_num_final
+			) AND
+			NOT EXISTS (
+				SELECT op.blob_outer_num FROM state.blob_outer_proof op
+				WHERE v.blob_inner_num >= op.blob_outer_num AND v.blob_inner_num <= op.blob_outer_num_final
 			)
 		ORDER BY b.batch_num ASC LIMIT 1
 		`
@@ -0,0 +1,159 @@
+package pgstatestorage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+const blobInnerFields = `
+	b.blob_inner_num,
+	COALESCE(b.blob_sequence_index, 0),
+	COALESCE(b.blob_type, 0),
+	b.max_sequence_timestamp,
+	COALESCE(b.zk_gas_limit, 0),
+	COALESCE(b.l1_info_tree_leaf_index, 0),
+	COALESCE(s.coinbase, ''),
+	b.point_z,
+	b.point_y,
+	b.data,
+	COALESCE(b.blob_state_root, ''),
+	COALESCE(b.blob_acc_input_hash, ''),
+	b.block_num`
+
+// AddBlobInner adds a new blob inner to the state
+func (p *PostgresStorage) AddBlobInner(ctx context.Context, blobInner *state.BlobInner, dbTx pgx.Tx) error {
+	const addBlobInnerSQL = `
+		INSERT INTO state.blob_inner (
+			blob_inner_num, blob_sequence_index, blob_type, max_sequence_timestamp, zk_gas_limit,
+			l1_info_tree_leaf_index, point_z, point_y, data, blob_state_root, blob_acc_input_hash, block_num)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+
+	e := p.getExecQuerier(dbTx)
+	_, err := e.Exec(ctx, addBlobInnerSQL,
+		blobInner.BlobInnerNum,
+		blobInner.BlobSequenceIndex,
+		uint8(blobInner.Type),
+		blobInner.MaxSequenceTimestamp.UTC(),
+		blobInner.ZkGasLimit,
+		blobInner.L1InfoLeafIndex,
+		blobInner.PointZ,
+		blobInner.PointY,
+		blobInner.Data,
+		blobInner.BlobStateRoot.String(),
+		blobInner.BlobAccInputHash.String(),
+		blobInner.BlockNumber,
+	)
+	return err
+}
+
+// GetBlobInner returns the blob inner identified by the provided number
+func (p *PostgresStorage) GetBlobInner(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx) (*state.BlobInner, error) {
+	const getBlobInnerSQL = `
+		SELECT ` + blobInnerFields + `
+		FROM state.blob_inner b
+		LEFT JOIN state.blob_sequence s ON s.index = b.blob_sequence_index
+		WHERE b.blob_inner_num = $1`
+
+	e := p.getExecQuerier(dbTx)
+	row := e.QueryRow(ctx, getBlobInnerSQL, blobInnerNum)
+	blobInner, err := scanBlobInner(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, state.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return blobInner, nil
+}
+
+// GetBlobInnerToProve return the next blob inner that is pending to generate
+// a blob inner proof. Only blob inners sequenced in L1 blocks up to maxL1Block
+// and including batches not yet verified are returned.
+func (p *PostgresStorage) GetBlobInnerToProve(ctx context.Context, lastVerfiedBatchNumber uint64, maxL1Block uint64, dbTx pgx.Tx) (*state.BlobInner, error) {
+	const getBlobInnerToProveSQL = `
+		SELECT ` + blobInnerFields + `
+		FROM state.blob_inner b
+		LEFT JOIN state.blob_sequence s ON s.index = b.blob_sequence_index
+		WHERE b.block_num <= $2 AND b.blob_state_root IS NOT NULL AND
+			EXISTS (
+				SELECT 1 FROM state.virtual_batch v
+				WHERE v.blob_inner_num = b.blob_inner_num AND v.batch_num > $1
+			) AND
+			NOT EXISTS (
+				SELECT 1 FROM state.blob_inner_proof ip
+				WHERE ip.blob_inner_num = b.blob_inner_num
+			) AND
+			NOT EXISTS (
+				SELECT 1 FROM state.blob_outer_proof op
+				WHERE b.blob_inner_num >= op.blob_outer_num AND b.blob_inner_num <= op.blob_outer_num_final
+			)
+		ORDER BY b.blob_inner_num ASC LIMIT 1`
+
+	e := p.getExecQuerier(dbTx)
+	row := e.QueryRow(ctx, getBlobInnerToProveSQL, lastVerfiedBatchNumber, maxL1Block)
+	blobInner, err := scanBlobInner(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, state.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return blobInner, nil
+}
+
+// GetBlobInnerBatchRange returns the first and last batch numbers included in
+// the provided blob inner
+func (p *PostgresStorage) GetBlobInnerBatchRange(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx) (uint64, uint64, error) {
+	const getBlobInnerBatchRangeSQL = "SELECT MIN(batch_num), MAX(batch_num) FROM state.virtual_batch WHERE blob_inner_num = $1"
+
+	var batchNumber, batchNumberFinal *uint64
+	e := p.getExecQuerier(dbTx)
+	err := e.QueryRow(ctx, getBlobInnerBatchRangeSQL, blobInnerNum).Scan(&batchNumber, &batchNumberFinal)
+	if err != nil {
+		return 0, 0, err
+	}
+	if batchNumber == nil || batchNumberFinal == nil {
+		return 0, 0, state.ErrNotFound
+	}
+	return *batchNumber, *batchNumberFinal, nil
+}
+
+func scanBlobInner(row pgx.Row) (*state.BlobInner, error) {
+	var (
+		blobInner            state.BlobInner
+		blobType             uint8
+		maxSequenceTimestamp *time.Time
+		coinbase             string
+		blobStateRoot        string
+		blobAccInputHash     string
+	)
+	err := row.Scan(
+		&blobInner.BlobInnerNum,
+		&blobInner.BlobSequenceIndex,
+		&blobType,
+		&maxSequenceTimestamp,
+		&blobInner.ZkGasLimit,
+		&blobInner.L1InfoLeafIndex,
+		&coinbase,
+		&blobInner.PointZ,
+		&blobInner.PointY,
+		&blobInner.Data,
+		&blobStateRoot,
+		&blobAccInputHash,
+		&blobInner.BlockNumber,
+	)
+	if err != nil {
+		return nil, err
+	}
+	blobInner.Type = state.BlobType(blobType)
+	if maxSequenceTimestamp != nil {
+		blobInner.MaxSequenceTimestamp = *maxSequenceTimestamp
+	}
+	blobInner.Coinbase = common.HexToAddress(coinbase)
+	blobInner.BlobStateRoot = common.HexToHash(blobStateRoot)
+	blobInner.BlobAccInputHash = common.HexToHash(blobAccInputHash)
+	return &blobInner, nil
+}
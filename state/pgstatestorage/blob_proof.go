@@ -0,0 +1,262 @@
+package pgstatestorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/jackc/pgx/v4"
+)
+
+// AddBlobInnerProof adds a blob inner proof to the storage
+func (p *PostgresStorage) AddBlobInnerProof(ctx context.Context, proof *state.BlobInnerProof, dbTx pgx.Tx) error {
+	const addBlobInnerProofSQL = "INSERT INTO state.blob_inner_proof (blob_inner_num, proof, proof_id, input_prover, prover, prover_id, generating_since, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)"
+	e := p.getExecQuerier(dbTx)
+	now := time.Now().UTC().Round(time.Microsecond)
+	_, err := e.Exec(ctx, addBlobInnerProofSQL, proof.BlobInnerNumber, proof.Proof, proof.ProofID, proof.InputProver, proof.Prover, proof.ProverID, proof.GeneratingSince, now, now)
+	return err
+}
+
+// UpdateBlobInnerProof updates a blob inner proof in the storage
+func (p *PostgresStorage) UpdateBlobInnerProof(ctx context.Context, proof *state.BlobInnerProof, dbTx pgx.Tx) error {
+	const updateBlobInnerProofSQL = "UPDATE state.blob_inner_proof SET proof = $2, proof_id = $3, input_prover = $4, prover = $5, prover_id = $6, generating_since = $7, updated_at = $8 WHERE blob_inner_num = $1"
+	e := p.getExecQuerier(dbTx)
+	now := time.Now().UTC().Round(time.Microsecond)
+	_, err := e.Exec(ctx, updateBlobInnerProofSQL, proof.BlobInnerNumber, proof.Proof, proof.ProofID, proof.InputProver, proof.Prover, proof.ProverID, proof.GeneratingSince, now)
+	return err
+}
+
+// DeleteBlobInnerProof deletes from the storage the blob inner proof of the provided blob inner
+func (p *PostgresStorage) DeleteBlobInnerProof(ctx context.Context, blobInnerNumber uint64, dbTx pgx.Tx) error {
+	const deleteBlobInnerProofSQL = "DELETE FROM state.blob_inner_proof WHERE blob_inner_num = $1"
+	e := p.getExecQuerier(dbTx)
+	_, err := e.Exec(ctx, deleteBlobInnerProofSQL, blobInnerNumber)
+	return err
+}
+
+// GetProofsToBlobOuter returns the next batch proof and blob inner proof that
+// can be used to generate a blob outer proof. The batch proof must cover all
+// the batches of the blob inner.
+func (p *PostgresStorage) GetProofsToBlobOuter(ctx context.Context, dbTx pgx.Tx) (*state.Proof, *state.BlobInnerProof, error) {
+	var (
+		batchProof     *state.Proof          = &state.Proof{}
+		blobInnerProof *state.BlobInnerProof = &state.BlobInnerProof{}
+	)
+
+	const getProofsToBlobOuterSQL = `
+		SELECT
+			p.batch_num,
+			p.batch_num_final,
+			p.proof,
+			p.proof_id,
+			p.input_prover,
+			p.prover,
+			p.prover_id,
+			p.generating_since,
+			p.created_at,
+			p.updated_at,
+			ip.blob_inner_num,
+			ip.proof,
+			ip.proof_id,
+			ip.input_prover,
+			ip.prover,
+			ip.prover_id,
+			ip.generating_since,
+			ip.created_at,
+			ip.updated_at
+		FROM state.blob_inner_proof ip INNER JOIN state.batch_proof p ON p.blob_inner_num = ip.blob_inner_num
+		WHERE ip.generating_since IS NULL AND p.generating_since IS NULL AND
+			  ip.proof IS NOT NULL AND p.proof IS NOT NULL AND
+			  p.batch_num = (SELECT MIN(v.batch_num) FROM state.virtual_batch v WHERE v.blob_inner_num = ip.blob_inner_num) AND
+			  p.batch_num_final = (SELECT MAX(v.batch_num) FROM state.virtual_batch v WHERE v.blob_inner_num = ip.blob_inner_num)
+		ORDER BY ip.blob_inner_num ASC
+		LIMIT 1
+		`
+
+	e := p.getExecQuerier(dbTx)
+	row := e.QueryRow(ctx, getProofsToBlobOuterSQL)
+	err := row.Scan(
+		&batchProof.BatchNumber, &batchProof.BatchNumberFinal, &batchProof.Proof, &batchProof.ProofID, &batchProof.InputProver, &batchProof.Prover, &batchProof.ProverID, &batchProof.GeneratingSince, &batchProof.CreatedAt, &batchProof.UpdatedAt,
+		&blobInnerProof.BlobInnerNumber, &blobInnerProof.Proof, &blobInnerProof.ProofID, &blobInnerProof.InputProver, &blobInnerProof.Prover, &blobInnerProof.ProverID, &blobInnerProof.GeneratingSince, &blobInnerProof.CreatedAt, &blobInnerProof.UpdatedAt)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, state.ErrNotFound
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	return batchProof, blobInnerProof, nil
+}
+
+// AddBlobOuterProof adds a blob outer proof to the storage
+func (p *PostgresStorage) AddBlobOuterProof(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx) error {
+	const addBlobOuterProofSQL = "INSERT INTO state.blob_outer_proof (blob_outer_num, blob_outer_num_final, proof, proof_id, input_prover, prover, prover_id, generating_since, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)"
+	e := p.getExecQuerier(dbTx)
+	now := time.Now().UTC().Round(time.Microsecond)
+	_, err := e.Exec(ctx, addBlobOuterProofSQL, proof.BlobOuterNumber, proof.BlobOuterNumberFinal, proof.Proof, proof.ProofID, proof.InputProver, proof.Prover, proof.ProverID, proof.GeneratingSince, now, now)
+	return err
+}
+
+// UpdateBlobOuterProof updates a blob outer proof in the storage
+func (p *PostgresStorage) UpdateBlobOuterProof(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx) error {
+	const updateBlobOuterProofSQL = "UPDATE state.blob_outer_proof SET proof = $3, proof_id = $4, input_prover = $5, prover = $6, prover_id = $7, generating_since = $8, updated_at = $9 WHERE blob_outer_num = $1 AND blob_outer_num_final = $2"
+	e := p.getExecQuerier(dbTx)
+	now := time.Now().UTC().Round(time.Microsecond)
+	_, err := e.Exec(ctx, updateBlobOuterProofSQL, proof.BlobOuterNumber, proof.BlobOuterNumberFinal, proof.Proof, proof.ProofID, proof.InputProver, proof.Prover, proof.ProverID, proof.GeneratingSince, now)
+	return err
+}
+
+// DeleteBlobOuterProofs deletes from the storage the blob outer proofs falling inside the blob outer numbers range.
+func (p *PostgresStorage) DeleteBlobOuterProofs(ctx context.Context, blobOuterNumber uint64, blobOuterNumberFinal uint64, dbTx pgx.Tx) error {
+	const deleteBlobOuterProofsSQL = "DELETE FROM state.blob_outer_proof WHERE blob_outer_num >= $1 AND blob_outer_num_final <= $2"
+	e := p.getExecQuerier(dbTx)
+	_, err := e.Exec(ctx, deleteBlobOuterProofsSQL, blobOuterNumber, blobOuterNumberFinal)
+	return err
+}
+
+// blobOuterProofFields returns the fields to read a blob outer proof aliased
+// as the provided name. The batch range is computed from the virtual batches
+// of the first and last blob inners covered by the proof.
+func blobOuterProofFields(alias string) string {
+	return fmt.Sprintf(`
+		%[1]s.blob_outer_num,
+		%[1]s.blob_outer_num_final,
+		COALESCE((SELECT MIN(v.batch_num) FROM state.virtual_batch v WHERE v.blob_inner_num = %[1]s.blob_outer_num), 0),
+		COALESCE((SELECT MAX(v.batch_num) FROM state.virtual_batch v WHERE v.blob_inner_num = %[1]s.blob_outer_num_final), 0),
+		%[1]s.proof,
+		%[1]s.proof_id,
+		%[1]s.input_prover,
+		%[1]s.prover,
+		%[1]s.prover_id,
+		%[1]s.generating_since,
+		%[1]s.created_at,
+		%[1]s.updated_at`, alias)
+}
+
+func blobOuterProofScanArgs(proof *state.BlobOuterProof) []interface{} {
+	return []interface{}{
+		&proof.BlobOuterNumber, &proof.BlobOuterNumberFinal, &proof.BatchNumber, &proof.BatchNumberFinal,
+		&proof.Proof, &proof.ProofID, &proof.InputProver, &proof.Prover, &proof.ProverID,
+		&proof.GeneratingSince, &proof.CreatedAt, &proof.UpdatedAt,
+	}
+}
+
+// GetBlobOuterProofsToAggregate return the next 2 blob outer proofs that it are possible to aggregate
+func (p *PostgresStorage) GetBlobOuterProofsToAggregate(ctx context.Context, dbTx pgx.Tx) (*state.BlobOuterProof, *state.BlobOuterProof, error) {
+	var (
+		proof1 *state.BlobOuterProof = &state.BlobOuterProof{}
+		proof2 *state.BlobOuterProof = &state.BlobOuterProof{}
+	)
+
+	getBlobOuterProofsToAggregateSQL := `
+		SELECT ` + blobOuterProofFields("p1") + `, ` + blobOuterProofFields("p2") + `
+		FROM state.blob_outer_proof p1 INNER JOIN state.blob_outer_proof p2 ON p1.blob_outer_num_final = p2.blob_outer_num - 1
+		WHERE p1.generating_since IS NULL AND p2.generating_since IS NULL AND
+			  p1.proof IS NOT NULL AND p2.proof IS NOT NULL
+		ORDER BY p1.blob_outer_num ASC
+		LIMIT 1
+		`
+
+	e := p.getExecQuerier(dbTx)
+	row := e.QueryRow(ctx, getBlobOuterProofsToAggregateSQL)
+	err := row.Scan(append(blobOuterProofScanArgs(proof1), blobOuterProofScanArgs(proof2)...)...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, state.ErrNotFound
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	return proof1, proof2, nil
+}
+
+// GetBlobOuterProofReadyForFinal return the blob outer proof that is ready to generate the final proof
+func (p *PostgresStorage) GetBlobOuterProofReadyForFinal(ctx context.Context, lastVerfiedBatchNumber uint64, dbTx pgx.Tx) (*state.BlobOuterProof, error) {
+	getBlobOuterProofReadyForFinalSQL := `
+		SELECT ` + blobOuterProofFields("p") + `
+		FROM state.blob_outer_proof p
+		WHERE p.generating_since IS NULL AND
+			(SELECT MIN(v.batch_num) FROM state.virtual_batch v WHERE v.blob_inner_num = p.blob_outer_num) = $1 AND
+			EXISTS (SELECT 1 FROM state.blob_sequence s1 WHERE s1.first_blob_sequenced = p.blob_outer_num) AND
+			EXISTS (SELECT 1 FROM state.blob_sequence s2 WHERE s2.last_blob_sequenced = p.blob_outer_num_final)
+		`
+
+	var proof *state.BlobOuterProof = &state.BlobOuterProof{}
+
+	e := p.getExecQuerier(dbTx)
+	row := e.QueryRow(ctx, getBlobOuterProofReadyForFinalSQL, lastVerfiedBatchNumber+1)
+	err := row.Scan(blobOuterProofScanArgs(proof)...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, state.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+// CheckBlobOuterProofContainsCompleteSequences checks if a blob outer proof contains complete blob sequences
+func (p *PostgresStorage) CheckBlobOuterProofContainsCompleteSequences(ctx context.Context, proof *state.BlobOuterProof, dbTx pgx.Tx) (bool, error) {
+	const getBlobOuterProofContainsCompleteSequencesSQL = `
+		SELECT EXISTS (SELECT 1 FROM state.blob_sequence s1 WHERE s1.first_blob_sequenced = $1) AND
+			   EXISTS (SELECT 1 FROM state.blob_sequence s2 WHERE s2.last_blob_sequenced = $2)
+		`
+	e := p.getExecQuerier(dbTx)
+	var exists bool
+	err := e.QueryRow(ctx, getBlobOuterProofContainsCompleteSequencesSQL, proof.BlobOuterNumber, proof.BlobOuterNumberFinal).Scan(&exists)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return exists, err
+	}
+	return exists, nil
+}
+
+// CleanupBlobProofs deletes from the storage the blob inner and blob outer
+// proofs whose batches are all below or equal to the specified batch number.
+func (p *PostgresStorage) CleanupBlobProofs(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) error {
+	const deleteBlobInnerProofsSQL = `
+		DELETE FROM state.blob_inner_proof ip
+		WHERE NOT EXISTS (SELECT 1 FROM state.virtual_batch v WHERE v.blob_inner_num = ip.blob_inner_num AND v.batch_num > $1)`
+	const deleteBlobOuterProofsSQL = `
+		DELETE FROM state.blob_outer_proof op
+		WHERE NOT EXISTS (SELECT 1 FROM state.virtual_batch v WHERE v.blob_inner_num = op.blob_outer_num_final AND v.batch_num > $1)`
+	e := p.getExecQuerier(dbTx)
+	if _, err := e.Exec(ctx, deleteBlobInnerProofsSQL, batchNumber); err != nil {
+		return err
+	}
+	_, err := e.Exec(ctx, deleteBlobOuterProofsSQL, batchNumber)
+	return err
+}
+
+// CleanupLockedBlobProofs deletes from the storage the blob inner and blob
+// outer proofs locked in generating state for more than the provided threshold.
+func (p *PostgresStorage) CleanupLockedBlobProofs(ctx context.Context, duration string, dbTx pgx.Tx) (int64, error) {
+	interval, err := toPostgresInterval(duration)
+	if err != nil {
+		return 0, err
+	}
+	e := p.getExecQuerier(dbTx)
+	var rowsAffected int64
+	for _, table := range []string{"state.blob_inner_proof", "state.blob_outer_proof"} {
+		sql := fmt.Sprintf("DELETE FROM %s WHERE generating_since < (NOW() - interval '%s')", table, interval)
+		ct, err := e.Exec(ctx, sql)
+		if err != nil {
+			return 0, err
+		}
+		rowsAffected += ct.RowsAffected()
+	}
+	return rowsAffected, nil
+}
+
+// DeleteUngeneratedBlobProofs deletes ungenerated blob inner and blob outer
+// proofs. This method is meant to be use during aggregator boot-up sequence
+func (p *PostgresStorage) DeleteUngeneratedBlobProofs(ctx context.Context, dbTx pgx.Tx) error {
+	const deleteUngeneratedBlobInnerProofsSQL = "DELETE FROM state.blob_inner_proof WHERE generating_since IS NOT NULL"
+	const deleteUngeneratedBlobOuterProofsSQL = "DELETE FROM state.blob_outer_proof WHERE generating_since IS NOT NULL"
+	e := p.getExecQuerier(dbTx)
+	if _, err := e.Exec(ctx, deleteUngeneratedBlobInnerProofsSQL); err != nil {
+		return err
+	}
+	_, err := e.Exec(ctx, deleteUngeneratedBlobOuterProofsSQL)
+	return err
+}
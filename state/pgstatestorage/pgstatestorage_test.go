@@ -528,6 +528,41 @@ func TestCleanupLockedProofs(t *testing.T) {
 	assert.Contains(proofs, newerProof)
 }
 
+func TestUnlockBatchProofs(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	initOrResetDB()
+	ctx := context.Background()
+	batchNumber := uint64(42)
+	_, err = testState.Exec(ctx, "INSERT INTO state.batch (batch_num,wip) VALUES ($1, FALSE), ($2, FALSE), ($3, FALSE)", batchNumber, batchNumber+1, batchNumber+2)
+	require.NoError(err)
+
+	now := time.Now().Round(time.Microsecond)
+	// generated proof locked to be aggregated
+	lockedProof := &state.Proof{BatchNumber: batchNumber, BatchNumberFinal: batchNumber, Proof: "proof", GeneratingSince: &now}
+	// proof still being generated
+	generatingProof := &state.Proof{BatchNumber: batchNumber + 1, BatchNumberFinal: batchNumber + 1, GeneratingSince: &now}
+	// generated proof not locked
+	generatedProof := &state.Proof{BatchNumber: batchNumber + 2, BatchNumberFinal: batchNumber + 2, Proof: "proof"}
+	for _, proof := range []*state.Proof{lockedProof, generatingProof, generatedProof} {
+		require.NoError(testState.AddBatchProof(ctx, proof, nil))
+	}
+
+	n, err := testState.UnlockBatchProofs(ctx, batchNumber, batchNumber+2, nil)
+	require.NoError(err)
+	assert.Equal(int64(1), n)
+
+	proofs, err := testState.GetBatchProofs(ctx, nil)
+	require.NoError(err)
+	require.Len(proofs, 3)
+	assert.Equal(batchNumber, proofs[0].BatchNumber)
+	assert.Nil(proofs[0].GeneratingSince)
+	assert.Equal(batchNumber+1, proofs[1].BatchNumber)
+	assert.NotNil(proofs[1].GeneratingSince)
+	assert.Equal(batchNumber+2, proofs[2].BatchNumber)
+	assert.Nil(proofs[2].GeneratingSince)
+}
+
 func TestVirtualBatch(t *testing.T) {
 	initOrResetDB()
 
@@ -1255,6 +1290,129 @@ func TestGetLogsByBlockNumber(t *testing.T) {
 	}
 }
 
+func TestGetReceipts(t *testing.T) {
+	initOrResetDB()
+
+	ctx := context.Background()
+	dbTx, err := testState.BeginStateTransaction(ctx)
+	require.NoError(t, err)
+	err = testState.AddBlock(ctx, block, dbTx)
+	assert.NoError(t, err)
+
+	batchNumber := uint64(1)
+	_, err = testState.Exec(ctx, "INSERT INTO state.batch (batch_num, wip) VALUES ($1, FALSE)", batchNumber)
+	assert.NoError(t, err)
+
+	time := time.Now()
+	nonce := uint64(0)
+
+	// logs per tx of each block, the second tx of the first block has no logs
+	logsPerTx := [][]int{{2, 0}, {1}}
+	for b, blockLogs := range logsPerTx {
+		blockNumber := big.NewInt(int64(b) + 1)
+		transactions := make([]*types.Transaction, 0, len(blockLogs))
+		receipts := make([]*types.Receipt, 0, len(blockLogs))
+		stateRoots := make([]common.Hash, 0, len(blockLogs))
+
+		logIndex := uint(0)
+		for txIndex, logsCount := range blockLogs {
+			nonce++
+			tx := types.NewTx(&types.LegacyTx{
+				Nonce:    nonce,
+				To:       nil,
+				Value:    new(big.Int),
+				Gas:      0,
+				GasPrice: big.NewInt(0),
+			})
+
+			logs := []*types.Log{}
+			for l := 0; l < logsCount; l++ {
+				logs = append(logs, &types.Log{TxHash: tx.Hash(), TxIndex: uint(txIndex), Index: logIndex, Topics: []common.Hash{common.HexToHash("0x1")}, Data: []byte{1}})
+				logIndex++
+			}
+
+			receipt := &types.Receipt{
+				Type:              tx.Type(),
+				PostState:         state.ZeroHash.Bytes(),
+				CumulativeGasUsed: 0,
+				EffectiveGasPrice: big.NewInt(0),
+				BlockNumber:       blockNumber,
+				GasUsed:           tx.Gas(),
+				TxHash:            tx.Hash(),
+				TransactionIndex:  uint(txIndex),
+				Status:            types.ReceiptStatusSuccessful,
+				Logs:              logs,
+			}
+
+			transactions = append(transactions, tx)
+			receipts = append(receipts, receipt)
+			stateRoots = append(stateRoots, state.ZeroHash)
+		}
+
+		header := state.NewL2Header(&types.Header{
+			Number:     blockNumber,
+			ParentHash: state.ZeroHash,
+			Coinbase:   state.ZeroAddress,
+			Root:       state.ZeroHash,
+			GasUsed:    1,
+			GasLimit:   10,
+			Time:       uint64(time.Unix()),
+		})
+
+		st := trie.NewStackTrie(nil)
+		l2Block := state.NewL2Block(header, transactions, []*state.L2Header{}, receipts, st)
+		for _, receipt := range receipts {
+			receipt.BlockHash = l2Block.Hash()
+		}
+
+		numTxs := len(transactions)
+		storeTxsEGPData := make([]state.StoreTxEGPData, numTxs)
+		txsL2Hash := make([]common.Hash, numTxs)
+		for i := range transactions {
+			storeTxsEGPData[i] = state.StoreTxEGPData{EGPLog: nil, EffectivePercentage: state.MaxEffectivePercentage}
+			txsL2Hash[i] = common.HexToHash(fmt.Sprintf("0x%d%d", b, i))
+		}
+
+		err = testState.AddL2Block(ctx, batchNumber, l2Block, receipts, txsL2Hash, storeTxsEGPData, stateRoots, dbTx)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, dbTx.Commit(ctx))
+
+	receipts, txs, err := testState.GetReceiptsByL2BlockNumber(ctx, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	require.Len(t, txs, 2)
+	for i, receipt := range receipts {
+		assert.Equal(t, txs[i].Hash(), receipt.TxHash)
+		assert.Equal(t, uint(i), receipt.TransactionIndex)
+		assert.Equal(t, uint64(1), receipt.BlockNumber.Uint64())
+		assert.Len(t, receipt.Logs, logsPerTx[0][i])
+		for _, log := range receipt.Logs {
+			assert.Equal(t, receipt.TxHash, log.TxHash)
+			assert.Equal(t, receipt.BlockHash, log.BlockHash)
+			assert.Equal(t, []byte{1}, log.Data)
+			assert.Equal(t, []common.Hash{common.HexToHash("0x1")}, log.Topics)
+		}
+
+		expectedReceipt, err := testState.GetTransactionReceipt(ctx, receipt.TxHash, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expectedReceipt.Bloom, receipt.Bloom)
+	}
+
+	receipts, txs, err = testState.GetReceiptsByBatchNumber(ctx, batchNumber, nil)
+	require.NoError(t, err)
+	require.Len(t, receipts, 3)
+	require.Len(t, txs, 3)
+	assert.Equal(t, uint64(2), receipts[2].BlockNumber.Uint64())
+	assert.Len(t, receipts[2].Logs, 1)
+
+	receipts, txs, err = testState.GetReceiptsByL2BlockNumber(ctx, 3, nil)
+	require.NoError(t, err)
+	assert.Empty(t, receipts)
+	assert.Empty(t, txs)
+}
+
 func TestGetNativeBlockHashesInRange(t *testing.T) {
 	initOrResetDB()
 
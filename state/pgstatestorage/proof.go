@@ -40,7 +40,7 @@ func (p *PostgresStorage) GetProofReadyForFinal(ctx context.Context, lastVerfied
 			p.created_at,
 			p.updated_at
 		FROM state.batch_proof p
-		WHERE batch_num = $1 AND generating_since IS NULL AND
+		WHERE batch_num = $1 AND generating_since IS NULL AND blob_inner_num IS NULL AND
 			EXISTS (SELECT 1 FROM state.sequences s1 WHERE s1.from_batch_num = p.batch_num) AND
 			EXISTS (SELECT 1 FROM state.sequences s2 WHERE s2.to_batch_num = p.batch_num_final)		
 		`
@@ -91,7 +91,7 @@ func (p *PostgresStorage) GetBatchProofsToAggregate(ctx context.Context, dbTx pg
 			p2.created_at as p2_created_at,
 			p2.updated_at as p2_updated_at
 		FROM state.batch_proof p1 INNER JOIN state.batch_proof p2 ON p1.batch_num_final = p2.batch_num - 1
-		WHERE p1.blob_inner_num = p2.blob_inner_num AND
+		WHERE p1.blob_inner_num IS NOT DISTINCT FROM p2.blob_inner_num AND
 			  p1.generating_since IS NULL AND p2.generating_since IS NULL AND 
 		 	  p1.proof IS NOT NULL AND p2.proof IS NOT NULL
 		ORDER BY p1.batch_num ASC
@@ -115,7 +115,9 @@ func (p *PostgresStorage) GetBatchProofsToAggregate(ctx context.Context, dbTx pg
 
 // AddBatchProof adds a batch proof to the storage
 func (p *PostgresStorage) AddBatchProof(ctx context.Context, proof *state.Proof, dbTx pgx.Tx) error {
-	const addBatchProofSQL = "INSERT INTO state.batch_proof (batch_num, batch_num_final, proof, proof_id, input_prover, prover, prover_id, generating_since, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)"
+	const addBatchProofSQL = `
+		INSERT INTO state.batch_proof (batch_num, batch_num_final, proof, proof_id, input_prover, prover, prover_id, generating_since, created_at, updated_at, blob_inner_num)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, (SELECT v.blob_inner_num FROM state.virtual_batch v WHERE v.batch_num = $1))`
 	e := p.getExecQuerier(dbTx)
 	now := time.Now().UTC().Round(time.Microsecond)
 	_, err := e.Exec(ctx, addBatchProofSQL, proof.BatchNumber, proof.BatchNumberFinal, proof.Proof, proof.ProofID, proof.InputProver, proof.Prover, proof.ProverID, proof.GeneratingSince, now, now)
@@ -170,6 +172,58 @@ func (p *PostgresStorage) DeleteUngeneratedBatchProofs(ctx context.Context, dbTx
 	return err
 }
 
+// GetBatchProofs returns all the batch proofs in the storage, ordered by batch number
+func (p *PostgresStorage) GetBatchProofs(ctx context.Context, dbTx pgx.Tx) ([]*state.Proof, error) {
+	const getBatchProofsSQL = `
+		SELECT
+			p.batch_num,
+			p.batch_num_final,
+			p.proof,
+			p.proof_id,
+			p.input_prover,
+			p.prover,
+			p.prover_id,
+			p.generating_since,
+			p.created_at,
+			p.updated_at
+		FROM state.batch_proof p
+		ORDER BY p.batch_num ASC, p.batch_num_final ASC
+		`
+	e := p.getExecQuerier(dbTx)
+	rows, err := e.Query(ctx, getBatchProofsSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	proofs := make([]*state.Proof, 0)
+	for rows.Next() {
+		proof := &state.Proof{}
+		err := rows.Scan(&proof.BatchNumber, &proof.BatchNumberFinal, &proof.Proof, &proof.ProofID, &proof.InputProver, &proof.Prover, &proof.ProverID, &proof.GeneratingSince, &proof.CreatedAt, &proof.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		proofs = append(proofs, proof)
+	}
+
+	return proofs, rows.Err()
+}
+
+// UnlockBatchProofs releases from the generating state the already generated batch proofs
+// falling inside the batch numbers range, it returns the number of proofs released.
+func (p *PostgresStorage) UnlockBatchProofs(ctx context.Context, batchNumber uint64, batchNumberFinal uint64, dbTx pgx.Tx) (int64, error) {
+	const unlockBatchProofsSQL = `
+		UPDATE state.batch_proof SET generating_since = NULL, updated_at = $3
+		WHERE batch_num >= $1 AND batch_num_final <= $2 AND generating_since IS NOT NULL AND proof <> ''`
+	e := p.getExecQuerier(dbTx)
+	now := time.Now().UTC().Round(time.Microsecond)
+	ct, err := e.Exec(ctx, unlockBatchProofsSQL, batchNumber, batchNumberFinal, now)
+	if err != nil {
+		return 0, err
+	}
+	return ct.RowsAffected(), nil
+}
+
 func toPostgresInterval(duration string) (string, error) {
 	unit := duration[len(duration)-1]
 	var pgUnit string
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
 
+	"github.com/0xPolygonHermez/zkevm-node/encoding"
 	"github.com/0xPolygonHermez/zkevm-node/hex"
 	"github.com/0xPolygonHermez/zkevm-node/state"
 	"github.com/ethereum/go-ethereum/common"
@@ -317,6 +319,174 @@ func (p *PostgresStorage) GetTransactionReceipt(ctx context.Context, transaction
 	return &receipt, nil
 }
 
+// GetReceiptsByL2BlockNumber gets the receipts, including their logs, and the txs
+// of the provided l2 block, ordered by tx index
+func (p *PostgresStorage) GetReceiptsByL2BlockNumber(ctx context.Context, blockNumber uint64, dbTx pgx.Tx) ([]*types.Receipt, []types.Transaction, error) {
+	return p.getReceipts(ctx, "t.l2_block_num = $1", blockNumber, dbTx)
+}
+
+// GetReceiptsByBatchNumber gets the receipts, including their logs, and the txs
+// of all the l2 blocks of the provided batch, ordered by l2 block and tx index
+func (p *PostgresStorage) GetReceiptsByBatchNumber(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) ([]*types.Receipt, []types.Transaction, error) {
+	return p.getReceipts(ctx, "b.batch_num = $1", batchNumber, dbTx)
+}
+
+// GetBatchesFees gets the sum of the fees paid by the txs of the batches in the
+// provided range, both included. The fee of each tx is the gas used by the tx
+// multiplied by its effective gas price
+func (p *PostgresStorage) GetBatchesFees(ctx context.Context, fromBatchNumber, toBatchNumber uint64, dbTx pgx.Tx) (*big.Int, error) {
+	const getBatchesFeesSQL = `
+		SELECT COALESCE(SUM(r.gas_used::NUMERIC * r.effective_gas_price::NUMERIC), 0)::TEXT
+		  FROM state.receipt r
+		 INNER JOIN state.transaction t
+		    ON t.hash = r.tx_hash
+		 INNER JOIN state.l2block b
+		    ON b.block_num = t.l2_block_num
+		 WHERE b.batch_num >= $1 AND b.batch_num <= $2`
+
+	var fees string
+	q := p.getExecQuerier(dbTx)
+	err := q.QueryRow(ctx, getBatchesFeesSQL, fromBatchNumber, toBatchNumber).Scan(&fees)
+	if err != nil {
+		return nil, err
+	}
+
+	result, ok := new(big.Int).SetString(fees, encoding.Base10)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse the fees of the batches %d-%d: %s", fromBatchNumber, toBatchNumber, fees)
+	}
+	return result, nil
+}
+
+// getReceipts loads with a single query the receipts, the logs and the txs
+// matching the provided condition, the receipts without logs are included
+// thanks to the left join, so their log columns are null
+func (p *PostgresStorage) getReceipts(ctx context.Context, condition string, arg interface{}, dbTx pgx.Tx) ([]*types.Receipt, []types.Transaction, error) {
+	const getReceiptsSQL = `
+		SELECT
+			r.tx_index,
+			r.tx_hash,
+			r.type,
+			r.post_state,
+			r.status,
+			r.cumulative_gas_used,
+			r.gas_used,
+			r.contract_address,
+			r.effective_gas_price,
+			t.encoded,
+			t.l2_block_num,
+			b.block_hash,
+			l.log_index,
+			l.address,
+			l.data,
+			l.topic0,
+			l.topic1,
+			l.topic2,
+			l.topic3
+		  FROM state.receipt r
+		 INNER JOIN state.transaction t
+		    ON t.hash = r.tx_hash
+		 INNER JOIN state.l2block b
+		    ON b.block_num = t.l2_block_num
+		  LEFT JOIN state.log l
+		    ON l.tx_hash = r.tx_hash
+		 WHERE %s
+		 ORDER BY t.l2_block_num ASC, r.tx_index ASC, l.log_index ASC`
+
+	q := p.getExecQuerier(dbTx)
+	rows, err := q.Query(ctx, fmt.Sprintf(getReceiptsSQL, condition), arg)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	receipts := []*types.Receipt{}
+	txs := []types.Transaction{}
+	var receipt *types.Receipt
+	for rows.Next() {
+		var (
+			txIndex                                             uint
+			txHash, encodedTx, contractAddress, l2BlockHash     string
+			receiptType                                         uint8
+			postState                                           []byte
+			status, cumulativeGasUsed, gasUsed, l2BlockNum      uint64
+			effectiveGasPrice                                   *uint64
+			logIndex                                            *uint
+			logAddress, logData, topic0, topic1, topic2, topic3 *string
+		)
+		err := rows.Scan(&txIndex, &txHash, &receiptType, &postState, &status, &cumulativeGasUsed, &gasUsed,
+			&contractAddress, &effectiveGasPrice, &encodedTx, &l2BlockNum, &l2BlockHash,
+			&logIndex, &logAddress, &logData, &topic0, &topic1, &topic2, &topic3)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// each receipt is returned once per log, so a new receipt
+		// is only created when the tx hash changes
+		if receipt == nil || receipt.TxHash != common.HexToHash(txHash) {
+			tx, err := state.DecodeTx(encodedTx)
+			if err != nil {
+				return nil, nil, err
+			}
+			receipt = &types.Receipt{
+				Type:              receiptType,
+				PostState:         postState,
+				Status:            status,
+				CumulativeGasUsed: cumulativeGasUsed,
+				TxHash:            common.HexToHash(txHash),
+				ContractAddress:   common.HexToAddress(contractAddress),
+				GasUsed:           gasUsed,
+				BlockHash:         common.HexToHash(l2BlockHash),
+				BlockNumber:       big.NewInt(0).SetUint64(l2BlockNum),
+				TransactionIndex:  txIndex,
+				Logs:              []*types.Log{},
+			}
+			if effectiveGasPrice != nil {
+				receipt.EffectiveGasPrice = big.NewInt(0).SetUint64(*effectiveGasPrice)
+			}
+			receipts = append(receipts, receipt)
+			txs = append(txs, *tx)
+		}
+
+		if logIndex == nil {
+			continue
+		}
+
+		log := &types.Log{
+			BlockNumber: l2BlockNum,
+			BlockHash:   receipt.BlockHash,
+			TxHash:      receipt.TxHash,
+			TxIndex:     txIndex,
+			Index:       *logIndex,
+			Topics:      []common.Hash{},
+		}
+		if logAddress != nil {
+			log.Address = common.HexToAddress(*logAddress)
+		}
+		if logData != nil {
+			log.Data, err = hex.DecodeHex(*logData)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		for _, topic := range []*string{topic0, topic1, topic2, topic3} {
+			if topic != nil {
+				log.Topics = append(log.Topics, common.HexToHash(*topic))
+			}
+		}
+		receipt.Logs = append(receipt.Logs, log)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	for _, receipt := range receipts {
+		receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+	}
+
+	return receipts, txs, nil
+}
+
 // GetTransactionByL2BlockHashAndIndex gets a transaction accordingly to the block hash and transaction index provided.
 // since we only have a single transaction per l2 block, any index different from 0 will return a not found result
 func (p *PostgresStorage) GetTransactionByL2BlockHashAndIndex(ctx context.Context, blockHash common.Hash, index uint64, dbTx pgx.Tx) (*types.Transaction, error) {
@@ -630,6 +800,77 @@ func (p *PostgresStorage) GetTransactionEGPLogByHash(ctx context.Context, transa
 	return &egpLog, nil
 }
 
+// GetL2BlocksFeeHistory gets the gas used and the fee data of the txs of the L2 blocks in the
+// provided range, sorted by block number and tx index. The tx is only decoded to get its gas
+// price when the receipt doesn't store the effective gas price
+func (p *PostgresStorage) GetL2BlocksFeeHistory(ctx context.Context, fromBlockNumber, toBlockNumber uint64, dbTx pgx.Tx) ([]state.L2BlockFeeHistory, error) {
+	const getL2BlocksFeeHistorySQL = `
+		SELECT b.block_num, b.header->>'gasUsed', b.header->>'gasLimit',
+		       r.gas_used, r.effective_gas_price, COALESCE(t.effective_percentage, 255),
+		       CASE WHEN r.effective_gas_price IS NULL THEN t.encoded END
+		  FROM state.l2block b
+		  LEFT JOIN state.transaction t
+		    ON t.l2_block_num = b.block_num
+		  LEFT JOIN state.receipt r
+		    ON r.tx_hash = t.hash
+		 WHERE b.block_num BETWEEN $1 AND $2
+		 ORDER BY b.block_num ASC, r.tx_index ASC`
+
+	q := p.getExecQuerier(dbTx)
+	rows, err := q.Query(ctx, getL2BlocksFeeHistorySQL, fromBlockNumber, toBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	blocks := make([]state.L2BlockFeeHistory, 0, toBlockNumber-fromBlockNumber+1)
+	for rows.Next() {
+		var (
+			blockNumber         uint64
+			blockGasUsed        string
+			blockGasLimit       string
+			gasUsed             *uint64
+			effectiveGasPrice   *uint64
+			effectivePercentage uint8
+			encoded             *string
+		)
+		if err := rows.Scan(&blockNumber, &blockGasUsed, &blockGasLimit, &gasUsed, &effectiveGasPrice, &effectivePercentage, &encoded); err != nil {
+			return nil, err
+		}
+
+		if len(blocks) == 0 || blocks[len(blocks)-1].BlockNumber != blockNumber {
+			blocks = append(blocks, state.L2BlockFeeHistory{
+				BlockNumber: blockNumber,
+				GasUsed:     hex.DecodeUint64(blockGasUsed),
+				GasLimit:    hex.DecodeUint64(blockGasLimit),
+				TxsFeeInfo:  []state.TxFeeInfo{},
+			})
+		}
+		// a block without txs has a single row with no tx data
+		if gasUsed == nil {
+			continue
+		}
+
+		txFeeInfo := state.TxFeeInfo{
+			GasUsed:             *gasUsed,
+			EffectivePercentage: effectivePercentage,
+		}
+		if effectiveGasPrice != nil {
+			txFeeInfo.EffectiveGasPrice = new(big.Int).SetUint64(*effectiveGasPrice)
+		} else if encoded != nil {
+			tx, err := state.DecodeTx(*encoded)
+			if err != nil {
+				return nil, err
+			}
+			txFeeInfo.EffectiveGasPrice = tx.GasPrice()
+		}
+		block := &blocks[len(blocks)-1]
+		block.TxsFeeInfo = append(block.TxsFeeInfo, txFeeInfo)
+	}
+
+	return blocks, nil
+}
+
 // GetL2TxHashByTxHash gets the L2 Hash from the tx found by the provided tx hash
 func (p *PostgresStorage) GetL2TxHashByTxHash(ctx context.Context, hash common.Hash, dbTx pgx.Tx) (*common.Hash, error) {
 	const getTransactionByHashSQL = "SELECT transaction.l2_hash FROM state.transaction WHERE hash = $1"
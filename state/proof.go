@@ -20,3 +20,45 @@ type Proof struct {
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 }
+
+// BlobInnerProof struct
+type BlobInnerProof struct {
+	BlobInnerNumber uint64
+	Proof           string
+	InputProver     string
+	ProofID         *string
+	// Prover name, unique identifier across prover reboots.
+	Prover *string
+	// ProverID prover process identifier.
+	ProverID *string
+	// GeneratingSince holds the timestamp for the moment in which the
+	// proof generation has started by a prover. Nil if the proof is not
+	// currently generating.
+	GeneratingSince *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// BlobOuterProof struct
+type BlobOuterProof struct {
+	BlobOuterNumber      uint64
+	BlobOuterNumberFinal uint64
+	// BatchNumber and BatchNumberFinal are the first and last batches
+	// included in the blob inners covered by the proof. They are not
+	// stored, but computed from the virtual batches when the proof is read.
+	BatchNumber      uint64
+	BatchNumberFinal uint64
+	Proof            string
+	InputProver      string
+	ProofID          *string
+	// Prover name, unique identifier across prover reboots.
+	Prover *string
+	// ProverID prover process identifier.
+	ProverID *string
+	// GeneratingSince holds the timestamp for the moment in which the
+	// proof generation has started by a prover. Nil if the proof is not
+	// currently generating.
+	GeneratingSince *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
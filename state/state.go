@@ -109,6 +109,15 @@ func (s *State) GetStorageAt(ctx context.Context, address common.Address, positi
 	return s.tree.GetStorageAt(ctx, address, position, root.Bytes())
 }
 
+// GetProof returns the proofs of the balance, nonce, code hash, code length and
+// the provided storage positions of an account for the provided state root
+func (s *State) GetProof(ctx context.Context, address common.Address, storagePositions []*big.Int, root common.Hash) (*merkletree.AccountProof, error) {
+	if s.tree == nil {
+		return nil, ErrStateTreeNil
+	}
+	return s.tree.GetAccountProof(ctx, address, storagePositions, root.Bytes())
+}
+
 // GetLastStateRoot returns the latest state root
 func (s *State) GetLastStateRoot(ctx context.Context, dbTx pgx.Tx) (common.Hash, error) {
 	lastBlockHeader, err := s.GetLastL2BlockHeader(ctx, dbTx)
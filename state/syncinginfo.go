@@ -20,6 +20,10 @@ type SyncingInfo struct {
 	CurrentBlockNumber    uint64 // last L2Block in state
 	EstimatedHighestBlock uint64 // estimated highest L2Block in state
 
+	LastBatchNumber             uint64 // last batch in state
+	LastBatchNumberSeen         uint64 // last batch sequenced on L1
+	LastBatchNumberConsolidated uint64 // last batch verified on L1
+
 	// IsSynchronizing indicates if the node is syncing (true -> syncing, false -> fully synced)
 	IsSynchronizing bool
 }
@@ -57,6 +61,10 @@ func (p *State) GetSyncingInfo(ctx context.Context, dbTx pgx.Tx) (SyncingInfo, e
 		return SyncingInfo{}, err
 	}
 
+	info.LastBatchNumber = lastBatchNumber
+	info.LastBatchNumberSeen = syncData.LastBatchNumberSeen
+	info.LastBatchNumberConsolidated = syncData.LastBatchNumberConsolidated
+
 	info.IsSynchronizing = syncData.LastBatchNumberSeen > lastBatchNumber
 	if info.IsSynchronizing {
 		// Estimation of block counting 1 l2block per missing batch
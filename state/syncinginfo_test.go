@@ -86,9 +86,12 @@ func TestGetSyncingInfoOk(t *testing.T) {
 	res, err := testState.GetSyncingInfo(ctx, dbTx)
 	require.NoError(t, err)
 	require.Equal(t, state.SyncingInfo{
-		InitialSyncingBlock:   uint64(123),
-		CurrentBlockNumber:    uint64(567),
-		EstimatedHighestBlock: uint64(597),
-		IsSynchronizing:       true,
+		InitialSyncingBlock:         uint64(123),
+		CurrentBlockNumber:          uint64(567),
+		EstimatedHighestBlock:       uint64(597),
+		LastBatchNumber:             uint64(12),
+		LastBatchNumberSeen:         uint64(50),
+		LastBatchNumberConsolidated: uint64(42),
+		IsSynchronizing:             true,
 	}, res)
 }
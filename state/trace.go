@@ -172,32 +172,7 @@ func (s *State) DebugTransaction(ctx context.Context, transactionHash common.Has
 		}
 		response = convertedResponse.BlockResponses[0].TransactionResponses[0]
 	} else {
-		traceConfigRequestV2 := &executor.TraceConfigV2{
-			TxHashToGenerateFullTrace: transactionHash.Bytes(),
-			// set the defaults to the maximum information we can have.
-			// this is needed to process custom tracers later
-			DisableStorage:   cFalse,
-			DisableStack:     cFalse,
-			EnableMemory:     cTrue,
-			EnableReturnData: cTrue,
-		}
-
-		// if the default tracer is used, then we review the information
-		// we want to have in the trace related to the parameters we received.
-		if traceConfig.IsDefaultTracer() {
-			if traceConfig.DisableStorage {
-				traceConfigRequestV2.DisableStorage = cTrue
-			}
-			if traceConfig.DisableStack {
-				traceConfigRequestV2.DisableStack = cTrue
-			}
-			if !traceConfig.EnableMemory {
-				traceConfigRequestV2.EnableMemory = cFalse
-			}
-			if !traceConfig.EnableReturnData {
-				traceConfigRequestV2.EnableReturnData = cFalse
-			}
-		}
+		traceConfigRequestV2 := newTraceConfigV2(transactionHash, traceConfig)
 
 		// if the l2 block number is 1, it means this is a network that started
 		// at least on Etrog fork, in this case the l2 block 1 will contain the
@@ -336,6 +311,300 @@ func (s *State) DebugTransaction(ctx context.Context, transactionHash common.Has
 		return nil, fmt.Errorf("tx hash not found in executor response")
 	}
 
+	senderAddress, err := GetSender(*tx)
+	if err != nil {
+		return nil, err
+	}
+
+	tracerContext := &tracers.Context{
+		BlockHash:   receipt.BlockHash,
+		BlockNumber: receipt.BlockNumber,
+		TxIndex:     int(receipt.TransactionIndex),
+		TxHash:      transactionHash,
+	}
+
+	return s.traceTransactionResponse(response, tx, senderAddress, *receipt, tracerContext, oldStateRoot, batch.StateRoot, endTime.Sub(startTime), traceConfig)
+}
+
+// newTraceConfigV2 builds the executor trace config to generate the full trace of the tx
+func newTraceConfigV2(txHash common.Hash, traceConfig TraceConfig) *executor.TraceConfigV2 {
+	traceConfigRequestV2 := &executor.TraceConfigV2{
+		TxHashToGenerateFullTrace: txHash.Bytes(),
+		// set the defaults to the maximum information we can have.
+		// this is needed to process custom tracers later
+		DisableStorage:   cFalse,
+		DisableStack:     cFalse,
+		EnableMemory:     cTrue,
+		EnableReturnData: cTrue,
+	}
+
+	// if the default tracer is used, then we review the information
+	// we want to have in the trace related to the parameters we received.
+	if traceConfig.IsDefaultTracer() {
+		if traceConfig.DisableStorage {
+			traceConfigRequestV2.DisableStorage = cTrue
+		}
+		if traceConfig.DisableStack {
+			traceConfigRequestV2.DisableStack = cTrue
+		}
+		if !traceConfig.EnableMemory {
+			traceConfigRequestV2.EnableMemory = cFalse
+		}
+		if !traceConfig.EnableReturnData {
+			traceConfigRequestV2.EnableReturnData = cFalse
+		}
+	}
+
+	return traceConfigRequestV2
+}
+
+// DebugUnsignedTransaction executes an unsigned tx on top of the provided l2 block,
+// or the last one if it's not provided, to generate its trace
+func (s *State) DebugUnsignedTransaction(ctx context.Context, tx *types.Transaction, senderAddress common.Address, l2BlockNumber *uint64, traceConfig TraceConfig, dbTx pgx.Tx) (*runtime.ExecutionResult, error) {
+	if s.executorClient == nil {
+		return nil, ErrExecutorNil
+	}
+	if s.tree == nil {
+		return nil, ErrStateTreeNil
+	}
+
+	var l2Block *L2Block
+	var err error
+	if l2BlockNumber == nil {
+		l2Block, err = s.GetLastL2Block(ctx, dbTx)
+	} else {
+		l2Block, err = s.GetL2BlockByNumber(ctx, *l2BlockNumber, dbTx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.GetBatchByL2BlockNumber(ctx, l2Block.NumberU64(), dbTx)
+	if err != nil {
+		return nil, err
+	}
+
+	forkID := s.GetForkIDByBatchNumber(batch.BatchNumber)
+	if forkID < FORKID_ETROG {
+		return nil, ErrTraceNotSupportedForForkID
+	}
+
+	loadedNonce, err := s.tree.GetNonce(ctx, senderAddress, l2Block.Root().Bytes())
+	if err != nil {
+		return nil, err
+	}
+	nonce := loadedNonce.Uint64()
+
+	batchL2Data, err := EncodeUnsignedTransaction(*tx, s.cfg.ChainID, &nonce, forkID)
+	if err != nil {
+		log.Errorf("error encoding unsigned transaction ", err)
+		return nil, err
+	}
+	transactions := append(s.BuildChangeL2Block(uint32(0), uint32(0)), batchL2Data...)
+
+	processBatchRequestV2 := &executor.ProcessBatchRequestV2{
+		From:             senderAddress.String(),
+		OldBatchNum:      batch.BatchNumber,
+		OldStateRoot:     l2Block.Root().Bytes(),
+		OldAccInputHash:  batch.AccInputHash.Bytes(),
+		Coinbase:         batch.Coinbase.String(),
+		ForkId:           forkID,
+		BatchL2Data:      transactions,
+		ChainId:          s.cfg.ChainID,
+		UpdateMerkleTree: cFalse,
+		NoCounters:       cTrue,
+		ContextId:        uuid.NewString(),
+
+		// v2 fields
+		L1InfoRoot:             l2Block.BlockInfoRoot().Bytes(),
+		TimestampLimit:         l2Block.Time(),
+		SkipFirstChangeL2Block: cFalse,
+		SkipWriteBlockInfoRoot: cTrue,
+	}
+
+	// the executor identifies the tx to trace by its hash, the hash of the unsigned
+	// tx is the one of the tx decoded from the batch data, as the executor does
+	decodedTxs, _, _, err := DecodeTxs(batchL2Data, forkID)
+	if err != nil {
+		return nil, err
+	}
+	if len(decodedTxs) != 1 {
+		return nil, fmt.Errorf("failed to decode the unsigned tx")
+	}
+	txHash := decodedTxs[0].Hash()
+
+	processBatchRequestV2.TraceConfig = newTraceConfigV2(txHash, traceConfig)
+	processBatchResponseV2, elapsed, err := s.processBatchV2ForTrace(ctx, processBatchRequestV2)
+	if err != nil {
+		return nil, err
+	}
+
+	convertedResponse, err := s.convertToProcessBatchResponseV2(processBatchResponseV2)
+	if err != nil {
+		return nil, err
+	}
+	if len(convertedResponse.BlockResponses) == 0 || len(convertedResponse.BlockResponses[0].TransactionResponses) == 0 {
+		return nil, fmt.Errorf("tx not found in executor response")
+	}
+	response := convertedResponse.BlockResponses[0].TransactionResponses[0]
+	if response.TxHash != txHash {
+		return nil, fmt.Errorf("tx hash not found in executor response")
+	}
+
+	receipt := types.Receipt{
+		Status:  types.ReceiptStatusSuccessful,
+		GasUsed: response.GasUsed,
+		TxHash:  txHash,
+	}
+	if response.RomError != nil {
+		receipt.Status = types.ReceiptStatusFailed
+	}
+
+	tracerContext := &tracers.Context{
+		BlockHash:   l2Block.Hash(),
+		BlockNumber: l2Block.Number(),
+		TxIndex:     0,
+		TxHash:      txHash,
+	}
+
+	return s.traceTransactionResponse(response, tx, senderAddress, receipt, tracerContext, l2Block.Root(), l2Block.Root(), elapsed, traceConfig)
+}
+
+// DebugBlockTransactions executes the txs of a block that doesn't need to be stored
+// in the state, on top of the state of its parent block, to generate their traces
+func (s *State) DebugBlockTransactions(ctx context.Context, block *types.Block, traceConfig TraceConfig, dbTx pgx.Tx) ([]*runtime.ExecutionResult, error) {
+	if s.executorClient == nil {
+		return nil, ErrExecutorNil
+	}
+
+	parentL2Block, err := s.GetL2BlockByHash(ctx, block.ParentHash(), dbTx)
+	if err != nil {
+		return nil, err
+	}
+	if block.Time() < parentL2Block.Time() {
+		return nil, ErrTimestampGE
+	}
+
+	batch, err := s.GetBatchByL2BlockNumber(ctx, parentL2Block.NumberU64(), dbTx)
+	if err != nil {
+		return nil, err
+	}
+
+	forkID := s.GetForkIDByBatchNumber(batch.BatchNumber)
+	if forkID < FORKID_ETROG {
+		return nil, ErrTraceNotSupportedForForkID
+	}
+
+	deltaTimestamp := uint32(block.Time() - parentL2Block.Time())
+	results := make([]*runtime.ExecutionResult, 0, len(block.Transactions()))
+
+	// the executor generates the full trace of a single tx per request, so each tx
+	// is executed once on top of the state root left by the previous one, continuing
+	// the same L2 block as the sequencer does when it adds the txs to a block
+	oldStateRoot := parentL2Block.Root()
+	for i, tx := range block.Transactions() {
+		senderAddress, err := GetSender(*tx)
+		if err != nil {
+			return nil, err
+		}
+
+		batchL2Data, err := EncodeTransactions([]types.Transaction{*tx}, []uint8{MaxEffectivePercentage}, forkID)
+		if err != nil {
+			log.Errorf("error encoding transaction ", err)
+			return nil, err
+		}
+		transactions := batchL2Data
+		skipFirstChangeL2Block := uint32(cTrue)
+		if i == 0 {
+			transactions = append(s.BuildChangeL2Block(deltaTimestamp, uint32(0)), batchL2Data...)
+			skipFirstChangeL2Block = cFalse
+		}
+
+		processBatchRequestV2 := &executor.ProcessBatchRequestV2{
+			OldBatchNum:      batch.BatchNumber,
+			OldStateRoot:     oldStateRoot.Bytes(),
+			OldAccInputHash:  batch.AccInputHash.Bytes(),
+			Coinbase:         block.Coinbase().String(),
+			ForkId:           forkID,
+			BatchL2Data:      transactions,
+			ChainId:          s.cfg.ChainID,
+			UpdateMerkleTree: cFalse,
+			TraceConfig:      newTraceConfigV2(tx.Hash(), traceConfig),
+			ContextId:        uuid.NewString(),
+
+			// v2 fields
+			L1InfoRoot:             parentL2Block.BlockInfoRoot().Bytes(),
+			TimestampLimit:         block.Time(),
+			SkipFirstChangeL2Block: skipFirstChangeL2Block,
+			SkipWriteBlockInfoRoot: cTrue,
+		}
+
+		processBatchResponseV2, elapsed, err := s.processBatchV2ForTrace(ctx, processBatchRequestV2)
+		if err != nil {
+			return nil, err
+		}
+
+		convertedResponse, err := s.convertToProcessBatchResponseV2(processBatchResponseV2)
+		if err != nil {
+			return nil, err
+		}
+		if len(convertedResponse.BlockResponses) == 0 || len(convertedResponse.BlockResponses[0].TransactionResponses) == 0 {
+			return nil, fmt.Errorf("tx not found in executor response")
+		}
+		response := convertedResponse.BlockResponses[0].TransactionResponses[0]
+		if response.TxHash != tx.Hash() {
+			return nil, fmt.Errorf("tx hash not found in executor response")
+		}
+
+		receipt := types.Receipt{
+			Status:  types.ReceiptStatusSuccessful,
+			GasUsed: response.GasUsed,
+			TxHash:  tx.Hash(),
+		}
+		if response.RomError != nil {
+			receipt.Status = types.ReceiptStatusFailed
+		}
+
+		tracerContext := &tracers.Context{
+			BlockHash:   block.Hash(),
+			BlockNumber: block.Number(),
+			TxIndex:     i,
+			TxHash:      tx.Hash(),
+		}
+
+		result, err := s.traceTransactionResponse(response, tx, senderAddress, receipt, tracerContext, oldStateRoot, parentL2Block.Root(), elapsed, traceConfig)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+		oldStateRoot = response.StateRoot
+	}
+
+	return results, nil
+}
+
+// processBatchV2ForTrace sends the batch to the executor and returns the
+// response and the time spent processing it
+func (s *State) processBatchV2ForTrace(ctx context.Context, processBatchRequestV2 *executor.ProcessBatchRequestV2) (*executor.ProcessBatchResponseV2, time.Duration, error) {
+	startTime := time.Now()
+	processBatchResponseV2, err := s.executorClient.ProcessBatchV2(ctx, processBatchRequestV2)
+	elapsed := time.Since(startTime)
+	if err != nil {
+		return nil, elapsed, err
+	} else if processBatchResponseV2.Error != executor.ExecutorError_EXECUTOR_ERROR_NO_ERROR {
+		err = executor.ExecutorErr(processBatchResponseV2.Error)
+		s.eventLog.LogExecutorError(ctx, processBatchResponseV2.Error, processBatchRequestV2)
+		return nil, elapsed, err
+	}
+	return processBatchResponseV2, elapsed, nil
+}
+
+// traceTransactionResponse builds the trace of a tx processed by the executor using
+// the tracer defined in the trace config. The fake EVM used to replay the trace reads
+// the state from tracerStateRoot
+func (s *State) traceTransactionResponse(response *ProcessTransactionResponse, tx *types.Transaction, senderAddress common.Address, receipt types.Receipt,
+	tracerContext *tracers.Context, oldStateRoot, tracerStateRoot common.Hash, elapsed time.Duration, traceConfig TraceConfig) (*runtime.ExecutionResult, error) {
+	var err error
 	result := &runtime.ExecutionResult{
 		CreateAddress: response.CreateAddress,
 		GasLeft:       response.GasLeft,
@@ -346,11 +615,6 @@ func (s *State) DebugTransaction(ctx context.Context, transactionHash common.Has
 		Err:           response.RomError,
 	}
 
-	senderAddress, err := GetSender(*tx)
-	if err != nil {
-		return nil, err
-	}
-
 	context := instrumentation.Context{
 		From:         senderAddress.String(),
 		Input:        tx.Data(),
@@ -359,7 +623,7 @@ func (s *State) DebugTransaction(ctx context.Context, transactionHash common.Has
 		Output:       result.ReturnValue,
 		GasPrice:     tx.GasPrice().String(),
 		OldStateRoot: oldStateRoot,
-		Time:         uint64(endTime.Sub(startTime)),
+		Time:         uint64(elapsed),
 		GasUsed:      result.GasUsed,
 	}
 
@@ -382,12 +646,6 @@ func (s *State) DebugTransaction(ctx context.Context, transactionHash common.Has
 
 	// select and prepare tracer
 	var tracer tracers.Tracer
-	tracerContext := &tracers.Context{
-		BlockHash:   receipt.BlockHash,
-		BlockNumber: receipt.BlockNumber,
-		TxIndex:     int(receipt.TransactionIndex),
-		TxHash:      transactionHash,
-	}
 
 	if traceConfig.IsDefaultTracer() {
 		structLoggerCfg := structlogger.Config{
@@ -397,7 +655,7 @@ func (s *State) DebugTransaction(ctx context.Context, transactionHash common.Has
 			EnableReturnData: traceConfig.EnableReturnData,
 		}
 		tracer := structlogger.NewStructLogger(structLoggerCfg)
-		traceResult, err := tracer.ParseTrace(result, *receipt)
+		traceResult, err := tracer.ParseTrace(result, receipt)
 		if err != nil {
 			return nil, err
 		}
@@ -437,7 +695,7 @@ func (s *State) DebugTransaction(ctx context.Context, transactionHash common.Has
 		return nil, fmt.Errorf("invalid tracer: %v, err: %v", traceConfig.Tracer, err)
 	}
 
-	fakeDB := &FakeDB{State: s, stateRoot: batch.StateRoot.Bytes()}
+	fakeDB := &FakeDB{State: s, stateRoot: tracerStateRoot.Bytes()}
 	evm := fakevm.NewFakeEVM(fakevm.BlockContext{BlockNumber: big.NewInt(1)}, fakevm.TxContext{GasPrice: gasPrice}, fakeDB, params.TestChainConfig, fakevm.Config{Debug: true, Tracer: tracer})
 
 	traceResult, err := s.buildTrace(evm, result, tracer)
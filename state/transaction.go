@@ -24,7 +24,7 @@ import (
 
 // GetSender gets the sender from the transaction's signature
 func GetSender(tx types.Transaction) (common.Address, error) {
-	signer := types.NewEIP155Signer(tx.ChainId())
+	signer := types.NewLondonSigner(tx.ChainId())
 	sender, err := signer.Sender(&tx)
 	if err != nil {
 		return common.Address{}, err
@@ -289,7 +289,7 @@ func (s *State) StoreL2Block(ctx context.Context, batchNumber uint64, l2Block *P
 
 // PreProcessUnsignedTransaction processes the unsigned transaction in order to calculate its zkCounters
 func (s *State) PreProcessUnsignedTransaction(ctx context.Context, tx *types.Transaction, sender common.Address, l2BlockNumber *uint64, dbTx pgx.Tx) (*ProcessBatchResponse, error) {
-	response, err := s.internalProcessUnsignedTransaction(ctx, tx, sender, l2BlockNumber, false, dbTx)
+	response, err := s.internalProcessUnsignedTransaction(ctx, tx, sender, l2BlockNumber, false, nil, nil, dbTx)
 	if err != nil {
 		return response, err
 	}
@@ -304,7 +304,7 @@ func (s *State) PreProcessTransaction(ctx context.Context, tx *types.Transaction
 		return nil, err
 	}
 
-	response, err := s.internalProcessUnsignedTransaction(ctx, tx, sender, nil, false, dbTx)
+	response, err := s.internalProcessUnsignedTransaction(ctx, tx, sender, nil, false, nil, nil, dbTx)
 	if err != nil {
 		return response, err
 	}
@@ -313,9 +313,11 @@ func (s *State) PreProcessTransaction(ctx context.Context, tx *types.Transaction
 }
 
 // ProcessUnsignedTransaction processes the given unsigned transaction.
-func (s *State) ProcessUnsignedTransaction(ctx context.Context, tx *types.Transaction, senderAddress common.Address, l2BlockNumber *uint64, noZKEVMCounters bool, dbTx pgx.Tx) (*runtime.ExecutionResult, error) {
+// The state and block overrides are optional, they allow to execute the
+// transaction against hypothetical state and block context
+func (s *State) ProcessUnsignedTransaction(ctx context.Context, tx *types.Transaction, senderAddress common.Address, l2BlockNumber *uint64, noZKEVMCounters bool, stateOverride StateOverride, blockOverride *BlockOverride, dbTx pgx.Tx) (*runtime.ExecutionResult, error) {
 	result := new(runtime.ExecutionResult)
-	response, err := s.internalProcessUnsignedTransaction(ctx, tx, senderAddress, l2BlockNumber, noZKEVMCounters, dbTx)
+	response, err := s.internalProcessUnsignedTransaction(ctx, tx, senderAddress, l2BlockNumber, noZKEVMCounters, stateOverride, blockOverride, dbTx)
 	if err != nil {
 		return nil, err
 	}
@@ -337,7 +339,11 @@ func (s *State) ProcessUnsignedTransaction(ctx context.Context, tx *types.Transa
 }
 
 // internalProcessUnsignedTransaction processes the given unsigned transaction.
-func (s *State) internalProcessUnsignedTransaction(ctx context.Context, tx *types.Transaction, senderAddress common.Address, l2BlockNumber *uint64, noZKEVMCounters bool, dbTx pgx.Tx) (*ProcessBatchResponse, error) {
+func (s *State) internalProcessUnsignedTransaction(ctx context.Context, tx *types.Transaction, senderAddress common.Address, l2BlockNumber *uint64, noZKEVMCounters bool, stateOverride StateOverride, blockOverride *BlockOverride, dbTx pgx.Tx) (*ProcessBatchResponse, error) {
+	if err := stateOverride.validate(); err != nil {
+		return nil, err
+	}
+
 	var l2Block *L2Block
 	var err error
 	if l2BlockNumber == nil {
@@ -355,16 +361,20 @@ func (s *State) internalProcessUnsignedTransaction(ctx context.Context, tx *type
 	}
 
 	forkID := s.GetForkIDByBatchNumber(batch.BatchNumber)
+	if err := blockOverride.validate(l2Block, forkID); err != nil {
+		return nil, err
+	}
+
 	if forkID < FORKID_ETROG {
-		return s.internalProcessUnsignedTransactionV1(ctx, tx, senderAddress, *batch, *l2Block, forkID, noZKEVMCounters, dbTx)
+		return s.internalProcessUnsignedTransactionV1(ctx, tx, senderAddress, *batch, *l2Block, forkID, noZKEVMCounters, stateOverride, blockOverride, dbTx)
 	} else {
-		return s.internalProcessUnsignedTransactionV2(ctx, tx, senderAddress, *batch, *l2Block, forkID, noZKEVMCounters, dbTx)
+		return s.internalProcessUnsignedTransactionV2(ctx, tx, senderAddress, *batch, *l2Block, forkID, noZKEVMCounters, stateOverride, blockOverride, dbTx)
 	}
 }
 
 // internalProcessUnsignedTransactionV1 processes the given unsigned transaction.
 // pre ETROG
-func (s *State) internalProcessUnsignedTransactionV1(ctx context.Context, tx *types.Transaction, senderAddress common.Address, batch Batch, l2Block L2Block, forkID uint64, noZKEVMCounters bool, dbTx pgx.Tx) (*ProcessBatchResponse, error) {
+func (s *State) internalProcessUnsignedTransactionV1(ctx context.Context, tx *types.Transaction, senderAddress common.Address, batch Batch, l2Block L2Block, forkID uint64, noZKEVMCounters bool, stateOverride StateOverride, blockOverride *BlockOverride, dbTx pgx.Tx) (*ProcessBatchResponse, error) {
 	var attempts = 1
 
 	if s.executorClient == nil {
@@ -383,12 +393,12 @@ func (s *State) internalProcessUnsignedTransactionV1(ctx context.Context, tx *ty
 	if l2Block.NumberU64() == latestL2BlockNumber {
 		timestamp = uint64(time.Now().Unix())
 	}
+	timestamp = blockOverride.timestamp(timestamp)
 
-	loadedNonce, err := s.tree.GetNonce(ctx, senderAddress, l2Block.Root().Bytes())
+	nonce, err := s.getUnsignedTxNonce(ctx, senderAddress, l2Block.Root(), stateOverride)
 	if err != nil {
 		return nil, err
 	}
-	nonce := loadedNonce.Uint64()
 
 	batchL2Data, err := EncodeUnsignedTransaction(*tx, s.cfg.ChainID, &nonce, forkID)
 	if err != nil {
@@ -403,11 +413,12 @@ func (s *State) internalProcessUnsignedTransactionV1(ctx context.Context, tx *ty
 		OldStateRoot:     l2Block.Root().Bytes(),
 		OldAccInputHash:  batch.AccInputHash.Bytes(),
 		ForkId:           forkID,
-		Coinbase:         l2Block.Coinbase().String(),
+		Coinbase:         blockOverride.coinbase(l2Block.Coinbase()).String(),
 		BatchL2Data:      batchL2Data,
 		ChainId:          s.cfg.ChainID,
 		UpdateMerkleTree: cFalse,
 		ContextId:        uuid.NewString(),
+		StateOverride:    stateOverride.toExecutorV1(),
 
 		// v1 fields
 		GlobalExitRoot: l2Block.GlobalExitRoot().Bytes(),
@@ -492,7 +503,7 @@ func (s *State) internalProcessUnsignedTransactionV1(ctx context.Context, tx *ty
 
 // internalProcessUnsignedTransactionV2 processes the given unsigned transaction.
 // post ETROG
-func (s *State) internalProcessUnsignedTransactionV2(ctx context.Context, tx *types.Transaction, senderAddress common.Address, batch Batch, l2Block L2Block, forkID uint64, noZKEVMCounters bool, dbTx pgx.Tx) (*ProcessBatchResponse, error) {
+func (s *State) internalProcessUnsignedTransactionV2(ctx context.Context, tx *types.Transaction, senderAddress common.Address, batch Batch, l2Block L2Block, forkID uint64, noZKEVMCounters bool, stateOverride StateOverride, blockOverride *BlockOverride, dbTx pgx.Tx) (*ProcessBatchResponse, error) {
 	var attempts = 1
 
 	if s.executorClient == nil {
@@ -502,13 +513,13 @@ func (s *State) internalProcessUnsignedTransactionV2(ctx context.Context, tx *ty
 		return nil, ErrStateTreeNil
 	}
 
-	loadedNonce, err := s.tree.GetNonce(ctx, senderAddress, l2Block.Root().Bytes())
+	nonce, err := s.getUnsignedTxNonce(ctx, senderAddress, l2Block.Root(), stateOverride)
 	if err != nil {
 		return nil, err
 	}
-	nonce := loadedNonce.Uint64()
 
-	transactions := s.BuildChangeL2Block(uint32(0), uint32(0))
+	timestamp := blockOverride.timestamp(l2Block.Time())
+	transactions := s.BuildChangeL2Block(uint32(timestamp-l2Block.Time()), uint32(0))
 
 	batchL2Data, err := EncodeUnsignedTransaction(*tx, s.cfg.ChainID, &nonce, forkID)
 	if err != nil {
@@ -524,16 +535,17 @@ func (s *State) internalProcessUnsignedTransactionV2(ctx context.Context, tx *ty
 		OldBatchNum:      batch.BatchNumber,
 		OldStateRoot:     l2Block.Root().Bytes(),
 		OldAccInputHash:  batch.AccInputHash.Bytes(),
-		Coinbase:         batch.Coinbase.String(),
+		Coinbase:         blockOverride.coinbase(batch.Coinbase).String(),
 		ForkId:           forkID,
 		BatchL2Data:      transactions,
 		ChainId:          s.cfg.ChainID,
 		UpdateMerkleTree: cFalse,
 		ContextId:        uuid.NewString(),
+		StateOverride:    stateOverride.withBlockOverride(blockOverride).toExecutorV2(),
 
 		// v2 fields
 		L1InfoRoot:             l2Block.BlockInfoRoot().Bytes(),
-		TimestampLimit:         l2Block.Time(),
+		TimestampLimit:         timestamp,
 		SkipFirstChangeL2Block: cFalse,
 		SkipWriteBlockInfoRoot: cTrue,
 	}
@@ -628,6 +640,20 @@ func (s *State) internalProcessUnsignedTransactionV2(ctx context.Context, tx *ty
 	return response, nil
 }
 
+// getUnsignedTxNonce returns the nonce to be used by the unsigned txs sent by
+// the provided address, taking into account the state override if any
+func (s *State) getUnsignedTxNonce(ctx context.Context, senderAddress common.Address, root common.Hash, stateOverride StateOverride) (uint64, error) {
+	if nonce := stateOverride.nonce(senderAddress); nonce != nil {
+		return *nonce, nil
+	}
+
+	loadedNonce, err := s.tree.GetNonce(ctx, senderAddress, root.Bytes())
+	if err != nil {
+		return 0, err
+	}
+	return loadedNonce.Uint64(), nil
+}
+
 // isContractCreation checks if the tx is a contract creation
 func (s *State) isContractCreation(tx *types.Transaction) bool {
 	return tx.To() == nil && len(tx.Data()) > 0
@@ -702,12 +728,18 @@ func CheckSupersetBatchTransactions(existingTxHashes []common.Hash, processedTxs
 	return nil
 }
 
-// EstimateGas for a transaction
-func (s *State) EstimateGas(transaction *types.Transaction, senderAddress common.Address, l2BlockNumber *uint64, dbTx pgx.Tx) (uint64, []byte, error) {
+// EstimateGas for a transaction.
+// The state and block overrides are optional, they allow to estimate the
+// gas against hypothetical state and block context
+func (s *State) EstimateGas(transaction *types.Transaction, senderAddress common.Address, l2BlockNumber *uint64, stateOverride StateOverride, blockOverride *BlockOverride, dbTx pgx.Tx) (uint64, []byte, error) {
 	const ethTransferGas = 21000
 
 	ctx := context.Background()
 
+	if err := stateOverride.validate(); err != nil {
+		return 0, nil, err
+	}
+
 	var l2Block *L2Block
 	var err error
 	if l2BlockNumber == nil {
@@ -725,16 +757,19 @@ func (s *State) EstimateGas(transaction *types.Transaction, senderAddress common
 	}
 
 	forkID := s.GetForkIDByBatchNumber(batch.BatchNumber)
+	if err := blockOverride.validate(l2Block, forkID); err != nil {
+		return 0, nil, err
+	}
+
 	latestL2BlockNumber, err := s.GetLastL2BlockNumber(ctx, dbTx)
 	if err != nil {
 		return 0, nil, err
 	}
 
-	loadedNonce, err := s.tree.GetNonce(ctx, senderAddress, l2Block.Root().Bytes())
+	nonce, err := s.getUnsignedTxNonce(ctx, senderAddress, l2Block.Root(), stateOverride)
 	if err != nil {
 		return 0, nil, err
 	}
-	nonce := loadedNonce.Uint64()
 
 	highEnd := MaxTxGasLimit
 
@@ -742,11 +777,14 @@ func (s *State) EstimateGas(transaction *types.Transaction, senderAddress common
 	// of the account afford
 	isGasPriceSet := transaction.GasPrice().BitLen() != 0
 	if isGasPriceSet {
-		senderBalance, err := s.tree.GetBalance(ctx, senderAddress, l2Block.Root().Bytes())
-		if errors.Is(err, ErrNotFound) {
-			senderBalance = big.NewInt(0)
-		} else if err != nil {
-			return 0, nil, err
+		senderBalance := stateOverride.balance(senderAddress)
+		if senderBalance == nil {
+			senderBalance, err = s.tree.GetBalance(ctx, senderAddress, l2Block.Root().Bytes())
+			if errors.Is(err, ErrNotFound) {
+				senderBalance = big.NewInt(0)
+			} else if err != nil {
+				return 0, nil, err
+			}
 		}
 
 		availableBalance := new(big.Int).Set(senderBalance)
@@ -785,7 +823,10 @@ func (s *State) EstimateGas(transaction *types.Transaction, senderAddress common
 	if lowEnd == ethTransferGas && transaction.To() != nil {
 		receiver := *transaction.To()
 		// check if the receiver address is not a smart contract
-		code, err := s.tree.GetCode(ctx, receiver, l2Block.Root().Bytes())
+		code := stateOverride.code(receiver)
+		if code == nil {
+			code, err = s.tree.GetCode(ctx, receiver, l2Block.Root().Bytes())
+		}
 		if err != nil {
 			log.Warnf("error while getting code for address %v: %v", receiver.String(), err)
 		} else if len(code) == 0 {
@@ -808,9 +849,9 @@ func (s *State) EstimateGas(transaction *types.Transaction, senderAddress common
 	var gasUsed uint64
 	var returnValue []byte
 	if forkID < FORKID_ETROG {
-		failed, reverted, gasUsed, returnValue, err = s.internalTestGasEstimationTransactionV1(ctx, batch, l2Block, latestL2BlockNumber, transaction, forkID, senderAddress, highEnd, nonce, false)
+		failed, reverted, gasUsed, returnValue, err = s.internalTestGasEstimationTransactionV1(ctx, batch, l2Block, latestL2BlockNumber, transaction, forkID, senderAddress, highEnd, nonce, stateOverride, blockOverride, false)
 	} else {
-		failed, reverted, gasUsed, returnValue, err = s.internalTestGasEstimationTransactionV2(ctx, batch, l2Block, latestL2BlockNumber, transaction, forkID, senderAddress, highEnd, nonce, false)
+		failed, reverted, gasUsed, returnValue, err = s.internalTestGasEstimationTransactionV2(ctx, batch, l2Block, latestL2BlockNumber, transaction, forkID, senderAddress, highEnd, nonce, stateOverride, blockOverride, false)
 	}
 
 	if failed {
@@ -843,9 +884,9 @@ func (s *State) EstimateGas(transaction *types.Transaction, senderAddress common
 
 		log.Debugf("Estimate gas. Trying to execute TX with %v gas", mid)
 		if forkID < FORKID_ETROG {
-			failed, reverted, _, _, err = s.internalTestGasEstimationTransactionV1(ctx, batch, l2Block, latestL2BlockNumber, transaction, forkID, senderAddress, mid, nonce, true)
+			failed, reverted, _, _, err = s.internalTestGasEstimationTransactionV1(ctx, batch, l2Block, latestL2BlockNumber, transaction, forkID, senderAddress, mid, nonce, stateOverride, blockOverride, true)
 		} else {
-			failed, reverted, _, _, err = s.internalTestGasEstimationTransactionV2(ctx, batch, l2Block, latestL2BlockNumber, transaction, forkID, senderAddress, mid, nonce, true)
+			failed, reverted, _, _, err = s.internalTestGasEstimationTransactionV2(ctx, batch, l2Block, latestL2BlockNumber, transaction, forkID, senderAddress, mid, nonce, stateOverride, blockOverride, true)
 		}
 		executionTime := time.Since(txExecutionStart)
 		totalExecutionTime += executionTime
@@ -879,11 +920,12 @@ func (s *State) EstimateGas(transaction *types.Transaction, senderAddress common
 // before ETROG
 func (s *State) internalTestGasEstimationTransactionV1(ctx context.Context, batch *Batch, l2Block *L2Block, latestL2BlockNumber uint64,
 	transaction *types.Transaction, forkID uint64, senderAddress common.Address,
-	gas uint64, nonce uint64, shouldOmitErr bool) (failed, reverted bool, gasUsed uint64, returnValue []byte, err error) {
+	gas uint64, nonce uint64, stateOverride StateOverride, blockOverride *BlockOverride, shouldOmitErr bool) (failed, reverted bool, gasUsed uint64, returnValue []byte, err error) {
 	timestamp := l2Block.Time()
 	if l2Block.NumberU64() == latestL2BlockNumber {
 		timestamp = uint64(time.Now().Unix())
 	}
+	timestamp = blockOverride.timestamp(timestamp)
 
 	tx := types.NewTx(&types.LegacyTx{
 		Nonce:    nonce,
@@ -907,11 +949,12 @@ func (s *State) internalTestGasEstimationTransactionV1(ctx context.Context, batc
 		OldStateRoot:     l2Block.Root().Bytes(),
 		OldAccInputHash:  batch.AccInputHash.Bytes(),
 		ForkId:           forkID,
-		Coinbase:         batch.Coinbase.String(),
+		Coinbase:         blockOverride.coinbase(batch.Coinbase).String(),
 		BatchL2Data:      batchL2Data,
 		ChainId:          s.cfg.ChainID,
 		UpdateMerkleTree: cFalse,
 		ContextId:        uuid.NewString(),
+		StateOverride:    stateOverride.toExecutorV1(),
 
 		// v1 fields
 		GlobalExitRoot: batch.GlobalExitRoot.Bytes(),
@@ -975,10 +1018,18 @@ func (s *State) internalTestGasEstimationTransactionV1(ctx context.Context, batc
 // after ETROG
 func (s *State) internalTestGasEstimationTransactionV2(ctx context.Context, batch *Batch, l2Block *L2Block, latestL2BlockNumber uint64,
 	transaction *types.Transaction, forkID uint64, senderAddress common.Address,
-	gas uint64, nonce uint64, shouldOmitErr bool) (failed, reverted bool, gasUsed uint64, returnValue []byte, err error) {
-	deltaTimestamp := uint32(uint64(time.Now().Unix()) - l2Block.Time())
+	gas uint64, nonce uint64, stateOverride StateOverride, blockOverride *BlockOverride, shouldOmitErr bool) (failed, reverted bool, gasUsed uint64, returnValue []byte, err error) {
+	timestamp := blockOverride.timestamp(uint64(time.Now().Unix()))
+	deltaTimestamp := uint32(timestamp - l2Block.Time())
 	transactions := s.BuildChangeL2Block(deltaTimestamp, uint32(0))
 
+	// the first change L2 block is only processed when the block context is
+	// overridden, otherwise the tx is executed in the context of the given block
+	skipFirstChangeL2Block := uint32(cTrue)
+	if blockOverride.overridesL2Block() {
+		skipFirstChangeL2Block = cFalse
+	}
+
 	tx := types.NewTx(&types.LegacyTx{
 		Nonce:    nonce,
 		To:       transaction.To(),
@@ -1002,17 +1053,18 @@ func (s *State) internalTestGasEstimationTransactionV2(ctx context.Context, batc
 		OldBatchNum:      batch.BatchNumber,
 		OldStateRoot:     l2Block.Root().Bytes(),
 		OldAccInputHash:  batch.AccInputHash.Bytes(),
-		Coinbase:         batch.Coinbase.String(),
+		Coinbase:         blockOverride.coinbase(batch.Coinbase).String(),
 		ForkId:           forkID,
 		BatchL2Data:      transactions,
 		ChainId:          s.cfg.ChainID,
 		UpdateMerkleTree: cFalse,
 		ContextId:        uuid.NewString(),
+		StateOverride:    stateOverride.withBlockOverride(blockOverride).toExecutorV2(),
 
 		// v2 fields
 		L1InfoRoot:             l2Block.BlockInfoRoot().Bytes(),
-		TimestampLimit:         uint64(time.Now().Unix()),
-		SkipFirstChangeL2Block: cTrue,
+		TimestampLimit:         timestamp,
+		SkipFirstChangeL2Block: skipFirstChangeL2Block,
 		SkipWriteBlockInfoRoot: cTrue,
 	}
 
@@ -171,6 +171,21 @@ type StoreTxEGPData struct {
 	EffectivePercentage uint8
 }
 
+// TxFeeInfo contains the fee data of a tx stored in a L2 block
+type TxFeeInfo struct {
+	GasUsed             uint64
+	EffectiveGasPrice   *big.Int
+	EffectivePercentage uint8
+}
+
+// L2BlockFeeHistory contains the gas used by a L2 block and the fee data of its txs
+type L2BlockFeeHistory struct {
+	BlockNumber uint64
+	GasUsed     uint64
+	GasLimit    uint64
+	TxsFeeInfo  []TxFeeInfo
+}
+
 // ZKCounters counters for the tx
 type ZKCounters struct {
 	GasUsed          uint64
@@ -1,14 +1,18 @@
 package feijoa
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/0xPolygonHermez/zkevm-node/etherman"
 	"github.com/0xPolygonHermez/zkevm-node/log"
 	"github.com/0xPolygonHermez/zkevm-node/state"
+	stateMetrics "github.com/0xPolygonHermez/zkevm-node/state/metrics"
 	"github.com/0xPolygonHermez/zkevm-node/synchronizer/actions"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/jackc/pgx/v4"
 )
 
@@ -16,60 +20,262 @@ import (
 type stateProcessorSequenceBlobsInterface interface {
 	AddBlobSequence(ctx context.Context, blobSequence *state.BlobSequence, dbTx pgx.Tx) error
 	GetLastBlobSequence(ctx context.Context, dbTx pgx.Tx) (*state.BlobSequence, error)
+	GetBlobInner(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx) (*state.BlobInner, error)
+	AddBlobInner(ctx context.Context, blobInner *state.BlobInner, dbTx pgx.Tx) error
+	ProcessBlobInner(ctx context.Context, request state.ProcessBlobInnerRequest) (*state.ProcessBlobInnerResponse, error)
+	GetLastVirtualBatchNum(ctx context.Context, dbTx pgx.Tx) (uint64, error)
+	GetBatchByNumber(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) (*state.Batch, error)
+	GetForkIDByBatchNumber(batchNumber uint64) uint64
+	GetL1InfoRootLeafByIndex(ctx context.Context, l1InfoTreeIndex uint32, dbTx pgx.Tx) (state.L1InfoTreeExitRootStorageEntry, error)
+	GetL1InfoTreeDataFromBatchL2Data(ctx context.Context, batchL2Data []byte, dbTx pgx.Tx) (map[uint32]state.L1DataV2, common.Hash, common.Hash, error)
+	ProcessAndStoreClosedBatchV2(ctx context.Context, processingCtx state.ProcessingContextV2, dbTx pgx.Tx, caller stateMetrics.CallerLabel) (common.Hash, uint64, string, error)
+	ResetTrustedState(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) error
+	AddVirtualBatch(ctx context.Context, virtualBatch *state.VirtualBatch, dbTx pgx.Tx) error
+}
+
+// syncProcessorSequenceBlobsInterface interface required from synchronizer
+type syncProcessorSequenceBlobsInterface interface {
+	PendingFlushID(flushID uint64, proverID string)
+	CleanTrustedState()
 }
 
 // ProcessorSequenceBlobs processor for SequenceBlobs
 type ProcessorSequenceBlobs struct {
-	actions.ProcessorBase[ProcessorL1InfoTreeUpdate]
+	actions.ProcessorBase[ProcessorSequenceBlobs]
 	state stateProcessorSequenceBlobsInterface
+	sync  syncProcessorSequenceBlobsInterface
 }
 
 // NewProcessorSequenceBlobs new processor for SequenceBlobs
-func NewProcessorSequenceBlobs(state stateProcessorSequenceBlobsInterface) *ProcessorSequenceBlobs {
+func NewProcessorSequenceBlobs(state stateProcessorSequenceBlobsInterface, sync syncProcessorSequenceBlobsInterface) *ProcessorSequenceBlobs {
 	return &ProcessorSequenceBlobs{
-		ProcessorBase: *actions.NewProcessorBase[ProcessorL1InfoTreeUpdate](
+		ProcessorBase: *actions.NewProcessorBase[ProcessorSequenceBlobs](
 			[]etherman.EventOrder{etherman.SequenceBlobsOrder},
 			actions.ForksIdOnlyFeijoa),
-		state: state}
+		state: state,
+		sync:  sync,
+	}
 }
 
 // Process process event
 // - Store BlobSequence
 // - Split Data into BlobInner (executor)
 // - Store BlobInner
+// - Process and store the batches of each BlobInner as virtual batches
 func (p *ProcessorSequenceBlobs) Process(ctx context.Context, order etherman.Order, l1Block *etherman.Block, dbTx pgx.Tx) error {
+	if l1Block == nil || len(l1Block.SequenceBlobs) <= order.Pos {
+		return actions.ErrInvalidParams
+	}
 	seqBlobs := l1Block.SequenceBlobs[order.Pos]
 
-	err := p.storeBlobSequence(ctx, dbTx, &seqBlobs, l1Block.ReceivedAt)
+	blobSequence, err := p.storeBlobSequence(ctx, dbTx, &seqBlobs, l1Block.ReceivedAt, l1Block.BlockNumber)
 	if err != nil {
 		return err
 	}
 
 	for idx := range seqBlobs.Blobs {
-		log.Infof("Blob %d: %s", idx, seqBlobs.Blobs[idx].String())
+		blobInnerNum := blobSequence.FirstBlobSequenced + uint64(idx)
+		log.Debugf("Blob %d (blobInnerNum: %d): %s", idx, blobInnerNum, seqBlobs.Blobs[idx].String())
+		err = p.processBlobInner(ctx, dbTx, blobSequence, blobInnerNum, &seqBlobs, idx, l1Block)
+		if err != nil {
+			log.Errorf("error processing blobInner %d. BlockNumber: %d, error: %v", blobInnerNum, l1Block.BlockNumber, err)
+			return err
+		}
 	}
 	return nil
 }
 
-func (p *ProcessorSequenceBlobs) storeBlobSequence(ctx context.Context, dbTx pgx.Tx, seqBlobs *etherman.SequenceBlobs, createAt time.Time) error {
+func (p *ProcessorSequenceBlobs) storeBlobSequence(ctx context.Context, dbTx pgx.Tx, seqBlobs *etherman.SequenceBlobs, createAt time.Time, blockNumber uint64) (*state.BlobSequence, error) {
 	if seqBlobs == nil || seqBlobs.EventData == nil {
-		return fmt.Errorf("sequence blobs is nil or EventData is nil")
+		return nil, fmt.Errorf("sequence blobs is nil or EventData is nil")
+	}
+	if uint64(len(seqBlobs.Blobs)) > seqBlobs.EventData.LastBlobSequenced {
+		return nil, fmt.Errorf("sequence blobs has %d blobs but lastBlobSequenced is %d", len(seqBlobs.Blobs), seqBlobs.EventData.LastBlobSequenced)
 	}
 
 	nextIndex := uint64(1)
 	previousBlobSequenceOnState, err := p.state.GetLastBlobSequence(ctx, dbTx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if previousBlobSequenceOnState != nil {
 		nextIndex = previousBlobSequenceOnState.Index + 1
 	}
 	stateBlobSequence := state.BlobSequence{
-		Index:             nextIndex,
-		L2Coinbase:        seqBlobs.L2Coinbase,
-		FinalAccInputHash: seqBlobs.FinalAccInputHash,
-		LastBlobSequenced: seqBlobs.EventData.LastBlobSequenced,
-		CreateAt:          createAt,
+		Index:              nextIndex,
+		L2Coinbase:         seqBlobs.L2Coinbase,
+		FinalAccInputHash:  seqBlobs.FinalAccInputHash,
+		FirstBlobSequenced: seqBlobs.EventData.LastBlobSequenced - uint64(len(seqBlobs.Blobs)) + 1,
+		LastBlobSequenced:  seqBlobs.EventData.LastBlobSequenced,
+		CreateAt:           createAt,
+		BlockNumber:        blockNumber,
+	}
+	err = p.state.AddBlobSequence(ctx, &stateBlobSequence, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	return &stateBlobSequence, nil
+}
+
+// processBlobInner splits the blob into its batches using the executor, stores the BlobInner
+// and process and store each batch linked to it
+func (p *ProcessorSequenceBlobs) processBlobInner(ctx context.Context, dbTx pgx.Tx, blobSequence *state.BlobSequence, blobInnerNum uint64,
+	seqBlobs *etherman.SequenceBlobs, blobIdx int, l1Block *etherman.Block) error {
+	blob := &seqBlobs.Blobs[blobIdx]
+
+	// The first blob inner starts from an empty blob state
+	var oldBlobStateRoot, oldBlobAccInputHash common.Hash
+	oldNumBlob := blobInnerNum - 1
+	if oldNumBlob > 0 {
+		previousBlobInner, err := p.state.GetBlobInner(ctx, oldNumBlob, dbTx)
+		if err != nil {
+			return fmt.Errorf("error getting previous blobInner %d. Err: %w", oldNumBlob, err)
+		}
+		oldBlobStateRoot = previousBlobInner.BlobStateRoot
+		oldBlobAccInputHash = previousBlobInner.BlobAccInputHash
+	}
+
+	// The batches of the blob follow the last virtual batch, the trusted state can be ahead of L1 and
+	// its batches are checked against the L1 data by processBatch
+	lastVirtualBatchNumber, err := p.state.GetLastVirtualBatchNum(ctx, dbTx)
+	if err != nil {
+		return fmt.Errorf("error getting last virtual batch number. Err: %w", err)
+	}
+	lastVirtualBatch, err := p.state.GetBatchByNumber(ctx, lastVirtualBatchNumber, dbTx)
+	if err != nil {
+		return fmt.Errorf("error getting last virtual batch %d. Err: %w", lastVirtualBatchNumber, err)
+	}
+	l1InfoTreeLeaf, err := p.state.GetL1InfoRootLeafByIndex(ctx, blob.Params.L1InfoLeafIndex, dbTx)
+	if err != nil {
+		return fmt.Errorf("error getting L1InfoTree leaf %d. Err: %w", blob.Params.L1InfoLeafIndex, err)
+	}
+
+	var pointZ, pointY []byte
+	if blob.BlobBlobTypeParams != nil {
+		pointZ = blob.BlobBlobTypeParams.Z
+		pointY = blob.BlobBlobTypeParams.Y
+	}
+	maxSequenceTimestamp := time.Unix(int64(blob.Params.MaxSequenceTimestamp), 0)
+
+	processResponse, err := p.state.ProcessBlobInner(ctx, state.ProcessBlobInnerRequest{
+		OldBlobStateRoot:    oldBlobStateRoot,
+		OldBlobAccInputHash: oldBlobAccInputHash,
+		OldNumBlob:          oldNumBlob,
+		OldStateRoot:        lastVirtualBatch.StateRoot,
+		ForkID:              p.state.GetForkIDByBatchNumber(lastVirtualBatchNumber + 1),
+		LastL1InfoTreeIndex: blob.Params.L1InfoLeafIndex,
+		LastL1InfoTreeRoot:  l1InfoTreeLeaf.L1InfoTreeRoot,
+		TimestampLimit:      blob.Params.MaxSequenceTimestamp,
+		Coinbase:            blobSequence.L2Coinbase,
+		ZkGasLimit:          blob.Params.ZkGasLimit,
+		BlobType:            state.BlobType(blob.Type),
+		PointZ:              pointZ,
+		PointY:              pointY,
+		BlobData:            blob.Data,
+		Caller:              stateMetrics.SynchronizerCallerLabel,
+	})
+	if err != nil {
+		return fmt.Errorf("error processing blobInner on executor. Err: %w", err)
 	}
-	return p.state.AddBlobSequence(ctx, &stateBlobSequence, dbTx)
+
+	blobInner := state.BlobInner{
+		BlobInnerNum:         blobInnerNum,
+		BlobSequenceIndex:    blobSequence.Index,
+		Type:                 state.BlobType(blob.Type),
+		MaxSequenceTimestamp: maxSequenceTimestamp,
+		ZkGasLimit:           blob.Params.ZkGasLimit,
+		L1InfoLeafIndex:      blob.Params.L1InfoLeafIndex,
+		Coinbase:             blobSequence.L2Coinbase,
+		PointZ:               pointZ,
+		PointY:               pointY,
+		Data:                 blob.Data,
+		BlobStateRoot:        processResponse.NewBlobStateRoot,
+		BlobAccInputHash:     processResponse.NewBlobAccInputHash,
+		BlockNumber:          l1Block.BlockNumber,
+	}
+	err = p.state.AddBlobInner(ctx, &blobInner, dbTx)
+	if err != nil {
+		return fmt.Errorf("error storing blobInner. Err: %w", err)
+	}
+
+	if processResponse.IsInvalid {
+		log.Warnf("blobInner %d is invalid (error: %v), no batches are going to be stored", blobInnerNum, processResponse.RomBlobError)
+		return nil
+	}
+
+	log.Infof("blobInner %d contains %d batches", blobInnerNum, len(processResponse.BatchData))
+	for idx, batchL2Data := range processResponse.BatchData {
+		batchNumber := lastVirtualBatchNumber + 1 + uint64(idx)
+		err = p.processBatch(ctx, dbTx, batchNumber, batchL2Data, blobInnerNum, seqBlobs, maxSequenceTimestamp, l1Block)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processBatch process and store a batch of a blobInner, if the batch is already on the trusted
+// state and it doesn't match the one on L1, the trusted state is reset
+func (p *ProcessorSequenceBlobs) processBatch(ctx context.Context, dbTx pgx.Tx, batchNumber uint64, batchL2Data []byte, blobInnerNum uint64,
+	seqBlobs *etherman.SequenceBlobs, timestampLimit time.Time, l1Block *etherman.Block) error {
+	leaves, l1InfoRoot, maxGER, err := p.state.GetL1InfoTreeDataFromBatchL2Data(ctx, batchL2Data, dbTx)
+	if err != nil {
+		return fmt.Errorf("error getting L1InfoTree data of batch %d. Err: %w", batchNumber, err)
+	}
+
+	mustBeProcessed := true
+	tBatch, err := p.state.GetBatchByNumber(ctx, batchNumber, dbTx)
+	if err != nil && !errors.Is(err, state.ErrNotFound) {
+		return fmt.Errorf("error checking trusted state of batch %d. Err: %w", batchNumber, err)
+	}
+	if tBatch != nil {
+		if !tBatch.WIP && bytes.Equal(tBatch.BatchL2Data, batchL2Data) {
+			log.Debugf("batch %d already on trusted state and matches L1 data", batchNumber)
+			mustBeProcessed = false
+		} else {
+			log.Warnf("missmatch in trusted state detected for batch %d (WIP: %v), discarding batches until batchNum %d", batchNumber, tBatch.WIP, batchNumber-1)
+			p.sync.CleanTrustedState()
+			err = p.state.ResetTrustedState(ctx, batchNumber-1, dbTx)
+			if err != nil {
+				return fmt.Errorf("error resetting trusted state to batch %d. Err: %w", batchNumber-1, err)
+			}
+		}
+	}
+
+	if mustBeProcessed {
+		processCtx := state.ProcessingContextV2{
+			BatchNumber:          batchNumber,
+			Coinbase:             seqBlobs.L2Coinbase,
+			Timestamp:            &timestampLimit,
+			L1InfoRoot:           l1InfoRoot,
+			L1InfoTreeData:       leaves,
+			BatchL2Data:          &batchL2Data,
+			SkipVerifyL1InfoRoot: 1,
+			GlobalExitRoot:       maxGER,
+			ClosingReason:        state.SyncL1EventSequencedBatchClosingReason,
+		}
+		log.Infof("processSequenceBlobs: ProcessAndStoreClosedBatch. BatchNumber: %d, BlobInnerNum: %d, BlockNumber: %d", batchNumber, blobInnerNum, l1Block.BlockNumber)
+		_, flushID, proverID, err := p.state.ProcessAndStoreClosedBatchV2(ctx, processCtx, dbTx, stateMetrics.SynchronizerCallerLabel)
+		if err != nil {
+			return fmt.Errorf("error storing batch %d. Err: %w", batchNumber, err)
+		}
+		p.sync.PendingFlushID(flushID, proverID)
+	}
+
+	virtualBatch := state.VirtualBatch{
+		BatchNumber:         batchNumber,
+		TxHash:              seqBlobs.TxHash,
+		Coinbase:            seqBlobs.L2Coinbase,
+		SequencerAddr:       seqBlobs.SequencerAddr,
+		BlockNumber:         l1Block.BlockNumber,
+		L1InfoRoot:          &l1InfoRoot,
+		TimestampBatchEtrog: &l1Block.ReceivedAt,
+		BlobInnerNum:        &blobInnerNum,
+	}
+	log.Infof("processSequenceBlobs: Storing virtualBatch. BatchNumber: %d, BlobInnerNum: %d, BlockNumber: %d", batchNumber, blobInnerNum, l1Block.BlockNumber)
+	err = p.state.AddVirtualBatch(ctx, &virtualBatch, dbTx)
+	if err != nil {
+		return fmt.Errorf("error storing virtualBatch %d. Err: %w", batchNumber, err)
+	}
+	return nil
 }
@@ -0,0 +1,115 @@
+package feijoa
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/0xPolygonHermez/zkevm-node/synchronizer/actions"
+	mock_syncinterfaces "github.com/0xPolygonHermez/zkevm-node/synchronizer/common/syncinterfaces/mocks"
+	syncMocks "github.com/0xPolygonHermez/zkevm-node/synchronizer/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mocksFeijoaProcessorSequenceBlobs struct {
+	State        *mock_syncinterfaces.StateFullInterface
+	Synchronizer *mock_syncinterfaces.SynchronizerFullInterface
+	DbTx         *syncMocks.DbTxMock
+}
+
+func createMocks(t *testing.T) *mocksFeijoaProcessorSequenceBlobs {
+	return &mocksFeijoaProcessorSequenceBlobs{
+		State:        mock_syncinterfaces.NewStateFullInterface(t),
+		Synchronizer: mock_syncinterfaces.NewSynchronizerFullInterface(t),
+		DbTx:         syncMocks.NewDbTxMock(t),
+	}
+}
+
+func TestSequenceBlobsNoData(t *testing.T) {
+	mocks := createMocks(t)
+	sut := NewProcessorSequenceBlobs(mocks.State, mocks.Synchronizer)
+	err := sut.Process(context.Background(), etherman.Order{}, nil, mocks.DbTx)
+	require.ErrorIs(t, err, actions.ErrInvalidParams)
+}
+
+// The trusted state is ahead of L1: batch 11 matches the L1 data and batch 12 doesn't
+func TestSequenceBlobsStoreBlobInnerAndVirtualBatchesTrustedStateAhead(t *testing.T) {
+	mocks := createMocks(t)
+	sut := NewProcessorSequenceBlobs(mocks.State, mocks.Synchronizer)
+	ctx := context.Background()
+	coinbase := common.HexToAddress("0x8dAF17A20c9DBA35f005b6324F493785D239719d")
+	l1InfoRoot := common.HexToHash("0x723e5c4c7ee7890e1e66c2e391d553ee792d2204ecb4fe921830f12f8dcd1a92")
+	batchL2Data := []byte{0x0b, 0x00, 0x00, 0x00, 0x01}
+	batchL2Data2 := []byte{0x0b, 0x00, 0x00, 0x00, 0x02}
+	l1Block := &etherman.Block{
+		BlockNumber: 123,
+		ReceivedAt:  time.Now(),
+		SequenceBlobs: []etherman.SequenceBlobs{
+			{
+				Blobs: []etherman.SequenceBlob{
+					{
+						Type:   etherman.TypeCallData,
+						Params: etherman.BlobCommonParams{MaxSequenceTimestamp: 1000, ZkGasLimit: 2000, L1InfoLeafIndex: 3},
+						Data:   []byte{0x01, 0x02},
+					},
+				},
+				L2Coinbase: coinbase,
+				TxHash:     common.HexToHash("0x1"),
+				EventData:  &etherman.SequenceBlobsEventData{LastBlobSequenced: 2},
+			},
+		},
+	}
+	previousBlobInner := &state.BlobInner{
+		BlobInnerNum:     1,
+		BlobStateRoot:    common.HexToHash("0x2"),
+		BlobAccInputHash: common.HexToHash("0x3"),
+	}
+
+	mocks.State.EXPECT().GetLastBlobSequence(ctx, mocks.DbTx).Return(&state.BlobSequence{Index: 1, LastBlobSequenced: 1}, nil).Once()
+	mocks.State.EXPECT().AddBlobSequence(ctx, mock.MatchedBy(func(seq *state.BlobSequence) bool {
+		return seq.Index == 2 && seq.FirstBlobSequenced == 2 && seq.LastBlobSequenced == 2 && seq.BlockNumber == 123
+	}), mocks.DbTx).Return(nil).Once()
+	mocks.State.EXPECT().GetBlobInner(ctx, uint64(1), mocks.DbTx).Return(previousBlobInner, nil).Once()
+	mocks.State.EXPECT().GetLastVirtualBatchNum(ctx, mocks.DbTx).Return(uint64(10), nil).Once()
+	mocks.State.EXPECT().GetBatchByNumber(ctx, uint64(10), mocks.DbTx).Return(&state.Batch{BatchNumber: 10, StateRoot: common.HexToHash("0x4")}, nil).Once()
+	mocks.State.EXPECT().GetL1InfoRootLeafByIndex(ctx, uint32(3), mocks.DbTx).Return(state.L1InfoTreeExitRootStorageEntry{L1InfoTreeRoot: l1InfoRoot}, nil).Once()
+	mocks.State.EXPECT().GetForkIDByBatchNumber(uint64(11)).Return(uint64(10)).Once()
+	mocks.State.EXPECT().ProcessBlobInner(ctx, mock.MatchedBy(func(req state.ProcessBlobInnerRequest) bool {
+		return req.OldNumBlob == 1 && req.OldBlobStateRoot == previousBlobInner.BlobStateRoot &&
+			req.OldStateRoot == common.HexToHash("0x4") && req.LastL1InfoTreeRoot == l1InfoRoot
+	})).Return(&state.ProcessBlobInnerResponse{
+		NewBlobStateRoot:    common.HexToHash("0x5"),
+		NewBlobAccInputHash: common.HexToHash("0x6"),
+		BatchData:           [][]byte{batchL2Data, batchL2Data2},
+	}, nil).Once()
+	mocks.State.EXPECT().AddBlobInner(ctx, mock.MatchedBy(func(blobInner *state.BlobInner) bool {
+		return blobInner.BlobInnerNum == 2 && blobInner.BlobSequenceIndex == 2 && blobInner.BlobStateRoot == common.HexToHash("0x5")
+	}), mocks.DbTx).Return(nil).Once()
+
+	// batch 11 is already on the trusted state with the same data, so it's only virtualized
+	mocks.State.EXPECT().GetL1InfoTreeDataFromBatchL2Data(ctx, batchL2Data, mocks.DbTx).Return(map[uint32]state.L1DataV2{}, l1InfoRoot, common.Hash{}, nil).Once()
+	mocks.State.EXPECT().GetBatchByNumber(ctx, uint64(11), mocks.DbTx).Return(&state.Batch{BatchNumber: 11, BatchL2Data: batchL2Data}, nil).Once()
+	mocks.State.EXPECT().AddVirtualBatch(ctx, mock.MatchedBy(func(virtualBatch *state.VirtualBatch) bool {
+		return virtualBatch.BatchNumber == 11 && virtualBatch.BlobInnerNum != nil && *virtualBatch.BlobInnerNum == 2
+	}), mocks.DbTx).Return(nil).Once()
+
+	// batch 12 on the trusted state doesn't match, so the trusted state is reset before processing it
+	mocks.State.EXPECT().GetL1InfoTreeDataFromBatchL2Data(ctx, batchL2Data2, mocks.DbTx).Return(map[uint32]state.L1DataV2{}, l1InfoRoot, common.Hash{}, nil).Once()
+	mocks.State.EXPECT().GetBatchByNumber(ctx, uint64(12), mocks.DbTx).Return(&state.Batch{BatchNumber: 12, BatchL2Data: []byte{0xff}}, nil).Once()
+	mocks.Synchronizer.EXPECT().CleanTrustedState().Once()
+	mocks.State.EXPECT().ResetTrustedState(ctx, uint64(11), mocks.DbTx).Return(nil).Once()
+	mocks.State.EXPECT().ProcessAndStoreClosedBatchV2(ctx, mock.MatchedBy(func(processCtx state.ProcessingContextV2) bool {
+		return processCtx.BatchNumber == 12 && processCtx.Coinbase == coinbase
+	}), mocks.DbTx, mock.Anything).Return(common.HexToHash("0x7"), uint64(1), "prover", nil).Once()
+	mocks.Synchronizer.EXPECT().PendingFlushID(uint64(1), "prover").Once()
+	mocks.State.EXPECT().AddVirtualBatch(ctx, mock.MatchedBy(func(virtualBatch *state.VirtualBatch) bool {
+		return virtualBatch.BatchNumber == 12 && virtualBatch.BlobInnerNum != nil && *virtualBatch.BlobInnerNum == 2
+	}), mocks.DbTx).Return(nil).Once()
+
+	err := sut.Process(ctx, etherman.Order{Pos: 0}, l1Block, mocks.DbTx)
+	require.NoError(t, err)
+}
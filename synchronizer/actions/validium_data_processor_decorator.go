@@ -0,0 +1,105 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-node/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/jackc/pgx/v4"
+)
+
+type batchDataProvider interface {
+	GetBatchL2Data(ctx context.Context, batchNumbers []uint64, hashes []common.Hash, dbTx pgx.Tx) ([][]byte, error)
+}
+
+type stateGetForcedBatches interface {
+	GetNextForcedBatches(ctx context.Context, nextForcedBatches int, dbTx pgx.Tx) ([]state.ForcedBatch, error)
+}
+
+// ValidiumDataProcessorDecorator This class is a decorator that fills the transactions of the batches
+// sequenced in validium mode before calling the real processor
+type ValidiumDataProcessorDecorator struct {
+	L1EventProcessor
+	dataProvider batchDataProvider
+	state        stateGetForcedBatches
+}
+
+// NewValidiumDataDecorator creates a new ValidiumDataProcessorDecorator
+func NewValidiumDataDecorator(l1EventProcessor L1EventProcessor, dataProvider batchDataProvider, state stateGetForcedBatches) *ValidiumDataProcessorDecorator {
+	return &ValidiumDataProcessorDecorator{
+		L1EventProcessor: l1EventProcessor,
+		dataProvider:     dataProvider,
+		state:            state,
+	}
+}
+
+// Process retrieves the transactions of the validium batches by its hash and after calls the real Process
+func (p *ValidiumDataProcessorDecorator) Process(ctx context.Context, order etherman.Order, l1Block *etherman.Block, dbTx pgx.Tx) error {
+	if order.Name == etherman.SequenceBatchesOrder && l1Block != nil && len(l1Block.SequencedBatches) > order.Pos {
+		if err := p.fillValidiumBatchesData(ctx, l1Block.SequencedBatches[order.Pos], dbTx); err != nil {
+			return err
+		}
+	}
+	return p.L1EventProcessor.Process(ctx, order, l1Block, dbTx)
+}
+
+// fillValidiumBatchesData sets the transactions of the validium batches of the sequence. The data of the
+// forced batches is on L1, so it's read from the state, the rest is requested to the data provider
+func (p *ValidiumDataProcessorDecorator) fillValidiumBatchesData(ctx context.Context, sequencedBatches []etherman.SequencedBatch, dbTx pgx.Tx) error {
+	var batchNumbers []uint64
+	var hashes []common.Hash
+	var forced []int
+	for i, sbatch := range sequencedBatches {
+		if sbatch.SequencedBatchValidiumData == nil || sbatch.PolygonRollupBaseEtrogBatchData == nil {
+			continue
+		}
+		if sbatch.PolygonRollupBaseEtrogBatchData.ForcedTimestamp > 0 {
+			forced = append(forced, i)
+			continue
+		}
+		batchNumbers = append(batchNumbers, sbatch.BatchNumber)
+		hashes = append(hashes, sbatch.SequencedBatchValidiumData.TransactionsHash)
+	}
+
+	if len(forced) > 0 {
+		forcedBatches, err := p.state.GetNextForcedBatches(ctx, len(forced), dbTx)
+		if err != nil {
+			log.Errorf("error getting the next forced batches to fill the validium sequence. Error: %v", err)
+			return err
+		}
+		if len(forcedBatches) != len(forced) {
+			return fmt.Errorf("%d forced batches found in the state for the %d forced batches of the validium sequence", len(forcedBatches), len(forced))
+		}
+		for i, pos := range forced {
+			sbatch := sequencedBatches[pos]
+			// A mismatch is left to be detected by the processor comparing the forced batch with the sequenced one
+			if crypto.Keccak256Hash(forcedBatches[i].RawTxsData) != sbatch.SequencedBatchValidiumData.TransactionsHash {
+				log.Warnf("forced batch %d data doesn't match the transactions hash of the validium sequence", sbatch.BatchNumber)
+				continue
+			}
+			sbatch.PolygonRollupBaseEtrogBatchData.Transactions = forcedBatches[i].RawTxsData
+		}
+	}
+
+	if len(batchNumbers) == 0 {
+		return nil
+	}
+	batchesData, err := p.dataProvider.GetBatchL2Data(ctx, batchNumbers, hashes, dbTx)
+	if err != nil {
+		log.Errorf("error getting the data of the validium batches %v. Error: %v", batchNumbers, err)
+		return err
+	}
+	i := 0
+	for _, sbatch := range sequencedBatches {
+		if sbatch.SequencedBatchValidiumData == nil || sbatch.PolygonRollupBaseEtrogBatchData == nil || sbatch.PolygonRollupBaseEtrogBatchData.ForcedTimestamp > 0 {
+			continue
+		}
+		sbatch.PolygonRollupBaseEtrogBatchData.Transactions = batchesData[i]
+		i++
+	}
+	return nil
+}
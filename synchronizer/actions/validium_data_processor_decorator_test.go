@@ -0,0 +1,77 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-node/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/etherman/smartcontracts/etrogpolygonzkevm"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type processorMock struct {
+	L1EventProcessor
+	processed *etherman.Block
+}
+
+func (p *processorMock) Process(ctx context.Context, order etherman.Order, l1Block *etherman.Block, dbTx pgx.Tx) error {
+	p.processed = l1Block
+	return nil
+}
+
+type batchDataProviderMock struct {
+	data map[common.Hash][]byte
+}
+
+func (m *batchDataProviderMock) GetBatchL2Data(ctx context.Context, batchNumbers []uint64, hashes []common.Hash, dbTx pgx.Tx) ([][]byte, error) {
+	batchesData := make([][]byte, 0, len(hashes))
+	for _, hash := range hashes {
+		batchesData = append(batchesData, m.data[hash])
+	}
+	return batchesData, nil
+}
+
+type forcedBatchesMock struct {
+	forcedBatches []state.ForcedBatch
+}
+
+func (m *forcedBatchesMock) GetNextForcedBatches(ctx context.Context, nextForcedBatches int, dbTx pgx.Tx) ([]state.ForcedBatch, error) {
+	return m.forcedBatches[:nextForcedBatches], nil
+}
+
+func newValidiumSequencedBatch(batchNumber uint64, data []byte, forcedTimestamp uint64) etherman.SequencedBatch {
+	return etherman.SequencedBatch{
+		BatchNumber:                     batchNumber,
+		PolygonRollupBaseEtrogBatchData: &etrogpolygonzkevm.PolygonRollupBaseEtrogBatchData{ForcedTimestamp: forcedTimestamp},
+		SequencedBatchValidiumData:      &etherman.SequencedBatchValidiumData{TransactionsHash: crypto.Keccak256Hash(data)},
+	}
+}
+
+func TestValidiumDataDecoratorProcess(t *testing.T) {
+	batchData := []byte{0x01, 0x02}
+	forcedData := []byte{0x03}
+	processor := &processorMock{}
+	dataProvider := &batchDataProviderMock{data: map[common.Hash][]byte{crypto.Keccak256Hash(batchData): batchData}}
+	st := &forcedBatchesMock{forcedBatches: []state.ForcedBatch{{RawTxsData: forcedData}}}
+	decorator := NewValidiumDataDecorator(processor, dataProvider, st)
+
+	l1Block := &etherman.Block{
+		SequencedBatches: [][]etherman.SequencedBatch{{
+			newValidiumSequencedBatch(1, batchData, 0),
+			newValidiumSequencedBatch(2, forcedData, 1700000000),
+			{BatchNumber: 3, PolygonRollupBaseEtrogBatchData: &etrogpolygonzkevm.PolygonRollupBaseEtrogBatchData{Transactions: []byte{0x04}}},
+		}},
+	}
+	err := decorator.Process(context.Background(), etherman.Order{Name: etherman.SequenceBatchesOrder, Pos: 0}, l1Block, nil)
+	require.NoError(t, err)
+	require.Equal(t, l1Block, processor.processed)
+
+	sequence := l1Block.SequencedBatches[0]
+	require.Equal(t, batchData, sequence[0].PolygonRollupBaseEtrogBatchData.Transactions)
+	require.Equal(t, forcedData, sequence[1].PolygonRollupBaseEtrogBatchData.Transactions)
+	require.Equal(t, []byte{0x04}, sequence[2].PolygonRollupBaseEtrogBatchData.Transactions)
+}
@@ -0,0 +1,13 @@
+package syncinterfaces
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+// BatchDataProvider retrieves the data of the batches sequenced in validium mode
+type BatchDataProvider interface {
+	GetBatchL2Data(ctx context.Context, batchNumbers []uint64, hashes []common.Hash, dbTx pgx.Tx) ([][]byte, error)
+}
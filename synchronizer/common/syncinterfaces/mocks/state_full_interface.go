@@ -84,6 +84,54 @@ func (_c *StateFullInterface_AddAccumulatedInputHash_Call) RunAndReturn(run func
 	return _c
 }
 
+// AddBlobInner provides a mock function with given fields: ctx, blobInner, dbTx
+func (_m *StateFullInterface) AddBlobInner(ctx context.Context, blobInner *state.BlobInner, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, blobInner, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBlobInner")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *state.BlobInner, pgx.Tx) error); ok {
+		r0 = rf(ctx, blobInner, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StateFullInterface_AddBlobInner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBlobInner'
+type StateFullInterface_AddBlobInner_Call struct {
+	*mock.Call
+}
+
+// AddBlobInner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blobInner *state.BlobInner
+//   - dbTx pgx.Tx
+func (_e *StateFullInterface_Expecter) AddBlobInner(ctx interface{}, blobInner interface{}, dbTx interface{}) *StateFullInterface_AddBlobInner_Call {
+	return &StateFullInterface_AddBlobInner_Call{Call: _e.mock.On("AddBlobInner", ctx, blobInner, dbTx)}
+}
+
+func (_c *StateFullInterface_AddBlobInner_Call) Run(run func(ctx context.Context, blobInner *state.BlobInner, dbTx pgx.Tx)) *StateFullInterface_AddBlobInner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*state.BlobInner), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StateFullInterface_AddBlobInner_Call) Return(_a0 error) *StateFullInterface_AddBlobInner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StateFullInterface_AddBlobInner_Call) RunAndReturn(run func(context.Context, *state.BlobInner, pgx.Tx) error) *StateFullInterface_AddBlobInner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddBlobSequence provides a mock function with given fields: ctx, blobSequence, dbTx
 func (_m *StateFullInterface) AddBlobSequence(ctx context.Context, blobSequence *state.BlobSequence, dbTx pgx.Tx) error {
 	ret := _m.Called(ctx, blobSequence, dbTx)
@@ -929,6 +977,66 @@ func (_c *StateFullInterface_GetBatchByNumber_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetBlobInner provides a mock function with given fields: ctx, blobInnerNum, dbTx
+func (_m *StateFullInterface) GetBlobInner(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx) (*state.BlobInner, error) {
+	ret := _m.Called(ctx, blobInnerNum, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobInner")
+	}
+
+	var r0 *state.BlobInner
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) (*state.BlobInner, error)); ok {
+		return rf(ctx, blobInnerNum, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) *state.BlobInner); ok {
+		r0 = rf(ctx, blobInnerNum, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.BlobInner)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, blobInnerNum, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateFullInterface_GetBlobInner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobInner'
+type StateFullInterface_GetBlobInner_Call struct {
+	*mock.Call
+}
+
+// GetBlobInner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blobInnerNum uint64
+//   - dbTx pgx.Tx
+func (_e *StateFullInterface_Expecter) GetBlobInner(ctx interface{}, blobInnerNum interface{}, dbTx interface{}) *StateFullInterface_GetBlobInner_Call {
+	return &StateFullInterface_GetBlobInner_Call{Call: _e.mock.On("GetBlobInner", ctx, blobInnerNum, dbTx)}
+}
+
+func (_c *StateFullInterface_GetBlobInner_Call) Run(run func(ctx context.Context, blobInnerNum uint64, dbTx pgx.Tx)) *StateFullInterface_GetBlobInner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StateFullInterface_GetBlobInner_Call) Return(_a0 *state.BlobInner, _a1 error) *StateFullInterface_GetBlobInner_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateFullInterface_GetBlobInner_Call) RunAndReturn(run func(context.Context, uint64, pgx.Tx) (*state.BlobInner, error)) *StateFullInterface_GetBlobInner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExitRootByGlobalExitRoot provides a mock function with given fields: ctx, ger, dbTx
 func (_m *StateFullInterface) GetExitRootByGlobalExitRoot(ctx context.Context, ger common.Hash, dbTx pgx.Tx) (*state.GlobalExitRoot, error) {
 	ret := _m.Called(ctx, ger, dbTx)
@@ -1188,6 +1296,64 @@ func (_c *StateFullInterface_GetForkIDs_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetL1InfoRootLeafByIndex provides a mock function with given fields: ctx, l1InfoTreeIndex, dbTx
+func (_m *StateFullInterface) GetL1InfoRootLeafByIndex(ctx context.Context, l1InfoTreeIndex uint32, dbTx pgx.Tx) (state.L1InfoTreeExitRootStorageEntry, error) {
+	ret := _m.Called(ctx, l1InfoTreeIndex, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetL1InfoRootLeafByIndex")
+	}
+
+	var r0 state.L1InfoTreeExitRootStorageEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, pgx.Tx) (state.L1InfoTreeExitRootStorageEntry, error)); ok {
+		return rf(ctx, l1InfoTreeIndex, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, pgx.Tx) state.L1InfoTreeExitRootStorageEntry); ok {
+		r0 = rf(ctx, l1InfoTreeIndex, dbTx)
+	} else {
+		r0 = ret.Get(0).(state.L1InfoTreeExitRootStorageEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, pgx.Tx) error); ok {
+		r1 = rf(ctx, l1InfoTreeIndex, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateFullInterface_GetL1InfoRootLeafByIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetL1InfoRootLeafByIndex'
+type StateFullInterface_GetL1InfoRootLeafByIndex_Call struct {
+	*mock.Call
+}
+
+// GetL1InfoRootLeafByIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - l1InfoTreeIndex uint32
+//   - dbTx pgx.Tx
+func (_e *StateFullInterface_Expecter) GetL1InfoRootLeafByIndex(ctx interface{}, l1InfoTreeIndex interface{}, dbTx interface{}) *StateFullInterface_GetL1InfoRootLeafByIndex_Call {
+	return &StateFullInterface_GetL1InfoRootLeafByIndex_Call{Call: _e.mock.On("GetL1InfoRootLeafByIndex", ctx, l1InfoTreeIndex, dbTx)}
+}
+
+func (_c *StateFullInterface_GetL1InfoRootLeafByIndex_Call) Run(run func(ctx context.Context, l1InfoTreeIndex uint32, dbTx pgx.Tx)) *StateFullInterface_GetL1InfoRootLeafByIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint32), args[2].(pgx.Tx))
+	})
+	return _c
+}
+
+func (_c *StateFullInterface_GetL1InfoRootLeafByIndex_Call) Return(_a0 state.L1InfoTreeExitRootStorageEntry, _a1 error) *StateFullInterface_GetL1InfoRootLeafByIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateFullInterface_GetL1InfoRootLeafByIndex_Call) RunAndReturn(run func(context.Context, uint32, pgx.Tx) (state.L1InfoTreeExitRootStorageEntry, error)) *StateFullInterface_GetL1InfoRootLeafByIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetL1InfoRootLeafByL1InfoRoot provides a mock function with given fields: ctx, l1InfoRoot, dbTx
 func (_m *StateFullInterface) GetL1InfoRootLeafByL1InfoRoot(ctx context.Context, l1InfoRoot common.Hash, dbTx pgx.Tx) (state.L1InfoTreeExitRootStorageEntry, error) {
 	ret := _m.Called(ctx, l1InfoRoot, dbTx)
@@ -2414,6 +2580,65 @@ func (_c *StateFullInterface_ProcessBatchV2_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// ProcessBlobInner provides a mock function with given fields: ctx, request
+func (_m *StateFullInterface) ProcessBlobInner(ctx context.Context, request state.ProcessBlobInnerRequest) (*state.ProcessBlobInnerResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessBlobInner")
+	}
+
+	var r0 *state.ProcessBlobInnerResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, state.ProcessBlobInnerRequest) (*state.ProcessBlobInnerResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, state.ProcessBlobInnerRequest) *state.ProcessBlobInnerResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*state.ProcessBlobInnerResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, state.ProcessBlobInnerRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateFullInterface_ProcessBlobInner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessBlobInner'
+type StateFullInterface_ProcessBlobInner_Call struct {
+	*mock.Call
+}
+
+// ProcessBlobInner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request state.ProcessBlobInnerRequest
+func (_e *StateFullInterface_Expecter) ProcessBlobInner(ctx interface{}, request interface{}) *StateFullInterface_ProcessBlobInner_Call {
+	return &StateFullInterface_ProcessBlobInner_Call{Call: _e.mock.On("ProcessBlobInner", ctx, request)}
+}
+
+func (_c *StateFullInterface_ProcessBlobInner_Call) Run(run func(ctx context.Context, request state.ProcessBlobInnerRequest)) *StateFullInterface_ProcessBlobInner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(state.ProcessBlobInnerRequest))
+	})
+	return _c
+}
+
+func (_c *StateFullInterface_ProcessBlobInner_Call) Return(_a0 *state.ProcessBlobInnerResponse, _a1 error) *StateFullInterface_ProcessBlobInner_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateFullInterface_ProcessBlobInner_Call) RunAndReturn(run func(context.Context, state.ProcessBlobInnerRequest) (*state.ProcessBlobInnerResponse, error)) *StateFullInterface_ProcessBlobInner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Reset provides a mock function with given fields: ctx, blockNumber, dbTx
 func (_m *StateFullInterface) Reset(ctx context.Context, blockNumber uint64, dbTx pgx.Tx) error {
 	ret := _m.Called(ctx, blockNumber, dbTx)
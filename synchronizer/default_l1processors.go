@@ -23,9 +23,14 @@ func defaultsL1EventProcessors(sync *ClientSynchronizer, l2Blockchecker *actions
 	p.Register(actions.NewCheckL2BlockDecorator(sequenceBatchesProcessor, l2Blockchecker))
 	p.Register(incaberry.NewProcessorL1VerifyBatch(sync.state))
 	p.Register(etrog.NewProcessorL1UpdateEtrogSequence(sync.state, sync, common.DefaultTimeProvider{}))
-	p.Register(actions.NewCheckL2BlockDecorator(elderberry.NewProcessorL1SequenceBatchesElderberry(sequenceBatchesProcessor, sync.state), l2Blockchecker))
+	var sequenceBatchesElderberryProcessor actions.L1EventProcessor = elderberry.NewProcessorL1SequenceBatchesElderberry(sequenceBatchesProcessor, sync.state)
+	if sync.dataAvailability != nil {
+		// In validium mode the transactions of the batches are retrieved by its hash before processing the sequence
+		sequenceBatchesElderberryProcessor = actions.NewValidiumDataDecorator(sequenceBatchesElderberryProcessor, sync.dataAvailability, sync.state)
+	}
+	p.Register(actions.NewCheckL2BlockDecorator(sequenceBatchesElderberryProcessor, l2Blockchecker))
 	// intialSequence is process in ETROG by the same class, this is just a wrapper to pass directly to ETROG
 	p.Register(elderberry.NewProcessorL1InitialSequenceBatchesElderberry(sequenceBatchesProcessor))
-	p.Register(feijoa.NewProcessorSequenceBlobs(sync.state))
+	p.Register(feijoa.NewProcessorSequenceBlobs(sync.state, sync))
 	return p.Build()
 }
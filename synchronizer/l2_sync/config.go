@@ -1,5 +1,12 @@
 package l2_sync
 
+const (
+	// JSONRPCSyncMode is the value for SyncMode to get the trusted batches polling the trusted node JSON-RPC
+	JSONRPCSyncMode = "jsonrpc"
+	// DataStreamSyncMode is the value for SyncMode to get the trusted batches from the data stream of the trusted sequencer
+	DataStreamSyncMode = "datastream"
+)
+
 // Config configuration of L2 sync process
 type Config struct {
 	// AcceptEmptyClosedBatches is a flag to enable or disable the acceptance of empty batches.
@@ -11,4 +18,12 @@ type Config struct {
 
 	// CheckLastL2BlockHashOnCloseBatch if is true when a batch is closed is force to check the last L2Block hash
 	CheckLastL2BlockHashOnCloseBatch bool `mapstructure:"CheckLastL2BlockHashOnCloseBatch"`
+
+	// SyncMode is the source of the trusted batches:
+	// - jsonrpc: polls the zkevm_getBatchByNumber endpoint of the trusted node each SyncInterval
+	// - datastream: consumes the L2 blocks as soon as they are published on the data stream of the trusted sequencer
+	SyncMode string `mapstructure:"SyncMode" jsonschema:"enum=jsonrpc,enum=datastream"`
+
+	// DataStreamServer is the address (host:port) of the data stream server of the trusted sequencer, used on datastream mode
+	DataStreamServer string `mapstructure:"DataStreamServer"`
 }
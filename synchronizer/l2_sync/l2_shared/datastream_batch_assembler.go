@@ -0,0 +1,169 @@
+package l2_shared
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-data-streamer/datastreamer"
+	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/types"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// ErrUnexpectedDataStreamEntry is returned when an entry of the data stream doesn't follow the expected sequence
+	ErrUnexpectedDataStreamEntry = errors.New("unexpected data stream entry")
+)
+
+// DataStreamBatchAssembler builds the trusted batches from the entries of the data stream. The stream
+// contains for each batch a batch bookmark followed by its L2 blocks (L2BlockStart, L2Tx..., L2BlockEnd),
+// or by an UpdateGER entry if the batch is empty. A batch is closed when the bookmark of the next one arrives
+type DataStreamBatchAssembler struct {
+	batchNumber       uint64
+	waitingBookmark   bool
+	lastEntry         uint64
+	previousStateRoot common.Hash
+	batch             *types.Batch
+	blocks            []state.L2BlockRaw
+	currentBlock      *state.L2BlockRaw
+}
+
+// NewDataStreamBatchAssembler creates a new DataStreamBatchAssembler
+func NewDataStreamBatchAssembler() *DataStreamBatchAssembler {
+	return &DataStreamBatchAssembler{}
+}
+
+// Reset discards the current batch and waits for the bookmark of batchNumber to start assembling it.
+// previousStateRoot is the StateRoot of the previous batch, used for the empty batches
+func (a *DataStreamBatchAssembler) Reset(batchNumber uint64, previousStateRoot common.Hash) {
+	a.batchNumber = batchNumber
+	a.waitingBookmark = true
+	a.previousStateRoot = previousStateRoot
+	a.batch = nil
+	a.blocks = nil
+	a.currentBlock = nil
+}
+
+// BatchNumber returns the batch that is being assembled
+func (a *DataStreamBatchAssembler) BatchNumber() uint64 {
+	return a.batchNumber
+}
+
+// AddEntry adds an entry of the data stream to the current batch. It returns the batch to be processed when the entry
+// completes a L2 block or an update of the batch (open), or when it's the bookmark of the next batch (closed). Otherwise returns nil
+func (a *DataStreamBatchAssembler) AddEntry(entry *datastreamer.FileEntry) (*types.Batch, error) {
+	if a.waitingBookmark {
+		// The entries before the bookmark of the batch are from a previous streaming
+		if entry.Type == state.EntryTypeBookMark {
+			bookmark := state.DSBookMark{}.Decode(entry.Data)
+			if bookmark.Type == state.BookMarkTypeBatch && bookmark.Value == a.batchNumber {
+				a.waitingBookmark = false
+				a.lastEntry = entry.Number
+				a.openBatch(bookmark.Value)
+			}
+		}
+		return nil, nil
+	}
+	if entry.Number <= a.lastEntry {
+		// The entry was already added, it's replayed by a restart of the streaming
+		return nil, nil
+	}
+	a.lastEntry = entry.Number
+
+	switch entry.Type {
+	case state.EntryTypeBookMark:
+		return a.addBookmark(state.DSBookMark{}.Decode(entry.Data))
+
+	case state.EntryTypeL2BlockStart:
+		blockStart := state.DSL2BlockStart{}.Decode(entry.Data)
+		if blockStart.BatchNumber != a.batchNumber || a.currentBlock != nil {
+			return nil, fmt.Errorf("L2BlockStart of L2Block %d (batch %d) on batch %d: %w", blockStart.L2BlockNumber, blockStart.BatchNumber, a.batchNumber, ErrUnexpectedDataStreamEntry)
+		}
+		a.currentBlock = &state.L2BlockRaw{
+			ChangeL2BlockHeader: state.ChangeL2BlockHeader{
+				DeltaTimestamp:  blockStart.DeltaTimestamp,
+				IndexL1InfoTree: blockStart.L1InfoTreeIndex,
+			},
+		}
+		a.batch.Coinbase = blockStart.Coinbase
+		a.batch.Timestamp = types.ArgUint64(blockStart.Timestamp)
+		// The GlobalExitRoot of the batch is the last one used by its L2 blocks
+		if blockStart.GlobalExitRoot != state.ZeroHash {
+			a.batch.GlobalExitRoot = blockStart.GlobalExitRoot
+		}
+		return nil, nil
+
+	case state.EntryTypeL2Tx:
+		if a.currentBlock == nil {
+			return nil, fmt.Errorf("L2Tx outside of a L2Block on batch %d: %w", a.batchNumber, ErrUnexpectedDataStreamEntry)
+		}
+		l2Tx := state.DSL2Transaction{}.Decode(entry.Data)
+		tx := new(ethTypes.Transaction)
+		if err := tx.UnmarshalBinary(l2Tx.Encoded); err != nil {
+			return nil, fmt.Errorf("failed to decode tx of batch %d from data stream entry %d: %w", a.batchNumber, entry.Number, err)
+		}
+		a.currentBlock.Transactions = append(a.currentBlock.Transactions, state.L2TxRaw{
+			EfficiencyPercentage: l2Tx.EffectiveGasPricePercentage,
+			Tx:                   *tx,
+		})
+		return nil, nil
+
+	case state.EntryTypeL2BlockEnd:
+		if a.currentBlock == nil {
+			return nil, fmt.Errorf("L2BlockEnd outside of a L2Block on batch %d: %w", a.batchNumber, ErrUnexpectedDataStreamEntry)
+		}
+		blockEnd := state.DSL2BlockEnd{}.Decode(entry.Data)
+		a.blocks = append(a.blocks, *a.currentBlock)
+		a.currentBlock = nil
+		batchL2Data, err := state.EncodeBatchV2(&state.BatchRawV2{Blocks: a.blocks})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode batch %d: %w", a.batchNumber, err)
+		}
+		a.batch.BatchL2Data = batchL2Data
+		a.batch.StateRoot = blockEnd.StateRoot
+		return a.getBatch(), nil
+
+	case state.EntryTypeUpdateGER:
+		updateGER := state.DSUpdateGER{}.Decode(entry.Data)
+		if updateGER.BatchNumber != a.batchNumber {
+			return nil, fmt.Errorf("UpdateGER of batch %d on batch %d: %w", updateGER.BatchNumber, a.batchNumber, ErrUnexpectedDataStreamEntry)
+		}
+		a.batch.GlobalExitRoot = updateGER.GlobalExitRoot
+		a.batch.Coinbase = updateGER.Coinbase
+		a.batch.Timestamp = types.ArgUint64(updateGER.Timestamp)
+		a.batch.StateRoot = updateGER.StateRoot
+		return a.getBatch(), nil
+	}
+	return nil, nil
+}
+
+func (a *DataStreamBatchAssembler) addBookmark(bookmark state.DSBookMark) (*types.Batch, error) {
+	if bookmark.Type != state.BookMarkTypeBatch {
+		return nil, nil
+	}
+	if bookmark.Value != a.batchNumber+1 || a.currentBlock != nil {
+		return nil, fmt.Errorf("bookmark of batch %d on batch %d: %w", bookmark.Value, a.batchNumber, ErrUnexpectedDataStreamEntry)
+	}
+	closedBatch := a.getBatch()
+	closedBatch.Closed = true
+	a.previousStateRoot = closedBatch.StateRoot
+	a.openBatch(bookmark.Value)
+	return closedBatch, nil
+}
+
+func (a *DataStreamBatchAssembler) openBatch(batchNumber uint64) {
+	a.batchNumber = batchNumber
+	a.blocks = nil
+	a.currentBlock = nil
+	a.batch = &types.Batch{
+		Number:    types.ArgUint64(batchNumber),
+		StateRoot: a.previousStateRoot,
+	}
+}
+
+// getBatch returns a copy of the current batch, so it's not modified by the processing
+func (a *DataStreamBatchAssembler) getBatch() *types.Batch {
+	batch := *a.batch
+	return &batch
+}
@@ -50,6 +50,9 @@ type ProcessData struct {
 	Description        string
 	// DebugPrefix is used to log, must prefix all logs entries
 	DebugPrefix string
+	// LocalExitRootFromExecution is true when the source of the TrustedBatch doesn't provide its LocalExitRoot (datastream mode),
+	// so it must be taken from the execution. The check of the StateRoot already guarantees that it's the right one
+	LocalExitRootFromExecution bool
 }
 
 // ProcessResponse contains the response of the process of a batch
@@ -305,6 +308,15 @@ func (s *ProcessorTrustedBatchSync) GetModeForProcessBatch(trustedNodeBatch *typ
 		return ProcessData{}, fmt.Errorf("trustedNodeBatch and statePreviousBatch can't be nil")
 	}
 
+	localExitRootFromExecution := s.Cfg.SyncMode == l2_sync.DataStreamSyncMode
+	if localExitRootFromExecution {
+		// Until it's executed the LocalExitRoot is the one of the batch on state, or the previous one if it's a new batch
+		trustedNodeBatch.LocalExitRoot = statePreviousBatch.LocalExitRoot
+		if stateBatch != nil {
+			trustedNodeBatch.LocalExitRoot = stateBatch.LocalExitRoot
+		}
+	}
+
 	var result ProcessData = ProcessData{}
 	if stateBatch == nil {
 		result = ProcessData{
@@ -374,6 +386,7 @@ func (s *ProcessorTrustedBatchSync) GetModeForProcessBatch(trustedNodeBatch *typ
 	result.OldAccInputHash = statePreviousBatch.AccInputHash
 	result.Now = s.timeProvider.Now()
 	result.DebugPrefix = fmt.Sprintf("%s mode %s:", debugPrefix, result.Mode)
+	result.LocalExitRootFromExecution = localExitRootFromExecution
 
 	if isTrustedBatchEmptyAndClosed(trustedNodeBatch) {
 		if s.Cfg.AcceptEmptyClosedBatches {
@@ -0,0 +1,269 @@
+package test_l2_shared
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-data-streamer/datastreamer"
+	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/types"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	commonSync "github.com/0xPolygonHermez/zkevm-node/synchronizer/common"
+	"github.com/0xPolygonHermez/zkevm-node/synchronizer/common/syncinterfaces"
+	mock_syncinterfaces "github.com/0xPolygonHermez/zkevm-node/synchronizer/common/syncinterfaces/mocks"
+	"github.com/0xPolygonHermez/zkevm-node/synchronizer/l2_sync/l2_shared"
+	mock_l2_shared "github.com/0xPolygonHermez/zkevm-node/synchronizer/l2_sync/l2_shared/mocks"
+	syncMocks "github.com/0xPolygonHermez/zkevm-node/synchronizer/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	coinbase = common.HexToAddress("0x617b3a3528F9cDd6630fd3301B9c8911F7Bf063D")
+	ger      = common.HexToHash("0x8a4c3d5ac3b1d5d3c7ff0ef6d5d5ae2d8e2b4b7e0e1cf4dbb1dc9f1e6c3a4b5c")
+)
+
+// dataStreamEntries returns the entries of the data stream for a batch with a L2 block with a tx
+// followed by the bookmark of the next batch, starting at the entry firstEntry
+func dataStreamEntries(t *testing.T, batchNumber uint64, firstEntry uint64) []datastreamer.FileEntry {
+	tx := ethTypes.NewTransaction(1, common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(1), nil)
+	encodedTx, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	entries := []datastreamer.FileEntry{
+		{Type: state.EntryTypeBookMark, Data: state.DSBookMark{Type: state.BookMarkTypeBatch, Value: batchNumber}.Encode()},
+		{Type: state.EntryTypeBookMark, Data: state.DSBookMark{Type: state.BookMarkTypeL2Block, Value: 10}.Encode()},
+		{Type: state.EntryTypeL2BlockStart, Data: state.DSL2BlockStart{BatchNumber: batchNumber, L2BlockNumber: 10, Timestamp: 1000, DeltaTimestamp: 3,
+			L1InfoTreeIndex: 7, GlobalExitRoot: ger, Coinbase: coinbase}.Encode()},
+		{Type: state.EntryTypeL2Tx, Data: state.DSL2Transaction{EffectiveGasPricePercentage: 255, IsValid: 1, StateRoot: hash1,
+			EncodedLength: uint32(len(encodedTx)), Encoded: encodedTx}.Encode()},
+		{Type: state.EntryTypeL2BlockEnd, Data: state.DSL2BlockEnd{L2BlockNumber: 10, BlockHash: hash1, StateRoot: hash1}.Encode()},
+		{Type: state.EntryTypeBookMark, Data: state.DSBookMark{Type: state.BookMarkTypeBatch, Value: batchNumber + 1}.Encode()},
+	}
+	for i := range entries {
+		entries[i].Number = firstEntry + uint64(i)
+	}
+	return entries
+}
+
+func TestDataStreamBatchAssembler(t *testing.T) {
+	sut := l2_shared.NewDataStreamBatchAssembler()
+	sut.Reset(5, hash2)
+	entries := dataStreamEntries(t, 5, 100)
+
+	// The entries of a previous streaming are discarded until the bookmark of the batch
+	batch, err := sut.AddEntry(&datastreamer.FileEntry{Type: state.EntryTypeL2BlockEnd, Number: 50, Data: state.DSL2BlockEnd{}.Encode()})
+	require.NoError(t, err)
+	require.Nil(t, batch)
+
+	var batches []*types.Batch
+	for i := range entries {
+		batch, err := sut.AddEntry(&entries[i])
+		require.NoError(t, err)
+		if batch != nil {
+			batches = append(batches, batch)
+		}
+	}
+	require.Equal(t, 2, len(batches))
+	require.Equal(t, uint64(6), sut.BatchNumber())
+
+	// The L2 block completes the open batch
+	openBatch := batches[0]
+	require.Equal(t, types.ArgUint64(5), openBatch.Number)
+	require.False(t, openBatch.Closed)
+	require.Equal(t, hash1, openBatch.StateRoot)
+	require.Equal(t, ger, openBatch.GlobalExitRoot)
+	require.Equal(t, coinbase, openBatch.Coinbase)
+	require.Equal(t, types.ArgUint64(1000), openBatch.Timestamp)
+	rawBatch, err := state.DecodeBatchV2(openBatch.BatchL2Data)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rawBatch.Blocks))
+	require.Equal(t, uint32(3), rawBatch.Blocks[0].DeltaTimestamp)
+	require.Equal(t, uint32(7), rawBatch.Blocks[0].IndexL1InfoTree)
+	require.Equal(t, 1, len(rawBatch.Blocks[0].Transactions))
+	require.Equal(t, uint8(255), rawBatch.Blocks[0].Transactions[0].EfficiencyPercentage)
+
+	// The bookmark of the next batch closes it
+	closedBatch := batches[1]
+	require.True(t, closedBatch.Closed)
+	require.Equal(t, openBatch.BatchL2Data, closedBatch.BatchL2Data)
+
+	// The replayed entries are ignored
+	batch, err = sut.AddEntry(&entries[4])
+	require.NoError(t, err)
+	require.Nil(t, batch)
+
+	// A L2 block of other batch is not expected
+	_, err = sut.AddEntry(&datastreamer.FileEntry{Type: state.EntryTypeL2BlockStart, Number: 200, Data: state.DSL2BlockStart{BatchNumber: 8}.Encode()})
+	require.ErrorIs(t, err, l2_shared.ErrUnexpectedDataStreamEntry)
+}
+
+func TestDataStreamBatchAssemblerEmptyBatch(t *testing.T) {
+	sut := l2_shared.NewDataStreamBatchAssembler()
+	sut.Reset(5, hash2)
+	entries := []datastreamer.FileEntry{
+		{Type: state.EntryTypeBookMark, Number: 1, Data: state.DSBookMark{Type: state.BookMarkTypeBatch, Value: 5}.Encode()},
+		{Type: state.EntryTypeBookMark, Number: 2, Data: state.DSBookMark{Type: state.BookMarkTypeBatch, Value: 6}.Encode()},
+	}
+	_, err := sut.AddEntry(&entries[0])
+	require.NoError(t, err)
+	batch, err := sut.AddEntry(&entries[1])
+	require.NoError(t, err)
+	require.True(t, batch.Closed)
+	require.Equal(t, 0, len(batch.BatchL2Data))
+	// The StateRoot of an empty batch is the one of the previous batch
+	require.Equal(t, hash2, batch.StateRoot)
+}
+
+func TestDataStreamBatchAssemblerUpdateGEREmptyBatch(t *testing.T) {
+	sut := l2_shared.NewDataStreamBatchAssembler()
+	sut.Reset(5, hash2)
+	entries := []datastreamer.FileEntry{
+		{Type: state.EntryTypeBookMark, Number: 1, Data: state.DSBookMark{Type: state.BookMarkTypeBatch, Value: 5}.Encode()},
+		{Type: state.EntryTypeUpdateGER, Number: 2, Data: state.DSUpdateGER{BatchNumber: 5, Timestamp: 1000, GlobalExitRoot: ger,
+			Coinbase: coinbase, ForkID: uint16(state.FORKID_ETROG), StateRoot: hash1}.Encode()},
+		{Type: state.EntryTypeBookMark, Number: 3, Data: state.DSBookMark{Type: state.BookMarkTypeBatch, Value: 6}.Encode()},
+	}
+	batch, err := sut.AddEntry(&entries[0])
+	require.NoError(t, err)
+	require.Nil(t, batch)
+
+	// The UpdateGER entry updates the open batch
+	openBatch, err := sut.AddEntry(&entries[1])
+	require.NoError(t, err)
+	require.False(t, openBatch.Closed)
+	require.Equal(t, types.ArgUint64(5), openBatch.Number)
+	require.Equal(t, ger, openBatch.GlobalExitRoot)
+	require.Equal(t, coinbase, openBatch.Coinbase)
+	require.Equal(t, types.ArgUint64(1000), openBatch.Timestamp)
+	require.Equal(t, hash1, openBatch.StateRoot)
+	require.Equal(t, 0, len(openBatch.BatchL2Data))
+
+	closedBatch, err := sut.AddEntry(&entries[2])
+	require.NoError(t, err)
+	require.True(t, closedBatch.Closed)
+	require.Equal(t, ger, closedBatch.GlobalExitRoot)
+	require.Equal(t, hash1, closedBatch.StateRoot)
+	require.Equal(t, 0, len(closedBatch.BatchL2Data))
+
+	// An UpdateGER of other batch is not expected
+	_, err = sut.AddEntry(&datastreamer.FileEntry{Type: state.EntryTypeUpdateGER, Number: 4, Data: state.DSUpdateGER{BatchNumber: 8}.Encode()})
+	require.ErrorIs(t, err, l2_shared.ErrUnexpectedDataStreamEntry)
+}
+
+func TestDataStreamTrustedBatchesRetrieveSyncTrustedState(t *testing.T) {
+	ctx := context.Background()
+	clientMock := mock_l2_shared.NewDataStreamClient(t)
+	stateMock := mock_l2_shared.NewStateInterface(t)
+	batchProcessorMock := mock_l2_shared.NewBatchProcessor(t)
+	syncMock := mock_syncinterfaces.NewSynchronizerFlushIDManager(t)
+	dbTxMock := syncMocks.NewDbTxMock(t)
+
+	var processEntry datastreamer.ProcessEntryFunc
+	clientMock.EXPECT().SetProcessEntryFunc(mock.Anything).Run(func(f datastreamer.ProcessEntryFunc) {
+		processEntry = f
+	}).Once()
+	trustedStateMngr := l2_shared.NewTrustedStateManager(commonSync.DefaultTimeProvider{}, time.Hour)
+	sut := l2_shared.NewDataStreamTrustedBatchesRetrieve(batchProcessorMock, clientMock, stateMock, syncMock, *trustedStateMngr, 100*time.Millisecond)
+
+	previousBatch := &state.Batch{BatchNumber: 4, StateRoot: hash2}
+	stateMock.EXPECT().GetBatchByNumber(mock.Anything, uint64(4), mock.Anything).Return(previousBatch, nil)
+	stateMock.EXPECT().GetBatchByNumber(mock.Anything, uint64(5), mock.Anything).Return(nil, state.ErrNotFound)
+	clientMock.EXPECT().Start().Return(nil).Once()
+	bookmark := state.DSBookMark{Type: state.BookMarkTypeBatch, Value: 5}
+	clientMock.EXPECT().ExecCommandStartBookmark(bookmark.Encode()).Run(func(fromBookmark []byte) {
+		go func() {
+			for _, entry := range dataStreamEntries(t, 5, 100) {
+				entry := entry
+				_ = processEntry(&entry, nil, nil)
+			}
+		}()
+	}).Return(nil).Once()
+
+	stateMock.EXPECT().BeginStateTransaction(mock.Anything).Return(dbTxMock, nil).Times(2)
+	batchProcessorMock.EXPECT().ProcessTrustedBatch(mock.Anything, mock.MatchedBy(func(batch *types.Batch) bool {
+		return batch.Number == 5 && !batch.Closed && batch.StateRoot == hash1
+	}), mock.Anything, dbTxMock, mock.Anything).Return(nil, nil).Once()
+	batchProcessorMock.EXPECT().ProcessTrustedBatch(mock.Anything, mock.MatchedBy(func(batch *types.Batch) bool {
+		return batch.Number == 5 && batch.Closed
+	}), mock.Anything, dbTxMock, mock.Anything).Return(nil, nil).Once()
+	syncMock.EXPECT().CheckFlushID(dbTxMock).Return(nil).Times(2)
+	dbTxMock.EXPECT().Commit(mock.Anything).Return(nil).Times(2)
+
+	err := sut.SyncTrustedState(ctx, 5, 5)
+	require.NoError(t, err)
+}
+
+func TestDataStreamTrustedBatchesRetrieveMissingBookmark(t *testing.T) {
+	ctx := context.Background()
+	clientMock := mock_l2_shared.NewDataStreamClient(t)
+	stateMock := mock_l2_shared.NewStateInterface(t)
+	clientMock.EXPECT().SetProcessEntryFunc(mock.Anything).Once()
+	trustedStateMngr := l2_shared.NewTrustedStateManager(commonSync.DefaultTimeProvider{}, time.Hour)
+	sut := l2_shared.NewDataStreamTrustedBatchesRetrieve(mock_l2_shared.NewBatchProcessor(t), clientMock, stateMock,
+		mock_syncinterfaces.NewSynchronizerFlushIDManager(t), *trustedStateMngr, 100*time.Millisecond)
+
+	stateMock.EXPECT().GetBatchByNumber(mock.Anything, uint64(4), mock.Anything).Return(nil, state.ErrNotFound)
+	clientMock.EXPECT().Start().Return(nil).Once()
+	clientMock.EXPECT().ExecCommandStartBookmark(mock.Anything).Return(errors.New("bookmark not found")).Once()
+
+	// The L1 synchronization must go on if the batch is not on the data stream
+	err := sut.SyncTrustedState(ctx, 5, 10)
+	require.ErrorIs(t, err, syncinterfaces.ErrCantSyncFromL2)
+}
+
+func TestDataStreamTrustedBatchesRetrieveEntriesOverflow(t *testing.T) {
+	ctx := context.Background()
+	clientMock := mock_l2_shared.NewDataStreamClient(t)
+	stateMock := mock_l2_shared.NewStateInterface(t)
+	batchProcessorMock := mock_l2_shared.NewBatchProcessor(t)
+	syncMock := mock_syncinterfaces.NewSynchronizerFlushIDManager(t)
+	dbTxMock := syncMocks.NewDbTxMock(t)
+
+	var processEntry datastreamer.ProcessEntryFunc
+	clientMock.EXPECT().SetProcessEntryFunc(mock.Anything).Run(func(f datastreamer.ProcessEntryFunc) {
+		processEntry = f
+	}).Once()
+	trustedStateMngr := l2_shared.NewTrustedStateManager(commonSync.DefaultTimeProvider{}, time.Hour)
+	sut := l2_shared.NewDataStreamTrustedBatchesRetrieve(batchProcessorMock, clientMock, stateMock, syncMock, *trustedStateMngr, 100*time.Millisecond)
+
+	stateMock.EXPECT().GetBatchByNumber(mock.Anything, uint64(4), mock.Anything).Return(&state.Batch{BatchNumber: 4, StateRoot: hash2}, nil)
+	stateMock.EXPECT().GetBatchByNumber(mock.Anything, uint64(5), mock.Anything).Return(nil, state.ErrNotFound)
+	clientMock.EXPECT().Start().Return(nil).Once()
+	bookmark := state.DSBookMark{Type: state.BookMarkTypeBatch, Value: 5}
+	// The first streaming sends more entries than the buffer can hold without blocking the client
+	clientMock.EXPECT().ExecCommandStartBookmark(bookmark.Encode()).Run(func(fromBookmark []byte) {
+		_ = processEntry(&datastreamer.FileEntry{Type: state.EntryTypeBookMark, Number: 1, Data: bookmark.Encode()}, nil, nil)
+		for i := uint64(2); i <= 1100; i++ {
+			_ = processEntry(&datastreamer.FileEntry{Type: state.EntryTypeBookMark, Number: i,
+				Data: state.DSBookMark{Type: state.BookMarkTypeL2Block, Value: i}.Encode()}, nil, nil)
+		}
+	}).Return(nil).Once()
+	// Once the buffered entries are processed the streaming is restarted from the current batch
+	clientMock.EXPECT().ExecCommandStop().Return(nil).Once()
+	clientMock.EXPECT().ExecCommandStartBookmark(bookmark.Encode()).Run(func(fromBookmark []byte) {
+		go func() {
+			for _, entry := range dataStreamEntries(t, 5, 100) {
+				entry := entry
+				_ = processEntry(&entry, nil, nil)
+			}
+		}()
+	}).Return(nil).Once()
+
+	stateMock.EXPECT().BeginStateTransaction(mock.Anything).Return(dbTxMock, nil).Times(2)
+	batchProcessorMock.EXPECT().ProcessTrustedBatch(mock.Anything, mock.MatchedBy(func(batch *types.Batch) bool {
+		return batch.Number == 5 && !batch.Closed
+	}), mock.Anything, dbTxMock, mock.Anything).Return(nil, nil).Once()
+	batchProcessorMock.EXPECT().ProcessTrustedBatch(mock.Anything, mock.MatchedBy(func(batch *types.Batch) bool {
+		return batch.Number == 5 && batch.Closed
+	}), mock.Anything, dbTxMock, mock.Anything).Return(nil, nil).Once()
+	syncMock.EXPECT().CheckFlushID(dbTxMock).Return(nil).Times(2)
+	dbTxMock.EXPECT().Commit(mock.Anything).Return(nil).Times(2)
+
+	err := sut.SyncTrustedState(ctx, 5, 5)
+	require.NoError(t, err)
+}
@@ -0,0 +1,247 @@
+/*
+object DataStreamTrustedBatchesRetrieve:
+- It gets the trusted batches from the data stream of the trusted sequencer, so the L2 blocks are processed as soon as they are published
+
+The batches are processed with the same BatchProcessor used by TrustedBatchesRetrieve
+*/
+package l2_shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-data-streamer/datastreamer"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/state"
+	"github.com/0xPolygonHermez/zkevm-node/synchronizer/common/syncinterfaces"
+)
+
+const (
+	// dataStreamEntriesBufferSize is the number of entries buffered while the L1 synchronization is done
+	dataStreamEntriesBufferSize = 1000
+)
+
+// DataStreamClient contains the methods required to consume the data stream
+type DataStreamClient interface {
+	Start() error
+	ExecCommandStartBookmark(fromBookmark []byte) error
+	ExecCommandStop() error
+	SetProcessEntryFunc(f datastreamer.ProcessEntryFunc)
+}
+
+// DataStreamTrustedBatchesRetrieve it gets the trusted batches from the data stream and for each L2 block
+// received calls the ProcessTrustedBatch method of the BatchProcessor interface
+type DataStreamTrustedBatchesRetrieve struct {
+	batchExecutor          BatchProcessor
+	client                 DataStreamClient
+	state                  StateInterface
+	sync                   syncinterfaces.SynchronizerFlushIDManager
+	TrustedStateMngr       TrustedStateManager
+	assembler              *DataStreamBatchAssembler
+	entries                chan datastreamer.FileEntry
+	entriesOverflowed      atomic.Bool
+	syncDuration           time.Duration
+	firstBatchNumberToSync uint64
+	connecting             chan error
+	connected              bool
+	streaming              bool
+	restartStreaming       bool
+}
+
+// NewDataStreamTrustedBatchesRetrieve creates a new DataStreamTrustedBatchesRetrieve. Each call to SyncTrustedState
+// consumes the data stream during syncDuration, so the L1 synchronization is done between them
+func NewDataStreamTrustedBatchesRetrieve(batchExecutor BatchProcessor,
+	client DataStreamClient,
+	state StateInterface,
+	sync syncinterfaces.SynchronizerFlushIDManager,
+	TrustedStateMngr TrustedStateManager,
+	syncDuration time.Duration,
+) *DataStreamTrustedBatchesRetrieve {
+	res := &DataStreamTrustedBatchesRetrieve{
+		batchExecutor:          batchExecutor,
+		client:                 client,
+		state:                  state,
+		sync:                   sync,
+		TrustedStateMngr:       TrustedStateMngr,
+		assembler:              NewDataStreamBatchAssembler(),
+		entries:                make(chan datastreamer.FileEntry, dataStreamEntriesBufferSize),
+		syncDuration:           syncDuration,
+		firstBatchNumberToSync: firstTrustedBatchNumber,
+	}
+	client.SetProcessEntryFunc(res.processEntry)
+	return res
+}
+
+// CleanTrustedState Clean cache of TrustedBatches and restart the streaming from the last batch on state
+func (s *DataStreamTrustedBatchesRetrieve) CleanTrustedState() {
+	s.TrustedStateMngr.Clear()
+	s.restartStreaming = true
+}
+
+// GetCachedBatch implements syncinterfaces.SyncTrustedStateExecutor. Returns a cached batch
+func (s *DataStreamTrustedBatchesRetrieve) GetCachedBatch(batchNumber uint64) *state.Batch {
+	return s.TrustedStateMngr.Cache.GetOrDefault(batchNumber, nil)
+}
+
+// SyncTrustedState processes the batches received from the data stream, starting from latestSyncedBatch, during syncDuration
+// or until a batch newer than maximumBatchNumberToProcess is received
+func (s *DataStreamTrustedBatchesRetrieve) SyncTrustedState(ctx context.Context, latestSyncedBatch uint64, maximumBatchNumberToProcess uint64) error {
+	if latestSyncedBatch == 0 {
+		log.Info("syncTrustedState: latestSyncedBatch is 0, assuming first batch as 1")
+		latestSyncedBatch = 1
+	}
+	batchNumberToSync := max(latestSyncedBatch, s.firstBatchNumberToSync)
+	if batchNumberToSync > maximumBatchNumberToProcess {
+		log.Infof("syncTrustedState: batch %d is over the maximum batch to process %d", batchNumberToSync, maximumBatchNumberToProcess)
+		return nil
+	}
+	// The streaming is restarted if the state is ahead, because the batches have been synced from L1
+	if !s.streaming || s.restartStreaming || batchNumberToSync > s.assembler.BatchNumber() {
+		err := s.startStreaming(ctx, batchNumberToSync)
+		if err != nil {
+			return err
+		}
+	}
+
+	timeout := time.NewTimer(s.syncDuration)
+	defer timeout.Stop()
+	for {
+		if s.entriesOverflowed.Load() && len(s.entries) == 0 {
+			// The buffered entries have been processed, the discarded ones are received again from the current batch
+			log.Warnf("syncTrustedState: data stream entries buffer was full, restarting data stream from batch %d", s.assembler.BatchNumber())
+			err := s.startStreaming(ctx, s.assembler.BatchNumber())
+			if err != nil {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timeout.C:
+			log.Infof("syncTrustedState: synchronized from data stream until batch %d", s.assembler.BatchNumber())
+			return nil
+		case entry := <-s.entries:
+			batchToSync, err := s.assembler.AddEntry(&entry)
+			if err != nil {
+				log.Errorf("syncTrustedState: error processing data stream entry %d. Error: %v", entry.Number, err)
+				s.CleanTrustedState()
+				return err
+			}
+			if batchToSync == nil {
+				continue
+			}
+			if uint64(batchToSync.Number) > maximumBatchNumberToProcess {
+				log.Infof("syncTrustedState: batch %d is over the maximum batch to process %d", batchToSync.Number, maximumBatchNumberToProcess)
+				s.restartStreaming = true
+				return nil
+			}
+			debugPrefix := fmt.Sprintf("syncTrustedState: batch[%d] (datastream entry %d)", batchToSync.Number, entry.Number)
+			err = processTrustedBatch(ctx, s.batchExecutor, s.state, s.sync, &s.TrustedStateMngr, batchToSync, debugPrefix)
+			if err != nil {
+				s.restartStreaming = true
+				return err
+			}
+		}
+	}
+}
+
+// processEntry is the callback of the data stream client, the entries are processed by SyncTrustedState.
+// It never blocks the client: when the buffer is full, because the L1 synchronization takes longer than the
+// buffered entries, the next entries are discarded and SyncTrustedState restarts the streaming from the
+// current batch once the buffered ones are processed
+func (s *DataStreamTrustedBatchesRetrieve) processEntry(entry *datastreamer.FileEntry, _ *datastreamer.StreamClient, _ *datastreamer.StreamServer) error {
+	if s.entriesOverflowed.Load() {
+		return nil
+	}
+	select {
+	case s.entries <- *entry:
+	default:
+		log.Warnf("syncTrustedState: data stream entries buffer is full, discarding entries from entry %d", entry.Number)
+		s.entriesOverflowed.Store(true)
+	}
+	return nil
+}
+
+// connect starts the data stream client, it waits up to syncDuration for the connection to the server
+func (s *DataStreamTrustedBatchesRetrieve) connect(ctx context.Context) error {
+	if s.connected {
+		return nil
+	}
+	if s.connecting == nil {
+		// Start blocks until the connection to the server is established
+		s.connecting = make(chan error, 1)
+		go func() {
+			s.connecting <- s.client.Start()
+		}()
+	}
+	select {
+	case err := <-s.connecting:
+		s.connecting = nil
+		if err != nil {
+			log.Errorf("syncTrustedState: error starting data stream client. Error: %v", err)
+			return err
+		}
+		s.connected = true
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(s.syncDuration):
+		log.Warnf("syncTrustedState: can't connect to the data stream server")
+		return syncinterfaces.ErrCantSyncFromL2
+	}
+}
+
+func (s *DataStreamTrustedBatchesRetrieve) startStreaming(ctx context.Context, batchNumber uint64) error {
+	err := s.connect(ctx)
+	if err != nil {
+		return err
+	}
+	if s.streaming {
+		err = s.stopStreaming()
+		if err != nil {
+			log.Errorf("syncTrustedState: error stopping data stream. Error: %v", err)
+			return err
+		}
+	}
+
+	// The previous batch is required for the StateRoot of an empty batch
+	previousBatch, err := s.state.GetBatchByNumber(ctx, batchNumber-1, nil)
+	if err != nil && !errors.Is(err, state.ErrNotFound) {
+		log.Errorf("syncTrustedState: error getting batch %d from state. Error: %v", batchNumber-1, err)
+		return err
+	}
+	previousStateRoot := state.ZeroHash
+	if previousBatch != nil {
+		previousStateRoot = previousBatch.StateRoot
+	}
+	s.TrustedStateMngr.Clear()
+	s.assembler.Reset(batchNumber, previousStateRoot)
+
+	log.Infof("syncTrustedState: starting data stream from batch %d", batchNumber)
+	bookmark := state.DSBookMark{
+		Type:  state.BookMarkTypeBatch,
+		Value: batchNumber,
+	}
+	err = s.client.ExecCommandStartBookmark(bookmark.Encode())
+	if err != nil {
+		// The bookmark of the batch could be missing on the data stream, so the L1 synchronization can't be stopped by it
+		return fmt.Errorf("failed to start data stream from batch %d: %v. Err: %w", batchNumber, err, syncinterfaces.ErrCantSyncFromL2)
+	}
+	s.streaming = true
+	s.restartStreaming = false
+	return nil
+}
+
+func (s *DataStreamTrustedBatchesRetrieve) stopStreaming() error {
+	err := s.client.ExecCommandStop()
+	// The pending entries are discarded, the new streaming sends them again
+	for len(s.entries) > 0 {
+		<-s.entries
+	}
+	s.entriesOverflowed.Store(false)
+	s.streaming = false
+	return err
+}
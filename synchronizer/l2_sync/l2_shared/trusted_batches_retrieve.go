@@ -121,45 +121,10 @@ func (s *TrustedBatchesRetrieve) syncTrustedBatchesToFrom(ctx context.Context, l
 			return err
 		}
 
-		dbTx, err := s.state.BeginStateTransaction(ctx)
+		err = processTrustedBatch(ctx, s.batchExecutor, s.state, s.sync, &s.TrustedStateMngr, batchToSync, debugPrefix)
 		if err != nil {
-			log.Errorf("%s error creating db transaction to sync trusted batch %d: %v", debugPrefix, batchNumberToSync, err)
 			return err
 		}
-		start = time.Now()
-		previousStatus, err := s.TrustedStateMngr.GetStateForWorkingBatch(ctx, batchNumberToSync, s.state, dbTx)
-		if err != nil {
-			log.Errorf("%s error getting current batches to sync trusted batch %d: %v", debugPrefix, batchNumberToSync, err)
-			return rollback(ctx, dbTx, err)
-		}
-		log.Debugf("%s processing trusted batch %d", debugPrefix, batchNumberToSync)
-		newTrustedState, err := s.batchExecutor.ProcessTrustedBatch(ctx, batchToSync, *previousStatus, dbTx, debugPrefix)
-		metrics.ProcessTrustedBatchTime(time.Since(start))
-		if err != nil {
-			log.Errorf("%s error processing trusted batch %d: %v", debugPrefix, batchNumberToSync, err)
-			s.TrustedStateMngr.Clear()
-			return rollback(ctx, dbTx, err)
-		}
-		log.Debugf("%s Checking FlushID to commit trustedState data to db", debugPrefix)
-		err = s.sync.CheckFlushID(dbTx)
-		if err != nil {
-			log.Errorf("%s error checking flushID. Error: %v", debugPrefix, err)
-			s.TrustedStateMngr.Clear()
-			return rollback(ctx, dbTx, err)
-		}
-
-		if err := dbTx.Commit(ctx); err != nil {
-			log.Errorf("%s error committing db transaction to sync trusted batch %v: %v", debugPrefix, batchNumberToSync, err)
-			s.TrustedStateMngr.Clear()
-			return err
-		}
-		// Update cache with result
-		if newTrustedState != nil {
-			s.TrustedStateMngr.Set(newTrustedState.LastTrustedBatches[0])
-			s.TrustedStateMngr.Set(newTrustedState.LastTrustedBatches[1])
-		} else {
-			s.TrustedStateMngr.Clear()
-		}
 		batchNumberToSync++
 	}
 
@@ -167,6 +132,52 @@ func (s *TrustedBatchesRetrieve) syncTrustedBatchesToFrom(ctx context.Context, l
 	return nil
 }
 
+// processTrustedBatch processes the trusted batch on its own db transaction and updates the cache of trusted batches with the result
+func processTrustedBatch(ctx context.Context, batchExecutor BatchProcessor, st StateInterface, sync syncinterfaces.SynchronizerFlushIDManager,
+	trustedStateMngr *TrustedStateManager, batchToSync *types.Batch, debugPrefix string) error {
+	batchNumberToSync := uint64(batchToSync.Number)
+	dbTx, err := st.BeginStateTransaction(ctx)
+	if err != nil {
+		log.Errorf("%s error creating db transaction to sync trusted batch %d: %v", debugPrefix, batchNumberToSync, err)
+		return err
+	}
+	start := time.Now()
+	previousStatus, err := trustedStateMngr.GetStateForWorkingBatch(ctx, batchNumberToSync, st, dbTx)
+	if err != nil {
+		log.Errorf("%s error getting current batches to sync trusted batch %d: %v", debugPrefix, batchNumberToSync, err)
+		return rollback(ctx, dbTx, err)
+	}
+	log.Debugf("%s processing trusted batch %d", debugPrefix, batchNumberToSync)
+	newTrustedState, err := batchExecutor.ProcessTrustedBatch(ctx, batchToSync, *previousStatus, dbTx, debugPrefix)
+	metrics.ProcessTrustedBatchTime(time.Since(start))
+	if err != nil {
+		log.Errorf("%s error processing trusted batch %d: %v", debugPrefix, batchNumberToSync, err)
+		trustedStateMngr.Clear()
+		return rollback(ctx, dbTx, err)
+	}
+	log.Debugf("%s Checking FlushID to commit trustedState data to db", debugPrefix)
+	err = sync.CheckFlushID(dbTx)
+	if err != nil {
+		log.Errorf("%s error checking flushID. Error: %v", debugPrefix, err)
+		trustedStateMngr.Clear()
+		return rollback(ctx, dbTx, err)
+	}
+
+	if err := dbTx.Commit(ctx); err != nil {
+		log.Errorf("%s error committing db transaction to sync trusted batch %v: %v", debugPrefix, batchNumberToSync, err)
+		trustedStateMngr.Clear()
+		return err
+	}
+	// Update cache with result
+	if newTrustedState != nil {
+		trustedStateMngr.Set(newTrustedState.LastTrustedBatches[0])
+		trustedStateMngr.Set(newTrustedState.LastTrustedBatches[1])
+	} else {
+		trustedStateMngr.Clear()
+	}
+	return nil
+}
+
 func rollback(ctx context.Context, dbTx pgx.Tx, err error) error {
 	rollbackErr := dbTx.Rollback(ctx)
 	if rollbackErr != nil {
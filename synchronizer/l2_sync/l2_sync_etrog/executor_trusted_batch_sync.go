@@ -148,6 +148,9 @@ func (b *SyncTrustedBatchExecutorForEtrog) FullProcess(ctx context.Context, data
 		return nil, err
 	}
 
+	if data.LocalExitRootFromExecution {
+		data.TrustedBatch.LocalExitRoot = processBatchResp.NewLocalExitRoot
+	}
 	err = batchResultSanityCheck(data, processBatchResp, debugStr)
 	if err != nil {
 		log.Errorf("%s error batchResultSanityCheck. Error: %s", data.DebugPrefix, err.Error())
@@ -213,6 +216,9 @@ func (b *SyncTrustedBatchExecutorForEtrog) IncrementalProcess(ctx context.Contex
 		return nil, err
 	}
 
+	if data.LocalExitRootFromExecution {
+		data.TrustedBatch.LocalExitRoot = processBatchResp.NewLocalExitRoot
+	}
 	err = batchResultSanityCheck(data, processBatchResp, debugStr)
 	if err != nil {
 		log.Errorf("%s error batchResultSanityCheck. Error: %s", data.DebugPrefix, err.Error())
@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/0xPolygonHermez/zkevm-data-streamer/datastreamer"
 	"github.com/0xPolygonHermez/zkevm-node/etherman"
 	"github.com/0xPolygonHermez/zkevm-node/event"
 	"github.com/0xPolygonHermez/zkevm-node/log"
@@ -18,6 +19,7 @@ import (
 	"github.com/0xPolygonHermez/zkevm-node/synchronizer/common/syncinterfaces"
 	"github.com/0xPolygonHermez/zkevm-node/synchronizer/l1_parallel_sync"
 	"github.com/0xPolygonHermez/zkevm-node/synchronizer/l1event_orders"
+	"github.com/0xPolygonHermez/zkevm-node/synchronizer/l2_sync"
 	"github.com/0xPolygonHermez/zkevm-node/synchronizer/l2_sync/l2_shared"
 	"github.com/0xPolygonHermez/zkevm-node/synchronizer/l2_sync/l2_sync_etrog"
 	"github.com/0xPolygonHermez/zkevm-node/synchronizer/metrics"
@@ -62,6 +64,7 @@ type ClientSynchronizer struct {
 	zkEVMClient                   syncinterfaces.ZKEVMClientInterface
 	zkEVMClientEthereumCompatible syncinterfaces.ZKEVMClientEthereumCompatibleInterface
 	eventLog                      syncinterfaces.EventLogInterface
+	dataAvailability              syncinterfaces.BatchDataProvider
 	ctx                           context.Context
 	cancelCtx                     context.CancelFunc
 	genesis                       state.Genesis
@@ -92,6 +95,7 @@ func NewSynchronizer(
 	zkEVMClient syncinterfaces.ZKEVMClientInterface,
 	zkEVMClientEthereumCompatible syncinterfaces.ZKEVMClientEthereumCompatibleInterface,
 	eventLog syncinterfaces.EventLogInterface,
+	dataAvailability syncinterfaces.BatchDataProvider,
 	genesis state.Genesis,
 	cfg Config,
 	runInDevelopmentMode bool) (Synchronizer, error) {
@@ -116,6 +120,7 @@ func NewSynchronizer(
 		zkEVMClient:                   zkEVMClient,
 		zkEVMClientEthereumCompatible: zkEVMClientEthereumCompatible,
 		eventLog:                      eventLog,
+		dataAvailability:              dataAvailability,
 		genesis:                       genesis,
 		cfg:                           cfg,
 		proverID:                      "",
@@ -138,7 +143,25 @@ func NewSynchronizer(
 			executor.AddPostChecker(l2_shared.NewPostClosedBatchCheckL2Block(res.state))
 		}
 
-		syncTrustedStateEtrog := l2_shared.NewTrustedBatchesRetrieve(executor, zkEVMClient, res.state, *sync, *l2_shared.NewTrustedStateManager(syncCommon.DefaultTimeProvider{}, timeOfLiveBatchOnCache))
+		trustedStateMngr := *l2_shared.NewTrustedStateManager(syncCommon.DefaultTimeProvider{}, timeOfLiveBatchOnCache)
+		var syncTrustedStateEtrog syncinterfaces.SyncTrustedStateExecutor
+		switch cfg.L2Synchronization.SyncMode {
+		case l2_sync.JSONRPCSyncMode, "":
+			log.Info("L2Synchronization SyncMode is jsonrpc")
+			syncTrustedStateEtrog = l2_shared.NewTrustedBatchesRetrieve(executor, zkEVMClient, res.state, *sync, trustedStateMngr)
+		case l2_sync.DataStreamSyncMode:
+			log.Infof("L2Synchronization SyncMode is datastream, server: %s", cfg.L2Synchronization.DataStreamServer)
+			dataStreamClient, err := datastreamer.NewClient(cfg.L2Synchronization.DataStreamServer, state.StreamTypeSequencer)
+			if err != nil {
+				log.Errorf("error creating data stream client. Error: %v", err)
+				cancel()
+				return nil, err
+			}
+			syncTrustedStateEtrog = l2_shared.NewDataStreamTrustedBatchesRetrieve(executor, dataStreamClient, res.state, *sync, trustedStateMngr, cfg.SyncInterval.Duration)
+		default:
+			cancel()
+			return nil, fmt.Errorf("L2Synchronization SyncMode %s is not valid. Valid values are: %s, %s", cfg.L2Synchronization.SyncMode, l2_sync.JSONRPCSyncMode, l2_sync.DataStreamSyncMode)
+		}
 		res.syncTrustedStateExecutor = l2_shared.NewSyncTrustedStateExecutorSelector(map[uint64]syncinterfaces.SyncTrustedStateExecutor{
 			uint64(state.FORKID_ETROG):        syncTrustedStateEtrog,
 			uint64(state.FORKID_ELDERBERRY):   syncTrustedStateEtrog,
@@ -453,6 +476,10 @@ func (s *ClientSynchronizer) Sync() error {
 					}
 				}
 				waitDuration = s.cfg.SyncInterval.Duration
+				if s.syncTrustedStateExecutor != nil && !s.isTrustedSequencer && err == nil && s.cfg.L2Synchronization.SyncMode == l2_sync.DataStreamSyncMode {
+					// The trusted state has been consuming the data stream during SyncInterval, so there is no need to wait
+					waitDuration = 0
+				}
 			}
 			//Sync L1Blocks
 			startL1 := time.Now()
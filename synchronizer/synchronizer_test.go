@@ -50,7 +50,7 @@ type mocks struct {
 func TestGivenPermissionlessNodeWhenSyncronizeAgainSameBatchThenUseTheOneInMemoryInstaeadOfGettingFromDb(t *testing.T) {
 	genesis, cfg, m := setupGenericTest(t)
 	ethermanForL1 := []syncinterfaces.EthermanFullInterface{m.Etherman}
-	syncInterface, err := NewSynchronizer(false, m.Etherman, ethermanForL1, m.State, m.Pool, m.EthTxManager, m.ZKEVMClient, m.zkEVMClientEthereumCompatible, nil, *genesis, *cfg, false)
+	syncInterface, err := NewSynchronizer(false, m.Etherman, ethermanForL1, m.State, m.Pool, m.EthTxManager, m.ZKEVMClient, m.zkEVMClientEthereumCompatible, nil, nil, *genesis, *cfg, false)
 	require.NoError(t, err)
 	sync, ok := syncInterface.(*ClientSynchronizer)
 	require.EqualValues(t, true, ok, "Can't convert to underlaying struct the interface of syncronizer")
@@ -90,7 +90,7 @@ func TestGivenPermissionlessNodeWhenSyncronizeAgainSameBatchThenUseTheOneInMemor
 func TestGivenPermissionlessNodeWhenSyncronizeFirstTimeABatchThenStoreItInALocalVar(t *testing.T) {
 	genesis, cfg, m := setupGenericTest(t)
 	ethermanForL1 := []syncinterfaces.EthermanFullInterface{m.Etherman}
-	syncInterface, err := NewSynchronizer(false, m.Etherman, ethermanForL1, m.State, m.Pool, m.EthTxManager, m.ZKEVMClient, m.zkEVMClientEthereumCompatible, nil, *genesis, *cfg, false)
+	syncInterface, err := NewSynchronizer(false, m.Etherman, ethermanForL1, m.State, m.Pool, m.EthTxManager, m.ZKEVMClient, m.zkEVMClientEthereumCompatible, nil, nil, *genesis, *cfg, false)
 	require.NoError(t, err)
 	sync, ok := syncInterface.(*ClientSynchronizer)
 	require.EqualValues(t, true, ok, "Can't convert to underlaying struct the interface of syncronizer")
@@ -139,7 +139,7 @@ func TestForcedBatchEtrog(t *testing.T) {
 		ZKEVMClient: mock_syncinterfaces.NewZKEVMClientInterface(t),
 	}
 	ethermanForL1 := []syncinterfaces.EthermanFullInterface{m.Etherman}
-	sync, err := NewSynchronizer(false, m.Etherman, ethermanForL1, m.State, m.Pool, m.EthTxManager, m.ZKEVMClient, m.zkEVMClientEthereumCompatible, nil, genesis, cfg, false)
+	sync, err := NewSynchronizer(false, m.Etherman, ethermanForL1, m.State, m.Pool, m.EthTxManager, m.ZKEVMClient, m.zkEVMClientEthereumCompatible, nil, nil, genesis, cfg, false)
 	require.NoError(t, err)
 
 	// state preparation
@@ -405,7 +405,7 @@ func TestSequenceForcedBatchIncaberry(t *testing.T) {
 		ZKEVMClient: mock_syncinterfaces.NewZKEVMClientInterface(t),
 	}
 	ethermanForL1 := []syncinterfaces.EthermanFullInterface{m.Etherman}
-	sync, err := NewSynchronizer(true, m.Etherman, ethermanForL1, m.State, m.Pool, m.EthTxManager, m.ZKEVMClient, m.zkEVMClientEthereumCompatible, nil, genesis, cfg, false)
+	sync, err := NewSynchronizer(true, m.Etherman, ethermanForL1, m.State, m.Pool, m.EthTxManager, m.ZKEVMClient, m.zkEVMClientEthereumCompatible, nil, nil, genesis, cfg, false)
 	require.NoError(t, err)
 	parentHash := common.HexToHash("0x111")
 	ethHeader := &ethTypes.Header{Number: big.NewInt(123456), ParentHash: parentHash}
@@ -637,6 +637,14 @@ func TestSequenceForcedBatchIncaberry(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestNewSynchronizerInvalidL2SyncMode(t *testing.T) {
+	genesis, cfg, m := setupGenericTest(t)
+	cfg.L2Synchronization.SyncMode = "invalid"
+	ethermanForL1 := []syncinterfaces.EthermanFullInterface{m.Etherman}
+	_, err := NewSynchronizer(false, m.Etherman, ethermanForL1, m.State, m.Pool, m.EthTxManager, m.ZKEVMClient, m.zkEVMClientEthereumCompatible, nil, nil, *genesis, *cfg, false)
+	require.Error(t, err)
+}
+
 func setupGenericTest(t *testing.T) (*state.Genesis, *Config, *mocks) {
 	genesis := state.Genesis{
 		BlockNumber: uint64(123456),
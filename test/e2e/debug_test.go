@@ -19,6 +19,7 @@ import (
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/require"
 )
 
@@ -788,6 +789,98 @@ func Test_DebugFirstBatch(t *testing.T) {
 	require.NotNil(t, response.Result)
 }
 
+func Test_DebugTraceCallAndTraceBlock(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	const l2NetworkURL = "http://localhost:8124"
+	const l2ExplorerRPCComponentName = "l2-explorer-json-rpc"
+
+	var err error
+	if !dockersArePreLaunchedForDebugTests {
+		err = operations.Teardown()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, operations.Teardown())
+			require.NoError(t, operations.StopComponent(l2ExplorerRPCComponentName))
+		}()
+	}
+
+	ctx := context.Background()
+	opsCfg := operations.GetDefaultOperationsConfig()
+	if !dockersArePreLaunchedForDebugTests {
+		opsMan, err := operations.NewManager(ctx, opsCfg)
+		require.NoError(t, err)
+		err = opsMan.Setup()
+		require.NoError(t, err)
+
+		err = operations.StartComponent(l2ExplorerRPCComponentName, func() (bool, error) { return operations.NodeUpCondition(l2NetworkURL) })
+		require.NoError(t, err)
+	} else {
+		log.Info("Using pre-launched dockers: no reset Database")
+	}
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	toAddress := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	ethereumClient := operations.MustGetClient(l2NetworkURL)
+	sourceAuth := operations.MustGetAuth(operations.DefaultSequencerPrivateKey, operations.DefaultL2ChainID)
+
+	debugOptions := map[string]interface{}{
+		"tracer": "callTracer",
+	}
+
+	// trace a call that is not sent to the network
+	callArgs := map[string]interface{}{
+		"from":  sourceAuth.From.String(),
+		"to":    toAddress.String(),
+		"value": hex.EncodeBig(big.NewInt(1000)),
+	}
+	response, err := client.JSONRPCCall(l2NetworkURL, "debug_traceCall", callArgs, "latest", debugOptions)
+	require.NoError(t, err)
+	require.Nil(t, response.Error)
+	callTrace := convertJson(t, response.Result, "debug_traceCall")
+	require.Equal(t, "CALL", callTrace["type"])
+	require.Equal(t, strings.ToLower(toAddress.String()), strings.ToLower(callTrace["to"].(string)))
+
+	// trace a block provided RLP encoded
+	nonce, err := ethereumClient.PendingNonceAt(ctx, sourceAuth.From)
+	require.NoError(t, err)
+	gasPrice, err := ethereumClient.SuggestGasPrice(ctx)
+	require.NoError(t, err)
+	tx := ethTypes.NewTx(&ethTypes.LegacyTx{
+		To:       &toAddress,
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Value:    big.NewInt(1000),
+		Gas:      21000,
+	})
+	signedTx, err := sourceAuth.Signer(sourceAuth.From, tx)
+	require.NoError(t, err)
+	err = ethereumClient.SendTransaction(ctx, signedTx)
+	require.NoError(t, err)
+	err = operations.WaitTxToBeMined(ctx, ethereumClient, signedTx, operations.DefaultTimeoutTxToBeMined)
+	require.NoError(t, err)
+
+	receipt, err := ethereumClient.TransactionReceipt(ctx, signedTx.Hash())
+	require.NoError(t, err)
+	block, err := ethereumClient.BlockByHash(ctx, receipt.BlockHash)
+	require.NoError(t, err)
+	blockRLP, err := rlp.EncodeToBytes(block)
+	require.NoError(t, err)
+
+	response, err = client.JSONRPCCall(l2NetworkURL, "debug_traceBlock", hex.EncodeToHex(blockRLP), debugOptions)
+	require.NoError(t, err)
+	require.Nil(t, response.Error)
+	traces := []interface{}{}
+	err = json.Unmarshal(response.Result, &traces)
+	require.NoError(t, err)
+	require.Equal(t, len(block.Transactions()), len(traces))
+}
+
 func getTxInResponseDebugTest(t *testing.T, response json.RawMessage, txIndex uint, debugPrefix string) map[string]interface{} {
 	valueMap := []interface{}{}
 	err := json.Unmarshal(response, &valueMap)